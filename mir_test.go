@@ -21,9 +21,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/filecoin-project/mir"
+	"github.com/filecoin-project/mir/pkg/availability/multisigcollector"
 	"github.com/filecoin-project/mir/pkg/deploytest"
 	"github.com/filecoin-project/mir/pkg/iss"
 	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool"
+	"github.com/filecoin-project/mir/pkg/mempool/txvalidator"
 	"github.com/filecoin-project/mir/pkg/modules"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/testsim"
@@ -53,6 +56,10 @@ type TestConfig struct {
 	Duration            time.Duration
 	Directory           string
 	SlowProposeReplicas map[int]bool
+	AdaptiveBatching    bool
+	MACAuthentication   bool
+	AvailabilityLayer   bool
+	SingleNodeRequests  bool
 	Logger              logging.Logger
 }
 
@@ -187,6 +194,57 @@ func testIntegrationWithISS(t *testing.T) {
 				NumFakeRequests: 100,
 				Duration:        10 * time.Second,
 			}},
+		16: {"Submit 100 fake requests with 4 nodes in simulation with adaptive batching",
+			&TestConfig{
+				NumReplicas:      4,
+				NumClients:       0,
+				Transport:        "sim",
+				NumFakeRequests:  100,
+				Duration:         10 * time.Second,
+				AdaptiveBatching: true,
+			}},
+		17: {"Submit 100 fake requests with 4 nodes in simulation with MAC authentication",
+			&TestConfig{
+				NumReplicas:       4,
+				NumClients:        0,
+				Transport:         "sim",
+				NumFakeRequests:   100,
+				Duration:          10 * time.Second,
+				MACAuthentication: true,
+			}},
+		18: {"Do nothing with 4 nodes in simulation with the availability layer",
+			&TestConfig{
+				NumReplicas:       4,
+				Transport:         "sim",
+				Duration:          4 * time.Second,
+				AvailabilityLayer: true,
+			}},
+		19: {"Submit 100 fake requests with 1 node in simulation with the availability layer",
+			&TestConfig{
+				NumReplicas:       1,
+				Transport:         "sim",
+				NumFakeRequests:   100,
+				Duration:          10 * time.Second,
+				AvailabilityLayer: true,
+			}},
+		20: {"Submit 100 fake requests with 4 nodes in simulation with the availability layer",
+			&TestConfig{
+				NumReplicas:       4,
+				Transport:         "sim",
+				NumFakeRequests:   100,
+				Duration:          10 * time.Second,
+				AvailabilityLayer: true,
+			}},
+		21: {"Submit 10 requests to a single one of 4 nodes with gRPC networking and the availability layer",
+			&TestConfig{
+				NumReplicas:        4,
+				NumClients:         1,
+				Transport:          "grpc",
+				NumNetRequests:     10,
+				Duration:           10 * time.Second,
+				AvailabilityLayer:  true,
+				SingleNodeRequests: true,
+			}},
 	}
 
 	for i, test := range tests {
@@ -377,6 +435,13 @@ func newDeployment(conf *TestConfig) (*deploytest.Deployment, error) {
 			// in the worst case, it will trigger view change by the segment timeout.
 			issConfig.MaxProposeDelay = issConfig.PBFTViewChangeBatchTimeout
 		}
+		if conf.AdaptiveBatching {
+			issConfig.AdaptiveBatching = true
+			issConfig.MaxBatchSize = 64
+		}
+		issConfig.PBFTMACAuthentication = conf.MACAuthentication
+		issConfig.CheckpointMACAuthentication = conf.MACAuthentication
+		issConfig.UseAvailabilityLayer = conf.AvailabilityLayer
 
 		issProtocol, err := iss.New(nodeID, issConfig, logging.Decorate(logger, "ISS: "))
 		if err != nil {
@@ -388,19 +453,61 @@ func newDeployment(conf *TestConfig) (*deploytest.Deployment, error) {
 			return nil, fmt.Errorf("error initializing Mir transport: %w", err)
 		}
 
-		modulesWithDefaults, err := iss.DefaultModules(map[t.ModuleID]modules.Module{
+		nodeModules[nodeID] = map[t.ModuleID]modules.Module{
 			"app":    &deploytest.FakeApp{},
 			"crypto": cryptoSystem.Module(nodeID),
 			"iss":    issProtocol,
 			"net":    transport,
-		})
+		}
+
+		if conf.AvailabilityLayer {
+			// With the availability layer, orderers do not know whether there are any pending requests
+			// and thus always wait for the maximal propose delay. Large batches compensate for that.
+			mempoolParams := simplemempool.DefaultModuleParams()
+			mempoolParams.MaxTransactionsInBatch = 64
+			for _, peerID := range nodeIDs {
+				if peerID != nodeID {
+					mempoolParams.GossipPeers = append(mempoolParams.GossipPeers, peerID)
+				}
+			}
+			mempool, err := simplemempool.NewModule(simplemempool.DefaultModuleConfig(), mempoolParams)
+			if err != nil {
+				return nil, fmt.Errorf("error creating mempool module: %w", err)
+			}
+			nodeModules[nodeID]["mempool"] = mempool
+			nodeModules[nodeID]["validator"] = txvalidator.New(txvalidator.AcceptAll)
+			availability, err := multisigcollector.NewModule(
+				multisigcollector.DefaultModuleConfig(),
+				&multisigcollector.ModuleParams{
+					InstanceUID:    []byte("testing instance"),
+					AllNodes:       nodeIDs,
+					RequestTimeout: t.TimeDuration(500 * time.Millisecond),
+				},
+				nodeID,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error creating availability module: %w", err)
+			}
+			nodeModules[nodeID]["availability"] = availability
+		}
+
+		modulesWithDefaults, err := iss.DefaultModules(nodeModules[nodeID])
 		if err != nil {
 			return nil, fmt.Errorf("error initializing the Mir modules: %w", err)
 		}
+		if err := iss.CheckModules(issConfig, modulesWithDefaults); err != nil {
+			return nil, fmt.Errorf("invalid Mir modules: %w", err)
+		}
 
 		nodeModules[nodeID] = modulesWithDefaults
 	}
 
+	// With gossiping mempools, requests only need to be submitted to a single node.
+	var netRequestsTarget t.NodeID
+	if conf.SingleNodeRequests {
+		netRequestsTarget = nodeIDs[0]
+	}
+
 	deployConf := &deploytest.TestConfig{
 		Info:                   conf.Info,
 		Simulation:             simulation,
@@ -410,6 +517,7 @@ func newDeployment(conf *TestConfig) (*deploytest.Deployment, error) {
 		NumClients:             conf.NumClients,
 		NumFakeRequests:        conf.NumFakeRequests,
 		NumNetRequests:         conf.NumNetRequests,
+		NetRequestsTarget:      netRequestsTarget,
 		FakeRequestsDestModule: t.ModuleID("iss"),
 		Directory:              conf.Directory,
 		Duration:               conf.Duration,
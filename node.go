@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"sync"
 
+	"github.com/filecoin-project/mir/pkg/commitlog"
 	"github.com/filecoin-project/mir/pkg/eventlog"
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/modules"
@@ -150,6 +151,23 @@ func (n *Node) InjectEvents(ctx context.Context, events *events.EventList) error
 	}
 }
 
+// CommitLog returns the query interface of the commit log maintained by the module with the given ID.
+// The module is usually a commitlog.Module, registered with the node as "commitlog".
+// CommitLog can be called concurrently with the operation of the node.
+func (n *Node) CommitLog(moduleID t.ModuleID) (commitlog.Reader, error) {
+	module, ok := n.modules[moduleID]
+	if !ok {
+		return nil, fmt.Errorf("unknown module: %v", moduleID)
+	}
+
+	reader, ok := module.(commitlog.Reader)
+	if !ok {
+		return nil, fmt.Errorf("module %v (%T) does not maintain a commit log", moduleID, module)
+	}
+
+	return reader, nil
+}
+
 // Run starts the Node.
 // First, it loads the contents of the WAL and enqueues all its contents for processing.
 // This makes sure that the WAL events end up first in all the modules' processing queues.
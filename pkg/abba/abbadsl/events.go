@@ -0,0 +1,133 @@
+package abbadsl
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/pb/abbapb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Module-specific dsl functions for emitting events.
+
+func Propose(m dsl.Module, dest t.ModuleID, value bool) {
+	dsl.EmitEvent(m, &eventpb.Event{
+		DestModule: dest.Pb(),
+
+		Type: &eventpb.Event_Abba{
+			Abba: &abbapb.Event{
+				Type: &abbapb.Event_Propose{
+					Propose: &abbapb.Propose{
+						Value: value,
+					},
+				},
+			},
+		},
+	})
+}
+
+func Decide(m dsl.Module, dest t.ModuleID, value bool) {
+	dsl.EmitEvent(m, &eventpb.Event{
+		DestModule: dest.Pb(),
+
+		Type: &eventpb.Event_Abba{
+			Abba: &abbapb.Event{
+				Type: &abbapb.Event_Decide{
+					Decide: &abbapb.Decide{
+						Value: value,
+					},
+				},
+			},
+		},
+	})
+}
+
+// Module-specific dsl functions for processing events.
+
+func UponEvent[EvWrapper abbapb.Event_TypeWrapper[Ev], Ev any](m dsl.Module, handler func(ev *Ev) error) {
+	dsl.UponEvent[*eventpb.Event_Abba](m, func(ev *abbapb.Event) error {
+		evWrapper, ok := ev.Type.(EvWrapper)
+		if !ok {
+			return nil
+		}
+		return handler(evWrapper.Unwrap())
+	})
+}
+
+func UponPropose(m dsl.Module, handler func(value bool) error) {
+	UponEvent[*abbapb.Event_Propose](m, func(ev *abbapb.Propose) error {
+		return handler(ev.Value)
+	})
+}
+
+func UponDecide(m dsl.Module, handler func(value bool) error) {
+	UponEvent[*abbapb.Event_Decide](m, func(ev *abbapb.Decide) error {
+		return handler(ev.Value)
+	})
+}
+
+func UponABBAMessageReceived(m dsl.Module, handler func(from t.NodeID, msg *abbapb.Message) error) {
+	dsl.UponMessageReceived(m, func(from t.NodeID, msg *messagepb.Message) error {
+		abbaMsgWrapper, ok := msg.Type.(*messagepb.Message_Abba)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, abbaMsgWrapper.Abba)
+	})
+}
+
+func UponBValMessageReceived(m dsl.Module, handler func(from t.NodeID, round uint64, value bool) error) {
+	UponABBAMessageReceived(m, func(from t.NodeID, msg *abbapb.Message) error {
+		bvalMsgWrapper, ok := msg.Type.(*abbapb.Message_BvalMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, bvalMsgWrapper.BvalMessage.Round, bvalMsgWrapper.BvalMessage.Value)
+	})
+}
+
+func UponAuxMessageReceived(m dsl.Module, handler func(from t.NodeID, round uint64, value bool) error) {
+	UponABBAMessageReceived(m, func(from t.NodeID, msg *abbapb.Message) error {
+		auxMsgWrapper, ok := msg.Type.(*abbapb.Message_AuxMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, auxMsgWrapper.AuxMessage.Round, auxMsgWrapper.AuxMessage.Value)
+	})
+}
+
+func UponConfMessageReceived(m dsl.Module, handler func(from t.NodeID, round uint64, values []bool) error) {
+	UponABBAMessageReceived(m, func(from t.NodeID, msg *abbapb.Message) error {
+		confMsgWrapper, ok := msg.Type.(*abbapb.Message_ConfMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, confMsgWrapper.ConfMessage.Round, confMsgWrapper.ConfMessage.Values)
+	})
+}
+
+func UponCoinShareMessageReceived(m dsl.Module, handler func(from t.NodeID, round uint64, share []byte) error) {
+	UponABBAMessageReceived(m, func(from t.NodeID, msg *abbapb.Message) error {
+		coinShareMsgWrapper, ok := msg.Type.(*abbapb.Message_CoinShareMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, coinShareMsgWrapper.CoinShareMessage.Round, coinShareMsgWrapper.CoinShareMessage.Share)
+	})
+}
+
+func UponFinishMessageReceived(m dsl.Module, handler func(from t.NodeID, value bool) error) {
+	UponABBAMessageReceived(m, func(from t.NodeID, msg *abbapb.Message) error {
+		finishMsgWrapper, ok := msg.Type.(*abbapb.Message_FinishMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, finishMsgWrapper.FinishMessage.Value)
+	})
+}
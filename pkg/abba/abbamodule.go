@@ -0,0 +1,598 @@
+package abba
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/mir/pkg/abba/abbadsl"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/messagebuffer"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/abbapb"
+	tcdsl "github.com/filecoin-project/mir/pkg/threshcrypto/dsl"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/maputil"
+)
+
+type ModuleConfig struct {
+	Self         t.ModuleID // id of this module
+	Consumer     t.ModuleID // id of the module to send the "Decide" event to
+	Net          t.ModuleID
+	ThreshCrypto t.ModuleID // id of the threshcrypto module producing the shares of the common coin
+}
+
+func DefaultModuleConfig(consumer t.ModuleID) *ModuleConfig {
+	return &ModuleConfig{
+		Self:         "abba",
+		Consumer:     consumer,
+		Net:          "net",
+		ThreshCrypto: "threshcrypto",
+	}
+}
+
+type ModuleParams struct {
+	InstanceUID []byte     // unique identifier for this instance of ABBA, from which the common coin derives
+	AllNodes    []t.NodeID // the list of participating nodes
+
+	// Maximal number of bytes used for buffering messages of rounds this node cannot process yet,
+	// i.e., of rounds after the one following its current round.
+	// This total buffer capacity is evenly split among the nodes, so that one node cannot exhaust it.
+	// When a node's buffer is full, messages of the nearest rounds are preferentially kept.
+	// If the capacity is 0, such messages are dropped on reception.
+	MsgBufCapacity int
+}
+
+func (params *ModuleParams) GetN() int {
+	return len(params.AllNodes)
+}
+
+func (params *ModuleParams) GetF() int {
+	return (params.GetN() - 1) / 3
+}
+
+// valueSet is a set of binary values, indexed by the values themselves.
+type valueSet [2]bool
+
+func index(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func (s valueSet) contains(value bool) bool {
+	return s[index(value)]
+}
+
+func (s valueSet) containsAll(other valueSet) bool {
+	return (s[0] || !other[0]) && (s[1] || !other[1])
+}
+
+func (s valueSet) size() int {
+	size := 0
+	for _, present := range s {
+		if present {
+			size++
+		}
+	}
+	return size
+}
+
+func (s valueSet) values() []bool {
+	values := make([]bool, 0, 2)
+	for i, present := range s {
+		if present {
+			values = append(values, i == 1)
+		}
+	}
+	return values
+}
+
+func newValueSet(values []bool) valueSet {
+	var s valueSet
+	for _, value := range values {
+		s[index(value)] = true
+	}
+	return s
+}
+
+// roundState is the state of one round of the agreement.
+// The state is kept for the current round, the round following it, and completed rounds.
+// Messages of later rounds are buffered until the node reaches the preceding round.
+// Of the state of a completed round, only the BVAL messages with values this node has not yet sent are tracked,
+// as lagging nodes may still depend on this node relaying them.
+// Once it has sent both values, the state of a completed round is freed.
+type roundState struct {
+	// the nodes from which a BVAL message with each of the values has been received,
+	// and the values for which this node has sent one
+	receivedBVal [2]map[t.NodeID]struct{}
+	sentBVal     valueSet
+
+	// the values delivered by the binary-value broadcast, and the first one of them to be delivered
+	binValues     valueSet
+	firstBinValue bool
+
+	// the first AUX (resp. CONF) message received from each node
+	receivedAux  map[t.NodeID]bool
+	receivedConf map[t.NodeID]valueSet
+
+	// the nodes from which a coin share has been received, and the verified coin shares received from each node
+	receivedCoinShare map[t.NodeID]struct{}
+	coinShares        map[t.NodeID][]byte
+
+	// the value of the coin, once the coin shares have been combined
+	coin bool
+
+	// the union of the values in the CONF messages this node waited for before revealing its coin share
+	confirmedValues valueSet
+
+	sentAux       bool
+	sentConf      bool
+	sentCoinShare bool
+	combiningCoin bool
+	tossedCoin    bool
+}
+
+type abbaModuleState struct {
+	proposed bool
+	round    uint64
+	estimate bool
+	rounds   map[uint64]*roundState
+
+	// buffers of the messages of rounds this node is not ready to process yet, one for each node
+	msgBuffers map[t.NodeID]*messagebuffer.MessageBuffer
+
+	decided        bool
+	sentFinish     bool
+	receivedFinish map[t.NodeID]bool
+	halted         bool
+}
+
+// NewModule returns a passive module for asynchronous binary Byzantine agreement.
+// It implements the randomized agreement of Mostéfaoui, Moumen, and Raynal ("Signature-free asynchronous Byzantine
+// consensus with t < n/3 and O(n²) messages", PODC 2014), with the additional CONF phase of Abraham, Ben-David, and
+// Yandamuri ("Efficient and adaptively secure asynchronous binary agreement via binding crusader agreement",
+// PODC 2022) that prevents the adversary from exploiting the common coin.
+// Each round consists of a binary-value broadcast of the nodes' estimates, the exchange of AUX and CONF messages,
+// and the toss of the common coin, which is derived from threshold signatures produced by the threshcrypto module.
+// The threshold of the threshold signature scheme must be f+1.
+// When a node decides, it emits a Decide event and broadcasts a FINISH message.
+// A node stops participating in the agreement after receiving FINISH messages with the same value
+// from 2f+1 nodes, which also makes every correct node decide (even without completing the round in which it would).
+// Each node must input its initial value through a Propose event.
+func NewModule(mc *ModuleConfig, params *ModuleParams, nodeID t.NodeID) modules.PassiveModule {
+	m := dsl.NewModule(mc.Self)
+
+	msgBuffers := messagebuffer.NewBuffers(
+		params.AllNodes,
+		params.MsgBufCapacity,
+		classifyBufferedMessage,
+		messagebuffer.NewDropCounters(),
+		logging.NilLogger,
+	)
+
+	state := abbaModuleState{
+		proposed:       false,
+		round:          0,
+		estimate:       false,
+		rounds:         make(map[uint64]*roundState),
+		msgBuffers:     msgBuffers,
+		decided:        false,
+		sentFinish:     false,
+		receivedFinish: make(map[t.NodeID]bool),
+		halted:         false,
+	}
+
+	isMember := make(map[t.NodeID]struct{}, len(params.AllNodes))
+	for _, n := range params.AllNodes {
+		isMember[n] = struct{}{}
+	}
+
+	getRound := func(round uint64) *roundState {
+		rs, ok := state.rounds[round]
+		if !ok {
+			rs = &roundState{
+				receivedBVal:      [2]map[t.NodeID]struct{}{make(map[t.NodeID]struct{}), make(map[t.NodeID]struct{})},
+				receivedAux:       make(map[t.NodeID]bool),
+				receivedConf:      make(map[t.NodeID]valueSet),
+				receivedCoinShare: make(map[t.NodeID]struct{}),
+				coinShares:        make(map[t.NodeID][]byte),
+			}
+			state.rounds[round] = rs
+		}
+		return rs
+	}
+
+	// compactRound frees the parts of the state of a completed round that are no longer needed.
+	compactRound := func(round uint64) {
+		rs, ok := state.rounds[round]
+		if !ok {
+			return
+		}
+		if rs.sentBVal.size() == 2 {
+			delete(state.rounds, round)
+			return
+		}
+		for i, sent := range rs.sentBVal {
+			if sent {
+				rs.receivedBVal[i] = nil
+			}
+		}
+		rs.receivedAux = nil
+		rs.receivedConf = nil
+		rs.receivedCoinShare = nil
+		rs.coinShares = nil
+	}
+
+	sendBVal := func(round uint64, value bool) {
+		rs := getRound(round)
+		if !rs.sentBVal.contains(value) {
+			rs.sentBVal[index(value)] = true
+			dsl.SendMessage(m, mc.Net, BValMessage(mc.Self, round, value), params.AllNodes)
+		}
+		if round < state.round {
+			compactRound(round)
+		}
+	}
+
+	sendFinish := func(value bool) {
+		if !state.sentFinish {
+			state.sentFinish = true
+			dsl.SendMessage(m, mc.Net, FinishMessage(mc.Self, value), params.AllNodes)
+		}
+	}
+
+	decide := func(value bool) {
+		if !state.decided {
+			state.decided = true
+			abbadsl.Decide(m, mc.Consumer, value)
+		}
+		sendFinish(value)
+	}
+
+	dsl.UponInit(m, func() error {
+		// no initialization required
+		return nil
+	})
+
+	abbadsl.UponPropose(m, func(value bool) error {
+		if state.proposed {
+			return fmt.Errorf("value already proposed")
+		}
+		state.proposed = true
+
+		// A node that has already halted (having received enough FINISH messages before its own proposal)
+		// has decided and does not participate in the agreement any more.
+		if state.halted {
+			return nil
+		}
+
+		state.estimate = value
+		sendBVal(state.round, value)
+		return nil
+	})
+
+	// Binary-value broadcast.
+	handleBVal := func(from t.NodeID, round uint64, value bool) {
+		if round < state.round {
+			// In a completed round, only relaying values this node has not yet sent is still needed.
+			if rs, ok := state.rounds[round]; !ok || rs.sentBVal.contains(value) {
+				return
+			}
+		}
+		rs := getRound(round)
+		rs.receivedBVal[index(value)][from] = struct{}{}
+
+		// Relay a value received from f+1 nodes, as at least one of them is correct.
+		if len(rs.receivedBVal[index(value)]) > params.GetF() {
+			sendBVal(round, value)
+		}
+
+		// A value received from 2f+1 nodes has been sent by at least f+1 correct nodes, and thus is delivered.
+		if round >= state.round &&
+			len(rs.receivedBVal[index(value)]) > 2*params.GetF() && !rs.binValues.contains(value) {
+			if rs.binValues.size() == 0 {
+				rs.firstBinValue = value
+			}
+			rs.binValues[index(value)] = true
+		}
+	}
+
+	// The AUX, CONF, and coin share messages of completed rounds are not needed any more.
+	handleAux := func(from t.NodeID, round uint64, value bool) {
+		if round < state.round {
+			return
+		}
+		rs := getRound(round)
+		if _, ok := rs.receivedAux[from]; !ok {
+			rs.receivedAux[from] = value
+		}
+	}
+
+	handleConf := func(from t.NodeID, round uint64, values []bool) {
+		if round < state.round {
+			return
+		}
+		rs := getRound(round)
+		if _, ok := rs.receivedConf[from]; !ok {
+			rs.receivedConf[from] = newValueSet(values)
+		}
+	}
+
+	handleCoinShare := func(from t.NodeID, round uint64, share []byte) {
+		if round < state.round {
+			return
+		}
+		rs := getRound(round)
+		if _, ok := rs.receivedCoinShare[from]; ok {
+			return
+		}
+		rs.receivedCoinShare[from] = struct{}{}
+		tcdsl.VerifyShare(m, mc.ThreshCrypto, coinData(params.InstanceUID, round), share, from,
+			&verifyCoinShareContext{round, from, share})
+	}
+
+	applyMessage := func(from t.NodeID, message proto.Message) {
+		switch msg := message.(*abbapb.Message).Type.(type) {
+		case *abbapb.Message_BvalMessage:
+			handleBVal(from, msg.BvalMessage.Round, msg.BvalMessage.Value)
+		case *abbapb.Message_AuxMessage:
+			handleAux(from, msg.AuxMessage.Round, msg.AuxMessage.Value)
+		case *abbapb.Message_ConfMessage:
+			handleConf(from, msg.ConfMessage.Round, msg.ConfMessage.Values)
+		case *abbapb.Message_CoinShareMessage:
+			handleCoinShare(from, msg.CoinShareMessage.Round, msg.CoinShareMessage.Share)
+		}
+	}
+
+	// filterBufferedMessage lets the buffered messages be applied once this node reaches the preceding round.
+	filterBufferedMessage := func(_ t.NodeID, message proto.Message) messagebuffer.Applicable {
+		round, ok := messageRound(message.(*abbapb.Message))
+		switch {
+		case !ok:
+			return messagebuffer.Invalid
+		case round > state.round+1:
+			return messagebuffer.Future
+		default:
+			return messagebuffer.Current
+		}
+	}
+
+	abbadsl.UponABBAMessageReceived(m, func(from t.NodeID, msg *abbapb.Message) error {
+		round, ok := messageRound(msg)
+		if _, member := isMember[from]; !ok || !member || state.halted {
+			return nil
+		}
+
+		// Allocating state (and verifying coin shares) for arbitrary rounds would let any node exhaust
+		// this node's resources. Thus, messages of rounds after the next one are only kept in a bounded buffer.
+		if round > state.round+1 {
+			state.msgBuffers[from].Store(msg)
+			return nil
+		}
+
+		applyMessage(from, msg)
+		return nil
+	})
+
+	tcdsl.UponSignShareResult(m, func(share []byte, context *signCoinShareContext) error {
+		if !state.halted {
+			dsl.SendMessage(m, mc.Net, CoinShareMessage(mc.Self, context.round, share), params.AllNodes)
+		}
+		return nil
+	})
+
+	tcdsl.UponVerifyShareResult(m, func(err error, context *verifyCoinShareContext) error {
+		if err == nil && !state.halted && context.round >= state.round {
+			getRound(context.round).coinShares[context.from] = context.share
+		}
+		return nil
+	})
+
+	tcdsl.UponRecoverResult(m, func(signature []byte, err error, context *combineCoinContext) error {
+		if state.halted {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not combine coin shares: %w", err)
+		}
+		rs := getRound(context.round)
+		rs.coin = coinValue(signature)
+		rs.tossedCoin = true
+		return nil
+	})
+
+	abbadsl.UponFinishMessageReceived(m, func(from t.NodeID, value bool) error {
+		if _, ok := isMember[from]; !ok || state.halted {
+			return nil
+		}
+		if _, ok := state.receivedFinish[from]; ok {
+			return nil
+		}
+		state.receivedFinish[from] = value
+
+		count := 0
+		for _, v := range state.receivedFinish {
+			if v == value {
+				count++
+			}
+		}
+
+		// A value announced by f+1 nodes has been decided by at least one correct node.
+		if count > params.GetF() {
+			sendFinish(value)
+		}
+
+		// A value announced by 2f+1 nodes will be announced by all correct nodes,
+		// so all correct nodes will decide without this node's participation.
+		if count > 2*params.GetF() {
+			decide(value)
+			state.halted = true
+			state.rounds = nil
+			state.msgBuffers = nil
+		}
+		return nil
+	})
+
+	// Advance through the steps of the current round as far as the received messages allow.
+	dsl.UponCondition(m, func() error {
+		for state.proposed && !state.halted {
+			round := state.round
+			if !advanceRound(m, mc, params, &state, sendBVal, decide) {
+				return nil
+			}
+
+			// On proceeding to the next round, free the state of the completed round
+			// and apply the buffered messages of the round following the new one.
+			if state.round != round {
+				compactRound(round)
+				for _, nodeID := range params.AllNodes {
+					state.msgBuffers[nodeID].Iterate(filterBufferedMessage, applyMessage)
+				}
+			}
+		}
+		return nil
+	})
+
+	return m
+}
+
+// advanceRound performs the next step of the current round, if possible.
+// It returns true if it performed a step.
+func advanceRound(
+	m dsl.Module,
+	mc *ModuleConfig,
+	params *ModuleParams,
+	state *abbaModuleState,
+	sendBVal func(round uint64, value bool),
+	decide func(value bool),
+) bool {
+	round := state.round
+	rs := state.rounds[round]
+	quorum := params.GetN() - params.GetF()
+
+	switch {
+	case !rs.sentAux:
+		// Once the binary-value broadcast delivers a value, send it in an AUX message.
+		if rs.binValues.size() == 0 {
+			return false
+		}
+		rs.sentAux = true
+		dsl.SendMessage(m, mc.Net, AuxMessage(mc.Self, round, rs.firstBinValue), params.AllNodes)
+		return true
+
+	case !rs.sentConf:
+		// Once N-f AUX messages carry delivered values, send the delivered values in a CONF message.
+		count := 0
+		for _, value := range rs.receivedAux {
+			if rs.binValues.contains(value) {
+				count++
+			}
+		}
+		if count < quorum {
+			return false
+		}
+		rs.sentConf = true
+		dsl.SendMessage(m, mc.Net, ConfMessage(mc.Self, round, rs.binValues.values()), params.AllNodes)
+		return true
+
+	case !rs.sentCoinShare:
+		// Once N-f CONF messages carry only delivered values, confirm those values and reveal the coin share.
+		count := 0
+		var confirmed valueSet
+		for _, values := range rs.receivedConf {
+			if rs.binValues.containsAll(values) {
+				count++
+				confirmed[0] = confirmed[0] || values[0]
+				confirmed[1] = confirmed[1] || values[1]
+			}
+		}
+		if count < quorum {
+			return false
+		}
+		rs.confirmedValues = confirmed
+		rs.sentCoinShare = true
+		tcdsl.SignShare(m, mc.ThreshCrypto, coinData(params.InstanceUID, round), &signCoinShareContext{round})
+		return true
+
+	case !rs.combiningCoin:
+		// Once f+1 verified coin shares are received, have them combined to toss the coin.
+		if len(rs.coinShares) <= params.GetF() {
+			return false
+		}
+		// Use the shares in a deterministic order.
+		shares := make([][]byte, 0, len(rs.coinShares))
+		maputil.IterateSorted(rs.coinShares, func(_ t.NodeID, share []byte) bool {
+			shares = append(shares, share)
+			return true
+		})
+		rs.combiningCoin = true
+		tcdsl.Recover(m, mc.ThreshCrypto, coinData(params.InstanceUID, round), shares, &combineCoinContext{round})
+		return true
+
+	case !rs.tossedCoin:
+		// Wait for the coin shares to be combined.
+		return false
+
+	default:
+		// Once the coin is tossed, update the estimate (possibly deciding) and proceed to the next round.
+		if rs.confirmedValues.size() == 1 {
+			value := rs.confirmedValues.contains(true)
+			state.estimate = value
+			if value == rs.coin {
+				decide(value)
+			}
+		} else {
+			state.estimate = rs.coin
+		}
+
+		state.round++
+		sendBVal(state.round, state.estimate)
+		return true
+	}
+}
+
+// messageRound returns the round of a message. The returned flag is false for messages not associated with a round.
+func messageRound(msg *abbapb.Message) (uint64, bool) {
+	switch msg := msg.Type.(type) {
+	case *abbapb.Message_BvalMessage:
+		return msg.BvalMessage.Round, true
+	case *abbapb.Message_AuxMessage:
+		return msg.AuxMessage.Round, true
+	case *abbapb.Message_ConfMessage:
+		return msg.ConfMessage.Round, true
+	case *abbapb.Message_CoinShareMessage:
+		return msg.CoinShareMessage.Round, true
+	default:
+		return 0, false
+	}
+}
+
+// classifyBufferedMessage returns the round of a buffered message as its rank,
+// so that messages of nearer rounds are preferentially kept, and the message type for counting dropped messages.
+func classifyBufferedMessage(message proto.Message) (uint64, string) {
+	msg := message.(*abbapb.Message)
+	round, _ := messageRound(msg)
+	return round, strings.TrimPrefix(fmt.Sprintf("%T", msg.Type), "*abbapb.Message_")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Context data structures                                                                                            //
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type signCoinShareContext struct {
+	round uint64
+}
+
+type verifyCoinShareContext struct {
+	round uint64
+	from  t.NodeID
+	share []byte
+}
+
+type combineCoinContext struct {
+	round uint64
+}
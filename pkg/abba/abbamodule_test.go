@@ -0,0 +1,310 @@
+package abba
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mirCrypto "github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/abbapb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	"github.com/filecoin-project/mir/pkg/testsim"
+	"github.com/filecoin-project/mir/pkg/threshcrypto"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// threshCryptoImpls returns, for each of the given nodes, a threshcrypto implementation with threshold f+1.
+// As generating the keys is slow, the keys are only generated once for each number of nodes.
+var threshCryptoImpls = func() func(tt *testing.T, nodeIDs []t.NodeID) map[t.NodeID]threshcrypto.ThreshCrypto {
+	impls := make(map[int]map[t.NodeID]threshcrypto.ThreshCrypto)
+	return func(tt *testing.T, nodeIDs []t.NodeID) map[t.NodeID]threshcrypto.ThreshCrypto {
+		if _, ok := impls[len(nodeIDs)]; !ok {
+			impls[len(nodeIDs)] = make(map[t.NodeID]threshcrypto.ThreshCrypto, len(nodeIDs))
+			threshold := (len(nodeIDs)-1)/3 + 1
+			for _, nodeID := range nodeIDs {
+				impl, err := threshcrypto.NodePseudo(nodeIDs, threshold, nodeID, mirCrypto.DefaultPseudoSeed)
+				require.NoError(tt, err)
+				impls[len(nodeIDs)][nodeID] = impl
+			}
+		}
+		return impls[len(nodeIDs)]
+	}
+}()
+
+// threshCryptoModules returns, for each of the given nodes, a threshcrypto module with threshold f+1.
+func threshCryptoModules(tt *testing.T, nodeIDs []t.NodeID) map[t.NodeID]*threshcrypto.MirModule {
+	impls := threshCryptoImpls(tt, nodeIDs)
+	modules := make(map[t.NodeID]*threshcrypto.MirModule, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		modules[nodeID] = threshcrypto.New(impls[nodeID])
+	}
+	return modules
+}
+
+// proposeEvent returns a Propose event with the given value for the ABBA module.
+func proposeEvent(mc *ModuleConfig, value bool) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: mc.Self.Pb(),
+		Type: &eventpb.Event_Abba{Abba: &abbapb.Event{Type: &abbapb.Event_Propose{
+			Propose: &abbapb.Propose{Value: value},
+		}}},
+	}
+}
+
+// runAgreement runs the agreement among len(proposals) nodes in the simulated runtime,
+// with each message and each result of the threshcrypto module delayed by a random duration,
+// and returns the values decided by each node.
+func runAgreement(tt *testing.T, seed int64, proposals []bool) map[t.NodeID][]bool {
+	nodeIDs := make([]t.NodeID, len(proposals))
+	for i := range nodeIDs {
+		nodeIDs[i] = t.NewNodeIDFromInt(i)
+	}
+	threshCrypto := threshCryptoModules(tt, nodeIDs)
+
+	mc := DefaultModuleConfig("app")
+	sim := testsim.NewRuntime(rand.New(rand.NewSource(seed))) //nolint:gosec
+
+	var lock sync.Mutex
+	decided := make(map[t.NodeID][]bool)
+	var failure error
+
+	chans := make(map[t.NodeID]*testsim.Chan, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		chans[nodeID] = testsim.NewChan()
+	}
+
+	// send delivers an event to a node after a random delay.
+	send := func(proc *testsim.Process, to t.NodeID, event *eventpb.Event) {
+		lock.Lock()
+		delay := testsim.RandDuration(sim.Rand, 0, 10*time.Millisecond)
+		lock.Unlock()
+
+		senderProc := proc.Fork()
+		go func() {
+			if senderProc.Delay(delay) {
+				senderProc.Send(chans[to], event)
+			}
+			senderProc.Exit()
+		}()
+	}
+
+	for i, nodeID := range nodeIDs {
+		nodeID := nodeID
+		params := &ModuleParams{InstanceUID: []byte("test"), AllNodes: nodeIDs, MsgBufCapacity: 1 << 20}
+		module := NewModule(mc, params, nodeID)
+		proc := sim.Spawn()
+
+		go func() {
+			for {
+				v, ok := proc.Recv(chans[nodeID])
+				if !ok {
+					return
+				}
+
+				evIn := v.(*eventpb.Event)
+				var evsOut *events.EventList
+				var err error
+				if t.ModuleID(evIn.DestModule) == mc.ThreshCrypto {
+					evsOut, err = threshCrypto[nodeID].ApplyEvents(events.ListOf(evIn))
+				} else {
+					evsOut, err = module.ApplyEvents(events.ListOf(evIn))
+				}
+				if err != nil {
+					lock.Lock()
+					failure = err
+					lock.Unlock()
+					continue
+				}
+
+				iter := evsOut.Iterator()
+				for ev := iter.Next(); ev != nil; ev = iter.Next() {
+					switch t.ModuleID(ev.DestModule) {
+					case mc.Self, mc.ThreshCrypto:
+						send(proc, nodeID, ev)
+					case mc.Net:
+						sendEvent := ev.Type.(*eventpb.Event_SendMessage).SendMessage
+						for _, dest := range t.NodeIDSlice(sendEvent.Destinations) {
+							send(proc, dest, events.MessageReceived(mc.Self, nodeID, sendEvent.Msg))
+						}
+					case mc.Consumer:
+						decide := ev.Type.(*eventpb.Event_Abba).Abba.Type.(*abbapb.Event_Decide).Decide
+						lock.Lock()
+						decided[nodeID] = append(decided[nodeID], decide.Value)
+						lock.Unlock()
+					}
+				}
+			}
+		}()
+
+		send(proc, nodeID, proposeEvent(mc, proposals[i]))
+	}
+
+	sim.Run()
+	sim.Stop()
+
+	require.NoError(tt, failure)
+	return decided
+}
+
+func TestAgreement(tt *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		decided := runAgreement(tt, seed, []bool{false, true, true, false})
+
+		require.Len(tt, decided, 4, "seed %d", seed)
+		value := decided[t.NewNodeIDFromInt(0)][0]
+		for _, values := range decided {
+			assert.Equal(tt, []bool{value}, values, "seed %d", seed)
+		}
+	}
+}
+
+func TestValidity(tt *testing.T) {
+	for _, value := range []bool{false, true} {
+		for seed := int64(0); seed < 5; seed++ {
+			decided := runAgreement(tt, seed, []bool{value, value, value, value, value, value, value})
+
+			require.Len(tt, decided, 7, "seed %d", seed)
+			for _, values := range decided {
+				assert.Equal(tt, []bool{value}, values, "seed %d", seed)
+			}
+		}
+	}
+}
+
+func TestProposeAfterHalting(tt *testing.T) {
+	nodeIDs := []t.NodeID{"0", "1", "2", "3"}
+	mc := DefaultModuleConfig("app")
+	module := NewModule(mc, &ModuleParams{InstanceUID: []byte("test"), AllNodes: nodeIDs}, "3")
+
+	// A lagging node receives FINISH messages from 2f+1 nodes before its own proposal, decides and halts.
+	evsIn := events.EmptyList()
+	for _, nodeID := range nodeIDs[:3] {
+		evsIn.PushBack(events.MessageReceived(mc.Self, nodeID, FinishMessage(mc.Self, true)))
+	}
+	evsOut, err := module.ApplyEvents(evsIn)
+	require.NoError(tt, err)
+
+	decided := make([]bool, 0)
+	iter := evsOut.Iterator()
+	for ev := iter.Next(); ev != nil; ev = iter.Next() {
+		if t.ModuleID(ev.DestModule) == mc.Consumer {
+			decided = append(decided, ev.Type.(*eventpb.Event_Abba).Abba.Type.(*abbapb.Event_Decide).Decide.Value)
+		}
+	}
+	assert.Equal(tt, []bool{true}, decided)
+
+	// The proposal is ignored once the node has halted.
+	evsOut, err = module.ApplyEvents(events.ListOf(proposeEvent(mc, false)))
+	require.NoError(tt, err)
+	assert.Equal(tt, 0, evsOut.Len())
+}
+
+func TestFutureRoundMessages(tt *testing.T) {
+	nodeIDs := []t.NodeID{"0", "1", "2", "3"}
+	mc := DefaultModuleConfig("app")
+	params := &ModuleParams{InstanceUID: []byte("test"), AllNodes: nodeIDs, MsgBufCapacity: 1024}
+	module := NewModule(mc, params, "3")
+
+	// apply applies the given messages to the module and returns the destination modules of the emitted events.
+	apply := func(from []t.NodeID, msg *messagepb.Message) []t.ModuleID {
+		evsIn := events.EmptyList()
+		for _, nodeID := range from {
+			evsIn.PushBack(events.MessageReceived(mc.Self, nodeID, msg))
+		}
+		evsOut, err := module.ApplyEvents(evsIn)
+		require.NoError(tt, err)
+
+		dests := make([]t.ModuleID, 0)
+		iter := evsOut.Iterator()
+		for ev := iter.Next(); ev != nil; ev = iter.Next() {
+			dests = append(dests, t.ModuleID(ev.DestModule))
+		}
+		return dests
+	}
+
+	// BVAL messages of the round following the current one are processed immediately and relayed.
+	assert.Equal(tt, []t.ModuleID{mc.Net}, apply(nodeIDs[:2], BValMessage(mc.Self, 1, true)))
+
+	// Messages of later rounds are only buffered.
+	assert.Empty(tt, apply(nodeIDs[:2], BValMessage(mc.Self, 2, true)))
+	assert.Empty(tt, apply(nodeIDs[:1], CoinShareMessage(mc.Self, 1000, []byte("share"))))
+
+	// A node flooding this node with messages of arbitrarily late rounds does not make it allocate state for them.
+	for round := uint64(3); round < 10000; round++ {
+		assert.Empty(tt, apply(nodeIDs[:1], BValMessage(mc.Self, round, false)))
+	}
+
+	// Coin shares of the round following the current one are verified immediately.
+	assert.Equal(tt, []t.ModuleID{mc.ThreshCrypto}, apply(nodeIDs[:1], CoinShareMessage(mc.Self, 1, []byte("share"))))
+}
+
+func TestBufferedMessagesApplied(tt *testing.T) {
+	nodeIDs := []t.NodeID{"0", "1", "2", "3"}
+	impls := threshCryptoImpls(tt, nodeIDs)
+	mc := DefaultModuleConfig("app")
+	params := &ModuleParams{InstanceUID: []byte("test"), AllNodes: nodeIDs, MsgBufCapacity: 1024}
+	module := NewModule(mc, params, "3")
+	threshCrypto := threshcrypto.New(impls["3"])
+
+	// process applies the given events, as well as all resulting events for the module and its threshcrypto module,
+	// and returns the BVAL messages sent in the process.
+	process := func(evs ...*eventpb.Event) []*abbapb.BValMessage {
+		sent := make([]*abbapb.BValMessage, 0)
+		for len(evs) > 0 {
+			ev := evs[0]
+			evs = evs[1:]
+
+			var evsOut *events.EventList
+			var err error
+			switch t.ModuleID(ev.DestModule) {
+			case mc.Self:
+				evsOut, err = module.ApplyEvents(events.ListOf(ev))
+			case mc.ThreshCrypto:
+				evsOut, err = threshCrypto.ApplyEvents(events.ListOf(ev))
+			case mc.Net:
+				if bval := ev.GetSendMessage().Msg.GetAbba().GetBvalMessage(); bval != nil {
+					sent = append(sent, bval)
+				}
+				continue
+			default:
+				continue
+			}
+			require.NoError(tt, err)
+			evs = append(evs, evsOut.Slice()...)
+		}
+		return sent
+	}
+
+	// received returns the events of receiving msg from each of the given nodes.
+	received := func(msg *messagepb.Message, from ...t.NodeID) []*eventpb.Event {
+		evs := make([]*eventpb.Event, len(from))
+		for i, nodeID := range from {
+			evs[i] = events.MessageReceived(mc.Self, nodeID, msg)
+		}
+		return evs
+	}
+
+	// The BVAL messages of round 2 are buffered, as the node is still in round 0.
+	assert.Empty(tt, process(received(BValMessage(mc.Self, 2, true), "0", "1")...))
+
+	// The node completes round 0 with the other nodes.
+	assert.Equal(tt, []*abbapb.BValMessage{{Round: 0, Value: true}}, process(proposeEvent(mc, true)))
+	process(received(BValMessage(mc.Self, 0, true), "0", "1", "2")...)
+	process(received(AuxMessage(mc.Self, 0, true), "0", "1", "2")...)
+	process(received(ConfMessage(mc.Self, 0, []bool{true}), "0", "1", "2")...)
+	evs := make([]*eventpb.Event, 0)
+	for _, nodeID := range nodeIDs[:2] {
+		share, err := impls[nodeID].SignShare(coinData(params.InstanceUID, 0))
+		require.NoError(tt, err)
+		evs = append(evs, received(CoinShareMessage(mc.Self, 0, share), nodeID)...)
+	}
+
+	// On proceeding to round 1, the node applies the buffered messages of round 2 and relays their value.
+	assert.Equal(tt, []*abbapb.BValMessage{{Round: 1, Value: true}, {Round: 2, Value: true}}, process(evs...))
+}
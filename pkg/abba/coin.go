@@ -0,0 +1,27 @@
+package abba
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// The common coin of the agreement is derived from threshold signatures produced by the threshcrypto module.
+// The shares of the coin of a round are the signature shares of the round number (see coinData),
+// and the value of the coin is the lowest bit of the hash of the resulting full signature (see coinValue).
+// As the full signature must be unique, the underlying threshold signature scheme must be deterministic
+// (which, e.g., threshold RSA and BLS signatures are).
+// The threshold of the scheme must be f+1, so that the coin stays unpredictable until a correct node reveals its share.
+
+// coinData returns the data signed by the nodes to produce the coin of the given round.
+// instanceUID must be unique for each agreement instance, so that the coins of different instances are independent.
+func coinData(instanceUID []byte, round uint64) [][]byte {
+	roundBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(roundBytes, round)
+	return [][]byte{instanceUID, []byte("COIN"), roundBytes}
+}
+
+// coinValue returns the value of the coin given the full threshold signature of the coin data.
+func coinValue(signature []byte) bool {
+	h := sha256.Sum256(signature)
+	return h[0]&1 == 1
+}
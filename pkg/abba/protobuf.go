@@ -0,0 +1,70 @@
+package abba
+
+import (
+	"github.com/filecoin-project/mir/pkg/pb/abbapb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+func Message(moduleID t.ModuleID, msg *abbapb.Message) *messagepb.Message {
+	return &messagepb.Message{
+		DestModule: moduleID.Pb(),
+		Type: &messagepb.Message_Abba{
+			Abba: msg,
+		},
+	}
+}
+
+func BValMessage(moduleID t.ModuleID, round uint64, value bool) *messagepb.Message {
+	return Message(moduleID, &abbapb.Message{
+		Type: &abbapb.Message_BvalMessage{
+			BvalMessage: &abbapb.BValMessage{
+				Round: round,
+				Value: value,
+			},
+		},
+	})
+}
+
+func AuxMessage(moduleID t.ModuleID, round uint64, value bool) *messagepb.Message {
+	return Message(moduleID, &abbapb.Message{
+		Type: &abbapb.Message_AuxMessage{
+			AuxMessage: &abbapb.AuxMessage{
+				Round: round,
+				Value: value,
+			},
+		},
+	})
+}
+
+func ConfMessage(moduleID t.ModuleID, round uint64, values []bool) *messagepb.Message {
+	return Message(moduleID, &abbapb.Message{
+		Type: &abbapb.Message_ConfMessage{
+			ConfMessage: &abbapb.ConfMessage{
+				Round:  round,
+				Values: values,
+			},
+		},
+	})
+}
+
+func CoinShareMessage(moduleID t.ModuleID, round uint64, share []byte) *messagepb.Message {
+	return Message(moduleID, &abbapb.Message{
+		Type: &abbapb.Message_CoinShareMessage{
+			CoinShareMessage: &abbapb.CoinShareMessage{
+				Round: round,
+				Share: share,
+			},
+		},
+	})
+}
+
+func FinishMessage(moduleID t.ModuleID, value bool) *messagepb.Message {
+	return Message(moduleID, &abbapb.Message{
+		Type: &abbapb.Message_FinishMessage{
+			FinishMessage: &abbapb.FinishMessage{
+				Value: value,
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package avid
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/common"
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/parts/batchreconstruction"
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/parts/certcreation"
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/parts/certverification"
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/parts/garbagecollection"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/modules"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+type ModuleConfig = common.ModuleConfig
+
+type ModuleParams = common.ModuleParams
+
+// DefaultModuleConfig returns a valid module config with default names for all modules.
+func DefaultModuleConfig() *ModuleConfig {
+	return common.DefaultModuleConfig()
+}
+
+// CheckParams checks whether the given module parameters satisfy all necessary constraints.
+func CheckParams(params *ModuleParams) error {
+	return common.CheckParams(params)
+}
+
+// NewModule creates a new instance of the AVID (asynchronous verifiable information dispersal) module,
+// an implementation of the availability layer based on erasure coding.
+// Whenever an availability certificate is requested, it pulls a batch from the mempool module,
+// Reed-Solomon-encodes it into one chunk per node such that any F+1 chunks suffice to reconstruct the batch,
+// and sends each node only its chunk, along with a proof of its inclusion in the Merkle tree over all chunks.
+// The certificate consists of the Merkle root and a quorum (i.e., more than (N+F)/2) of signatures confirming that
+// other nodes have stored their chunks.
+// Compared to the multisig collector, this reduces the dissemination bandwidth per node from O(batch) to O(batch/N),
+// at the cost of having to reconstruct the batch from F+1 chunks when its transactions are requested.
+// As nodes only receive chunks, the transactions of a batch are validated (through the mempool)
+// when it is reconstructed and invalid transactions are left out of the reconstructed batch.
+// NewModule returns an error if the module parameters are not valid.
+func NewModule(mc *ModuleConfig, params *ModuleParams, nodeID t.NodeID) (modules.PassiveModule, error) {
+	if err := CheckParams(params); err != nil {
+		return nil, fmt.Errorf("invalid AVID parameters: %w", err)
+	}
+
+	m := dsl.NewModule(mc.Self)
+
+	commonState := common.NewState()
+
+	// The module does not need any initialization,
+	// but it must accept the Init event that is sent to all modules of a node.
+	dsl.UponInit(m, func() error {
+		return nil
+	})
+
+	certcreation.IncludeCreatingCertificates(m, mc, params, nodeID, commonState)
+	certverification.IncludeVerificationOfCertificates(m, mc, params, nodeID, commonState)
+	batchreconstruction.IncludeBatchReconstruction(m, mc, params, nodeID, commonState)
+	garbagecollection.IncludeGarbageCollection(m, mc, commonState)
+
+	return m, nil
+}
@@ -0,0 +1,297 @@
+package avid
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	aevents "github.com/filecoin-project/mir/pkg/availability/events"
+	"github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/events"
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/modules"
+	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
+	"github.com/filecoin-project/mir/pkg/pb/dslpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+const consumerModule = t.ModuleID("consumer")
+
+// testSystem is a system of AVID modules of 4 nodes, with a dummy crypto module and a mempool mock per node.
+// The mempool mock considers all transactions valid except for invalidTx.
+// The network is simulated by delivering messages sent by one module to the others,
+// unless filtered out by the network's drop function.
+type testSystem struct {
+	tt      *testing.T
+	nodeIDs []t.NodeID
+	mc      *ModuleConfig
+	nodes   map[t.NodeID]modules.PassiveModule
+	cryptos map[t.NodeID]modules.PassiveModule
+	drop    func(from, to t.NodeID, msg *avidpb.Message) bool
+
+	// Events waiting to be applied and timer events waiting to be fired.
+	queue  []nodeEvent
+	timers []nodeEvent
+
+	// Transactions the mempool mock of each node returns when asked for a new batch.
+	newBatch map[t.NodeID][][]byte
+
+	// Certificates created and transactions provided to the consumer module at each node.
+	certs    map[t.NodeID][]*apb.Cert
+	provided map[t.NodeID][][]byte
+
+	// Messages sent by each node.
+	sent map[t.NodeID][]*avidpb.Message
+}
+
+type nodeEvent struct {
+	nodeID t.NodeID
+	event  *eventpb.Event
+}
+
+func newTestSystem(tt *testing.T) *testSystem {
+	nodeIDs := []t.NodeID{"0", "1", "2", "3"}
+	ts := &testSystem{
+		tt:       tt,
+		nodeIDs:  nodeIDs,
+		mc:       DefaultModuleConfig(),
+		nodes:    make(map[t.NodeID]modules.PassiveModule),
+		cryptos:  make(map[t.NodeID]modules.PassiveModule),
+		drop:     func(_, _ t.NodeID, _ *avidpb.Message) bool { return false },
+		newBatch: make(map[t.NodeID][][]byte),
+		certs:    make(map[t.NodeID][]*apb.Cert),
+		provided: make(map[t.NodeID][][]byte),
+		sent:     make(map[t.NodeID][]*avidpb.Message),
+	}
+
+	params := &ModuleParams{
+		InstanceUID:    []byte("test"),
+		AllNodes:       nodeIDs,
+		RequestTimeout: 1000,
+	}
+	for _, nodeID := range nodeIDs {
+		module, err := NewModule(ts.mc, params, nodeID)
+		require.NoError(tt, err)
+		ts.nodes[nodeID] = module
+		ts.cryptos[nodeID] = crypto.New(&crypto.DummyCrypto{DummySig: []byte("sig")})
+	}
+
+	return ts
+}
+
+// requestCert has node nodeID request a certificate.
+func (ts *testSystem) requestCert(nodeID t.NodeID) {
+	origin := &apb.RequestCertOrigin{
+		Module: consumerModule.Pb(),
+		Type:   &apb.RequestCertOrigin_Dsl{Dsl: &dslpb.Origin{ContextID: 0}},
+	}
+	ts.queue = append(ts.queue, nodeEvent{nodeID, aevents.RequestCert(ts.mc.Self, origin)})
+}
+
+// requestTransactions has node nodeID request the transactions of the batch certified by the given certificate.
+func (ts *testSystem) requestTransactions(nodeID t.NodeID, cert *apb.Cert) {
+	origin := &apb.RequestTransactionsOrigin{
+		Module: consumerModule.Pb(),
+		Type:   &apb.RequestTransactionsOrigin_Dsl{Dsl: &dslpb.Origin{ContextID: 0}},
+	}
+	ts.queue = append(ts.queue, nodeEvent{nodeID, aevents.RequestTransactions(ts.mc.Self, cert, origin)})
+}
+
+// run processes events until there are none left. Pending timer events are not fired.
+func (ts *testSystem) run() {
+	for len(ts.queue) > 0 {
+		next := ts.queue[0]
+		ts.queue = ts.queue[1:]
+
+		switch t.ModuleID(next.event.DestModule) {
+		case ts.mc.Self:
+			evsOut, err := ts.nodes[next.nodeID].ApplyEvents(events.ListOf(next.event))
+			require.NoError(ts.tt, err)
+			for _, ev := range evsOut.Slice() {
+				ts.queue = append(ts.queue, nodeEvent{next.nodeID, ev})
+			}
+		case ts.mc.Crypto:
+			evsOut, err := ts.cryptos[next.nodeID].ApplyEvents(events.ListOf(next.event))
+			require.NoError(ts.tt, err)
+			for _, ev := range evsOut.Slice() {
+				ts.queue = append(ts.queue, nodeEvent{next.nodeID, ev})
+			}
+		case ts.mc.Net:
+			sendEvent := next.event.GetSendMessage()
+			msg := sendEvent.Msg.Type.(*messagepb.Message_Avid).Avid
+			ts.sent[next.nodeID] = append(ts.sent[next.nodeID], msg)
+			for _, dest := range t.NodeIDSlice(sendEvent.Destinations) {
+				if !ts.drop(next.nodeID, dest, msg) {
+					ts.queue = append(ts.queue, nodeEvent{dest, events.MessageReceived(ts.mc.Self, next.nodeID, sendEvent.Msg)})
+				}
+			}
+		case ts.mc.Timer:
+			for _, ev := range next.event.GetTimerDelay().Events {
+				ts.timers = append(ts.timers, nodeEvent{next.nodeID, ev})
+			}
+		case ts.mc.Mempool:
+			ts.queue = append(ts.queue, nodeEvent{next.nodeID, ts.applyMempoolEvent(next.nodeID, next.event)})
+		case consumerModule:
+			switch e := next.event.GetAvailability().Type.(type) {
+			case *apb.Event_ProvideTransactions:
+				ts.provided[next.nodeID] = e.ProvideTransactions.Txs
+			case *apb.Event_NewCert:
+				ts.certs[next.nodeID] = append(ts.certs[next.nodeID], e.NewCert.Cert)
+			default:
+				ts.tt.Fatalf("unexpected availability event: %T", e)
+			}
+		default:
+			ts.tt.Fatalf("unexpected destination module: %v", next.event.DestModule)
+		}
+	}
+}
+
+// fireTimers fires all pending timer events and processes the resulting events.
+func (ts *testSystem) fireTimers() {
+	ts.queue = append(ts.queue, ts.timers...)
+	ts.timers = nil
+	ts.run()
+}
+
+// applyMempoolEvent returns the response of the mempool mock of node nodeID to the given event.
+func (ts *testSystem) applyMempoolEvent(nodeID t.NodeID, event *eventpb.Event) *eventpb.Event {
+	switch e := event.GetMempool().Type.(type) {
+	case *mempoolpb.Event_RequestBatch:
+		origin := e.RequestBatch.Origin
+		txs := ts.newBatch[nodeID]
+		txIDs := make([]t.TxID, len(txs))
+		for i, tx := range txs {
+			txIDs[i] = t.TxID("id-" + string(tx))
+		}
+		return mpevents.NewBatch(t.ModuleID(origin.Module), txIDs, txs, origin)
+	case *mempoolpb.Event_VerifyTransactions:
+		origin := e.VerifyTransactions.Origin
+		valid := make([]bool, len(e.VerifyTransactions.Txs))
+		errs := make([]string, len(e.VerifyTransactions.Txs))
+		for i, tx := range e.VerifyTransactions.Txs {
+			valid[i] = !bytes.Equal(tx, invalidTx)
+			if !valid[i] {
+				errs[i] = "invalid transaction"
+			}
+		}
+		priorities := make([]uint64, len(e.VerifyTransactions.Txs))
+		return mpevents.TransactionsVerified(t.ModuleID(origin.Module), valid, errs, priorities, origin)
+	default:
+		ts.tt.Fatalf("unexpected mempool event: %T", e)
+		return nil
+	}
+}
+
+// createCert has node nodeID create a certificate for the given transactions.
+func (ts *testSystem) createCert(nodeID t.NodeID, txs [][]byte) *apb.Cert {
+	ts.newBatch[nodeID] = txs
+	ts.requestCert(nodeID)
+	ts.run()
+	require.Len(ts.tt, ts.certs[nodeID], 1)
+	return ts.certs[nodeID][0]
+}
+
+// requestedChunks returns the number of nodes node nodeID asked for their chunk.
+func (ts *testSystem) requestedChunks(nodeID t.NodeID) int {
+	n := 0
+	for _, msg := range ts.sent[nodeID] {
+		if msg.GetRequestChunk() != nil {
+			n++
+		}
+	}
+	return n
+}
+
+var testTxs = [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+
+var invalidTx = []byte("invalid")
+
+func TestBatchReconstruction(tt *testing.T) {
+	ts := newTestSystem(tt)
+	cert := ts.createCert("0", testTxs)
+
+	ts.requestTransactions("1", cert)
+	ts.run()
+	assert.Equal(tt, testTxs, ts.provided["1"])
+}
+
+func TestBatchReconstructionRetry(tt *testing.T) {
+	ts := newTestSystem(tt)
+	cert := ts.createCert("0", testTxs)
+
+	// Initially, no node responds to chunk requests.
+	ts.drop = func(_, _ t.NodeID, msg *avidpb.Message) bool {
+		return msg.GetRequestChunk() != nil
+	}
+	ts.requestTransactions("1", cert)
+	ts.run()
+	assert.Nil(tt, ts.provided["1"])
+
+	// On timeout, the chunks are requested again.
+	ts.drop = func(_, _ t.NodeID, _ *avidpb.Message) bool { return false }
+	ts.fireTimers()
+	assert.Equal(tt, testTxs, ts.provided["1"])
+
+	// Once the batch is reconstructed, no more requests are sent.
+	ts.sent["1"] = nil
+	ts.fireTimers()
+	assert.Zero(tt, ts.requestedChunks("1"))
+}
+
+func TestBatchReconstructionValidation(tt *testing.T) {
+	ts := newTestSystem(tt)
+
+	// The invalid transaction is left out of the reconstructed batch.
+	cert := ts.createCert("0", [][]byte{testTxs[0], invalidTx, testTxs[1]})
+	ts.requestTransactions("1", cert)
+	ts.run()
+	assert.Equal(tt, [][]byte{testTxs[0], testTxs[1]}, ts.provided["1"])
+
+	// The validated batch is stored.
+	ts.sent["1"] = nil
+	ts.provided["1"] = nil
+	ts.requestTransactions("1", cert)
+	ts.run()
+	assert.Equal(tt, [][]byte{testTxs[0], testTxs[1]}, ts.provided["1"])
+	assert.Zero(tt, ts.requestedChunks("1"))
+}
+
+func TestCheckParams(tt *testing.T) {
+	nodeIDs := []t.NodeID{"0", "1", "2", "3"}
+	manyNodeIDs := make([]t.NodeID, 257)
+	for i := range manyNodeIDs {
+		manyNodeIDs[i] = t.NewNodeIDFromInt(i)
+	}
+
+	testCases := map[string]struct {
+		params *ModuleParams
+		valid  bool
+	}{
+		"valid":          {&ModuleParams{AllNodes: nodeIDs, RequestTimeout: 1}, true},
+		"no nodes":       {&ModuleParams{AllNodes: nil, RequestTimeout: 1}, false},
+		"too many nodes": {&ModuleParams{AllNodes: manyNodeIDs, RequestTimeout: 1}, false},
+		"duplicate node": {&ModuleParams{AllNodes: []t.NodeID{"0", "1", "0"}, RequestTimeout: 1}, false},
+		"zero timeout":   {&ModuleParams{AllNodes: nodeIDs, RequestTimeout: 0}, false},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		tt.Run(name, func(tt *testing.T) {
+			err := CheckParams(tc.params)
+			if tc.valid {
+				assert.NoError(tt, err)
+			} else {
+				assert.Error(tt, err)
+			}
+
+			_, err = NewModule(DefaultModuleConfig(), tc.params, "0")
+			assert.Equal(tt, tc.valid, err == nil)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package common
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/erasure"
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/merkle"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// InstanceUID is used to uniquely identify an instance of the AVID module.
+// It is used to prevent cross-instance signature replay attack and should be unique across all executions.
+type InstanceUID []byte
+
+// Bytes returns the binary representation of the InstanceUID.
+func (uid InstanceUID) Bytes() []byte {
+	return uid
+}
+
+// ModuleConfig sets the module ids. All replicas are expected to use identical module configurations.
+type ModuleConfig struct {
+	Self    t.ModuleID // id of this module
+	Mempool t.ModuleID
+	Net     t.ModuleID
+	Crypto  t.ModuleID
+	Timer   t.ModuleID
+}
+
+// DefaultModuleConfig returns a valid module config with default names for all modules.
+func DefaultModuleConfig() *ModuleConfig {
+	return &ModuleConfig{
+		Self:    "availability",
+		Mempool: "mempool",
+		Net:     "net",
+		Crypto:  "crypto",
+		Timer:   "timer",
+	}
+}
+
+// ModuleParams sets the values for the parameters of an instance of the protocol.
+// All replicas are expected to use identical module parameters.
+type ModuleParams struct {
+	InstanceUID []byte     // unique identifier for this instance of AVID, used to prevent cross-instance replay attacks
+	AllNodes    []t.NodeID // the list of participating nodes
+
+	// Time to wait for enough chunks during batch reconstruction
+	// before asking the nodes that have not provided their chunk again.
+	RequestTimeout t.TimeDuration
+}
+
+// CheckParams checks whether the given module parameters satisfy all necessary constraints.
+func CheckParams(params *ModuleParams) error {
+	if len(params.AllNodes) == 0 {
+		return fmt.Errorf("empty AllNodes")
+	}
+
+	// Each node is responsible for one chunk of each batch and the erasure code only supports a limited number of chunks.
+	if len(params.AllNodes) > erasure.MaxChunks {
+		return fmt.Errorf("too many nodes: %d (at most %d supported)", len(params.AllNodes), erasure.MaxChunks)
+	}
+
+	nodes := make(map[t.NodeID]struct{}, len(params.AllNodes))
+	for _, nodeID := range params.AllNodes {
+		if _, ok := nodes[nodeID]; ok {
+			return fmt.Errorf("duplicate node in AllNodes: %v", nodeID)
+		}
+		nodes[nodeID] = struct{}{}
+	}
+
+	// RequestTimeout must be positive, as chunks would otherwise be requested again without ever waiting.
+	if params.RequestTimeout <= 0 {
+		return fmt.Errorf("non-positive RequestTimeout: %d", params.RequestTimeout)
+	}
+
+	return nil
+}
+
+// N is the total number of replicas.
+func (params *ModuleParams) N() int {
+	return len(params.AllNodes)
+}
+
+// F is the maximum number of replicas that can be tolerated.
+func (params *ModuleParams) F() int {
+	return (params.N() - 1) / 3
+}
+
+// K is the number of chunks necessary to reconstruct a batch.
+func (params *ModuleParams) K() int {
+	return params.F() + 1
+}
+
+// Chunk is a chunk of an erasure-coded batch along with its inclusion proof in the Merkle tree over all chunks.
+type Chunk struct {
+	Data  []byte
+	Proof [][]byte
+}
+
+// State represents the common state used by all parts of the AVID implementation.
+// Batches and chunks are indexed by the Merkle root of the chunks of the batch, converted to a string.
+type State struct {
+	// Chunks stored by this node, i.e., the chunks of other nodes' batches this node is responsible for.
+	ChunkStore map[string]*Chunk
+
+	// Full batches known to this node, i.e., the batches it proposed or reconstructed.
+	BatchStore map[string][][]byte
+
+	// Retention index associated with the chunks and batches stored from now on.
+	RetentionIndex t.RetentionIndex
+
+	// Merkle roots of the stored chunks and batches, indexed by the retention index associated with them.
+	// When the retention index of a root is raised, the root is added under the new index
+	// and its entry under the old one is ignored.
+	RootsByRetIdx map[t.RetentionIndex][]string
+
+	// Retention index associated with the Merkle root of each stored chunk and batch.
+	RetIdxByRoot map[string]t.RetentionIndex
+}
+
+// NewState returns a new empty State.
+func NewState() *State {
+	return &State{
+		ChunkStore:     make(map[string]*Chunk),
+		BatchStore:     make(map[string][][]byte),
+		RetentionIndex: 0,
+		RootsByRetIdx:  make(map[t.RetentionIndex][]string),
+		RetIdxByRoot:   make(map[string]t.RetentionIndex),
+	}
+}
+
+// StoreChunk stores a chunk, associating it with the current retention index.
+// If the chunk is already stored, only its retention index is raised to the current one.
+func (s *State) StoreChunk(root []byte, chunk *Chunk) {
+	if _, ok := s.ChunkStore[string(root)]; !ok {
+		s.ChunkStore[string(root)] = chunk
+	}
+	s.retain(string(root))
+}
+
+// StoreBatch stores a full batch, associating it with the current retention index.
+// If the batch is already stored, only its retention index is raised to the current one.
+func (s *State) StoreBatch(root []byte, txs [][]byte) {
+	if _, ok := s.BatchStore[string(root)]; !ok {
+		s.BatchStore[string(root)] = txs
+	}
+	s.retain(string(root))
+}
+
+// retain associates the chunk and batch with the given Merkle root with the current retention index,
+// unless they are already associated with a higher one.
+func (s *State) retain(root string) {
+	if retIdx, ok := s.RetIdxByRoot[root]; ok && retIdx >= s.RetentionIndex {
+		return
+	}
+	s.RetIdxByRoot[root] = s.RetentionIndex
+	s.RootsByRetIdx[s.RetentionIndex] = append(s.RootsByRetIdx[s.RetentionIndex], root)
+}
+
+// ForgetBatchesBefore removes all chunks and batches associated with a retention index smaller than retIdx.
+func (s *State) ForgetBatchesBefore(retIdx t.RetentionIndex) {
+	for idx, roots := range s.RootsByRetIdx {
+		if idx >= retIdx {
+			continue
+		}
+
+		for _, root := range roots {
+			// Skip entries of roots that have been re-associated with a higher retention index.
+			if rootRetIdx, ok := s.RetIdxByRoot[root]; !ok || rootRetIdx != idx {
+				continue
+			}
+			delete(s.ChunkStore, root)
+			delete(s.BatchStore, root)
+			delete(s.RetIdxByRoot, root)
+		}
+		delete(s.RootsByRetIdx, idx)
+	}
+}
+
+// SigData is the binary data that should be signed for forming a certificate.
+func SigData(instanceUID InstanceUID, merkleRoot []byte) [][]byte {
+	return [][]byte{instanceUID.Bytes(), []byte("CHUNK_STORED"), merkleRoot}
+}
+
+// EncodeBatch erasure-codes a batch of transactions into one chunk per node
+// and computes the Merkle tree over the chunks.
+// It returns the Merkle root and the chunks along with their inclusion proofs.
+func EncodeBatch(params *ModuleParams, txs [][]byte) ([]byte, []*Chunk, error) {
+	data, err := erasure.Encode(serializeBatch(txs), params.N(), params.K())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root, proofs := merkle.Build(data)
+	chunks := make([]*Chunk, len(data))
+	for i := range data {
+		chunks[i] = &Chunk{Data: data[i], Proof: proofs[i]}
+	}
+	return root, chunks, nil
+}
+
+// DecodeBatch reconstructs a batch of transactions from at least K chunks, indexed by the position of the
+// corresponding node in the membership.
+// DecodeBatch also checks that the batch has been encoded correctly, i.e., that encoding the reconstructed batch
+// results in the given Merkle root. If it does not, DecodeBatch returns an error.
+// Since all the chunks are verified against the Merkle root, all correct nodes then obtain an error.
+func DecodeBatch(params *ModuleParams, root []byte, chunks map[int][]byte) ([][]byte, error) {
+	data, err := erasure.Decode(chunks, params.N(), params.K())
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := deserializeBatch(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-encode the batch to make sure that all nodes would reconstruct it from any subset of chunks.
+	reencoded, err := erasure.Encode(data, params.N(), params.K())
+	if err != nil {
+		return nil, err
+	}
+	if reencodedRoot, _ := merkle.Build(reencoded); string(reencodedRoot) != string(root) {
+		return nil, fmt.Errorf("batch has not been encoded correctly")
+	}
+
+	return txs, nil
+}
+
+// VerifyChunk checks that chunk is the chunk of the node with the given index in the membership
+// for the batch with the given Merkle root.
+func VerifyChunk(params *ModuleParams, root []byte, index int, chunk *Chunk) bool {
+	return merkle.Verify(root, chunk.Data, index, params.N(), chunk.Proof)
+}
+
+// serializeBatch encodes a list of transactions as a single byte slice, prefixing each transaction with its length.
+func serializeBatch(txs [][]byte) []byte {
+	size := 0
+	for _, tx := range txs {
+		size += 8 + len(tx)
+	}
+
+	data := make([]byte, size)
+	pos := 0
+	for _, tx := range txs {
+		binary.BigEndian.PutUint64(data[pos:], uint64(len(tx)))
+		pos += 8 + copy(data[pos+8:], tx)
+	}
+	return data
+}
+
+// deserializeBatch decodes a list of transactions encoded by serializeBatch.
+func deserializeBatch(data []byte) ([][]byte, error) {
+	txs := make([][]byte, 0)
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, fmt.Errorf("truncated transaction length")
+		}
+		txLen := binary.BigEndian.Uint64(data)
+		data = data[8:]
+		if txLen > uint64(len(data)) {
+			return nil, fmt.Errorf("truncated transaction")
+		}
+		txs = append(txs, data[:txLen])
+		data = data[txLen:]
+	}
+	return txs, nil
+}
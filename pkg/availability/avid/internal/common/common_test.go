@@ -0,0 +1,58 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestEncodeDecodeBatch(t *testing.T) {
+	params := &ModuleParams{AllNodes: []types.NodeID{"0", "1", "2", "3"}}
+	txs := [][]byte{[]byte("tx0"), {}, []byte("transaction 2")}
+
+	root, chunks, err := EncodeBatch(params, txs)
+	require.NoError(t, err)
+	require.Len(t, chunks, params.N())
+
+	for i, chunk := range chunks {
+		assert.True(t, VerifyChunk(params, root, i, chunk))
+		assert.False(t, VerifyChunk(params, root, (i+1)%params.N(), chunk))
+	}
+
+	// Any F+1 chunks are sufficient to reconstruct the batch.
+	decoded, err := DecodeBatch(params, root, map[int][]byte{1: chunks[1].Data, 3: chunks[3].Data})
+	require.NoError(t, err)
+	assert.Equal(t, txs, decoded)
+
+	// Chunks that are not consistent with the Merkle root are detected.
+	otherRoot, _, err := EncodeBatch(params, [][]byte{[]byte("other")})
+	require.NoError(t, err)
+	_, err = DecodeBatch(params, otherRoot, map[int][]byte{1: chunks[1].Data, 3: chunks[3].Data})
+	assert.Error(t, err)
+}
+
+func TestStoreRaisesRetentionIndex(t *testing.T) {
+	s := NewState()
+	s.StoreChunk([]byte("r0"), &Chunk{Data: []byte{0}})
+	s.StoreBatch([]byte("r1"), [][]byte{{1}})
+
+	// Storing the chunk and the batch again in a later epoch retains them longer.
+	s.RetentionIndex = 2
+	s.StoreChunk([]byte("r0"), &Chunk{Data: []byte{0}})
+	s.StoreBatch([]byte("r0"), [][]byte{{0}})
+	s.StoreBatch([]byte("r2"), [][]byte{{2}})
+
+	s.ForgetBatchesBefore(2)
+	assert.Contains(t, s.ChunkStore, "r0")
+	assert.Contains(t, s.BatchStore, "r0")
+	assert.NotContains(t, s.BatchStore, "r1")
+	assert.Contains(t, s.BatchStore, "r2")
+
+	s.ForgetBatchesBefore(3)
+	assert.Empty(t, s.ChunkStore)
+	assert.Empty(t, s.BatchStore)
+	assert.Empty(t, s.RetIdxByRoot)
+}
@@ -0,0 +1,109 @@
+package aviddsl
+
+import (
+	"fmt"
+
+	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Module-specific dsl functions for processing events.
+
+func UponAvidMessageReceived(m dsl.Module, handler func(from t.NodeID, msg *avidpb.Message) error) {
+	dsl.UponMessageReceived(m, func(from t.NodeID, msg *messagepb.Message) error {
+		avidMsgWrapper, ok := msg.Type.(*messagepb.Message_Avid)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, avidMsgWrapper.Avid)
+	})
+}
+
+func UponChunkMessageReceived(
+	m dsl.Module,
+	handler func(from t.NodeID, merkleRoot []byte, chunk []byte, proof [][]byte, reqID uint64) error,
+) {
+	UponAvidMessageReceived(m, func(from t.NodeID, msg *avidpb.Message) error {
+		chunkMsgWrapper, ok := msg.Type.(*avidpb.Message_Chunk)
+		if !ok {
+			return nil
+		}
+		chunkMsg := chunkMsgWrapper.Chunk
+
+		return handler(from, chunkMsg.MerkleRoot, chunkMsg.Chunk, chunkMsg.Proof, chunkMsg.ReqId)
+	})
+}
+
+func UponSigMessageReceived(m dsl.Module, handler func(from t.NodeID, signature []byte, reqID uint64) error) {
+	UponAvidMessageReceived(m, func(from t.NodeID, msg *avidpb.Message) error {
+		sigMsgWrapper, ok := msg.Type.(*avidpb.Message_Sig)
+		if !ok {
+			return nil
+		}
+		sigMsg := sigMsgWrapper.Sig
+
+		return handler(from, sigMsg.Signature, sigMsg.ReqId)
+	})
+}
+
+func UponRequestChunkMessageReceived(
+	m dsl.Module,
+	handler func(from t.NodeID, merkleRoot []byte, reqID uint64) error,
+) {
+	UponAvidMessageReceived(m, func(from t.NodeID, msg *avidpb.Message) error {
+		requestChunkMsgWrapper, ok := msg.Type.(*avidpb.Message_RequestChunk)
+		if !ok {
+			return nil
+		}
+		requestChunkMsg := requestChunkMsgWrapper.RequestChunk
+
+		return handler(from, requestChunkMsg.MerkleRoot, requestChunkMsg.ReqId)
+	})
+}
+
+func UponProvideChunkMessageReceived(
+	m dsl.Module,
+	handler func(from t.NodeID, chunk []byte, proof [][]byte, reqID uint64) error,
+) {
+	UponAvidMessageReceived(m, func(from t.NodeID, msg *avidpb.Message) error {
+		provideChunkMsgWrapper, ok := msg.Type.(*avidpb.Message_ProvideChunk)
+		if !ok {
+			return nil
+		}
+		provideChunkMsg := provideChunkMsgWrapper.ProvideChunk
+
+		return handler(from, provideChunkMsg.Chunk, provideChunkMsg.Proof, provideChunkMsg.ReqId)
+	})
+}
+
+func UponEvent[EvWrapper avidpb.Event_TypeWrapper[Ev], Ev any](m dsl.Module, handler func(ev *Ev) error) {
+	adsl.UponEvent[*apb.Event_Avid](m, func(ev *avidpb.Event) error {
+		evWrapper, ok := ev.Type.(EvWrapper)
+		if !ok {
+			return nil
+		}
+		return handler(evWrapper.Unwrap())
+	})
+}
+
+func UponRequestTimeout(m dsl.Module, handler func(reqID uint64) error) {
+	UponEvent[*avidpb.Event_RequestTimeout](m, func(ev *avidpb.RequestTimeout) error {
+		return handler(ev.ReqId)
+	})
+}
+
+func UponRequestTransactions(m dsl.Module, handler func(cert *avidpb.Cert, origin *apb.RequestTransactionsOrigin) error) {
+	adsl.UponRequestTransactions(m, func(cert *apb.Cert, origin *apb.RequestTransactionsOrigin) error {
+		avidCertWrapper, ok := cert.Type.(*apb.Cert_Avid)
+		if !ok {
+			return fmt.Errorf("unexpected certificate type. Expected: %T, got: %T", avidCertWrapper, cert.Type)
+		}
+
+		return handler(avidCertWrapper.Avid, origin)
+	})
+}
@@ -0,0 +1,165 @@
+// Package erasure implements a systematic Reed-Solomon erasure code over GF(2^8).
+// Data is split into k data chunks and extended to n chunks in total,
+// such that any k of the n chunks are sufficient to recover the data.
+//
+// Each byte position is treated independently. The bytes of the k data chunks at a given position
+// are interpreted as the values P(0), ..., P(k-1) of a polynomial P of degree less than k,
+// and chunk i contains the value P(i) at that position.
+// Encoding and decoding are thus both performed by Lagrange interpolation.
+package erasure
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// MaxChunks is the maximal number of chunks supported, limited by the size of the field.
+const MaxChunks = 256
+
+// Encode splits data into k data chunks and computes n-k additional parity chunks.
+// All chunks have the same size. The length of the data is encoded along with it,
+// so that Decode can strip the padding.
+func Encode(data []byte, n, k int) ([][]byte, error) {
+	if err := checkParams(n, k); err != nil {
+		return nil, err
+	}
+
+	// Prefix the data with its length and pad it to a multiple of k.
+	chunkSize := (8 + len(data) + k - 1) / k
+	padded := make([]byte, chunkSize*k)
+	binary.BigEndian.PutUint64(padded, uint64(len(data)))
+	copy(padded[8:], data)
+
+	chunks := make([][]byte, n)
+	points := make([]int, k)
+	for i := 0; i < k; i++ {
+		chunks[i] = padded[i*chunkSize : (i+1)*chunkSize]
+		points[i] = i
+	}
+
+	for i := k; i < n; i++ {
+		chunks[i] = interpolate(points, chunks[:k], i, chunkSize)
+	}
+
+	return chunks, nil
+}
+
+// Decode recovers the data encoded by Encode from at least k chunks.
+// The chunks are indexed by their position in the output of Encode.
+// Only k of the given chunks (those with the lowest indices) are used.
+func Decode(chunks map[int][]byte, n, k int) ([]byte, error) {
+	if err := checkParams(n, k); err != nil {
+		return nil, err
+	}
+
+	// Select k valid chunks of equal size.
+	points := make([]int, 0, k)
+	values := make([][]byte, 0, k)
+	chunkSize := -1
+	for i := 0; i < n && len(points) < k; i++ {
+		chunk, ok := chunks[i]
+		if !ok {
+			continue
+		}
+		if chunkSize == -1 {
+			chunkSize = len(chunk)
+		} else if len(chunk) != chunkSize {
+			return nil, fmt.Errorf("chunks of different sizes: %d and %d", chunkSize, len(chunk))
+		}
+		points = append(points, i)
+		values = append(values, chunk)
+	}
+	if len(points) < k {
+		return nil, fmt.Errorf("not enough chunks: %d (need %d)", len(points), k)
+	}
+
+	// Recover the data chunks.
+	padded := make([]byte, 0, chunkSize*k)
+	for i := 0; i < k; i++ {
+		if chunk, ok := chunks[i]; ok && len(chunk) == chunkSize {
+			padded = append(padded, chunk...)
+		} else {
+			padded = append(padded, interpolate(points, values, i, chunkSize)...)
+		}
+	}
+
+	// Strip the length prefix and the padding.
+	if len(padded) < 8 {
+		return nil, fmt.Errorf("chunks too short")
+	}
+	dataLen := binary.BigEndian.Uint64(padded)
+	if dataLen > uint64(len(padded)-8) {
+		return nil, fmt.Errorf("invalid data length: %d", dataLen)
+	}
+	return padded[8 : 8+dataLen], nil
+}
+
+func checkParams(n, k int) error {
+	if k < 1 || k > n || n > MaxChunks {
+		return fmt.Errorf("invalid erasure code parameters: n=%d, k=%d", n, k)
+	}
+	return nil
+}
+
+// interpolate computes the chunk at point x from the given chunks at the given (distinct) points.
+func interpolate(points []int, values [][]byte, x int, chunkSize int) []byte {
+	result := make([]byte, chunkSize)
+	for j, xj := range points {
+		// Compute the Lagrange basis polynomial for point xj, evaluated at x.
+		coef := byte(1)
+		for m, xm := range points {
+			if m != j {
+				// In GF(2^8), subtraction is XOR.
+				coef = gfMul(coef, gfDiv(byte(x^xm), byte(xj^xm)))
+			}
+		}
+
+		if coef == 0 {
+			continue
+		}
+		for b, v := range values[j] {
+			result[b] ^= gfMul(coef, v)
+		}
+	}
+	return result
+}
+
+// ============================================================
+// GF(2^8) arithmetic
+// ============================================================
+
+var (
+	gfExp [510]byte
+	gfLog [256]int
+)
+
+func init() {
+	// Generate the tables using the primitive polynomial x^8 + x^4 + x^3 + x^2 + 1.
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfExp[i+255] = byte(x)
+		gfLog[x] = i
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]+gfLog[b]]
+}
+
+func gfDiv(a, b byte) byte {
+	if b == 0 {
+		panic("division by zero")
+	}
+	if a == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]+255-gfLog[b]]
+}
@@ -0,0 +1,34 @@
+package erasure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	n, k := 7, 3
+	data := []byte("The quick brown fox jumps over the lazy dog.")
+
+	chunks, err := Encode(data, n, k)
+	require.NoError(t, err)
+	require.Len(t, chunks, n)
+
+	// Any k chunks are sufficient to recover the data.
+	for _, indices := range [][]int{{0, 1, 2}, {4, 5, 6}, {0, 3, 6}, {2, 5, 1}} {
+		subset := make(map[int][]byte)
+		for _, i := range indices {
+			subset[i] = chunks[i]
+		}
+		decoded, err := Decode(subset, n, k)
+		require.NoError(t, err)
+		assert.Equal(t, data, decoded)
+	}
+
+	_, err = Decode(map[int][]byte{0: chunks[0], 5: chunks[5]}, n, k)
+	assert.Error(t, err)
+
+	_, err = Encode(data, 300, 3)
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+// Package merkle implements a simple binary Merkle tree over a list of leaves, using SHA-256.
+// Leaves and inner nodes are hashed with different prefixes to prevent second preimage attacks.
+// If the number of nodes at some level of the tree is odd, the last node is paired with an empty hash.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+// Build computes the Merkle tree over the given leaves
+// and returns its root along with an inclusion proof for each leaf.
+// The proof of a leaf consists of the hashes of the siblings on the path from the leaf to the root.
+func Build(leaves [][]byte) ([]byte, [][][]byte) {
+	level := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = leafHash(leaf)
+	}
+
+	proofs := make([][][]byte, len(leaves))
+	positions := make([]int, len(leaves))
+	for i := range leaves {
+		positions[i] = i
+	}
+
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, []byte{})
+		}
+
+		for i := range leaves {
+			proofs[i] = append(proofs[i], level[positions[i]^1])
+			positions[i] /= 2
+		}
+
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = innerHash(level[2*i], level[2*i+1])
+		}
+		level = next
+	}
+
+	if len(level) == 0 {
+		return innerHash(nil, nil), proofs
+	}
+	return level[0], proofs
+}
+
+// Verify checks that leaf is the leaf with the given index in the Merkle tree over n leaves with the given root,
+// using the inclusion proof produced by Build.
+func Verify(root []byte, leaf []byte, index int, n int, proof [][]byte) bool {
+	if index < 0 || index >= n || len(proof) != depth(n) {
+		return false
+	}
+
+	h := leafHash(leaf)
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			h = innerHash(h, sibling)
+		} else {
+			h = innerHash(sibling, h)
+		}
+		index /= 2
+	}
+	return bytes.Equal(h, root)
+}
+
+// depth returns the depth of the Merkle tree over n leaves, i.e., the length of the inclusion proofs.
+func depth(n int) int {
+	d := 0
+	for width := n; width > 1; width = (width + 1) / 2 {
+		d++
+	}
+	return d
+}
+
+func leafHash(leaf []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0})
+	h.Write(leaf)
+	return h.Sum(nil)
+}
+
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{1})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
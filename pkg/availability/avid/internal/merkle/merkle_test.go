@@ -0,0 +1,25 @@
+package merkle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildVerify(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		leaves := make([][]byte, n)
+		for i := range leaves {
+			leaves[i] = []byte{byte(i)}
+		}
+
+		root, proofs := Build(leaves)
+		for i := range leaves {
+			assert.True(t, Verify(root, leaves[i], i, n, proofs[i]))
+			assert.False(t, Verify(root, []byte{byte(i + 1)}, i, n, proofs[i]))
+			if n > 1 {
+				assert.False(t, Verify(root, leaves[i], (i+1)%n, n, proofs[i]))
+			}
+		}
+	}
+}
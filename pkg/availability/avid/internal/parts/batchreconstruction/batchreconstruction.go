@@ -0,0 +1,208 @@
+package batchreconstruction
+
+import (
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/common"
+	aviddsl "github.com/filecoin-project/mir/pkg/availability/avid/internal/dsl"
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/protobuf"
+	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/events"
+	mempooldsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
+)
+
+// State represents the state related to this part of the module.
+type State struct {
+	*common.State
+	NextReqID    RequestID
+	RequestState map[RequestID]*RequestState
+}
+
+// RequestID is used to uniquely identify an outgoing request.
+type RequestID = uint64
+
+// RequestState represents the state related to a request on the source node of the request.
+// The node disposes of this state as soon as the request is completed.
+type RequestState struct {
+	MerkleRoot []byte
+	ReqOrigin  *apb.RequestTransactionsOrigin
+
+	// Verified chunks received so far, indexed by the position of the sending node in the membership.
+	Chunks map[int][]byte
+
+	// Set to true when the batch has been decoded and its transactions are being validated.
+	Decoded bool
+}
+
+// IncludeBatchReconstruction registers event handlers for processing availabilitypb.RequestTransactions events.
+// If the batch is not stored locally, its chunks are requested from all nodes and the batch is reconstructed
+// as soon as K valid chunks are received.
+// The nodes that have not provided a valid chunk are asked again every params.RequestTimeout until then.
+// Since a node only receives its own chunk of a batch when signing it, it cannot validate the transactions
+// of the batch at that point. Instead, the transactions of a reconstructed batch are validated through the mempool
+// and invalid ones are left out. As validation is deterministic, all correct nodes leave out the same transactions.
+func IncludeBatchReconstruction(
+	m dsl.Module,
+	mc *common.ModuleConfig,
+	params *common.ModuleParams,
+	nodeID t.NodeID,
+	commonState *common.State,
+) {
+	state := State{
+		State:        commonState,
+		NextReqID:    0,
+		RequestState: make(map[uint64]*RequestState),
+	}
+
+	// completeRequest stores the reconstructed batch and provides its transactions to the requesting module.
+	completeRequest := func(reqID RequestID, txs [][]byte) {
+		requestState := state.RequestState[reqID]
+		state.StoreBatch(requestState.MerkleRoot, txs)
+		adsl.ProvideTransactions(m, t.ModuleID(requestState.ReqOrigin.Module), txs, requestState.ReqOrigin)
+
+		// Dispose of the state associated with this request.
+		delete(state.RequestState, reqID)
+	}
+
+	// tryReconstruct decodes the batch if enough chunks have been received and has its transactions validated.
+	tryReconstruct := func(reqID RequestID) {
+		requestState := state.RequestState[reqID]
+		if requestState.Decoded || len(requestState.Chunks) < params.K() {
+			return
+		}
+
+		txs, err := common.DecodeBatch(params, requestState.MerkleRoot, requestState.Chunks)
+		if err != nil || len(txs) == 0 {
+			// If decoding fails, the proposer of the batch did not encode it correctly.
+			// Since all correct nodes detect this regardless of the chunks they use, they all output an empty batch.
+			completeRequest(reqID, [][]byte{})
+			return
+		}
+
+		requestState.Decoded = true
+		mempooldsl.VerifyTransactions(m, mc.Mempool, txs, &verifyReconstructedTxsContext{reqID, txs})
+	}
+
+	// requestChunks asks all other nodes that have not provided a valid chunk yet for their chunk
+	// and sets up a timeout after which they are asked again.
+	requestChunks := func(reqID RequestID) {
+		requestState := state.RequestState[reqID]
+
+		nodes := make([]t.NodeID, 0, len(params.AllNodes))
+		for i, id := range params.AllNodes {
+			if _, ok := requestState.Chunks[i]; !ok && id != nodeID {
+				nodes = append(nodes, id)
+			}
+		}
+		dsl.SendMessage(m, mc.Net, protobuf.RequestChunkMessage(mc.Self, requestState.MerkleRoot, reqID), nodes)
+
+		dsl.EmitEvent(m, events.TimerDelay(mc.Timer,
+			[]*eventpb.Event{protobuf.RequestTimeout(mc.Self, reqID)},
+			params.RequestTimeout))
+	}
+
+	// When receive a request for transactions, first check the local storage and then ask other nodes.
+	aviddsl.UponRequestTransactions(m, func(cert *avidpb.Cert, origin *apb.RequestTransactionsOrigin) error {
+		txs, ok := state.BatchStore[string(cert.MerkleRoot)]
+		if ok {
+			adsl.ProvideTransactions(m, t.ModuleID(origin.Module), txs, origin)
+			return nil
+		}
+
+		reqID := state.NextReqID
+		state.NextReqID++
+
+		state.RequestState[reqID] = &RequestState{
+			MerkleRoot: cert.MerkleRoot,
+			ReqOrigin:  origin,
+			Chunks:     make(map[int][]byte),
+		}
+
+		// Use the own chunk, if present.
+		if chunk, ok := state.ChunkStore[string(cert.MerkleRoot)]; ok {
+			state.RequestState[reqID].Chunks[sliceutil.Index(params.AllNodes, nodeID)] = chunk.Data
+			tryReconstruct(reqID)
+			if requestState, ok := state.RequestState[reqID]; !ok || requestState.Decoded {
+				return nil
+			}
+		}
+
+		requestChunks(reqID)
+		return nil
+	})
+
+	// When not enough chunks have been received in time, ask the nodes that have not provided their chunk again.
+	aviddsl.UponRequestTimeout(m, func(reqID RequestID) error {
+		if requestState, ok := state.RequestState[reqID]; !ok || requestState.Decoded {
+			// The request has already been completed or enough chunks have been received.
+			return nil
+		}
+
+		requestChunks(reqID)
+		return nil
+	})
+
+	// When receive a request for a chunk from another node, send the chunk in response.
+	aviddsl.UponRequestChunkMessageReceived(m, func(from t.NodeID, root []byte, reqID RequestID) error {
+		chunk, ok := state.ChunkStore[string(root)]
+		if !ok {
+			// Ignore request for a chunk this node does not have.
+			return nil
+		}
+
+		dsl.SendMessage(m, mc.Net, protobuf.ProvideChunkMessage(mc.Self, chunk.Data, chunk.Proof, reqID), []t.NodeID{from})
+		return nil
+	})
+
+	// When receive a requested chunk, verify it and, if enough chunks have been received, reconstruct the batch.
+	aviddsl.UponProvideChunkMessageReceived(m, func(from t.NodeID, data []byte, proof [][]byte, reqID RequestID) error {
+		requestState, ok := state.RequestState[reqID]
+		if !ok {
+			// Ignore a message with an invalid or outdated request id.
+			return nil
+		}
+
+		index := sliceutil.Index(params.AllNodes, from)
+		if _, ok := requestState.Chunks[index]; ok {
+			// Ignore duplicate chunk.
+			return nil
+		}
+
+		if !common.VerifyChunk(params, requestState.MerkleRoot, index, &common.Chunk{Data: data, Proof: proof}) {
+			// Ignore invalid chunk.
+			return nil
+		}
+
+		requestState.Chunks[index] = data
+		tryReconstruct(reqID)
+		return nil
+	})
+
+	// When the transactions of the reconstructed batch are validated, provide the valid ones.
+	mempooldsl.UponTransactionsVerified(m,
+		func(errs []error, _ []uint64, _ bool, context *verifyReconstructedTxsContext) error {
+			validTxs := make([][]byte, 0, len(context.txs))
+			for i, err := range errs {
+				if err == nil {
+					validTxs = append(validTxs, context.txs[i])
+				}
+			}
+
+			completeRequest(context.reqID, validTxs)
+			return nil
+		},
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Context data structures                                                                                            //
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type verifyReconstructedTxsContext struct {
+	reqID RequestID
+	txs   [][]byte
+}
@@ -0,0 +1,196 @@
+package certcreation
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/common"
+	aviddsl "github.com/filecoin-project/mir/pkg/availability/avid/internal/dsl"
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/protobuf"
+	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/availability/internal/certutil"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	mempooldsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
+)
+
+// State represents the state related to this part of the module.
+type State struct {
+	*common.State
+	NextReqID    RequestID
+	RequestState map[RequestID]*RequestState
+}
+
+// RequestID is used to uniquely identify an outgoing request.
+type RequestID = uint64
+
+// RequestState represents the state related to a request on the source node of the request.
+// The node disposes of this state as soon as the request is completed.
+type RequestState struct {
+	ReqOrigin  *apb.RequestCertOrigin
+	MerkleRoot []byte
+
+	receivedSig map[t.NodeID]bool
+	sigs        map[t.NodeID][]byte
+}
+
+// IncludeCreatingCertificates registers event handlers for processing availabilitypb.RequestCert events.
+func IncludeCreatingCertificates(
+	m dsl.Module,
+	mc *common.ModuleConfig,
+	params *common.ModuleParams,
+	nodeID t.NodeID,
+	commonState *common.State,
+) {
+	state := State{
+		State:        commonState,
+		NextReqID:    0,
+		RequestState: make(map[uint64]*RequestState),
+	}
+
+	////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+	// code for the source of the request                                                                             //
+	////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	// When a batch is requested by the consensus layer, request a batch of transactions from the mempool.
+	adsl.UponRequestCert(m, func(origin *apb.RequestCertOrigin) error {
+		reqID := state.NextReqID
+		state.NextReqID++
+
+		state.RequestState[reqID] = &RequestState{
+			ReqOrigin:   origin,
+			receivedSig: make(map[t.NodeID]bool),
+			sigs:        make(map[t.NodeID][]byte),
+		}
+
+		mempooldsl.RequestBatch(m, mc.Mempool, &requestBatchFromMempoolContext{reqID})
+		return nil
+	})
+
+	// When the mempool provides a batch, encode it and send each node its chunk.
+	mempooldsl.UponNewBatch(m, func(txIDs []t.TxID, txs [][]byte, context *requestBatchFromMempoolContext) error {
+		root, chunks, err := common.EncodeBatch(params, txs)
+		if err != nil {
+			return fmt.Errorf("failed to encode batch: %w", err)
+		}
+
+		// Keep the whole batch, so that it does not need to be reconstructed if requested.
+		state.StoreBatch(root, txs)
+		state.RequestState[context.reqID].MerkleRoot = root
+
+		for i, id := range params.AllNodes {
+			dsl.SendMessage(m, mc.Net,
+				protobuf.ChunkMessage(mc.Self, root, chunks[i].Data, chunks[i].Proof, context.reqID),
+				[]t.NodeID{id})
+		}
+		return nil
+	})
+
+	// When receive a signature, verify its correctness.
+	aviddsl.UponSigMessageReceived(m, func(from t.NodeID, signature []byte, reqID RequestID) error {
+		requestState, ok := state.RequestState[reqID]
+		if !ok || requestState.MerkleRoot == nil {
+			// Ignore a message with an invalid or outdated request id.
+			return nil
+		}
+
+		if !requestState.receivedSig[from] {
+			requestState.receivedSig[from] = true
+			sigData := common.SigData(params.InstanceUID, requestState.MerkleRoot)
+			dsl.VerifyOneNodeSig(m, mc.Crypto, sigData, signature, from, &verifySigContext{reqID, signature})
+		}
+		return nil
+	})
+
+	// When a signature is verified, store it in memory.
+	dsl.UponOneNodeSigVerified(m, func(nodeID t.NodeID, err error, context *verifySigContext) error {
+		if err != nil {
+			// Ignore invalid signature.
+			return nil
+		}
+		requestState, ok := state.RequestState[context.reqID]
+		if !ok {
+			// The request has already been completed.
+			return nil
+		}
+
+		requestState.sigs[nodeID] = context.signature
+		return nil
+	})
+
+	// When a quorum (more than (N+F)/2) of signatures are collected, create and output a certificate.
+	// Such a quorum guarantees that at least F+1 correct nodes store their chunks,
+	// which is sufficient to reconstruct the batch.
+	dsl.UponCondition(m, func() error {
+		// Iterate over active outgoing requests.
+		// Most of the time, there is expected to be at most one active outgoing request.
+		for reqID, requestState := range state.RequestState {
+			if len(requestState.sigs) > (params.N()+params.F())/2 {
+				// Order the signatures as the signers in the membership, as encoded in the signer bitmap.
+				signers := make(map[t.NodeID]struct{}, len(requestState.sigs))
+				certSigs := make([][]byte, 0, len(requestState.sigs))
+				for _, id := range params.AllNodes {
+					if sig, ok := requestState.sigs[id]; ok {
+						signers[id] = struct{}{}
+						certSigs = append(certSigs, sig)
+					}
+				}
+
+				requestingModule := t.ModuleID(requestState.ReqOrigin.Module)
+				cert := protobuf.Cert(requestState.MerkleRoot, certutil.SignerBitmap(params.AllNodes, signers), certSigs)
+				adsl.NewCert(m, requestingModule, cert, requestState.ReqOrigin)
+
+				// Dispose of the state associated with this request.
+				delete(state.RequestState, reqID)
+			}
+		}
+		return nil
+	})
+
+	////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+	// code for all other nodes                                                                                       //
+	////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+	ownIndex := sliceutil.Index(params.AllNodes, nodeID)
+
+	// When receive a chunk, verify it against the Merkle root, store it, and generate a signature.
+	aviddsl.UponChunkMessageReceived(m, func(from t.NodeID, root []byte, data []byte, proof [][]byte, reqID uint64) error {
+		chunk := &common.Chunk{Data: data, Proof: proof}
+		if !common.VerifyChunk(params, root, ownIndex, chunk) {
+			// Ignore invalid chunk.
+			return nil
+		}
+
+		// TODO: use persistent storage for crash-recovery.
+		state.StoreChunk(root, chunk)
+
+		sigMsg := common.SigData(params.InstanceUID, root)
+		dsl.SignRequest(m, mc.Crypto, sigMsg, &signReceivedChunkContext{from, reqID})
+		return nil
+	})
+
+	// When a signature is generated, send it to the process that sent the request.
+	dsl.UponSignResult(m, func(signature []byte, context *signReceivedChunkContext) error {
+		dsl.SendMessage(m, mc.Net, protobuf.SigMessage(mc.Self, signature, context.reqID), []t.NodeID{context.sourceID})
+		return nil
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Context data structures                                                                                            //
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type requestBatchFromMempoolContext struct {
+	reqID RequestID
+}
+
+type signReceivedChunkContext struct {
+	sourceID t.NodeID
+	reqID    RequestID
+}
+
+type verifySigContext struct {
+	reqID     RequestID
+	signature []byte
+}
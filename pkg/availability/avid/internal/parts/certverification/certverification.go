@@ -0,0 +1,99 @@
+package certverification
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/common"
+	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/availability/internal/certutil"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
+)
+
+// IncludeVerificationOfCertificates registers event handlers for processing availabilitypb.VerifyCert events.
+func IncludeVerificationOfCertificates(
+	m dsl.Module,
+	mc *common.ModuleConfig,
+	params *common.ModuleParams,
+	nodeID t.NodeID,
+	_ *common.State, // this part of the protocol is stateless.
+) {
+	// When receive a request to verify a certificate, check that it is structurally correct and verify the signatures.
+	adsl.UponVerifyCert(m, func(cert *apb.Cert, origin *apb.VerifyCertOrigin) error {
+		avidCert, signers, err := verifyCertificateStructure(params, cert)
+		if err != nil {
+			adsl.CertVerified(m, t.ModuleID(origin.Module), err, origin)
+			return nil
+		}
+
+		sigMsg := common.SigData(params.InstanceUID, avidCert.MerkleRoot)
+		dsl.VerifyNodeSigs(m, mc.Crypto,
+			/*data*/ sliceutil.Repeat(sigMsg, len(signers)),
+			/*signatures*/ avidCert.Signatures,
+			/*nodeIDs*/ signers,
+			/*context*/ &verifySigsInCertContext{origin},
+		)
+		return nil
+	})
+
+	// When the signatures in a certificate are verified, output the result of certificate verification.
+	dsl.UponNodeSigsVerified(m, func(nodeIDs []t.NodeID, errs []error, allOK bool, context *verifySigsInCertContext) error {
+		var err error
+		if !allOK {
+			err = errors.New("some signatures are invalid")
+		}
+
+		adsl.CertVerified(m, t.ModuleID(context.origin.Module), err, context.origin)
+		return nil
+	})
+}
+
+// verifyCertificateStructure checks that the certificate is well-formed without checking the validity of the
+// cryptographic authenticators like hashes and digital signatures.
+// On success, it returns the certificate along with the signers decoded from its signer bitmap.
+func verifyCertificateStructure(params *common.ModuleParams, cert *apb.Cert) (*avidpb.Cert, []t.NodeID, error) {
+	// Check that the certificate is present.
+	if cert == nil || cert.Type == nil {
+		return nil, nil, fmt.Errorf("the certificate is nil")
+	}
+
+	// Check that the certificate is of the right type.
+	avidCertWrapper, ok := cert.Type.(*apb.Cert_Avid)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected certificate type")
+	}
+	avidCert := avidCertWrapper.Avid
+
+	// Check that the certificate commits to some chunks.
+	if len(avidCert.MerkleRoot) == 0 {
+		return nil, nil, fmt.Errorf("the Merkle root is empty")
+	}
+
+	// Check that the signer bitmap is valid w.r.t. the membership.
+	// This also guarantees that the identities of the signing nodes are valid and not repeated.
+	signers, err := certutil.BitmapSigners(params.AllNodes, avidCert.SignerBitmap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check that the certificate contains a sufficient number of signatures.
+	if len(signers) <= (params.N()+params.F())/2 {
+		return nil, nil, fmt.Errorf("insuficient number of signatures")
+	}
+
+	if len(signers) != len(avidCert.Signatures) {
+		return nil, nil, fmt.Errorf("the number of signatures does not correspond to the number of signers")
+	}
+
+	return avidCert, signers, nil
+}
+
+// Context data structures                                                                                            //
+
+type verifySigsInCertContext struct {
+	origin *apb.VerifyCertOrigin
+}
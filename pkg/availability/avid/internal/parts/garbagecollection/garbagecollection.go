@@ -0,0 +1,37 @@
+package garbagecollection
+
+import (
+	"github.com/filecoin-project/mir/pkg/availability/avid/internal/common"
+	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	mempooldsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// IncludeGarbageCollection registers event handlers for processing
+// availabilitypb.SetRetentionIndex and availabilitypb.ForgetBatchesBefore events.
+// Both events are also forwarded to the mempool.
+func IncludeGarbageCollection(
+	m dsl.Module,
+	mc *common.ModuleConfig,
+	commonState *common.State,
+) {
+
+	// When the consensus layer announces a new retention index, associate all newly stored batches with it.
+	adsl.UponSetRetentionIndex(m, func(retIdx t.RetentionIndex) error {
+		if retIdx > commonState.RetentionIndex {
+			commonState.RetentionIndex = retIdx
+		}
+
+		mempooldsl.SetRetentionIndex(m, mc.Mempool, retIdx)
+		return nil
+	})
+
+	// When the consensus layer does not need old batches any more, remove them and their chunks from the store.
+	adsl.UponForgetBatchesBefore(m, func(retIdx t.RetentionIndex) error {
+		commonState.ForgetBatchesBefore(retIdx)
+
+		mempooldsl.ForgetTransactionsBefore(m, mc.Mempool, retIdx)
+		return nil
+	})
+}
@@ -0,0 +1,102 @@
+package protobuf
+
+import (
+	aevents "github.com/filecoin-project/mir/pkg/availability/events"
+	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+func Message(moduleID t.ModuleID, msg *avidpb.Message) *messagepb.Message {
+	return &messagepb.Message{
+		DestModule: moduleID.Pb(),
+		Type: &messagepb.Message_Avid{
+			Avid: msg,
+		},
+	}
+}
+
+func ChunkMessage(
+	moduleID t.ModuleID,
+	merkleRoot []byte,
+	chunk []byte,
+	proof [][]byte,
+	reqID uint64,
+) *messagepb.Message {
+	return Message(moduleID, &avidpb.Message{
+		Type: &avidpb.Message_Chunk{
+			Chunk: &avidpb.ChunkMessage{
+				MerkleRoot: merkleRoot,
+				Chunk:      chunk,
+				Proof:      proof,
+				ReqId:      reqID,
+			},
+		},
+	})
+}
+
+func SigMessage(moduleID t.ModuleID, signature []byte, reqID uint64) *messagepb.Message {
+	return Message(moduleID, &avidpb.Message{
+		Type: &avidpb.Message_Sig{
+			Sig: &avidpb.SigMessage{
+				Signature: signature,
+				ReqId:     reqID,
+			},
+		},
+	})
+}
+
+func RequestChunkMessage(moduleID t.ModuleID, merkleRoot []byte, reqID uint64) *messagepb.Message {
+	return Message(moduleID, &avidpb.Message{
+		Type: &avidpb.Message_RequestChunk{
+			RequestChunk: &avidpb.RequestChunkMessage{
+				MerkleRoot: merkleRoot,
+				ReqId:      reqID,
+			},
+		},
+	})
+}
+
+func ProvideChunkMessage(moduleID t.ModuleID, chunk []byte, proof [][]byte, reqID uint64) *messagepb.Message {
+	return Message(moduleID, &avidpb.Message{
+		Type: &avidpb.Message_ProvideChunk{
+			ProvideChunk: &avidpb.ProvideChunkMessage{
+				Chunk: chunk,
+				Proof: proof,
+				ReqId: reqID,
+			},
+		},
+	})
+}
+
+func Event(moduleID t.ModuleID, ev *avidpb.Event) *eventpb.Event {
+	return aevents.Event(moduleID, &apb.Event{
+		Type: &apb.Event_Avid{
+			Avid: ev,
+		},
+	})
+}
+
+func RequestTimeout(moduleID t.ModuleID, reqID uint64) *eventpb.Event {
+	return Event(moduleID, &avidpb.Event{
+		Type: &avidpb.Event_RequestTimeout{
+			RequestTimeout: &avidpb.RequestTimeout{
+				ReqId: reqID,
+			},
+		},
+	})
+}
+
+func Cert(merkleRoot []byte, signerBitmap []byte, signatures [][]byte) *apb.Cert {
+	return &apb.Cert{
+		Type: &apb.Cert_Avid{
+			Avid: &avidpb.Cert{
+				MerkleRoot:   merkleRoot,
+				SignerBitmap: signerBitmap,
+				Signatures:   signatures,
+			},
+		},
+	}
+}
@@ -35,7 +35,7 @@ func VerifyCert[C any](m dsl.Module, dest t.ModuleID, cert *apb.Cert, context *C
 	contextID := m.DslHandle().StoreContext(context)
 
 	origin := &apb.VerifyCertOrigin{
-		Module: dest.Pb(),
+		Module: m.ModuleID().Pb(),
 		Type: &apb.VerifyCertOrigin_Dsl{
 			Dsl: dsl.Origin(contextID),
 		},
@@ -56,7 +56,7 @@ func RequestTransactions[C any](m dsl.Module, dest t.ModuleID, cert *apb.Cert, c
 	contextID := m.DslHandle().StoreContext(context)
 
 	origin := &apb.RequestTransactionsOrigin{
-		Module: dest.Pb(),
+		Module: m.ModuleID().Pb(),
 		Type: &apb.RequestTransactionsOrigin_Dsl{
 			Dsl: dsl.Origin(contextID),
 		},
@@ -70,6 +70,18 @@ func ProvideTransactions(m dsl.Module, dest t.ModuleID, txs [][]byte, origin *ap
 	dsl.EmitEvent(m, aevents.ProvideTransactions(dest, txs, origin))
 }
 
+// SetRetentionIndex informs the availability layer about the retention index
+// to associate with all the batches it stores from now on.
+func SetRetentionIndex(m dsl.Module, dest t.ModuleID, retIdx t.RetentionIndex) {
+	dsl.EmitEvent(m, aevents.SetRetentionIndex(dest, retIdx))
+}
+
+// ForgetBatchesBefore lets the availability layer garbage-collect all the batches
+// associated with a retention index smaller than retIdx.
+func ForgetBatchesBefore(m dsl.Module, dest t.ModuleID, retIdx t.RetentionIndex) {
+	dsl.EmitEvent(m, aevents.ForgetBatchesBefore(dest, retIdx))
+}
+
 // Module-specific dsl functions for processing events.
 
 // UponEvent registers a handler for the given availability layer event type.
@@ -157,3 +169,17 @@ func UponProvideTransactions[C any](m dsl.Module, handler func(txs [][]byte, con
 		return handler(ev.Txs, context)
 	})
 }
+
+// UponSetRetentionIndex registers a handler for the SetRetentionIndex events.
+func UponSetRetentionIndex(m dsl.Module, handler func(retIdx t.RetentionIndex) error) {
+	UponEvent[*apb.Event_SetRetentionIndex](m, func(ev *apb.SetRetentionIndex) error {
+		return handler(t.RetentionIndex(ev.RetentionIndex))
+	})
+}
+
+// UponForgetBatchesBefore registers a handler for the ForgetBatchesBefore events.
+func UponForgetBatchesBefore(m dsl.Module, handler func(retIdx t.RetentionIndex) error) {
+	UponEvent[*apb.Event_ForgetBatchesBefore](m, func(ev *apb.ForgetBatchesBefore) error {
+		return handler(t.RetentionIndex(ev.RetentionIndex))
+	})
+}
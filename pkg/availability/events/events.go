@@ -91,3 +91,27 @@ func ProvideTransactions(dest t.ModuleID, txs [][]byte, origin *apb.RequestTrans
 		},
 	})
 }
+
+// SetRetentionIndex informs the availability layer about the retention index
+// to associate with all the batches it stores from now on.
+func SetRetentionIndex(dest t.ModuleID, retIdx t.RetentionIndex) *eventpb.Event {
+	return Event(dest, &apb.Event{
+		Type: &apb.Event_SetRetentionIndex{
+			SetRetentionIndex: &apb.SetRetentionIndex{
+				RetentionIndex: retIdx.Pb(),
+			},
+		},
+	})
+}
+
+// ForgetBatchesBefore lets the availability layer garbage-collect all the batches
+// associated with a retention index smaller than retIdx.
+func ForgetBatchesBefore(dest t.ModuleID, retIdx t.RetentionIndex) *eventpb.Event {
+	return Event(dest, &apb.Event{
+		Type: &apb.Event_ForgetBatchesBefore{
+			ForgetBatchesBefore: &apb.ForgetBatchesBefore{
+				RetentionIndex: retIdx.Pb(),
+			},
+		},
+	})
+}
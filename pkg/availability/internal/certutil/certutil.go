@@ -0,0 +1,41 @@
+// Package certutil contains helpers for encoding availability certificates, shared by the availability modules.
+package certutil
+
+import (
+	"fmt"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// SignerBitmap encodes a set of signers as a bitmap relative to the membership allNodes.
+// Bit i (i.e., bit i%8 of byte i/8) is set if and only if allNodes[i] is contained in signers.
+// Signers that are not part of the membership are ignored.
+func SignerBitmap(allNodes []t.NodeID, signers map[t.NodeID]struct{}) []byte {
+	bitmap := make([]byte, (len(allNodes)+7)/8)
+	for i, nodeID := range allNodes {
+		if _, ok := signers[nodeID]; ok {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	return bitmap
+}
+
+// BitmapSigners decodes a bitmap produced by SignerBitmap and returns the signers, ordered as in allNodes.
+// It returns an error if the bitmap does not have the right length or has bits set that do not correspond to a node.
+func BitmapSigners(allNodes []t.NodeID, bitmap []byte) ([]t.NodeID, error) {
+	if len(bitmap) != (len(allNodes)+7)/8 {
+		return nil, fmt.Errorf("invalid signer bitmap length: %d (expected %d)", len(bitmap), (len(allNodes)+7)/8)
+	}
+
+	signers := make([]t.NodeID, 0)
+	for i := 0; i < 8*len(bitmap); i++ {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if i >= len(allNodes) {
+			return nil, fmt.Errorf("signer bitmap refers to non-existent node %d", i)
+		}
+		signers = append(signers, allNodes[i])
+	}
+	return signers, nil
+}
@@ -0,0 +1,26 @@
+package certutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestSignerBitmap(t *testing.T) {
+	allNodes := []types.NodeID{"0", "1", "2", "3", "4", "5", "6", "7", "8"}
+
+	bitmap := SignerBitmap(allNodes, map[types.NodeID]struct{}{"8": {}, "1": {}, "unknown": {}, "2": {}})
+	assert.Equal(t, []byte{0b110, 0b1}, bitmap)
+
+	signers, err := BitmapSigners(allNodes, bitmap)
+	require.NoError(t, err)
+	assert.Equal(t, []types.NodeID{"1", "2", "8"}, signers)
+
+	_, err = BitmapSigners(allNodes, []byte{0b110})
+	assert.Error(t, err)
+	_, err = BitmapSigners(allNodes, []byte{0b110, 0b10})
+	assert.Error(t, err)
+}
@@ -0,0 +1,165 @@
+// Package batchstore implements the storage of batches used by the multisig collector.
+// By signing a batch, a node promises to store it (until it is garbage-collected), such that the batch can be
+// retrieved using a certificate containing the signature.
+// Keeping this promise across restarts of the node requires the batch to be stored durably
+// before it is signed, which is what the on-disk implementation of the Store interface (FileStore) provides.
+package batchstore
+
+import (
+	"sync"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Store is a store of batches and the transactions they contain.
+// Each batch is associated with a retention index used for garbage collection.
+type Store interface {
+
+	// StoreBatch stores a batch and its transactions, associating the batch with the retention index retIdx.
+	// If the batch is already stored, StoreBatch only raises its retention index to retIdx (if retIdx is higher),
+	// such that a batch that is needed again is not garbage-collected.
+	// When StoreBatch returns without an error, the batch must be stored as durably as the implementation allows.
+	StoreBatch(retIdx t.RetentionIndex, batchID t.BatchID, txIDs []t.TxID, txs [][]byte) error
+
+	// BatchTxIDs returns the IDs of the transactions in the batch with ID batchID.
+	// The returned flag is false if the batch is not stored.
+	BatchTxIDs(batchID t.BatchID) ([]t.TxID, bool)
+
+	// Transaction returns the stored transaction with ID txID.
+	// The returned flag is false if the transaction is not stored.
+	Transaction(txID t.TxID) ([]byte, bool)
+
+	// ForgetBatchesBefore removes all batches associated with a retention index smaller than retIdx,
+	// along with the transactions that are not contained in any of the remaining batches.
+	ForgetBatchesBefore(retIdx t.RetentionIndex) error
+}
+
+// MemStore is a Store that only keeps the batches in memory. It is safe for concurrent use.
+type MemStore struct {
+	lock sync.RWMutex
+
+	batches      map[t.BatchID][]t.TxID
+	transactions map[t.TxID][]byte
+
+	// IDs of the stored batches, indexed by the retention index associated with them.
+	// When the retention index of a batch is raised, the batch is added under the new index
+	// and its entry under the old one is ignored.
+	batchesByRetIdx map[t.RetentionIndex][]t.BatchID
+
+	// Retention index associated with each stored batch.
+	retIdxs map[t.BatchID]t.RetentionIndex
+
+	// Number of stored batches containing each stored transaction.
+	txRefCounts map[t.TxID]int
+}
+
+// NewMemStore returns a new empty MemStore.
+func NewMemStore() *MemStore {
+	s := &MemStore{}
+	s.init()
+	return s
+}
+
+// init initializes the (empty) in-memory data structures of the store.
+func (s *MemStore) init() {
+	s.batches = make(map[t.BatchID][]t.TxID)
+	s.transactions = make(map[t.TxID][]byte)
+	s.batchesByRetIdx = make(map[t.RetentionIndex][]t.BatchID)
+	s.retIdxs = make(map[t.BatchID]t.RetentionIndex)
+	s.txRefCounts = make(map[t.TxID]int)
+}
+
+// StoreBatch implements Store.
+func (s *MemStore) StoreBatch(retIdx t.RetentionIndex, batchID t.BatchID, txIDs []t.TxID, txs [][]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.storeBatch(retIdx, batchID, txIDs, txs)
+	return nil
+}
+
+// BatchTxIDs implements Store.
+func (s *MemStore) BatchTxIDs(batchID t.BatchID) ([]t.TxID, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	txIDs, ok := s.batches[batchID]
+	return txIDs, ok
+}
+
+// Transaction implements Store.
+func (s *MemStore) Transaction(txID t.TxID) ([]byte, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	tx, ok := s.transactions[txID]
+	return tx, ok
+}
+
+// ForgetBatchesBefore implements Store.
+func (s *MemStore) ForgetBatchesBefore(retIdx t.RetentionIndex) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.forgetBatchesBefore(retIdx)
+	return nil
+}
+
+// storeBatch performs the work of StoreBatch.
+// It returns false if the batch is already stored with a retention index of at least retIdx, i.e., if it has no effect.
+// The caller must hold the lock.
+func (s *MemStore) storeBatch(retIdx t.RetentionIndex, batchID t.BatchID, txIDs []t.TxID, txs [][]byte) bool {
+	if s.retainedUntil(retIdx, batchID) {
+		return false
+	}
+
+	if _, ok := s.batches[batchID]; !ok {
+		s.batches[batchID] = txIDs
+		for i, txID := range txIDs {
+			s.transactions[txID] = txs[i]
+			s.txRefCounts[txID]++
+		}
+	}
+
+	s.retIdxs[batchID] = retIdx
+	s.batchesByRetIdx[retIdx] = append(s.batchesByRetIdx[retIdx], batchID)
+	return true
+}
+
+// retainedUntil returns true if the batch with ID batchID is stored with a retention index of at least retIdx.
+// The caller must hold the lock.
+func (s *MemStore) retainedUntil(retIdx t.RetentionIndex, batchID t.BatchID) bool {
+	oldRetIdx, ok := s.retIdxs[batchID]
+	return ok && oldRetIdx >= retIdx
+}
+
+// forgetBatchesBefore performs the work of ForgetBatchesBefore and returns the IDs of the removed batches.
+// The caller must hold the lock.
+func (s *MemStore) forgetBatchesBefore(retIdx t.RetentionIndex) []t.BatchID {
+	removed := make([]t.BatchID, 0)
+	for idx, batchIDs := range s.batchesByRetIdx {
+		if idx >= retIdx {
+			continue
+		}
+
+		for _, batchID := range batchIDs {
+			// Skip entries of batches that have been re-associated with a higher retention index
+			// (or have already been removed through their entry under such an index).
+			if batchRetIdx, ok := s.retIdxs[batchID]; !ok || batchRetIdx != idx {
+				continue
+			}
+
+			for _, txID := range s.batches[batchID] {
+				if s.txRefCounts[txID]--; s.txRefCounts[txID] == 0 {
+					delete(s.txRefCounts, txID)
+					delete(s.transactions, txID)
+				}
+			}
+			delete(s.batches, batchID)
+			delete(s.retIdxs, batchID)
+			removed = append(removed, batchID)
+		}
+		delete(s.batchesByRetIdx, idx)
+	}
+	return removed
+}
@@ -0,0 +1,88 @@
+package batchstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestForgetBatchesBefore(t *testing.T) {
+	s := NewMemStore()
+	require.NoError(t, s.StoreBatch(0, "b0", []types.TxID{"t0", "t1"}, [][]byte{{0}, {1}}))
+	require.NoError(t, s.StoreBatch(1, "b1", []types.TxID{"t1", "t2"}, [][]byte{{1}, {2}}))
+	require.NoError(t, s.StoreBatch(2, "b2", []types.TxID{"t3"}, [][]byte{{3}}))
+
+	// Only the first batch is forgotten, along with the transaction not contained in any other batch.
+	require.NoError(t, s.ForgetBatchesBefore(1))
+	_, ok := s.BatchTxIDs("b0")
+	assert.False(t, ok)
+	_, ok = s.Transaction("t0")
+	assert.False(t, ok)
+	_, ok = s.Transaction("t1")
+	assert.True(t, ok)
+
+	require.NoError(t, s.ForgetBatchesBefore(2))
+	assert.Equal(t, map[types.BatchID][]types.TxID{"b2": {"t3"}}, s.batches)
+	assert.Equal(t, map[types.TxID][]byte{"t3": {3}}, s.transactions)
+	assert.Equal(t, map[types.TxID]int{"t3": 1}, s.txRefCounts)
+}
+
+func TestStoreBatchRaisesRetentionIndex(t *testing.T) {
+	s := NewMemStore()
+	require.NoError(t, s.StoreBatch(0, "b0", []types.TxID{"t0"}, [][]byte{{0}}))
+	require.NoError(t, s.StoreBatch(2, "b0", []types.TxID{"t0"}, [][]byte{{0}}))
+
+	// Storing the batch again with a lower retention index does not lower it.
+	require.NoError(t, s.StoreBatch(1, "b0", []types.TxID{"t0"}, [][]byte{{0}}))
+
+	// The batch is retained until its highest retention index is garbage-collected.
+	require.NoError(t, s.ForgetBatchesBefore(2))
+	txIDs, ok := s.BatchTxIDs("b0")
+	assert.True(t, ok)
+	assert.Equal(t, []types.TxID{"t0"}, txIDs)
+	assert.Equal(t, map[types.TxID]int{"t0": 1}, s.txRefCounts)
+
+	require.NoError(t, s.ForgetBatchesBefore(3))
+	assert.Empty(t, s.batches)
+	assert.Empty(t, s.transactions)
+	assert.Empty(t, s.txRefCounts)
+	assert.Empty(t, s.retIdxs)
+}
+
+func TestFileStoreReopen(t *testing.T) {
+	path := t.TempDir()
+
+	s, err := OpenFileStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s.StoreBatch(0, "b0", []types.TxID{"t0"}, [][]byte{{0}}))
+	require.NoError(t, s.StoreBatch(1, "b1", []types.TxID{"t1", "t2"}, [][]byte{{1}, {2}}))
+	require.NoError(t, s.StoreBatch(2, "b2", []types.TxID{"t3"}, [][]byte{{3}}))
+	require.NoError(t, s.StoreBatch(0, "b3", []types.TxID{"t4"}, [][]byte{{4}}))
+	require.NoError(t, s.StoreBatch(3, "b3", []types.TxID{"t4"}, [][]byte{{4}}))
+	require.NoError(t, s.ForgetBatchesBefore(1))
+	require.NoError(t, s.Close())
+
+	s, err = OpenFileStore(path)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, s.Close()) }()
+
+	_, ok := s.BatchTxIDs("b0")
+	assert.False(t, ok)
+	txIDs, ok := s.BatchTxIDs("b1")
+	assert.True(t, ok)
+	assert.Equal(t, []types.TxID{"t1", "t2"}, txIDs)
+	tx, ok := s.Transaction("t3")
+	assert.True(t, ok)
+	assert.Equal(t, []byte{3}, tx)
+
+	// The raised retention index of a batch survives re-opening the store.
+	require.NoError(t, s.ForgetBatchesBefore(3))
+	_, ok = s.BatchTxIDs("b2")
+	assert.False(t, ok)
+	txIDs, ok = s.BatchTxIDs("b3")
+	assert.True(t, ok)
+	assert.Equal(t, []types.TxID{"t4"}, txIDs)
+}
@@ -0,0 +1,143 @@
+package batchstore
+
+import (
+	"fmt"
+
+	"github.com/tidwall/wal"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// FileStore is a Store backed by an append-only log file on disk, such that the stored batches survive restarts.
+// All batches are also kept in memory. FileStore is safe for concurrent use.
+type FileStore struct {
+	MemStore
+
+	log *wal.Log
+
+	// For each stored batch, the index of the corresponding record in log.
+	logIndices map[t.BatchID]uint64
+}
+
+// OpenFileStore returns a new FileStore backed by a log file in the directory at path.
+// If the directory already contains stored batches, they are all loaded.
+func OpenFileStore(path string) (*FileStore, error) {
+	log, err := wal.Open(path, &wal.Options{NoSync: true, NoCopy: true})
+	if err != nil {
+		return nil, fmt.Errorf("could not open batch store file: %w", err)
+	}
+
+	s := &FileStore{
+		log:        log,
+		logIndices: make(map[t.BatchID]uint64),
+	}
+	s.init()
+
+	// Load existing batches.
+	firstIndex, err := log.FirstIndex()
+	if err != nil {
+		return nil, fmt.Errorf("could not read first batch store index: %w", err)
+	}
+	lastIndex, err := log.LastIndex()
+	if err != nil {
+		return nil, fmt.Errorf("could not read last batch store index: %w", err)
+	}
+	for i := firstIndex; i != 0 && i <= lastIndex; i++ {
+		data, err := log.Read(i)
+		if err != nil {
+			return nil, fmt.Errorf("could not read batch store index %d: %w", i, err)
+		}
+		batch := &mscpb.StoredBatch{}
+		if err := proto.Unmarshal(data, batch); err != nil {
+			return nil, fmt.Errorf("could not decode stored batch at index %d: %w", i, err)
+		}
+		batchID := t.BatchID(batch.BatchId)
+		if s.storeBatch(t.RetentionIndex(batch.RetentionIndex), batchID, t.TxIDSlice(batch.TxIds), batch.Txs) {
+			s.logIndices[batchID] = i
+		}
+	}
+
+	return s, nil
+}
+
+// StoreBatch implements Store.
+// The batch is written (and synced) to the file before it is added to the in-memory store.
+// If the retention index of an already stored batch is raised, the batch is written to the file again
+// with the new retention index, such that the record is retained as long as the batch.
+func (s *FileStore) StoreBatch(retIdx t.RetentionIndex, batchID t.BatchID, txIDs []t.TxID, txs [][]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.retainedUntil(retIdx, batchID) {
+		return nil
+	}
+
+	data, err := proto.Marshal(&mscpb.StoredBatch{
+		BatchId:        batchID.Pb(),
+		TxIds:          t.TxIDSlicePb(txIDs),
+		Txs:            txs,
+		RetentionIndex: retIdx.Pb(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal batch: %w", err)
+	}
+
+	lastIndex, err := s.log.LastIndex()
+	if err != nil {
+		return fmt.Errorf("could not read last batch store index: %w", err)
+	}
+	if err := s.log.Write(lastIndex+1, data); err != nil {
+		return fmt.Errorf("could not write batch: %w", err)
+	}
+	if err := s.log.Sync(); err != nil {
+		return fmt.Errorf("could not sync batch store: %w", err)
+	}
+	s.logIndices[batchID] = lastIndex + 1
+
+	s.storeBatch(retIdx, batchID, txIDs, txs)
+	return nil
+}
+
+// ForgetBatchesBefore implements Store.
+// The file is truncated up to the first record that is still needed.
+// As the file cannot be truncated completely, the most recently written batch always remains in the file
+// and will be loaded again when the store is re-opened.
+func (s *FileStore) ForgetBatchesBefore(retIdx t.RetentionIndex) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, batchID := range s.forgetBatchesBefore(retIdx) {
+		delete(s.logIndices, batchID)
+	}
+
+	// Find the first record in the file that needs to be retained.
+	lastIndex, err := s.log.LastIndex()
+	if err != nil {
+		return fmt.Errorf("could not read last batch store index: %w", err)
+	}
+	firstRetained := lastIndex
+	for _, logIndex := range s.logIndices {
+		if logIndex < firstRetained {
+			firstRetained = logIndex
+		}
+	}
+
+	if firstRetained == 0 {
+		// The file is empty.
+		return nil
+	}
+	if err := s.log.TruncateFront(firstRetained); err != nil {
+		return fmt.Errorf("could not truncate batch store: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file backing the store.
+func (s *FileStore) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.log.Close()
+}
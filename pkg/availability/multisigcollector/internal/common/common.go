@@ -1,6 +1,9 @@
 package common
 
 import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/batchstore"
 	t "github.com/filecoin-project/mir/pkg/types"
 )
 
@@ -15,19 +18,23 @@ func (uid InstanceUID) Bytes() []byte {
 
 // ModuleConfig sets the module ids. All replicas are expected to use identical module configurations.
 type ModuleConfig struct {
-	Self    t.ModuleID // id of this module
-	Mempool t.ModuleID
-	Net     t.ModuleID
-	Crypto  t.ModuleID
+	Self         t.ModuleID // id of this module
+	Mempool      t.ModuleID
+	Net          t.ModuleID
+	Crypto       t.ModuleID
+	ThreshCrypto t.ModuleID // only used if the AggregateSignatures module parameter is set
+	Timer        t.ModuleID
 }
 
 // DefaultModuleConfig returns a valid module config with default names for all modules.
 func DefaultModuleConfig() *ModuleConfig {
 	return &ModuleConfig{
-		Self:    "availability",
-		Mempool: "mempool",
-		Net:     "net",
-		Crypto:  "crypto",
+		Self:         "availability",
+		Mempool:      "mempool",
+		Net:          "net",
+		Crypto:       "crypto",
+		ThreshCrypto: "threshcrypto",
+		Timer:        "timer",
 	}
 }
 
@@ -36,6 +43,37 @@ func DefaultModuleConfig() *ModuleConfig {
 type ModuleParams struct {
 	InstanceUID []byte     // unique identifier for this instance of BCB, used to prevent cross-instance replay attacks
 	AllNodes    []t.NodeID // the list of participating nodes
+
+	// Time to wait for a response from a node during batch reconstruction before asking the next one.
+	RequestTimeout t.TimeDuration
+
+	// If set to true, nodes sign batches with signature shares produced by the threshcrypto module
+	// and certificates contain a single signature combined from a quorum of shares
+	// instead of one signature per signer.
+	// The threshold of the threshcrypto module must then be the quorum size, i.e., (N+F)/2+1.
+	AggregateSignatures bool
+}
+
+// CheckParams checks whether the given module parameters satisfy all necessary constraints.
+func CheckParams(params *ModuleParams) error {
+	if len(params.AllNodes) == 0 {
+		return fmt.Errorf("empty AllNodes")
+	}
+
+	nodes := make(map[t.NodeID]struct{}, len(params.AllNodes))
+	for _, nodeID := range params.AllNodes {
+		if _, ok := nodes[nodeID]; ok {
+			return fmt.Errorf("duplicate node in AllNodes: %v", nodeID)
+		}
+		nodes[nodeID] = struct{}{}
+	}
+
+	// RequestTimeout must be positive, as the signers would otherwise be asked in turn without ever waiting.
+	if params.RequestTimeout <= 0 {
+		return fmt.Errorf("non-positive RequestTimeout: %d", params.RequestTimeout)
+	}
+
+	return nil
 }
 
 // N is the total number of replicas.
@@ -48,10 +86,48 @@ func (params *ModuleParams) F() int {
 	return (params.N() - 1) / 3
 }
 
+// Quorum is the number of signatures a certificate must contain, i.e., the smallest number larger than (N+F)/2.
+func (params *ModuleParams) Quorum() int {
+	return (params.N()+params.F())/2 + 1
+}
+
 // State represents the common state used by all parts of the multisig collector implementation.
 type State struct {
-	BatchStore       map[t.BatchID][]t.TxID
-	TransactionStore map[t.TxID][]byte
+	// Store of the batches this node has signed or reconstructed.
+	Store batchstore.Store
+
+	// Retention index associated with the batches stored from now on.
+	RetentionIndex t.RetentionIndex
+}
+
+// NewState returns a new State using the given batch store.
+func NewState(store batchstore.Store) *State {
+	return &State{
+		Store:          store,
+		RetentionIndex: 0,
+	}
+}
+
+// StoreBatch stores a batch and its transactions, associating the batch with the current retention index.
+// If the batch is already stored with a lower retention index, its retention index is raised to the current one.
+func (s *State) StoreBatch(batchID t.BatchID, txIDs []t.TxID, txs [][]byte) error {
+	return s.Store.StoreBatch(s.RetentionIndex, batchID, txIDs, txs)
+}
+
+// LookUpBatch returns the transactions of a stored batch. The returned flag is false if the batch is not stored.
+func (s *State) LookUpBatch(batchID t.BatchID) ([][]byte, bool) {
+	txIDs, ok := s.Store.BatchTxIDs(batchID)
+	if !ok {
+		return nil, false
+	}
+
+	txs := make([][]byte, len(txIDs))
+	for i, txID := range txIDs {
+		if txs[i], ok = s.Store.Transaction(txID); !ok {
+			return nil, false
+		}
+	}
+	return txs, true
 }
 
 // SigData is the binary data that should be signed for forming a certificate.
@@ -48,27 +48,70 @@ func UponSigMessageReceived(m dsl.Module, handler func(from t.NodeID, signature
 	})
 }
 
-func UponRequestBatchMessageReceived(m dsl.Module, handler func(from t.NodeID, batchID t.BatchID, reqID uint64) error) {
+func UponRequestTxIDsMessageReceived(m dsl.Module, handler func(from t.NodeID, batchID t.BatchID, reqID uint64) error) {
 	UponMscMessageReceived(m, func(from t.NodeID, msg *mscpb.Message) error {
-		requestBatchMsgWrapper, ok := msg.Type.(*mscpb.Message_RequestBatch)
+		requestTxIDsMsgWrapper, ok := msg.Type.(*mscpb.Message_RequestTxIds)
 		if !ok {
 			return nil
 		}
-		requestBatchMsg := requestBatchMsgWrapper.RequestBatch
+		requestTxIDsMsg := requestTxIDsMsgWrapper.RequestTxIds
 
-		return handler(from, t.BatchID(requestBatchMsg.BatchId), requestBatchMsg.ReqId)
+		return handler(from, t.BatchID(requestTxIDsMsg.BatchId), requestTxIDsMsg.ReqId)
 	})
 }
 
-func UponProvideBatchMessageReceived(m dsl.Module, handler func(from t.NodeID, txs [][]byte, reqID uint64) error) {
+func UponProvideTxIDsMessageReceived(m dsl.Module, handler func(from t.NodeID, txIDs []t.TxID, reqID uint64) error) {
 	UponMscMessageReceived(m, func(from t.NodeID, msg *mscpb.Message) error {
-		provideBatchMsgWrapper, ok := msg.Type.(*mscpb.Message_ProvideBatch)
+		provideTxIDsMsgWrapper, ok := msg.Type.(*mscpb.Message_ProvideTxIds)
 		if !ok {
 			return nil
 		}
-		provideBatchMsg := provideBatchMsgWrapper.ProvideBatch
+		provideTxIDsMsg := provideTxIDsMsgWrapper.ProvideTxIds
 
-		return handler(from, provideBatchMsg.Txs, provideBatchMsg.ReqId)
+		return handler(from, t.TxIDSlice(provideTxIDsMsg.TxIds), provideTxIDsMsg.ReqId)
+	})
+}
+
+func UponRequestMissingTxsMessageReceived(
+	m dsl.Module,
+	handler func(from t.NodeID, txIDs []t.TxID, reqID uint64) error,
+) {
+	UponMscMessageReceived(m, func(from t.NodeID, msg *mscpb.Message) error {
+		requestMissingTxsMsgWrapper, ok := msg.Type.(*mscpb.Message_RequestMissingTxs)
+		if !ok {
+			return nil
+		}
+		requestMissingTxsMsg := requestMissingTxsMsgWrapper.RequestMissingTxs
+
+		return handler(from, t.TxIDSlice(requestMissingTxsMsg.TxIds), requestMissingTxsMsg.ReqId)
+	})
+}
+
+func UponProvideMissingTxsMessageReceived(m dsl.Module, handler func(from t.NodeID, txs [][]byte, reqID uint64) error) {
+	UponMscMessageReceived(m, func(from t.NodeID, msg *mscpb.Message) error {
+		provideMissingTxsMsgWrapper, ok := msg.Type.(*mscpb.Message_ProvideMissingTxs)
+		if !ok {
+			return nil
+		}
+		provideMissingTxsMsg := provideMissingTxsMsgWrapper.ProvideMissingTxs
+
+		return handler(from, provideMissingTxsMsg.Txs, provideMissingTxsMsg.ReqId)
+	})
+}
+
+func UponEvent[EvWrapper mscpb.Event_TypeWrapper[Ev], Ev any](m dsl.Module, handler func(ev *Ev) error) {
+	adsl.UponEvent[*apb.Event_Msc](m, func(ev *mscpb.Event) error {
+		evWrapper, ok := ev.Type.(EvWrapper)
+		if !ok {
+			return nil
+		}
+		return handler(evWrapper.Unwrap())
+	})
+}
+
+func UponRequestTimeout(m dsl.Module, handler func(reqID uint64, attempt uint64) error) {
+	UponEvent[*mscpb.Event_RequestTimeout](m, func(ev *mscpb.RequestTimeout) error {
+		return handler(ev.ReqId, ev.Attempt)
 	})
 }
 
@@ -1,15 +1,21 @@
 package batchreconstruction
 
 import (
+	"fmt"
+
 	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/availability/internal/certutil"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/common"
 	mscdsl "github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/dsl"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/protobuf"
 	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/events"
 	mempooldsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
 	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
 )
 
 // State represents the state related to this part of the module.
@@ -27,9 +33,27 @@ type RequestID = uint64
 type RequestState struct {
 	BatchID   t.BatchID
 	ReqOrigin *apb.RequestTransactionsOrigin
+
+	// Nodes that can be asked for the batch (i.e., the signers of the certificate other than this node),
+	// the index of the one currently being asked, and the number of the current attempt.
+	Signers       []t.NodeID
+	CurrentSigner int
+	Attempt       uint64
+
+	// IDs of the transactions in the batch. Nil until the IDs are obtained from another node and verified.
+	TxIDs []t.TxID
+
+	// The transactions of the batch. Txs[i] is only valid if Present[i] is true.
+	Txs     [][]byte
+	Present []bool
 }
 
 // IncludeBatchReconstruction registers event handlers for processing availabilitypb.RequestTransactions events.
+// If the batch is not stored locally, it is reconstructed in two steps.
+// First, the IDs of the transactions in the batch are obtained from another node and verified against the batch ID.
+// Then, only the transactions not available locally or in the mempool are pulled from another node.
+// In both steps, the signers of the certificate are asked one at a time, moving to the next one if the current one
+// does not provide a valid response within params.RequestTimeout.
 func IncludeBatchReconstruction(
 	m dsl.Module,
 	mc *common.ModuleConfig,
@@ -43,102 +67,293 @@ func IncludeBatchReconstruction(
 		RequestState: make(map[uint64]*RequestState),
 	}
 
+	// askSigner sends the next message of the reconstruction protocol to the current signer
+	// and sets up a timeout for the response.
+	askSigner := func(reqID RequestID) {
+		requestState := state.RequestState[reqID]
+		requestState.Attempt++
+		signer := requestState.Signers[requestState.CurrentSigner]
+
+		if requestState.TxIDs == nil {
+			dsl.SendMessage(m, mc.Net,
+				protobuf.RequestTxIDsMessage(mc.Self, requestState.BatchID, reqID),
+				[]t.NodeID{signer})
+		} else {
+			dsl.SendMessage(m, mc.Net,
+				protobuf.RequestMissingTxsMessage(mc.Self, requestState.missingTxIDs(), reqID),
+				[]t.NodeID{signer})
+		}
+
+		dsl.EmitEvent(m, events.TimerDelay(mc.Timer,
+			[]*eventpb.Event{protobuf.RequestTimeout(mc.Self, reqID, requestState.Attempt)},
+			params.RequestTimeout))
+	}
+
+	// askNextSigner moves on to the next signer in turn and asks it.
+	askNextSigner := func(reqID RequestID) {
+		requestState := state.RequestState[reqID]
+		requestState.CurrentSigner = (requestState.CurrentSigner + 1) % len(requestState.Signers)
+		askSigner(reqID)
+	}
+
+	// completeRequest stores the reconstructed batch and provides its transactions to the requesting module.
+	completeRequest := func(reqID RequestID) error {
+		requestState := state.RequestState[reqID]
+		if err := state.StoreBatch(requestState.BatchID, requestState.TxIDs, requestState.Txs); err != nil {
+			return fmt.Errorf("could not store batch %v: %w", requestState.BatchID, err)
+		}
+		adsl.ProvideTransactions(m, t.ModuleID(requestState.ReqOrigin.Module), requestState.Txs, requestState.ReqOrigin)
+
+		// Dispose of the state associated with this request.
+		delete(state.RequestState, reqID)
+		return nil
+	}
+
 	// When receive a request for transactions, first check the local storage and then ask other nodes.
 	mscdsl.UponRequestTransactions(m, func(cert *mscpb.Cert, origin *apb.RequestTransactionsOrigin) error {
-		txIDs, ok := state.BatchStore[t.BatchID(cert.BatchId)]
+		txs, ok := state.LookUpBatch(t.BatchID(cert.BatchId))
 		if ok {
-			txs := make([][]byte, len(txIDs))
-			for i, txID := range txIDs {
-				txs[i] = state.TransactionStore[txID]
-			}
-
 			adsl.ProvideTransactions(m, t.ModuleID(origin.Module), txs, origin)
 			return nil
 		}
 
+		certSigners, err := certutil.BitmapSigners(params.AllNodes, cert.SignerBitmap)
+		if err != nil {
+			return fmt.Errorf("invalid certificate: %w", err)
+		}
+
+		signers := make([]t.NodeID, 0, len(certSigners))
+		for _, signer := range certSigners {
+			if signer != nodeID {
+				signers = append(signers, signer)
+			}
+		}
+
+		// An aggregated signature does not reveal the signers, so the signer bitmap is not authenticated.
+		// Thus, all other nodes are asked in turn if needed, starting with the ones the certificate claims as signers.
+		if len(cert.AggregatedSignature) != 0 {
+			for _, node := range params.AllNodes {
+				if node != nodeID && !sliceutil.Contains(signers, node) {
+					signers = append(signers, node)
+				}
+			}
+		}
+		if len(signers) == 0 {
+			return fmt.Errorf("no node to request batch %v from", t.BatchID(cert.BatchId))
+		}
+
 		reqID := state.NextReqID
 		state.NextReqID++
 
-		state.RequestState[reqID] = &RequestState{t.BatchID(cert.BatchId), origin}
+		state.RequestState[reqID] = &RequestState{
+			BatchID:   t.BatchID(cert.BatchId),
+			ReqOrigin: origin,
+			Signers:   signers,
+		}
 
-		dsl.SendMessage(m, mc.Net,
-			protobuf.RequestBatchMessage(mc.Self, t.BatchID(cert.BatchId), reqID),
-			t.NodeIDSlice(cert.Signers))
+		askSigner(reqID)
 		return nil
 	})
 
-	// When receive a request for batch from another node, send all transactions in response.
-	mscdsl.UponRequestBatchMessageReceived(m, func(from t.NodeID, batchID t.BatchID, reqID RequestID) error {
-		txIDs, ok := state.BatchStore[batchID]
+	// When the current attempt times out, ask the next signer.
+	mscdsl.UponRequestTimeout(m, func(reqID RequestID, attempt uint64) error {
+		requestState, ok := state.RequestState[reqID]
+		if !ok || requestState.Attempt != attempt {
+			// The request has already been completed or the attempt has been abandoned.
+			return nil
+		}
+
+		askNextSigner(reqID)
+		return nil
+	})
+
+	// When receive a request for the transaction IDs of a batch from another node, send them in response.
+	mscdsl.UponRequestTxIDsMessageReceived(m, func(from t.NodeID, batchID t.BatchID, reqID RequestID) error {
+		txIDs, ok := state.Store.BatchTxIDs(batchID)
 		if !ok {
 			// Ignore invalid request.
 			return nil
 		}
 
-		txs := make([][]byte, len(txIDs))
-		for i, txID := range txIDs {
-			txs[i] = state.TransactionStore[txID]
+		dsl.SendMessage(m, mc.Net, protobuf.ProvideTxIDsMessage(mc.Self, txIDs, reqID), []t.NodeID{from})
+		return nil
+	})
+
+	// When receive the requested transaction IDs, compute the id of the batch they form.
+	mscdsl.UponProvideTxIDsMessageReceived(m, func(from t.NodeID, txIDs []t.TxID, reqID RequestID) error {
+		requestState, ok := state.RequestState[reqID]
+		if !ok || requestState.TxIDs != nil || from != requestState.currentSigner() {
+			// Ignore a message with an invalid or outdated request id or from a node that has not been asked.
+			return nil
 		}
 
-		dsl.SendMessage(m, mc.Net, protobuf.ProvideBatchMessage(mc.Self, txs, reqID), []t.NodeID{from})
+		mempooldsl.RequestBatchID(m, mc.Mempool, txIDs, &requestBatchIDContext{reqID, requestState.Attempt, txIDs})
 		return nil
 	})
 
-	// When receive a requested batch, compute the ids of the received transactions.
-	mscdsl.UponProvideBatchMessageReceived(m, func(from t.NodeID, txs [][]byte, reqID RequestID) error {
-		_, ok := state.RequestState[reqID]
+	// When the id of the batch is computed, check if it is correct and look up the transactions in the mempool.
+	mempooldsl.UponBatchIDResponse(m, func(batchID t.BatchID, context *requestBatchIDContext) error {
+		requestState, ok := state.RequestState[context.reqID]
+		if !ok || requestState.TxIDs != nil {
+			// The request has already been completed or the transaction IDs are already known.
+			return nil
+		}
+
+		if batchID != requestState.BatchID {
+			// The received transaction IDs are not valid. Unless already done on timeout, ask the next signer.
+			if context.attempt == requestState.Attempt {
+				askNextSigner(context.reqID)
+			}
+			return nil
+		}
+
+		// The current attempt succeeded. Make sure its timeout is ignored.
+		requestState.Attempt++
+
+		requestState.TxIDs = context.txIDs
+		requestState.Txs = make([][]byte, len(context.txIDs))
+		requestState.Present = make([]bool, len(context.txIDs))
+		for i, txID := range context.txIDs {
+			requestState.Txs[i], requestState.Present[i] = state.Store.Transaction(txID)
+		}
+
+		missingTxIDs := requestState.missingTxIDs()
+		if len(missingTxIDs) == 0 {
+			return completeRequest(context.reqID)
+		}
+
+		mempooldsl.RequestTransactions(m, mc.Mempool, missingTxIDs, &requestTransactionsContext{context.reqID})
+		return nil
+	})
+
+	// When the mempool provides the transactions it has, pull the remaining ones from other nodes.
+	mempooldsl.UponTransactionsResponse(m, func(present []bool, txs [][]byte, context *requestTransactionsContext) error {
+		requestState, ok := state.RequestState[context.reqID]
 		if !ok {
-			// Ignore a message with an invalid or outdated request id.
+			// The request has already been completed.
 			return nil
 		}
 
-		mempooldsl.RequestTransactionIDs(m, mc.Mempool, txs, &requestTxIDsContext{reqID, txs})
+		requestState.fillMissing(present, txs)
+		if len(requestState.missingTxIDs()) == 0 {
+			return completeRequest(context.reqID)
+		}
+
+		// The current signer has just provided the transaction IDs, so it is likely to have the transactions too.
+		askSigner(context.reqID)
 		return nil
 	})
 
-	// When transaction ids are computed, compute the id of the batch.
-	mempooldsl.UponTransactionIDsResponse(m, func(txIDs []t.TxID, context *requestTxIDsContext) error {
-		mempooldsl.RequestBatchID(m, mc.Mempool, txIDs, &requestBatchIDContext{context.reqID, context.txs, txIDs})
+	// When receive a request for missing transactions from another node, send them in response.
+	mscdsl.UponRequestMissingTxsMessageReceived(m, func(from t.NodeID, txIDs []t.TxID, reqID RequestID) error {
+		txs := make([][]byte, len(txIDs))
+		for i, txID := range txIDs {
+			tx, ok := state.Store.Transaction(txID)
+			if !ok {
+				// Ignore request for transactions this node does not have.
+				return nil
+			}
+			txs[i] = tx
+		}
+
+		dsl.SendMessage(m, mc.Net, protobuf.ProvideMissingTxsMessage(mc.Self, txs, reqID), []t.NodeID{from})
 		return nil
 	})
 
-	// When the id of the batch is computed, check if it is correct, store the transactions, and complete the request.
-	mempooldsl.UponBatchIDResponse(m, func(batchID t.BatchID, context *requestBatchIDContext) error {
+	// When receive the requested transactions, compute their ids.
+	mscdsl.UponProvideMissingTxsMessageReceived(m, func(from t.NodeID, txs [][]byte, reqID RequestID) error {
+		requestState, ok := state.RequestState[reqID]
+		if !ok || requestState.TxIDs == nil || from != requestState.currentSigner() {
+			// Ignore a message with an invalid or outdated request id or from a node that has not been asked.
+			return nil
+		}
+
+		mempooldsl.RequestTransactionIDs(m, mc.Mempool, txs, &requestTxIDsContext{reqID, requestState.Attempt, txs})
+		return nil
+	})
+
+	// When transaction ids are computed, check that they are the requested ones and complete the request.
+	mempooldsl.UponTransactionIDsResponse(m, func(txIDs []t.TxID, context *requestTxIDsContext) error {
 		requestState, ok := state.RequestState[context.reqID]
 		if !ok {
 			// The request has already been completed.
 			return nil
 		}
 
-		if batchID != requestState.BatchID {
-			// The received batch is not valid, keep waiting for a valid response.
+		if !equalTxIDs(txIDs, requestState.missingTxIDs()) {
+			// The received transactions are not valid. Unless already done on timeout, ask the next signer.
+			if context.attempt == requestState.Attempt {
+				askNextSigner(context.reqID)
+			}
 			return nil
 		}
 
-		state.BatchStore[batchID] = context.txIDs
-		for i, txID := range context.txIDs {
-			state.TransactionStore[txID] = context.txs[i]
+		requestState.fillMissing(nil, context.txs)
+		return completeRequest(context.reqID)
+	})
+}
+
+// currentSigner returns the node asked in the current attempt.
+func (rs *RequestState) currentSigner() t.NodeID {
+	return rs.Signers[rs.CurrentSigner]
+}
+
+// missingTxIDs returns the IDs of the transactions of the batch that are still missing, in the order of the batch.
+func (rs *RequestState) missingTxIDs() []t.TxID {
+	missing := make([]t.TxID, 0)
+	for i, txID := range rs.TxIDs {
+		if !rs.Present[i] {
+			missing = append(missing, txID)
 		}
+	}
+	return missing
+}
 
-		adsl.ProvideTransactions(m, t.ModuleID(requestState.ReqOrigin.Module), context.txs, requestState.ReqOrigin)
+// fillMissing fills in the missing transactions of the batch with txs, in the order of the batch.
+// If present is not nil, only the transactions txs[j] for which present[j] is true are filled in
+// (but the corresponding missing transaction is skipped even if present[j] is false).
+func (rs *RequestState) fillMissing(present []bool, txs [][]byte) {
+	j := 0
+	for i := range rs.TxIDs {
+		if rs.Present[i] {
+			continue
+		}
+		if present == nil || present[j] {
+			rs.Txs[i] = txs[j]
+			rs.Present[i] = true
+		}
+		j++
+	}
+}
 
-		// Dispose of the state associated with this request.
-		delete(state.RequestState, context.reqID)
-		return nil
-	})
+func equalTxIDs(a, b []t.TxID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 // Context data structures                                                                                            //
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-type requestTxIDsContext struct {
-	reqID RequestID
-	txs   [][]byte
+type requestBatchIDContext struct {
+	reqID   RequestID
+	attempt uint64
+	txIDs   []t.TxID
 }
 
-type requestBatchIDContext struct {
+type requestTransactionsContext struct {
 	reqID RequestID
-	txs   [][]byte
-	txIDs []t.TxID
+}
+
+type requestTxIDsContext struct {
+	reqID   RequestID
+	attempt uint64
+	txs     [][]byte
 }
@@ -1,15 +1,18 @@
 package certcreation
 
 import (
+	"fmt"
+
 	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/availability/internal/certutil"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/common"
 	mscdsl "github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/dsl"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/protobuf"
 	"github.com/filecoin-project/mir/pkg/dsl"
 	mempooldsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
 	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	tcdsl "github.com/filecoin-project/mir/pkg/threshcrypto/dsl"
 	t "github.com/filecoin-project/mir/pkg/types"
-	"github.com/filecoin-project/mir/pkg/util/maputil"
 )
 
 // State represents the state related to this part of the module.
@@ -30,6 +33,10 @@ type RequestState struct {
 
 	receivedSig map[t.NodeID]bool
 	sigs        map[t.NodeID][]byte
+
+	// Set to true when the signature shares are being combined into a single signature.
+	// Only used if the AggregateSignatures module parameter is set.
+	recovering bool
 }
 
 // IncludeCreatingCertificates registers event handlers for processing availabilitypb.RequestCert events.
@@ -80,7 +87,7 @@ func IncludeCreatingCertificates(
 		return nil
 	})
 
-	// When receive a signature, verify its correctness.
+	// When receive a signature (or a signature share), verify its correctness.
 	mscdsl.UponSigMessageReceived(m, func(from t.NodeID, signature []byte, reqID RequestID) error {
 		requestState, ok := state.RequestState[reqID]
 		if !ok {
@@ -91,13 +98,17 @@ func IncludeCreatingCertificates(
 		if !requestState.receivedSig[from] {
 			requestState.receivedSig[from] = true
 			sigData := common.SigData(params.InstanceUID, requestState.BatchID)
-			dsl.VerifyOneNodeSig(m, mc.Crypto, sigData, signature, from, &verifySigContext{reqID, signature})
+			if params.AggregateSignatures {
+				tcdsl.VerifyShare(m, mc.ThreshCrypto, sigData, signature, from, &verifySigContext{reqID, from, signature})
+			} else {
+				dsl.VerifyOneNodeSig(m, mc.Crypto, sigData, signature, from, &verifySigContext{reqID, from, signature})
+			}
 		}
 		return nil
 	})
 
-	// When a signature is verified, store it in memory.
-	dsl.UponOneNodeSigVerified(m, func(nodeID t.NodeID, err error, context *verifySigContext) error {
+	// storeSig stores a verified signature (or signature share) in memory.
+	storeSig := func(err error, context *verifySigContext) error {
 		if err != nil {
 			// Ignore invalid signature.
 			return nil
@@ -108,7 +119,40 @@ func IncludeCreatingCertificates(
 			return nil
 		}
 
-		requestState.sigs[nodeID] = context.signature
+		requestState.sigs[context.nodeID] = context.signature
+		return nil
+	}
+
+	// When a signature is verified, store it in memory.
+	dsl.UponOneNodeSigVerified(m, func(_ t.NodeID, err error, context *verifySigContext) error {
+		return storeSig(err, context)
+	})
+
+	// When a signature share is verified, store it in memory.
+	tcdsl.UponVerifyShareResult(m, storeSig)
+
+	// When the signature shares are combined, create and output a certificate with the aggregated signature.
+	tcdsl.UponRecoverResult(m, func(fullSig []byte, err error, context *recoverContext) error {
+		if err != nil {
+			// The shares have all been verified, so combining them must not fail.
+			return fmt.Errorf("could not combine signature shares: %w", err)
+		}
+		requestState, ok := state.RequestState[context.reqID]
+		if !ok {
+			// The request has already been completed.
+			return nil
+		}
+
+		requestingModule := t.ModuleID(requestState.ReqOrigin.Module)
+		cert := protobuf.AggregatedCert(
+			requestState.BatchID,
+			certutil.SignerBitmap(params.AllNodes, context.signers),
+			fullSig,
+		)
+		adsl.NewCert(m, requestingModule, cert, requestState.ReqOrigin)
+
+		// Dispose of the state associated with this request.
+		delete(state.RequestState, context.reqID)
 		return nil
 	})
 
@@ -117,11 +161,27 @@ func IncludeCreatingCertificates(
 		// Iterate over active outgoing requests.
 		//Most of the time, there is expected to be at most one active outgoing request.
 		for reqID, requestState := range state.RequestState {
-			if len(requestState.sigs) > (params.N()+params.F())/2 {
-				certNodes, certSigs := maputil.GetKeysAndValues(requestState.sigs)
+			if len(requestState.sigs) >= params.Quorum() && !requestState.recovering {
+				// Order the signatures as the signers in the membership, as encoded in the signer bitmap.
+				signers := make(map[t.NodeID]struct{}, len(requestState.sigs))
+				certSigs := make([][]byte, 0, len(requestState.sigs))
+				for _, id := range params.AllNodes {
+					if sig, ok := requestState.sigs[id]; ok {
+						signers[id] = struct{}{}
+						certSigs = append(certSigs, sig)
+					}
+				}
+
+				// With aggregated signatures, the certificate is only output when the shares have been combined.
+				if params.AggregateSignatures {
+					requestState.recovering = true
+					sigData := common.SigData(params.InstanceUID, requestState.BatchID)
+					tcdsl.Recover(m, mc.ThreshCrypto, sigData, certSigs, &recoverContext{reqID, signers})
+					continue
+				}
 
 				requestingModule := t.ModuleID(requestState.ReqOrigin.Module)
-				cert := protobuf.Cert(requestState.BatchID, certNodes, certSigs)
+				cert := protobuf.Cert(requestState.BatchID, certutil.SignerBitmap(params.AllNodes, signers), certSigs)
 				adsl.NewCert(m, requestingModule, cert, requestState.ReqOrigin)
 
 				// Dispose of the state associated with this request.
@@ -135,38 +195,56 @@ func IncludeCreatingCertificates(
 	// code for all other nodes                                                                                       //
 	////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-	// When receive a request for a signature, compute the ids of the received transactions.
+	// When receive a request for a signature, have the received transactions validated by the application.
 	mscdsl.UponRequestSigMessageReceived(m, func(from t.NodeID, txs [][]byte, reqID RequestID) error {
-		mempooldsl.RequestTransactionIDs(m, mc.Mempool, txs, &computeIDsOfReceivedTxsContext{from, txs, reqID})
+		mempooldsl.VerifyTransactions(m, mc.Mempool, txs, &verifyReceivedTxsContext{from, txs, reqID})
 		return nil
 	})
 
-	// When the ids of the received transactions are computed, store the transactions and compute the id of the batch.
-	mempooldsl.UponTransactionIDsResponse(m, func(txIDs []t.TxID, context *computeIDsOfReceivedTxsContext) error {
-		// TODO: use persistent storage for crash-recovery.
-		for i, txID := range txIDs {
-			state.TransactionStore[txID] = context.txs[i]
-		}
+	// When the received transactions are validated, compute their ids.
+	// A batch containing an invalid transaction is not signed, such that no certificate can be formed for it.
+	mempooldsl.UponTransactionsVerified(m,
+		func(_ []error, _ []uint64, allOK bool, context *verifyReceivedTxsContext) error {
+			if !allOK {
+				return nil
+			}
+
+			mempooldsl.RequestTransactionIDs(m, mc.Mempool, context.txs,
+				&computeIDsOfReceivedTxsContext{context.sourceID, context.txs, context.reqID})
+			return nil
+		},
+	)
 
+	// When the ids of the received transactions are computed, compute the id of the batch.
+	mempooldsl.UponTransactionIDsResponse(m, func(txIDs []t.TxID, context *computeIDsOfReceivedTxsContext) error {
 		mempooldsl.RequestBatchID(m, mc.Mempool, txIDs,
-			&computeIDOfReceivedBatchContext{context.sourceID, txIDs, context.reqID})
+			&computeIDOfReceivedBatchContext{context.sourceID, txIDs, context.txs, context.reqID})
 		return nil
 	})
 
 	// When the id of the batch is computed, store the batch and generate a signature.
 	mempooldsl.UponBatchIDResponse(m, func(batchID t.BatchID, context *computeIDOfReceivedBatchContext) error {
-		state.BatchStore[batchID] = context.txIDs
+		// Store the batch before signing it, as the signature claims that the batch is stored.
+		if err := state.StoreBatch(batchID, context.txIDs, context.txs); err != nil {
+			return fmt.Errorf("could not store batch %v: %w", batchID, err)
+		}
 
 		sigMsg := common.SigData(params.InstanceUID, batchID)
-		dsl.SignRequest(m, mc.Crypto, sigMsg, &signReceivedBatchContext{context.sourceID, context.reqID})
+		if params.AggregateSignatures {
+			tcdsl.SignShare(m, mc.ThreshCrypto, sigMsg, &signReceivedBatchContext{context.sourceID, context.reqID})
+		} else {
+			dsl.SignRequest(m, mc.Crypto, sigMsg, &signReceivedBatchContext{context.sourceID, context.reqID})
+		}
 		return nil
 	})
 
-	// When a signature is generated, send it to the process that sent the request.
-	dsl.UponSignResult(m, func(signature []byte, context *signReceivedBatchContext) error {
+	// When a signature (or signature share) is generated, send it to the process that sent the request.
+	sendSig := func(signature []byte, context *signReceivedBatchContext) error {
 		dsl.SendMessage(m, mc.Net, protobuf.SigMessage(mc.Self, signature, context.reqID), []t.NodeID{context.sourceID})
 		return nil
-	})
+	}
+	dsl.UponSignResult(m, sendSig)
+	tcdsl.UponSignShareResult(m, sendSig)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -182,6 +260,12 @@ type requestIDOfOwnBatchContext struct {
 	txs   [][]byte
 }
 
+type verifyReceivedTxsContext struct {
+	sourceID t.NodeID
+	txs      [][]byte
+	reqID    RequestID
+}
+
 type computeIDsOfReceivedTxsContext struct {
 	sourceID t.NodeID
 	txs      [][]byte
@@ -191,6 +275,7 @@ type computeIDsOfReceivedTxsContext struct {
 type computeIDOfReceivedBatchContext struct {
 	sourceID t.NodeID
 	txIDs    []t.TxID
+	txs      [][]byte
 	reqID    RequestID
 }
 
@@ -201,5 +286,11 @@ type signReceivedBatchContext struct {
 
 type verifySigContext struct {
 	reqID     RequestID
+	nodeID    t.NodeID
 	signature []byte
 }
+
+type recoverContext struct {
+	reqID   RequestID
+	signers map[t.NodeID]struct{}
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 
 	adsl "github.com/filecoin-project/mir/pkg/availability/dsl"
+	"github.com/filecoin-project/mir/pkg/availability/internal/certutil"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/common"
 	"github.com/filecoin-project/mir/pkg/dsl"
 	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+	tcdsl "github.com/filecoin-project/mir/pkg/threshcrypto/dsl"
 	t "github.com/filecoin-project/mir/pkg/types"
 	"github.com/filecoin-project/mir/pkg/util/sliceutil"
 )
@@ -23,17 +25,24 @@ func IncludeVerificationOfCertificates(
 ) {
 	// When receive a request to verify a certificate, check that it is structurally correct and verify the signatures.
 	adsl.UponVerifyCert(m, func(cert *apb.Cert, origin *apb.VerifyCertOrigin) error {
-		mscCert, err := verifyCertificateStructure(params, cert)
+		mscCert, signers, err := verifyCertificateStructure(params, cert)
 		if err != nil {
 			adsl.CertVerified(m, t.ModuleID(origin.Module), err, origin)
 			return nil
 		}
 
 		sigMsg := common.SigData(params.InstanceUID, t.BatchID(mscCert.BatchId))
+
+		// An aggregated signature is verified as a single signature of the whole system.
+		if len(mscCert.AggregatedSignature) != 0 {
+			tcdsl.VerifyFull(m, mc.ThreshCrypto, sigMsg, mscCert.AggregatedSignature, &verifySigsInCertContext{origin})
+			return nil
+		}
+
 		dsl.VerifyNodeSigs(m, mc.Crypto,
-			/*data*/ sliceutil.Repeat(sigMsg, len(mscCert.Signers)),
+			/*data*/ sliceutil.Repeat(sigMsg, len(signers)),
 			/*signatures*/ mscCert.Signatures,
-			/*nodeIDs*/ t.NodeIDSlice(mscCert.Signers),
+			/*nodeIDs*/ signers,
 			/*context*/ &verifySigsInCertContext{origin},
 		)
 		return nil
@@ -49,55 +58,63 @@ func IncludeVerificationOfCertificates(
 		adsl.CertVerified(m, t.ModuleID(context.origin.Module), err, context.origin)
 		return nil
 	})
+
+	// When the aggregated signature in a certificate is verified, output the result of certificate verification.
+	tcdsl.UponVerifyFullResult(m, func(err error, context *verifySigsInCertContext) error {
+		if err != nil {
+			err = fmt.Errorf("invalid aggregated signature: %w", err)
+		}
+
+		adsl.CertVerified(m, t.ModuleID(context.origin.Module), err, context.origin)
+		return nil
+	})
 }
 
 // verifyCertificateStructure checks that the certificate is well-formed without checking the validity of the
 // cryptographic authenticators like hashes and digital signatures.
-func verifyCertificateStructure(params *common.ModuleParams, cert *apb.Cert) (*mscpb.Cert, error) {
+// On success, it returns the certificate along with the signers decoded from its signer bitmap.
+func verifyCertificateStructure(params *common.ModuleParams, cert *apb.Cert) (*mscpb.Cert, []t.NodeID, error) {
 	// Check that the certificate is present.
 	if cert == nil || cert.Type == nil {
-		return nil, fmt.Errorf("the certificate is nil")
+		return nil, nil, fmt.Errorf("the certificate is nil")
 	}
 
 	// Check that the certificate is of the right type.
 	mscCertWrapper, ok := cert.Type.(*apb.Cert_Msc)
 	if !ok {
-		return nil, fmt.Errorf("unexpected certificate type")
+		return nil, nil, fmt.Errorf("unexpected certificate type")
 	}
 	mscCert := mscCertWrapper.Msc
 
-	// Check that the certificate contains a sufficient number of signatures.
-	if len(mscCert.Signers) <= (params.N()+params.F())/2 {
-		return nil, fmt.Errorf("insuficient number of signatures")
+	// Check that the signer bitmap is valid w.r.t. the membership.
+	// This also guarantees that the identities of the signing nodes are valid and not repeated.
+	signers, err := certutil.BitmapSigners(params.AllNodes, mscCert.SignerBitmap)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if len(mscCert.Signers) != len(mscCert.Signatures) {
-		return nil, fmt.Errorf("the number of signatures does not correspond to the number of signers")
+	// Check that the certificate contains a sufficient number of signatures.
+	if len(signers) < params.Quorum() {
+		return nil, nil, fmt.Errorf("insuficient number of signatures")
 	}
 
-	// Check that the identities of the signing nodes are not repeated.
-	alreadySeen := make(map[t.NodeID]struct{})
-	for _, idRaw := range mscCert.Signers {
-		id := t.NodeID(idRaw)
-		if _, ok := alreadySeen[id]; ok {
-			return nil, fmt.Errorf("some node ids in the certificate are repeated multiple times")
+	// A certificate contains either a single aggregated signature or one signature per signer.
+	// Aggregated signatures are only accepted if the threshcrypto module is used.
+	if len(mscCert.AggregatedSignature) != 0 {
+		if !params.AggregateSignatures {
+			return nil, nil, fmt.Errorf("aggregated signatures are not enabled")
 		}
-		alreadySeen[id] = struct{}{}
-	}
-
-	// Check that the identities of the source node and the signing nodes are valid.
-	allNodes := make(map[t.NodeID]struct{})
-	for _, id := range params.AllNodes {
-		allNodes[id] = struct{}{}
+		if len(mscCert.Signatures) != 0 {
+			return nil, nil, fmt.Errorf("certificate contains both an aggregated signature and individual signatures")
+		}
+		return mscCert, signers, nil
 	}
 
-	for _, idRaw := range mscCert.Signers {
-		if _, ok := allNodes[t.NodeID(idRaw)]; !ok {
-			return nil, fmt.Errorf("unknown node id: %v", t.NodeID(idRaw))
-		}
+	if len(signers) != len(mscCert.Signatures) {
+		return nil, nil, fmt.Errorf("the number of signatures does not correspond to the number of signers")
 	}
 
-	return mscCert, nil
+	return mscCert, signers, nil
 }
 
 // Context data structures                                                                                            //
@@ -1,8 +1,10 @@
 package protobuf
 
 import (
+	aevents "github.com/filecoin-project/mir/pkg/availability/events"
 	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/pb/messagepb"
 	t "github.com/filecoin-project/mir/pkg/types"
 )
@@ -38,10 +40,10 @@ func SigMessage(moduleID t.ModuleID, signature []byte, reqID uint64) *messagepb.
 	})
 }
 
-func RequestBatchMessage(moduleID t.ModuleID, batchID t.BatchID, reqID uint64) *messagepb.Message {
+func RequestTxIDsMessage(moduleID t.ModuleID, batchID t.BatchID, reqID uint64) *messagepb.Message {
 	return Message(moduleID, &mscpb.Message{
-		Type: &mscpb.Message_RequestBatch{
-			RequestBatch: &mscpb.RequestBatchMessage{
+		Type: &mscpb.Message_RequestTxIds{
+			RequestTxIds: &mscpb.RequestTxIDsMessage{
 				BatchId: batchID.Pb(),
 				ReqId:   reqID,
 			},
@@ -49,24 +51,77 @@ func RequestBatchMessage(moduleID t.ModuleID, batchID t.BatchID, reqID uint64) *
 	})
 }
 
-func ProvideBatchMessage(moduleID t.ModuleID, txs [][]byte, reqID uint64) *messagepb.Message {
+func ProvideTxIDsMessage(moduleID t.ModuleID, txIDs []t.TxID, reqID uint64) *messagepb.Message {
 	return Message(moduleID, &mscpb.Message{
-		Type: &mscpb.Message_ProvideBatch{
-			ProvideBatch: &mscpb.ProvideBatchMessage{
-				Txs:   txs,
+		Type: &mscpb.Message_ProvideTxIds{
+			ProvideTxIds: &mscpb.ProvideTxIDsMessage{
+				TxIds: t.TxIDSlicePb(txIDs),
 				ReqId: reqID,
 			},
 		},
 	})
 }
 
-func Cert(batchID t.BatchID, signers []t.NodeID, signatures [][]byte) *apb.Cert {
+func RequestMissingTxsMessage(moduleID t.ModuleID, txIDs []t.TxID, reqID uint64) *messagepb.Message {
+	return Message(moduleID, &mscpb.Message{
+		Type: &mscpb.Message_RequestMissingTxs{
+			RequestMissingTxs: &mscpb.RequestMissingTxsMessage{
+				ReqId: reqID,
+				TxIds: t.TxIDSlicePb(txIDs),
+			},
+		},
+	})
+}
+
+func ProvideMissingTxsMessage(moduleID t.ModuleID, txs [][]byte, reqID uint64) *messagepb.Message {
+	return Message(moduleID, &mscpb.Message{
+		Type: &mscpb.Message_ProvideMissingTxs{
+			ProvideMissingTxs: &mscpb.ProvideMissingTxsMessage{
+				ReqId: reqID,
+				Txs:   txs,
+			},
+		},
+	})
+}
+
+func Event(moduleID t.ModuleID, ev *mscpb.Event) *eventpb.Event {
+	return aevents.Event(moduleID, &apb.Event{
+		Type: &apb.Event_Msc{
+			Msc: ev,
+		},
+	})
+}
+
+func RequestTimeout(moduleID t.ModuleID, reqID uint64, attempt uint64) *eventpb.Event {
+	return Event(moduleID, &mscpb.Event{
+		Type: &mscpb.Event_RequestTimeout{
+			RequestTimeout: &mscpb.RequestTimeout{
+				ReqId:   reqID,
+				Attempt: attempt,
+			},
+		},
+	})
+}
+
+func Cert(batchID t.BatchID, signerBitmap []byte, signatures [][]byte) *apb.Cert {
+	return &apb.Cert{
+		Type: &apb.Cert_Msc{
+			Msc: &mscpb.Cert{
+				BatchId:      batchID.Pb(),
+				SignerBitmap: signerBitmap,
+				Signatures:   signatures,
+			},
+		},
+	}
+}
+
+func AggregatedCert(batchID t.BatchID, signerBitmap []byte, aggregatedSignature []byte) *apb.Cert {
 	return &apb.Cert{
 		Type: &apb.Cert_Msc{
 			Msc: &mscpb.Cert{
-				BatchId:    batchID.Pb(),
-				Signers:    t.NodeIDSlicePb(signers),
-				Signatures: signatures,
+				BatchId:             batchID.Pb(),
+				SignerBitmap:        signerBitmap,
+				AggregatedSignature: aggregatedSignature,
 			},
 		},
 	}
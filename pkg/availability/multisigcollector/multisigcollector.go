@@ -1,10 +1,14 @@
 package multisigcollector
 
 import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/batchstore"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/common"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/parts/batchreconstruction"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/parts/certcreation"
 	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/parts/certverification"
+	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/parts/garbagecollection"
 	"github.com/filecoin-project/mir/pkg/dsl"
 	"github.com/filecoin-project/mir/pkg/modules"
 	t "github.com/filecoin-project/mir/pkg/types"
@@ -14,22 +18,62 @@ type ModuleConfig = common.ModuleConfig
 
 type ModuleParams = common.ModuleParams
 
+// DefaultModuleConfig returns a valid module config with default names for all modules.
+func DefaultModuleConfig() *ModuleConfig {
+	return common.DefaultModuleConfig()
+}
+
+// CheckParams checks whether the given module parameters satisfy all necessary constraints.
+func CheckParams(params *ModuleParams) error {
+	return common.CheckParams(params)
+}
+
 // NewModule creates a new instance of the multisig collector module.
 // Multisig collector is the simplest implementation of the availability layer.
 // Whenever an availability certificate is requested, it pulls a batch from the mempool module,
 // sends it to all replicas and collects a quorum (i.e., more than (N+F)/2) of signatures confirming that
 // other nodes have persistently stored the batch.
-func NewModule(mc *ModuleConfig, params *ModuleParams, nodeID t.NodeID) modules.PassiveModule {
-	m := dsl.NewModule(mc.Self)
+// If params.AggregateSignatures is set, the nodes produce threshold signature shares (using the threshcrypto module)
+// instead of signatures, and the certificate contains a single signature combined from a quorum of shares.
+// Before signing a batch received from another node, a node has its transactions validated through the mempool
+// and does not sign batches containing invalid transactions.
+// Stored batches are garbage-collected as instructed by the consensus layer
+// through the SetRetentionIndex and ForgetBatchesBefore events, which are also forwarded to the mempool.
+// The batches are only stored in memory. Use NewModuleWithStore to store them durably.
+// NewModule returns an error if the module parameters are not valid.
+func NewModule(mc *ModuleConfig, params *ModuleParams, nodeID t.NodeID) (modules.PassiveModule, error) {
+	return NewModuleWithStore(mc, params, nodeID, batchstore.NewMemStore())
+}
 
-	commonState := &common.State{
-		BatchStore:       make(map[t.BatchID][]t.TxID),
-		TransactionStore: make(map[t.TxID][]byte),
+// NewModuleWithStore creates a new instance of the multisig collector module that uses the given batch store.
+// A node stores each batch in the store before signing it.
+// With a durable store (e.g., batchstore.FileStore), the node thus keeps its promise to provide the signed batches
+// even after a restart.
+// NewModuleWithStore returns an error if the module parameters are not valid.
+func NewModuleWithStore(
+	mc *ModuleConfig,
+	params *ModuleParams,
+	nodeID t.NodeID,
+	store batchstore.Store,
+) (modules.PassiveModule, error) {
+	if err := CheckParams(params); err != nil {
+		return nil, fmt.Errorf("invalid multisig collector parameters: %w", err)
 	}
 
+	m := dsl.NewModule(mc.Self)
+
+	commonState := common.NewState(store)
+
+	// The module does not need any initialization,
+	// but it must accept the Init event that is sent to all modules of a node.
+	dsl.UponInit(m, func() error {
+		return nil
+	})
+
 	certcreation.IncludeCreatingCertificates(m, mc, params, nodeID, commonState)
 	certverification.IncludeVerificationOfCertificates(m, mc, params, nodeID, commonState)
 	batchreconstruction.IncludeBatchReconstruction(m, mc, params, nodeID, commonState)
+	garbagecollection.IncludeGarbageCollection(m, mc, commonState)
 
-	return m
+	return m, nil
 }
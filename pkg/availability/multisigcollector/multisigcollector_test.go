@@ -0,0 +1,425 @@
+package multisigcollector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	aevents "github.com/filecoin-project/mir/pkg/availability/events"
+	"github.com/filecoin-project/mir/pkg/availability/internal/certutil"
+	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/batchstore"
+	"github.com/filecoin-project/mir/pkg/availability/multisigcollector/internal/protobuf"
+	"github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/events"
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/modules"
+	apb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+	"github.com/filecoin-project/mir/pkg/pb/dslpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	"github.com/filecoin-project/mir/pkg/threshcrypto"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
+)
+
+const consumerModule = t.ModuleID("consumer")
+
+// txID and batchID compute transaction and batch IDs the way the test mempool does.
+func txID(tx []byte) t.TxID {
+	return t.TxID("id-" + string(tx))
+}
+
+func batchID(txIDs []t.TxID) t.BatchID {
+	ids := make([]string, len(txIDs))
+	for i, id := range txIDs {
+		ids[i] = string(id)
+	}
+	return t.BatchID(strings.Join(ids, "|"))
+}
+
+// testSystem is a system of multisig collector modules of 4 nodes, with a simple mempool mock per node
+// and, if signatures are aggregated, a threshcrypto module per node.
+// The network is simulated by delivering messages sent by one module to the others,
+// unless filtered out by the network's drop function.
+type testSystem struct {
+	tt       *testing.T
+	nodeIDs  []t.NodeID
+	mc       *ModuleConfig
+	nodes    map[t.NodeID]modules.PassiveModule
+	tcs      map[t.NodeID]modules.PassiveModule
+	mempools map[t.NodeID]map[t.TxID][]byte
+	drop     func(from, to t.NodeID, msg *mscpb.Message) bool
+
+	// Events waiting to be applied and timer events waiting to be fired.
+	queue  []nodeEvent
+	timers []nodeEvent
+
+	// Transactions the mempool mock of each node returns when asked for a new batch.
+	newBatch map[t.NodeID][][]byte
+
+	// Transactions provided to the consumer module at each node.
+	provided map[t.NodeID][][]byte
+
+	// Certificates created and results of certificate verification (nil if valid) at each node.
+	certs    map[t.NodeID][]*apb.Cert
+	verified map[t.NodeID][]error
+
+	// Messages sent by each node.
+	sent map[t.NodeID][]*mscpb.Message
+}
+
+type nodeEvent struct {
+	nodeID t.NodeID
+	event  *eventpb.Event
+}
+
+// newTestSystem creates a new testSystem where the given batch is stored by the nodes in storing.
+func newTestSystem(tt *testing.T, aggregateSignatures bool, txs [][]byte, storing ...t.NodeID) *testSystem {
+	nodeIDs := []t.NodeID{"0", "1", "2", "3"}
+	ts := &testSystem{
+		tt:       tt,
+		nodeIDs:  nodeIDs,
+		mc:       DefaultModuleConfig(),
+		nodes:    make(map[t.NodeID]modules.PassiveModule),
+		tcs:      make(map[t.NodeID]modules.PassiveModule),
+		mempools: make(map[t.NodeID]map[t.TxID][]byte),
+		newBatch: make(map[t.NodeID][][]byte),
+		drop:     func(_, _ t.NodeID, _ *mscpb.Message) bool { return false },
+		provided: make(map[t.NodeID][][]byte),
+		certs:    make(map[t.NodeID][]*apb.Cert),
+		verified: make(map[t.NodeID][]error),
+		sent:     make(map[t.NodeID][]*mscpb.Message),
+	}
+
+	txIDs := make([]t.TxID, len(txs))
+	for i, tx := range txs {
+		txIDs[i] = txID(tx)
+	}
+
+	params := &ModuleParams{
+		InstanceUID:         []byte("test"),
+		AllNodes:            nodeIDs,
+		RequestTimeout:      1000,
+		AggregateSignatures: aggregateSignatures,
+	}
+	for _, nodeID := range nodeIDs {
+		store := batchstore.NewMemStore()
+		for _, n := range storing {
+			if n == nodeID {
+				require.NoError(tt, store.StoreBatch(0, batchID(txIDs), txIDs, txs))
+			}
+		}
+
+		module, err := NewModuleWithStore(ts.mc, params, nodeID, store)
+		require.NoError(tt, err)
+		ts.nodes[nodeID] = module
+		ts.mempools[nodeID] = make(map[t.TxID][]byte)
+
+		if aggregateSignatures {
+			tc, err := threshcrypto.NodePseudo(nodeIDs, params.Quorum(), nodeID, crypto.DefaultPseudoSeed)
+			require.NoError(tt, err)
+			ts.tcs[nodeID] = threshcrypto.New(tc)
+		}
+	}
+
+	return ts
+}
+
+// requestTransactions has node nodeID request the transactions of the batch with the given ID,
+// certified by the given signers.
+func (ts *testSystem) requestTransactions(nodeID t.NodeID, batch t.BatchID, signers ...t.NodeID) {
+	ts.requestCertTransactions(nodeID, protobuf.Cert(batch, ts.signerBitmap(signers...), nil))
+}
+
+// requestCertTransactions has node nodeID request the transactions of the batch certified by the given certificate.
+func (ts *testSystem) requestCertTransactions(nodeID t.NodeID, cert *apb.Cert) {
+	origin := &apb.RequestTransactionsOrigin{
+		Module: consumerModule.Pb(),
+		Type:   &apb.RequestTransactionsOrigin_Dsl{Dsl: &dslpb.Origin{ContextID: 0}},
+	}
+	ts.queue = append(ts.queue, nodeEvent{nodeID, aevents.RequestTransactions(ts.mc.Self, cert, origin)})
+}
+
+// signerBitmap returns the signer bitmap of the given nodes.
+func (ts *testSystem) signerBitmap(signers ...t.NodeID) []byte {
+	signerSet := make(map[t.NodeID]struct{})
+	for _, signer := range signers {
+		signerSet[signer] = struct{}{}
+	}
+	return certutil.SignerBitmap(ts.nodeIDs, signerSet)
+}
+
+// requestCert has node nodeID request a certificate.
+func (ts *testSystem) requestCert(nodeID t.NodeID) {
+	origin := &apb.RequestCertOrigin{
+		Module: consumerModule.Pb(),
+		Type:   &apb.RequestCertOrigin_Dsl{Dsl: &dslpb.Origin{ContextID: 0}},
+	}
+	ts.queue = append(ts.queue, nodeEvent{nodeID, aevents.RequestCert(ts.mc.Self, origin)})
+}
+
+// verifyCert has node nodeID verify the given certificate.
+func (ts *testSystem) verifyCert(nodeID t.NodeID, cert *apb.Cert) {
+	origin := &apb.VerifyCertOrigin{
+		Module: consumerModule.Pb(),
+		Type:   &apb.VerifyCertOrigin_Dsl{Dsl: &dslpb.Origin{ContextID: 0}},
+	}
+	ts.queue = append(ts.queue, nodeEvent{nodeID, aevents.VerifyCert(ts.mc.Self, cert, origin)})
+}
+
+// run processes events until there are none left. Pending timer events are not fired.
+func (ts *testSystem) run() {
+	for len(ts.queue) > 0 {
+		next := ts.queue[0]
+		ts.queue = ts.queue[1:]
+
+		switch t.ModuleID(next.event.DestModule) {
+		case ts.mc.Self:
+			evsOut, err := ts.nodes[next.nodeID].ApplyEvents(events.ListOf(next.event))
+			require.NoError(ts.tt, err)
+			for _, ev := range evsOut.Slice() {
+				ts.queue = append(ts.queue, nodeEvent{next.nodeID, ev})
+			}
+		case ts.mc.Net:
+			sendEvent := next.event.GetSendMessage()
+			msg := sendEvent.Msg.Type.(*messagepb.Message_MultisigCollector).MultisigCollector
+			ts.sent[next.nodeID] = append(ts.sent[next.nodeID], msg)
+			for _, dest := range t.NodeIDSlice(sendEvent.Destinations) {
+				if !ts.drop(next.nodeID, dest, msg) {
+					ts.queue = append(ts.queue, nodeEvent{dest, events.MessageReceived(ts.mc.Self, next.nodeID, sendEvent.Msg)})
+				}
+			}
+		case ts.mc.Timer:
+			for _, ev := range next.event.GetTimerDelay().Events {
+				ts.timers = append(ts.timers, nodeEvent{next.nodeID, ev})
+			}
+		case ts.mc.ThreshCrypto:
+			evsOut, err := ts.tcs[next.nodeID].ApplyEvents(events.ListOf(next.event))
+			require.NoError(ts.tt, err)
+			for _, ev := range evsOut.Slice() {
+				ts.queue = append(ts.queue, nodeEvent{next.nodeID, ev})
+			}
+		case ts.mc.Mempool:
+			ts.queue = append(ts.queue, nodeEvent{next.nodeID, ts.applyMempoolEvent(next.nodeID, next.event)})
+		case consumerModule:
+			switch e := next.event.GetAvailability().Type.(type) {
+			case *apb.Event_ProvideTransactions:
+				ts.provided[next.nodeID] = e.ProvideTransactions.Txs
+			case *apb.Event_NewCert:
+				ts.certs[next.nodeID] = append(ts.certs[next.nodeID], e.NewCert.Cert)
+			case *apb.Event_CertVerified:
+				ts.verified[next.nodeID] = append(ts.verified[next.nodeID], t.ErrorFromPb(e.CertVerified.Valid, e.CertVerified.Err))
+			default:
+				ts.tt.Fatalf("unexpected availability event: %T", e)
+			}
+		default:
+			ts.tt.Fatalf("unexpected destination module: %v", next.event.DestModule)
+		}
+	}
+}
+
+// fireTimers fires all pending timer events and processes the resulting events.
+func (ts *testSystem) fireTimers() {
+	ts.queue = append(ts.queue, ts.timers...)
+	ts.timers = nil
+	ts.run()
+}
+
+// applyMempoolEvent returns the response of the mempool mock of node nodeID to the given event.
+func (ts *testSystem) applyMempoolEvent(nodeID t.NodeID, event *eventpb.Event) *eventpb.Event {
+	switch e := event.GetMempool().Type.(type) {
+	case *mempoolpb.Event_RequestBatch:
+		origin := e.RequestBatch.Origin
+		txs := ts.newBatch[nodeID]
+		txIDs := make([]t.TxID, len(txs))
+		for i, tx := range txs {
+			txIDs[i] = txID(tx)
+		}
+		return mpevents.NewBatch(t.ModuleID(origin.Module), txIDs, txs, origin)
+	case *mempoolpb.Event_VerifyTransactions:
+		origin := e.VerifyTransactions.Origin
+		n := len(e.VerifyTransactions.Txs)
+		return mpevents.TransactionsVerified(t.ModuleID(origin.Module),
+			sliceutil.Repeat(true, n), sliceutil.Repeat("", n), sliceutil.Repeat(uint64(0), n), origin)
+	case *mempoolpb.Event_RequestBatchId:
+		origin := e.RequestBatchId.Origin
+		return mpevents.BatchIDResponse(t.ModuleID(origin.Module), batchID(t.TxIDSlice(e.RequestBatchId.TxIds)), origin)
+	case *mempoolpb.Event_RequestTransactions:
+		origin := e.RequestTransactions.Origin
+		present := make([]bool, len(e.RequestTransactions.TxIds))
+		txs := make([][]byte, len(e.RequestTransactions.TxIds))
+		for i, id := range t.TxIDSlice(e.RequestTransactions.TxIds) {
+			txs[i], present[i] = ts.mempools[nodeID][id]
+		}
+		return mpevents.TransactionsResponse(t.ModuleID(origin.Module), present, txs, origin)
+	case *mempoolpb.Event_RequestTransactionIds:
+		origin := e.RequestTransactionIds.Origin
+		txIDs := make([]t.TxID, len(e.RequestTransactionIds.Txs))
+		for i, tx := range e.RequestTransactionIds.Txs {
+			txIDs[i] = txID(tx)
+		}
+		return mpevents.TransactionIDsResponse(t.ModuleID(origin.Module), txIDs, origin)
+	default:
+		ts.tt.Fatalf("unexpected mempool event: %T", e)
+		return nil
+	}
+}
+
+// requestedMissingTxs returns the IDs of the transactions node nodeID requested from other nodes.
+func (ts *testSystem) requestedMissingTxs(nodeID t.NodeID) [][]t.TxID {
+	var requested [][]t.TxID
+	for _, msg := range ts.sent[nodeID] {
+		if req := msg.GetRequestMissingTxs(); req != nil {
+			requested = append(requested, t.TxIDSlice(req.TxIds))
+		}
+	}
+	return requested
+}
+
+var testTxs = [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+
+func testBatchID() t.BatchID {
+	return batchID([]t.TxID{txID(testTxs[0]), txID(testTxs[1]), txID(testTxs[2])})
+}
+
+func TestBatchReconstruction(tt *testing.T) {
+	ts := newTestSystem(tt, false, testTxs, "1", "2")
+
+	// Node 0 already has one of the transactions in its mempool.
+	ts.mempools["0"][txID(testTxs[0])] = testTxs[0]
+
+	ts.requestTransactions("0", testBatchID(), "1", "2")
+	ts.run()
+
+	assert.Equal(tt, testTxs, ts.provided["0"])
+
+	// Only the transactions missing in the mempool are pulled.
+	assert.Equal(tt, [][]t.TxID{{txID(testTxs[1]), txID(testTxs[2])}}, ts.requestedMissingTxs("0"))
+
+	// The reconstructed batch is stored and can be provided without asking other nodes again.
+	ts.sent["0"] = nil
+	ts.requestTransactions("0", testBatchID(), "1", "2")
+	ts.run()
+	assert.Empty(tt, ts.sent["0"])
+}
+
+func TestBatchReconstructionTimeout(tt *testing.T) {
+	ts := newTestSystem(tt, false, testTxs, "1", "2")
+
+	// Node 1 does not respond.
+	ts.drop = func(_, to t.NodeID, _ *mscpb.Message) bool {
+		return to == "1"
+	}
+
+	ts.requestTransactions("0", testBatchID(), "1", "2")
+	ts.run()
+	assert.Nil(tt, ts.provided["0"])
+
+	// On timeout, the next signer is asked.
+	ts.fireTimers()
+	assert.Equal(tt, testTxs, ts.provided["0"])
+}
+
+func TestBatchReconstructionInvalidResponse(tt *testing.T) {
+	ts := newTestSystem(tt, false, testTxs, "2")
+
+	// Node 1 claims the batch consists of different transactions.
+	otherTxIDs := []t.TxID{txID([]byte("x"))}
+	ts.drop = func(_, to t.NodeID, _ *mscpb.Message) bool {
+		return to == "1"
+	}
+
+	ts.requestTransactions("0", testBatchID(), "1", "2")
+	ts.queue = append(ts.queue, nodeEvent{"0", events.MessageReceived(
+		ts.mc.Self, "1", protobuf.ProvideTxIDsMessage(ts.mc.Self, otherTxIDs, 0),
+	)})
+	ts.run()
+
+	// The invalid response makes node 0 ask the next signer without waiting for the timeout.
+	assert.Equal(tt, testTxs, ts.provided["0"])
+}
+
+func TestBatchReconstructionAggregatedCert(tt *testing.T) {
+	ts := newTestSystem(tt, true, testTxs, "3")
+
+	// The signer bitmap of a certificate with an aggregated signature is not authenticated.
+	// Even if the claimed signers do not have the batch, it is obtained from another node.
+	ts.requestCertTransactions("0", protobuf.AggregatedCert(testBatchID(), ts.signerBitmap("1", "2"), []byte("sig")))
+	ts.run()
+	ts.fireTimers()
+	ts.fireTimers()
+	assert.Equal(tt, testTxs, ts.provided["0"])
+}
+
+func TestAggregatedCertificate(tt *testing.T) {
+	ts := newTestSystem(tt, true, nil)
+	ts.newBatch["0"] = testTxs
+
+	ts.requestCert("0")
+	ts.run()
+
+	// The certificate contains a single aggregated signature of a quorum of nodes.
+	require.Len(tt, ts.certs["0"], 1)
+	cert := ts.certs["0"][0]
+	mscCert := cert.GetMsc()
+	require.NotNil(tt, mscCert)
+	assert.Equal(tt, testBatchID(), t.BatchID(mscCert.BatchId))
+	assert.Empty(tt, mscCert.Signatures)
+	assert.NotEmpty(tt, mscCert.AggregatedSignature)
+	signers, err := certutil.BitmapSigners(ts.nodeIDs, mscCert.SignerBitmap)
+	require.NoError(tt, err)
+	assert.GreaterOrEqual(tt, len(signers), 3)
+
+	// The certificate is valid at other nodes, but not if its signature is changed.
+	ts.verifyCert("1", cert)
+	invalidSig := append([]byte{}, mscCert.AggregatedSignature...)
+	invalidSig[len(invalidSig)-1]++
+	ts.verifyCert("1", protobuf.AggregatedCert(testBatchID(), mscCert.SignerBitmap, invalidSig))
+	ts.verifyCert("1", protobuf.AggregatedCert("other batch", mscCert.SignerBitmap, mscCert.AggregatedSignature))
+	ts.run()
+	require.Len(tt, ts.verified["1"], 3)
+	assert.NoError(tt, ts.verified["1"][0])
+	assert.Error(tt, ts.verified["1"][1])
+	assert.Error(tt, ts.verified["1"][2])
+
+	// Without aggregated signatures enabled, the certificate is not accepted.
+	ts = newTestSystem(tt, false, nil)
+	ts.verifyCert("1", cert)
+	ts.run()
+	require.Len(tt, ts.verified["1"], 1)
+	assert.Error(tt, ts.verified["1"][0])
+}
+
+func TestCheckParams(tt *testing.T) {
+	nodeIDs := []t.NodeID{"0", "1", "2", "3"}
+
+	testCases := map[string]struct {
+		params *ModuleParams
+		valid  bool
+	}{
+		"valid":            {&ModuleParams{AllNodes: nodeIDs, RequestTimeout: 1}, true},
+		"no nodes":         {&ModuleParams{AllNodes: nil, RequestTimeout: 1}, false},
+		"duplicate node":   {&ModuleParams{AllNodes: []t.NodeID{"0", "1", "0"}, RequestTimeout: 1}, false},
+		"zero timeout":     {&ModuleParams{AllNodes: nodeIDs, RequestTimeout: 0}, false},
+		"negative timeout": {&ModuleParams{AllNodes: nodeIDs, RequestTimeout: -1}, false},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		tt.Run(name, func(tt *testing.T) {
+			err := CheckParams(tc.params)
+			if tc.valid {
+				assert.NoError(tt, err)
+			} else {
+				assert.Error(tt, err)
+			}
+
+			_, err = NewModule(DefaultModuleConfig(), tc.params, "0")
+			assert.Equal(tt, tc.valid, err == nil)
+		})
+	}
+}
@@ -42,6 +42,28 @@ func Deliver(m dsl.Module, dest t.ModuleID, data []byte) {
 	})
 }
 
+// InstanceDeliver is emitted by the BCB manager when the instance with the given leader and sequence number
+// delivers data.
+func InstanceDeliver(m dsl.Module, dest t.ModuleID, leader t.NodeID, sn t.SeqNr, data []byte) {
+	dsl.EmitEvent(m, &eventpb.Event{
+		DestModule: dest.Pb(),
+
+		Type: &eventpb.Event_Bcb{
+			Bcb: &bcbpb.Event{
+				Type: &bcbpb.Event_InstanceDeliver{
+					InstanceDeliver: &bcbpb.InstanceDeliver{
+						InstanceId: &bcbpb.InstanceID{
+							Leader: leader.Pb(),
+							Sn:     sn.Pb(),
+						},
+						Data: data,
+					},
+				},
+			},
+		},
+	})
+}
+
 // Module-specific dsl functions for processing events.
 
 func UponEvent[EvWrapper bcbpb.Event_TypeWrapper[Ev], Ev any](m dsl.Module, handler func(ev *Ev) error) {
@@ -66,6 +88,12 @@ func UponDeliver(m dsl.Module, handler func(data []byte) error) {
 	})
 }
 
+func UponInstanceDeliver(m dsl.Module, handler func(leader t.NodeID, sn t.SeqNr, data []byte) error) {
+	UponEvent[*bcbpb.Event_InstanceDeliver](m, func(ev *bcbpb.InstanceDeliver) error {
+		return handler(t.NodeID(ev.InstanceId.Leader), t.SeqNr(ev.InstanceId.Sn), ev.Data)
+	})
+}
+
 func UponBCBMessageReceived(m dsl.Module, handler func(from t.NodeID, msg *bcbpb.Message) error) {
 	dsl.UponMessageReceived(m, func(from t.NodeID, msg *messagepb.Message) error {
 		cbMsgWrapper, ok := msg.Type.(*messagepb.Message_Bcb)
@@ -113,3 +141,26 @@ func UponFinalMessageReceived(
 		return handler(from, finalMsg.Data, t.NodeIDSlice(finalMsg.Signers), finalMsg.Signatures)
 	})
 }
+
+func UponInstanceMessageReceived(
+	m dsl.Module,
+	handler func(from t.NodeID, leader t.NodeID, sn t.SeqNr, msg *bcbpb.Message) error,
+) {
+	UponBCBMessageReceived(m, func(from t.NodeID, msg *bcbpb.Message) error {
+		instanceMsgWrapper, ok := msg.Type.(*bcbpb.Message_InstanceMessage)
+		if !ok {
+			return nil
+		}
+
+		instanceMsg := instanceMsgWrapper.InstanceMessage
+		if instanceMsg.InstanceId == nil || instanceMsg.Message == nil {
+			return nil
+		}
+		return handler(
+			from,
+			t.NodeID(instanceMsg.InstanceId.Leader),
+			t.SeqNr(instanceMsg.InstanceId.Sn),
+			instanceMsg.Message,
+		)
+	})
+}
@@ -0,0 +1,324 @@
+package bcb
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/bcb/bcbdsl"
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/bcbpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/maputil"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
+)
+
+type ManagerParams struct {
+	InstanceUID []byte     // unique identifier for this BCB manager, from which the identifiers of its instances derive
+	AllNodes    []t.NodeID // the list of participating nodes
+	WindowSize  int        // the maximal number of instances of each leader that can be in progress concurrently
+}
+
+// CheckManagerParams checks whether the given BCB manager parameters satisfy all necessary constraints.
+func CheckManagerParams(params *ManagerParams) error {
+	if len(params.AllNodes) == 0 {
+		return fmt.Errorf("empty AllNodes")
+	}
+
+	// WindowSize must be positive, as no instance could ever be started otherwise.
+	if params.WindowSize <= 0 {
+		return fmt.Errorf("non-positive WindowSize: %d", params.WindowSize)
+	}
+
+	return nil
+}
+
+func (params *ManagerParams) GetN() int {
+	return len(params.AllNodes)
+}
+
+func (params *ManagerParams) GetF() int {
+	return (params.GetN() - 1) / 3
+}
+
+// InstanceID identifies a BCB instance hosted by the BCB manager.
+// Each node numbers the instances it is the leader of consecutively, starting from 0.
+type InstanceID struct {
+	Leader t.NodeID
+	Sn     t.SeqNr
+}
+
+// Pb converts an InstanceID to its protobuf representation.
+func (id InstanceID) Pb() *bcbpb.InstanceID {
+	return &bcbpb.InstanceID{
+		Leader: id.Leader.Pb(),
+		Sn:     id.Sn.Pb(),
+	}
+}
+
+type managerState struct {
+	// sequence number of the next instance this node is the leader of
+	nextSn t.SeqNr
+
+	// data of the instances requested while this node already led params.WindowSize instances that have not delivered
+	pendingRequests [][]byte
+
+	// states of the instances that have been started, but have not yet delivered
+	instances map[InstanceID]*cbModuleState
+
+	// for each leader, the lowest sequence number of an instance that has not yet delivered
+	lowWatermarks map[t.NodeID]t.SeqNr
+
+	// delivered instances with a sequence number above the low watermark of their leader
+	deliveredAboveWatermark map[InstanceID]struct{}
+
+	// data of the start messages received for instances beyond the window of their leader, but within the next one
+	bufferedStarts map[InstanceID][]byte
+}
+
+type managerSignStartMessageContext struct {
+	instanceID InstanceID
+}
+
+type managerVerifyEchoContext struct {
+	instanceID InstanceID
+	signature  []byte
+}
+
+type managerVerifyFinalContext struct {
+	instanceID InstanceID
+	data       []byte
+}
+
+// NewManagerModule returns a passive module hosting arbitrarily many concurrent instances of the
+// Signed Echo Broadcast implemented by NewModule, each instance being identified by an InstanceID.
+// Each Request event starts a new instance with this node as the leader and the next sequence number.
+// On the other nodes, an instance is created when the first message from its leader is received.
+// When an instance delivers, the manager emits an InstanceDeliver event to the consumer
+// and garbage-collects the state of the instance.
+// Messages belonging to instances that have already delivered are ignored.
+//
+// To bound the state kept for each leader, instances are only started within a window of params.WindowSize
+// sequence numbers above the lowest sequence number of the leader's instances that have not yet delivered.
+// The leader defers the instances it is requested to start beyond its window until the window advances.
+// Other nodes buffer the start messages of the next window, as they may lag behind the leader, and ignore the rest.
+// Final messages are processed regardless of the window, so that a lagging node still delivers all instances.
+// As valid final messages require signatures of nodes that started the instance within their windows,
+// this does not let a Byzantine leader make the other nodes keep track of arbitrarily many instances.
+// NewManagerModule returns an error if the parameters are not valid.
+func NewManagerModule(mc *ModuleConfig, params *ManagerParams, nodeID t.NodeID) (modules.PassiveModule, error) {
+	if err := CheckManagerParams(params); err != nil {
+		return nil, fmt.Errorf("invalid BCB manager parameters: %w", err)
+	}
+
+	m := dsl.NewModule(mc.Self)
+
+	state := managerState{
+		nextSn:                  0,
+		pendingRequests:         nil,
+		instances:               make(map[InstanceID]*cbModuleState),
+		lowWatermarks:           make(map[t.NodeID]t.SeqNr),
+		deliveredAboveWatermark: make(map[InstanceID]struct{}),
+		bufferedStarts:          make(map[InstanceID][]byte),
+	}
+
+	isMember := make(map[t.NodeID]struct{}, len(params.AllNodes))
+	for _, n := range params.AllNodes {
+		isMember[n] = struct{}{}
+	}
+
+	// delivered returns true if the instance has already delivered (and thus been garbage-collected).
+	delivered := func(id InstanceID) bool {
+		if id.Sn < state.lowWatermarks[id.Leader] {
+			return true
+		}
+		_, ok := state.deliveredAboveWatermark[id]
+		return ok
+	}
+
+	// getInstance returns the state of an instance that has not yet delivered, creating it if necessary.
+	getInstance := func(id InstanceID) *cbModuleState {
+		instance, ok := state.instances[id]
+		if !ok {
+			instance = &cbModuleState{
+				request:      nil,
+				sentEcho:     false,
+				sentFinal:    false,
+				delivered:    false,
+				receivedEcho: make(map[t.NodeID]bool),
+				echoSigs:     make(map[t.NodeID][]byte),
+			}
+			state.instances[id] = instance
+		}
+		return instance
+	}
+
+	// inWindow returns true if the sequence number of the instance lies
+	// within the given number of windows above the low watermark of its leader.
+	inWindow := func(id InstanceID, windows int) bool {
+		return id.Sn < state.lowWatermarks[id.Leader]+t.SeqNr(windows*params.WindowSize)
+	}
+
+	// sigData returns the data signed by the nodes when echoing the data broadcast in an instance.
+	// It includes the instance ID to prevent replaying signatures across instances.
+	sigData := func(id InstanceID, data []byte) [][]byte {
+		return [][]byte{params.InstanceUID, []byte(id.Leader), id.Sn.Bytes(), []byte("ECHO"), data}
+	}
+
+	dsl.UponInit(m, func() error {
+		// no initialization required
+		return nil
+	})
+
+	// startInstance starts a new instance with this node as the leader.
+	startInstance := func(data []byte) {
+		id := InstanceID{Leader: nodeID, Sn: state.nextSn}
+		state.nextSn++
+
+		getInstance(id).request = data
+		dsl.SendMessage(m, mc.Net, InstanceStartMessage(mc.Self, id, data), params.AllNodes)
+	}
+
+	// echoStart has the data of a start message signed, unless this node already echoed the instance.
+	echoStart := func(id InstanceID, data []byte) {
+		if instance := getInstance(id); !instance.sentEcho {
+			dsl.SignRequest(m, mc.Crypto, sigData(id, data), &managerSignStartMessageContext{id})
+		}
+	}
+
+	// advanceWindow is called when the low watermark of leader has advanced.
+	// It starts the deferred instances and processes the buffered start messages that now fall within the window.
+	advanceWindow := func(leader t.NodeID) {
+		if leader == nodeID {
+			for len(state.pendingRequests) > 0 && inWindow(InstanceID{Leader: nodeID, Sn: state.nextSn}, 1) {
+				data := state.pendingRequests[0]
+				state.pendingRequests = state.pendingRequests[1:]
+				startInstance(data)
+			}
+			return
+		}
+
+		for sn := state.lowWatermarks[leader]; inWindow(InstanceID{Leader: leader, Sn: sn}, 1); sn++ {
+			id := InstanceID{Leader: leader, Sn: sn}
+			if data, ok := state.bufferedStarts[id]; ok {
+				delete(state.bufferedStarts, id)
+				if !delivered(id) {
+					echoStart(id, data)
+				}
+			}
+		}
+	}
+
+	// Start a new instance with this node as the leader, or defer it if the window is full.
+	bcbdsl.UponRequest(m, func(data []byte) error {
+		if len(state.pendingRequests) > 0 || !inWindow(InstanceID{Leader: nodeID, Sn: state.nextSn}, 1) {
+			state.pendingRequests = append(state.pendingRequests, data)
+			return nil
+		}
+		startInstance(data)
+		return nil
+	})
+
+	// Route the received messages to the corresponding instances.
+	bcbdsl.UponInstanceMessageReceived(m, func(from t.NodeID, leader t.NodeID, sn t.SeqNr, msg *bcbpb.Message) error {
+		id := InstanceID{Leader: leader, Sn: sn}
+		if _, ok := isMember[leader]; !ok || delivered(id) {
+			return nil
+		}
+
+		switch msg := msg.Type.(type) {
+		case *bcbpb.Message_StartMessage:
+			switch {
+			case from != leader:
+				return nil
+			case inWindow(id, 1):
+				echoStart(id, msg.StartMessage.Data)
+			case inWindow(id, 2):
+				state.bufferedStarts[id] = msg.StartMessage.Data
+			}
+		case *bcbpb.Message_EchoMessage:
+			// Only the leader processes echo messages, and only after it started the instance.
+			instance, ok := state.instances[id]
+			if nodeID == leader && ok && !instance.receivedEcho[from] && instance.request != nil {
+				instance.receivedEcho[from] = true
+				dsl.VerifyOneNodeSig(m, mc.Crypto, sigData(id, instance.request), msg.EchoMessage.Signature, from,
+					&managerVerifyEchoContext{id, msg.EchoMessage.Signature})
+			}
+		case *bcbpb.Message_FinalMessage:
+			signers := t.NodeIDSlice(msg.FinalMessage.Signers)
+			signatures := msg.FinalMessage.Signatures
+			if len(signers) == len(signatures) && distinct(signers) && len(signers) > (params.GetN()+params.GetF())/2 {
+				sigMsgs := sliceutil.Repeat(sigData(id, msg.FinalMessage.Data), len(signers))
+				dsl.VerifyNodeSigs(m, mc.Crypto, sigMsgs, signatures, signers,
+					&managerVerifyFinalContext{id, msg.FinalMessage.Data})
+			}
+		}
+		return nil
+	})
+
+	dsl.UponSignResult(m, func(signature []byte, context *managerSignStartMessageContext) error {
+		instance, ok := state.instances[context.instanceID]
+		if ok && !instance.sentEcho {
+			instance.sentEcho = true
+			dsl.SendMessage(m, mc.Net,
+				InstanceEchoMessage(mc.Self, context.instanceID, signature),
+				[]t.NodeID{context.instanceID.Leader})
+		}
+		return nil
+	})
+
+	// Once enough echo signatures are collected for an instance, send the final message.
+	dsl.UponOneNodeSigVerified(m, func(nodeID t.NodeID, err error, context *managerVerifyEchoContext) error {
+		instance, ok := state.instances[context.instanceID]
+		if !ok || err != nil {
+			return nil
+		}
+
+		instance.echoSigs[nodeID] = context.signature
+		if len(instance.echoSigs) > (params.GetN()+params.GetF())/2 && !instance.sentFinal {
+			instance.sentFinal = true
+			certSigners, certSignatures := maputil.GetKeysAndValues(instance.echoSigs)
+			dsl.SendMessage(m, mc.Net,
+				InstanceFinalMessage(mc.Self, context.instanceID, instance.request, certSigners, certSignatures),
+				params.AllNodes)
+		}
+		return nil
+	})
+
+	// Deliver and garbage-collect an instance once a valid final message is received.
+	dsl.UponNodeSigsVerified(m, func(_ []t.NodeID, _ []error, allOK bool, context *managerVerifyFinalContext) error {
+		id := context.instanceID
+		if !allOK || delivered(id) {
+			return nil
+		}
+
+		bcbdsl.InstanceDeliver(m, mc.Consumer, id.Leader, id.Sn, context.data)
+
+		delete(state.instances, id)
+		delete(state.bufferedStarts, id)
+		state.deliveredAboveWatermark[id] = struct{}{}
+		for {
+			lowest := InstanceID{Leader: id.Leader, Sn: state.lowWatermarks[id.Leader]}
+			if _, ok := state.deliveredAboveWatermark[lowest]; !ok {
+				break
+			}
+			delete(state.deliveredAboveWatermark, lowest)
+			state.lowWatermarks[id.Leader]++
+		}
+		advanceWindow(id.Leader)
+		return nil
+	})
+
+	return m, nil
+}
+
+// distinct returns true if no node appears more than once in nodeIDs.
+func distinct(nodeIDs []t.NodeID) bool {
+	seen := make(map[t.NodeID]struct{}, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if _, ok := seen[nodeID]; ok {
+			return false
+		}
+		seen[nodeID] = struct{}{}
+	}
+	return true
+}
@@ -0,0 +1,205 @@
+package bcb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mirCrypto "github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/bcbpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+type nodeEvent struct {
+	nodeID t.NodeID
+	event  *eventpb.Event
+}
+
+// managerTestSystem connects the BCB managers of multiple nodes
+// and delivers all the events they produce in FIFO order.
+type managerTestSystem struct {
+	mc        *ModuleConfig
+	managers  map[t.NodeID]modules.PassiveModule
+	cryptos   map[t.NodeID]modules.PassiveModule
+	queue     []nodeEvent
+	delivered map[t.NodeID][]*bcbpb.InstanceDeliver
+	sent      []nodeEvent
+}
+
+func newManagerTestSystem(tt *testing.T, n int, windowSize int) *managerTestSystem {
+	nodeIDs := make([]t.NodeID, n)
+	for i := range nodeIDs {
+		nodeIDs[i] = t.NewNodeIDFromInt(i)
+	}
+
+	s := &managerTestSystem{
+		mc:        DefaultModuleConfig("app"),
+		managers:  make(map[t.NodeID]modules.PassiveModule),
+		cryptos:   make(map[t.NodeID]modules.PassiveModule),
+		delivered: make(map[t.NodeID][]*bcbpb.InstanceDeliver),
+	}
+	params := &ManagerParams{InstanceUID: []byte("test"), AllNodes: nodeIDs, WindowSize: windowSize}
+	cryptoImpls := make([]mirCrypto.Crypto, n)
+	for i, nodeID := range nodeIDs {
+		var err error
+		cryptoImpls[i], err = mirCrypto.NodePseudo(nodeIDs, nodeID, mirCrypto.DefaultPseudoSeed)
+		require.NoError(tt, err)
+		s.cryptos[nodeID] = mirCrypto.New(cryptoImpls[i])
+		s.managers[nodeID], err = NewManagerModule(s.mc, params, nodeID)
+		require.NoError(tt, err)
+	}
+
+	// Make sure that all nodes accept each other's signatures.
+	// Otherwise, no instance would ever deliver and the tests would fail for reasons unrelated to the manager.
+	data := [][]byte{[]byte("key check")}
+	for i, signer := range cryptoImpls {
+		signature, err := signer.Sign(data)
+		require.NoError(tt, err)
+		for _, verifier := range cryptoImpls {
+			require.NoError(tt, verifier.Verify(data, signature, nodeIDs[i]), "inconsistent pseudo-random keys")
+		}
+	}
+	return s
+}
+
+func (s *managerTestSystem) run(tt *testing.T) {
+	for len(s.queue) > 0 {
+		next := s.queue[0]
+		s.queue = s.queue[1:]
+
+		var module modules.PassiveModule
+		switch t.ModuleID(next.event.DestModule) {
+		case s.mc.Self:
+			module = s.managers[next.nodeID]
+		case s.mc.Crypto:
+			module = s.cryptos[next.nodeID]
+		case s.mc.Net:
+			s.sent = append(s.sent, next)
+			sendEvent := next.event.Type.(*eventpb.Event_SendMessage).SendMessage
+			for _, dest := range sendEvent.Destinations {
+				s.queue = append(s.queue, nodeEvent{
+					t.NodeID(dest),
+					events.MessageReceived(t.ModuleID(sendEvent.Msg.DestModule), next.nodeID, sendEvent.Msg),
+				})
+			}
+			continue
+		case s.mc.Consumer:
+			deliver := next.event.Type.(*eventpb.Event_Bcb).Bcb.Type.(*bcbpb.Event_InstanceDeliver).InstanceDeliver
+			s.delivered[next.nodeID] = append(s.delivered[next.nodeID], deliver)
+			continue
+		default:
+			tt.Fatalf("unexpected destination module: %v", next.event.DestModule)
+		}
+
+		evsOut, err := module.ApplyEvents(events.ListOf(next.event))
+		require.NoError(tt, err)
+		iter := evsOut.Iterator()
+		for ev := iter.Next(); ev != nil; ev = iter.Next() {
+			s.queue = append(s.queue, nodeEvent{next.nodeID, ev})
+		}
+	}
+}
+
+// request has node nodeID broadcast the given data in a new instance.
+func (s *managerTestSystem) request(nodeID t.NodeID, data string) {
+	s.queue = append(s.queue, nodeEvent{nodeID, &eventpb.Event{
+		DestModule: s.mc.Self.Pb(),
+		Type: &eventpb.Event_Bcb{Bcb: &bcbpb.Event{Type: &bcbpb.Event_Request{
+			Request: &bcbpb.Request{Data: []byte(data)},
+		}}},
+	}})
+}
+
+// echoedSns returns the sequence numbers of the instances of leader that node nodeID sent an echo message for.
+func (s *managerTestSystem) echoedSns(nodeID t.NodeID, leader t.NodeID) []t.SeqNr {
+	sns := make([]t.SeqNr, 0)
+	for _, sent := range s.sent {
+		msg := sent.event.GetSendMessage().Msg.GetBcb().GetInstanceMessage()
+		if sent.nodeID == nodeID && msg.GetMessage().GetEchoMessage() != nil && msg.InstanceId.Leader == leader.Pb() {
+			sns = append(sns, t.SeqNr(msg.InstanceId.Sn))
+		}
+	}
+	return sns
+}
+
+func TestManagerConcurrentInstances(tt *testing.T) {
+	s := newManagerTestSystem(tt, 4, 2)
+
+	// Each node broadcasts two values concurrently.
+	for nodeID := range s.managers {
+		for i := 0; i < 2; i++ {
+			s.request(nodeID, fmt.Sprintf("%v-%d", nodeID, i))
+		}
+	}
+	s.run(tt)
+
+	for nodeID := range s.managers {
+		require.Len(tt, s.delivered[nodeID], 8)
+		for _, deliver := range s.delivered[nodeID] {
+			assert.Equal(tt, fmt.Sprintf("%v-%d", deliver.InstanceId.Leader, deliver.InstanceId.Sn), string(deliver.Data))
+		}
+	}
+
+	// Replaying all messages after the instances have delivered does not cause any further delivery.
+	for _, sent := range s.sent {
+		s.queue = append(s.queue, sent)
+	}
+	s.run(tt)
+	for nodeID := range s.managers {
+		assert.Len(tt, s.delivered[nodeID], 8)
+	}
+}
+
+func TestManagerWindow(tt *testing.T) {
+	s := newManagerTestSystem(tt, 4, 2)
+
+	// Instances requested beyond the leader's window are deferred and delivered in order once the window advances.
+	for i := 0; i < 5; i++ {
+		s.request("0", fmt.Sprintf("0-%d", i))
+	}
+	s.run(tt)
+
+	for nodeID := range s.managers {
+		require.Len(tt, s.delivered[nodeID], 5)
+		for i, deliver := range s.delivered[nodeID] {
+			assert.Equal(tt, uint64(i), deliver.InstanceId.Sn)
+			assert.Equal(tt, fmt.Sprintf("0-%d", i), string(deliver.Data))
+		}
+	}
+}
+
+func TestManagerRejectsInstancesBeyondWindow(tt *testing.T) {
+	s := newManagerTestSystem(tt, 4, 2)
+
+	// A Byzantine leader starts instances far beyond its window, which are ignored,
+	// and right beyond its window, which are only buffered.
+	for _, sn := range []t.SeqNr{1000, 2} {
+		msg := InstanceStartMessage(s.mc.Self, InstanceID{Leader: "0", Sn: sn}, []byte(fmt.Sprintf("0-%d", sn)))
+		s.queue = append(s.queue, nodeEvent{"1", events.MessageReceived(s.mc.Self, "0", msg)})
+	}
+	s.run(tt)
+	assert.Empty(tt, s.echoedSns("1", "0"))
+
+	// Once the window advances, the buffered instance is started and delivered as well.
+	s.request("0", "0-0")
+	s.request("0", "0-1")
+	s.run(tt)
+	assert.Equal(tt, []t.SeqNr{0, 1, 2}, s.echoedSns("1", "0"))
+	assert.Len(tt, s.delivered["1"], 2)
+
+	s.request("0", "0-2")
+	s.run(tt)
+	require.Len(tt, s.delivered["1"], 3)
+	assert.Equal(tt, uint64(2), s.delivered["1"][2].InstanceId.Sn)
+}
+
+func TestCheckManagerParams(tt *testing.T) {
+	assert.NoError(tt, CheckManagerParams(&ManagerParams{AllNodes: []t.NodeID{"0"}, WindowSize: 1}))
+	assert.Error(tt, CheckManagerParams(&ManagerParams{AllNodes: []t.NodeID{"0"}, WindowSize: 0}))
+	assert.Error(tt, CheckManagerParams(&ManagerParams{AllNodes: nil, WindowSize: 1}))
+}
@@ -16,25 +16,66 @@ func Message(moduleID t.ModuleID, msg *bcbpb.Message) *messagepb.Message {
 }
 
 func StartMessage(moduleID t.ModuleID, data []byte) *messagepb.Message {
+	return Message(moduleID, startMessage(data))
+}
+
+func EchoMessage(moduleID t.ModuleID, signature []byte) *messagepb.Message {
+	return Message(moduleID, echoMessage(signature))
+}
+
+func FinalMessage(moduleID t.ModuleID, data []byte, signers []t.NodeID, signatures [][]byte) *messagepb.Message {
+	return Message(moduleID, finalMessage(data, signers, signatures))
+}
+
+func InstanceMessage(moduleID t.ModuleID, instanceID InstanceID, msg *bcbpb.Message) *messagepb.Message {
 	return Message(moduleID, &bcbpb.Message{
+		Type: &bcbpb.Message_InstanceMessage{
+			InstanceMessage: &bcbpb.InstanceMessage{
+				InstanceId: instanceID.Pb(),
+				Message:    msg,
+			},
+		},
+	})
+}
+
+func InstanceStartMessage(moduleID t.ModuleID, instanceID InstanceID, data []byte) *messagepb.Message {
+	return InstanceMessage(moduleID, instanceID, startMessage(data))
+}
+
+func InstanceEchoMessage(moduleID t.ModuleID, instanceID InstanceID, signature []byte) *messagepb.Message {
+	return InstanceMessage(moduleID, instanceID, echoMessage(signature))
+}
+
+func InstanceFinalMessage(
+	moduleID t.ModuleID,
+	instanceID InstanceID,
+	data []byte,
+	signers []t.NodeID,
+	signatures [][]byte,
+) *messagepb.Message {
+	return InstanceMessage(moduleID, instanceID, finalMessage(data, signers, signatures))
+}
+
+func startMessage(data []byte) *bcbpb.Message {
+	return &bcbpb.Message{
 		Type: &bcbpb.Message_StartMessage{
 			StartMessage: &bcbpb.StartMessage{Data: data},
 		},
-	})
+	}
 }
 
-func EchoMessage(moduleID t.ModuleID, signature []byte) *messagepb.Message {
-	return Message(moduleID, &bcbpb.Message{
+func echoMessage(signature []byte) *bcbpb.Message {
+	return &bcbpb.Message{
 		Type: &bcbpb.Message_EchoMessage{
 			EchoMessage: &bcbpb.EchoMessage{
 				Signature: signature,
 			},
 		},
-	})
+	}
 }
 
-func FinalMessage(moduleID t.ModuleID, data []byte, signers []t.NodeID, signatures [][]byte) *messagepb.Message {
-	return Message(moduleID, &bcbpb.Message{
+func finalMessage(data []byte, signers []t.NodeID, signatures [][]byte) *bcbpb.Message {
+	return &bcbpb.Message{
 		Type: &bcbpb.Message_FinalMessage{
 			FinalMessage: &bcbpb.FinalMessage{
 				Data:       data,
@@ -42,5 +83,5 @@ func FinalMessage(moduleID t.ModuleID, data []byte, signers []t.NodeID, signatur
 				Signatures: signatures,
 			},
 		},
-	})
+	}
 }
@@ -0,0 +1,225 @@
+// Package clientquota implements per-client limits on requests submitted to a node.
+// It prevents a single client from exhausting the resources (e.g., request bucket space) shared by all clients.
+// Three kinds of limits are supported:
+// - The size of a single request's payload.
+// - The number of outstanding requests of a client, i.e., requests that have been accepted but not yet committed.
+// - The rate at which a client submits requests, enforced using a token bucket per client.
+package clientquota
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Errors returned when a request violates the quota of its client.
+// The errors returned by the functions of this package wrap these errors with more details.
+var (
+	ErrRequestTooLarge    = errors.New("request too large")
+	ErrTooManyOutstanding = errors.New("too many outstanding requests")
+	ErrRateExceeded       = errors.New("request rate exceeded")
+)
+
+// Params holds the quota applied to each client.
+// The zero value of each parameter disables the corresponding limit.
+type Params struct {
+
+	// Maximal size of a request's payload in bytes.
+	// Must not be negative.
+	MaxRequestBytes int
+
+	// Maximal number of requests of a single client that have been accepted, but not yet committed.
+	// Must not be negative.
+	MaxOutstandingRequests int
+
+	// Number of requests per second a client is allowed to submit in the long run.
+	// Must not be negative.
+	Rate float64
+
+	// Maximal number of requests a client is allowed to submit in a burst, exceeding Rate.
+	// This is the capacity of the client's token bucket.
+	// Must be positive if Rate is positive.
+	Burst int
+}
+
+// CheckParams checks whether the given quota parameters satisfy all necessary constraints.
+func CheckParams(p *Params) error {
+	if p.MaxRequestBytes < 0 {
+		return fmt.Errorf("negative MaxRequestBytes: %d", p.MaxRequestBytes)
+	}
+
+	if p.MaxOutstandingRequests < 0 {
+		return fmt.Errorf("negative MaxOutstandingRequests: %d", p.MaxOutstandingRequests)
+	}
+
+	if p.Rate < 0 {
+		return fmt.Errorf("negative Rate: %f", p.Rate)
+	}
+
+	if p.Rate > 0 && p.Burst <= 0 {
+		return fmt.Errorf("non-positive Burst with rate limiting enabled: %d", p.Burst)
+	}
+
+	return nil
+}
+
+// CheckSize returns a non-nil error if the payload of the request exceeds maxRequestBytes.
+// A maxRequestBytes value of 0 means no limit.
+func CheckSize(maxRequestBytes int, req *requestpb.Request) error {
+	if maxRequestBytes != 0 && len(req.Data) > maxRequestBytes {
+		return fmt.Errorf("%w: %d bytes (max %d)", ErrRequestTooLarge, len(req.Data), maxRequestBytes)
+	}
+	return nil
+}
+
+// ============================================================
+// Outstanding requests
+// ============================================================
+
+// OutstandingTracker keeps track of the requests each client has outstanding.
+// It does not depend on time and is thus suitable for use inside deterministic protocol state machines.
+// OutstandingTracker is not safe for concurrent use.
+type OutstandingTracker struct {
+	maxOutstanding int
+	outstanding    map[t.ClientID]map[t.ReqNo]struct{}
+}
+
+// NewOutstandingTracker returns a new OutstandingTracker
+// that allows each client to have at most maxOutstanding requests outstanding.
+// A maxOutstanding value of 0 means no limit.
+func NewOutstandingTracker(maxOutstanding int) *OutstandingTracker {
+	return &OutstandingTracker{
+		maxOutstanding: maxOutstanding,
+		outstanding:    make(map[t.ClientID]map[t.ReqNo]struct{}),
+	}
+}
+
+// Check returns a non-nil error if the client cannot have any more requests outstanding.
+func (ot *OutstandingTracker) Check(clientID t.ClientID) error {
+	if ot.maxOutstanding != 0 && len(ot.outstanding[clientID]) >= ot.maxOutstanding {
+		return fmt.Errorf("%w: %d (max %d)", ErrTooManyOutstanding, len(ot.outstanding[clientID]), ot.maxOutstanding)
+	}
+	return nil
+}
+
+// Add marks the request as outstanding.
+func (ot *OutstandingTracker) Add(clientID t.ClientID, reqNo t.ReqNo) {
+	if _, ok := ot.outstanding[clientID]; !ok {
+		ot.outstanding[clientID] = make(map[t.ReqNo]struct{})
+	}
+	ot.outstanding[clientID][reqNo] = struct{}{}
+}
+
+// Remove marks the request as no longer outstanding (e.g. because it has been committed).
+// Removing a request that is not outstanding has no effect.
+func (ot *OutstandingTracker) Remove(clientID t.ClientID, reqNo t.ReqNo) {
+	delete(ot.outstanding[clientID], reqNo)
+	if len(ot.outstanding[clientID]) == 0 {
+		delete(ot.outstanding, clientID)
+	}
+}
+
+// ============================================================
+// Request rate
+// ============================================================
+
+// minEvictionThreshold is the number of token buckets a RateLimiter holds before it first evicts full buckets.
+const minEvictionThreshold = 1024
+
+// RateLimiter limits the rate at which each client can submit requests, using a token bucket per client.
+// Since its behavior depends on time, it must not be used inside deterministic protocol state machines.
+// RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	rate  float64
+	burst int
+
+	lock    sync.Mutex
+	buckets map[t.ClientID]*tokenBucket
+
+	// Number of token buckets at which the full buckets are evicted next.
+	// A full bucket is equivalent to no bucket, since a new client starts with a full bucket.
+	// The threshold is set to twice the number of buckets remaining after each eviction,
+	// so that the cost of evictions is amortized over the creation of new buckets.
+	evictionThreshold int
+}
+
+// tokenBucket represents the rate limiting state of a single client.
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter allowing each client to submit rate requests per second,
+// with bursts of up to burst requests.
+// A rate of 0 means no limit.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		rate:              rate,
+		burst:             burst,
+		buckets:           make(map[t.ClientID]*tokenBucket),
+		evictionThreshold: minEvictionThreshold,
+	}
+}
+
+// Allow consumes one token from the client's token bucket at time now.
+// If the bucket is empty, Allow returns a non-nil error.
+func (rl *RateLimiter) Allow(clientID t.ClientID, now time.Time) error {
+	if rl.rate == 0 {
+		return nil
+	}
+
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	// Look up the client's token bucket, creating a full one if the client is new.
+	bucket, ok := rl.buckets[clientID]
+	if !ok {
+		if len(rl.buckets) >= rl.evictionThreshold {
+			rl.evictFull(now)
+		}
+		bucket = &tokenBucket{tokens: float64(rl.burst), lastRefill: now}
+		rl.buckets[clientID] = bucket
+	}
+
+	// Refill the bucket according to the time elapsed since the last refill.
+	rl.refill(bucket, now)
+
+	// Consume a token if there is one.
+	if bucket.tokens < 1 {
+		return fmt.Errorf("%w: %f requests per second (burst %d)", ErrRateExceeded, rl.rate, rl.burst)
+	}
+	bucket.tokens--
+
+	return nil
+}
+
+// refill adds to the bucket the tokens accumulated since its last refill, up to the burst.
+func (rl *RateLimiter) refill(bucket *tokenBucket, now time.Time) {
+	if elapsed := now.Sub(bucket.lastRefill); elapsed > 0 {
+		bucket.tokens += elapsed.Seconds() * rl.rate
+		if bucket.tokens > float64(rl.burst) {
+			bucket.tokens = float64(rl.burst)
+		}
+		bucket.lastRefill = now
+	}
+}
+
+// evictFull removes the token buckets that have been refilled up to the burst by time now
+// and updates the eviction threshold.
+func (rl *RateLimiter) evictFull(now time.Time) {
+	for clientID, bucket := range rl.buckets {
+		rl.refill(bucket, now)
+		if bucket.tokens >= float64(rl.burst) {
+			delete(rl.buckets, clientID)
+		}
+	}
+
+	rl.evictionThreshold = 2 * len(rl.buckets)
+	if rl.evictionThreshold < minEvictionThreshold {
+		rl.evictionThreshold = minEvictionThreshold
+	}
+}
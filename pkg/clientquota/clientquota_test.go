@@ -0,0 +1,88 @@
+package clientquota
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestCheckSize(t *testing.T) {
+	req := &requestpb.Request{ClientId: "c", ReqNo: 0, Data: []byte("hello")}
+
+	assert.Nil(t, CheckSize(0, req))
+	assert.Nil(t, CheckSize(5, req))
+	assert.ErrorIs(t, CheckSize(4, req), ErrRequestTooLarge)
+}
+
+func TestOutstandingTracker(t *testing.T) {
+	ot := NewOutstandingTracker(2)
+
+	ot.Add("a", 0)
+	assert.Nil(t, ot.Check("a"))
+	ot.Add("a", 1)
+	assert.ErrorIs(t, ot.Check("a"), ErrTooManyOutstanding)
+
+	// Other clients are not affected.
+	assert.Nil(t, ot.Check("b"))
+
+	// Removing a request that is not outstanding has no effect.
+	ot.Remove("a", 5)
+	assert.ErrorIs(t, ot.Check("a"), ErrTooManyOutstanding)
+
+	ot.Remove("a", 0)
+	assert.Nil(t, ot.Check("a"))
+}
+
+func TestRateLimiter(t *testing.T) {
+	rl := NewRateLimiter(2, 3)
+	start := time.Unix(0, 0)
+
+	// A full burst is allowed at once.
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, rl.Allow("a", start))
+	}
+	assert.ErrorIs(t, rl.Allow("a", start), ErrRateExceeded)
+
+	// Other clients have their own token buckets.
+	assert.Nil(t, rl.Allow("b", start))
+
+	// After half a second, one token is refilled.
+	assert.Nil(t, rl.Allow("a", start.Add(500*time.Millisecond)))
+	assert.ErrorIs(t, rl.Allow("a", start.Add(500*time.Millisecond)), ErrRateExceeded)
+
+	// The bucket never holds more than the burst.
+	later := start.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, rl.Allow("a", later))
+	}
+	assert.ErrorIs(t, rl.Allow("a", later), ErrRateExceeded)
+
+	// Rate 0 means no limit.
+	assert.Nil(t, NewRateLimiter(0, 0).Allow("a", start))
+}
+
+func TestRateLimiterEviction(t *testing.T) {
+	rl := NewRateLimiter(1, 2)
+	start := time.Unix(0, 0)
+
+	// Many short-lived clients create many token buckets.
+	for i := 0; i < minEvictionThreshold-1; i++ {
+		assert.Nil(t, rl.Allow(types.ClientID(fmt.Sprint(i)), start))
+	}
+	assert.Nil(t, rl.Allow("a", start))
+	assert.Nil(t, rl.Allow("a", start))
+	assert.Equal(t, minEvictionThreshold, len(rl.buckets))
+
+	// Once their buckets are full again, they are evicted when new clients appear,
+	// while the buckets of clients that still lack tokens are kept.
+	later := start.Add(1500 * time.Millisecond)
+	assert.Nil(t, rl.Allow("b", later))
+	assert.Equal(t, 2, len(rl.buckets))
+	assert.Nil(t, rl.Allow("a", later))
+	assert.ErrorIs(t, rl.Allow("a", later), ErrRateExceeded)
+}
@@ -0,0 +1,38 @@
+package events
+
+import (
+	clpb "github.com/filecoin-project/mir/pkg/pb/commitlogpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Event creates an eventpb.Event out of a commitlogpb.Event.
+func Event(dest t.ModuleID, ev *clpb.Event) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: dest.Pb(),
+		Type: &eventpb.Event_CommitLog{
+			CommitLog: ev,
+		},
+	}
+}
+
+// Entry is used by the ordering protocol to announce a newly delivered entry to the commit log.
+func Entry(dest t.ModuleID, entry *clpb.Entry) *eventpb.Event {
+	return Event(dest, &clpb.Event{
+		Type: &clpb.Event_Entry{
+			Entry: entry,
+		},
+	})
+}
+
+// StableCheckpoint is used by the ordering protocol to announce a new stable checkpoint to the commit log.
+func StableCheckpoint(dest t.ModuleID, epoch t.EpochNr, sn t.SeqNr) *eventpb.Event {
+	return Event(dest, &clpb.Event{
+		Type: &clpb.Event_StableCheckpoint{
+			StableCheckpoint: &clpb.StableCheckpoint{
+				Epoch: epoch.Pb(),
+				Sn:    sn.Pb(),
+			},
+		},
+	})
+}
@@ -0,0 +1,89 @@
+package commitlog
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/commitlogpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Module is a passive module that stores the commit log entries announced by the ordering protocol in a Store.
+// Module implements Reader, so that the stored entries can be queried (see also mir.Node.CommitLog).
+type Module struct {
+	*Store
+
+	// Number of stable checkpoints for which the entries preceding them are retained.
+	retainedCheckpoints int
+
+	// Sequence numbers of the stable checkpoints announced so far and not yet used for pruning, in increasing order.
+	checkpoints []t.SeqNr
+}
+
+// NewModule returns a new commit log module storing the entries in store.
+// On each stable checkpoint, the module prunes the entries preceding
+// the retainedCheckpoints-th most recent stable checkpoint before the new one.
+// With retainedCheckpoints set to 0, the entries are thus retained until the next stable checkpoint that covers them.
+func NewModule(store *Store, retainedCheckpoints int) *Module {
+	return &Module{
+		Store:               store,
+		retainedCheckpoints: retainedCheckpoints,
+		checkpoints:         make([]t.SeqNr, 0),
+	}
+}
+
+// ApplyEvents stores the announced commit log entries and prunes them on stable checkpoints.
+func (m *Module) ApplyEvents(eventsIn *events.EventList) (*events.EventList, error) {
+	return modules.ApplyEventsSequentially(eventsIn, m.applyEvent)
+}
+
+// The ImplementsModule method only serves the purpose of indicating that this is a Module and must not be called.
+func (m *Module) ImplementsModule() {}
+
+func (m *Module) applyEvent(event *eventpb.Event) (*events.EventList, error) {
+	switch e := event.Type.(type) {
+	case *eventpb.Event_Init:
+		// no actions on init
+	case *eventpb.Event_CommitLog:
+		switch e := e.CommitLog.Type.(type) {
+		case *commitlogpb.Event_Entry:
+			if err := m.Append(e.Entry); err != nil {
+				return nil, fmt.Errorf("could not store commit log entry (sn %d): %w", e.Entry.Sn, err)
+			}
+		case *commitlogpb.Event_StableCheckpoint:
+			if err := m.applyStableCheckpoint(t.SeqNr(e.StableCheckpoint.Sn)); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unexpected type of commit log event: %T", e)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type of commit log event: %T", event.Type)
+	}
+
+	return events.EmptyList(), nil
+}
+
+// applyStableCheckpoint prunes the entries that are not to be retained any more after a new stable checkpoint.
+func (m *Module) applyStableCheckpoint(sn t.SeqNr) error {
+
+	// Ignore outdated checkpoints.
+	if len(m.checkpoints) > 0 && sn <= m.checkpoints[len(m.checkpoints)-1] {
+		return nil
+	}
+	m.checkpoints = append(m.checkpoints, sn)
+
+	// Nothing to prune while fewer checkpoints than the retained ones have been observed.
+	if len(m.checkpoints) <= m.retainedCheckpoints {
+		return nil
+	}
+
+	pruneSN := m.checkpoints[len(m.checkpoints)-1-m.retainedCheckpoints]
+	m.checkpoints = m.checkpoints[len(m.checkpoints)-m.retainedCheckpoints:]
+	if err := m.Prune(pruneSN); err != nil {
+		return fmt.Errorf("could not prune commit log (sn %d): %w", pruneSN, err)
+	}
+	return nil
+}
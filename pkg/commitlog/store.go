@@ -0,0 +1,286 @@
+// Package commitlog implements a queryable store of the commit log produced by the ordering protocol.
+// The ordering protocol (ISS) announces each delivered commit log entry to the commit log module,
+// which keeps the entries indexed by sequence number, by epoch, and by the requests they contain.
+// This makes it possible to find out whether and where a particular request has been ordered.
+// Entries are retained at least until the ordering protocol announces a stable checkpoint that covers them.
+// The store can optionally be backed by a file on disk, in which case the entries survive restarts of the node.
+package commitlog
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/tidwall/wal"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/mir/pkg/pb/commitlogpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// RequestLocation describes where in the commit log a request has been ordered.
+type RequestLocation struct {
+
+	// Sequence number of the commit log entry containing the request.
+	Sn t.SeqNr
+
+	// Epoch in which the entry has been delivered.
+	Epoch t.EpochNr
+
+	// Position of the request within the entry's batch.
+	Index int
+}
+
+// Reader is the query interface of the commit log.
+// All its methods are safe to call concurrently with the node's operation.
+type Reader interface {
+
+	// Entry returns the commit log entry with sequence number sn.
+	// The returned flag is false if no such entry is (or is no longer) stored.
+	Entry(sn t.SeqNr) (*commitlogpb.Entry, bool)
+
+	// EpochEntries returns all stored commit log entries delivered in the given epoch, ordered by sequence number.
+	EpochEntries(epoch t.EpochNr) []*commitlogpb.Entry
+
+	// FindRequest returns the location of the request with the given client ID and request number in the commit log.
+	// The returned flag is false if the request is not contained in any stored entry.
+	FindRequest(clientID t.ClientID, reqNo t.ReqNo) (RequestLocation, bool)
+}
+
+// reqKey identifies a request in the request index of the Store.
+type reqKey struct {
+	clientID t.ClientID
+	reqNo    t.ReqNo
+}
+
+// Store holds commit log entries in memory, indexed by sequence number, epoch, and request,
+// optionally backed by a log file on disk.
+// Store implements the Reader interface and is safe for concurrent use.
+type Store struct {
+	lock sync.RWMutex
+
+	// Stored entries and their indices.
+	entries  map[t.SeqNr]*commitlogpb.Entry
+	epochs   map[t.EpochNr][]t.SeqNr
+	requests map[reqKey]RequestLocation
+
+	// On-disk backing of the store. Nil if the store is only kept in memory.
+	// For each stored entry, logIndices holds the index of the corresponding record in log.
+	log        *wal.Log
+	logIndices map[t.SeqNr]uint64
+}
+
+// NewStore returns a new empty Store that is only kept in memory.
+func NewStore() *Store {
+	return &Store{
+		entries:  make(map[t.SeqNr]*commitlogpb.Entry),
+		epochs:   make(map[t.EpochNr][]t.SeqNr),
+		requests: make(map[reqKey]RequestLocation),
+	}
+}
+
+// OpenStore returns a new Store backed by a log file in the directory at path.
+// If the directory already contains a commit log, all its entries are loaded.
+func OpenStore(path string) (*Store, error) {
+	log, err := wal.Open(path, &wal.Options{NoSync: true, NoCopy: true})
+	if err != nil {
+		return nil, fmt.Errorf("could not open commit log file: %w", err)
+	}
+
+	s := NewStore()
+	s.log = log
+	s.logIndices = make(map[t.SeqNr]uint64)
+
+	// Load existing entries.
+	firstIndex, err := log.FirstIndex()
+	if err != nil {
+		return nil, fmt.Errorf("could not read first commit log index: %w", err)
+	}
+	lastIndex, err := log.LastIndex()
+	if err != nil {
+		return nil, fmt.Errorf("could not read last commit log index: %w", err)
+	}
+	for i := firstIndex; i != 0 && i <= lastIndex; i++ {
+		data, err := log.Read(i)
+		if err != nil {
+			return nil, fmt.Errorf("could not read commit log index %d: %w", i, err)
+		}
+		entry := &commitlogpb.Entry{}
+		if err := proto.Unmarshal(data, entry); err != nil {
+			return nil, fmt.Errorf("could not decode commit log entry at index %d: %w", i, err)
+		}
+		s.index(entry)
+		s.logIndices[t.SeqNr(entry.Sn)] = i
+	}
+
+	return s, nil
+}
+
+// Append adds an entry to the store, replacing any previously stored entry with the same sequence number.
+// If the store is backed by a file on disk, the entry is written (and synced) to the file first.
+func (s *Store) Append(entry *commitlogpb.Entry) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.log != nil {
+		data, err := proto.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("could not marshal commit log entry: %w", err)
+		}
+
+		lastIndex, err := s.log.LastIndex()
+		if err != nil {
+			return fmt.Errorf("could not read last commit log index: %w", err)
+		}
+		if err := s.log.Write(lastIndex+1, data); err != nil {
+			return fmt.Errorf("could not write commit log entry: %w", err)
+		}
+		if err := s.log.Sync(); err != nil {
+			return fmt.Errorf("could not sync commit log: %w", err)
+		}
+		s.logIndices[t.SeqNr(entry.Sn)] = lastIndex + 1
+	}
+
+	s.remove(t.SeqNr(entry.Sn))
+	s.index(entry)
+	return nil
+}
+
+// Prune removes all entries with sequence numbers lower than sn.
+// If the store is backed by a file on disk, the file is truncated accordingly.
+// As the file cannot be truncated completely, the most recently written entry always remains in the file
+// and will be loaded again when the store is re-opened.
+func (s *Store) Prune(sn t.SeqNr) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for entrySN := range s.entries {
+		if entrySN < sn {
+			s.remove(entrySN)
+		}
+	}
+
+	if s.log == nil {
+		return nil
+	}
+
+	// Find the first record in the file that needs to be retained.
+	lastIndex, err := s.log.LastIndex()
+	if err != nil {
+		return fmt.Errorf("could not read last commit log index: %w", err)
+	}
+	firstRetained := lastIndex
+	for entrySN, logIndex := range s.logIndices {
+		if entrySN < sn {
+			delete(s.logIndices, entrySN)
+		} else if logIndex < firstRetained {
+			firstRetained = logIndex
+		}
+	}
+
+	if firstRetained == 0 {
+		// The file is empty.
+		return nil
+	}
+	if err := s.log.TruncateFront(firstRetained); err != nil {
+		return fmt.Errorf("could not truncate commit log: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file backing the store, if any.
+func (s *Store) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.log == nil {
+		return nil
+	}
+	return s.log.Close()
+}
+
+// Entry implements Reader.
+func (s *Store) Entry(sn t.SeqNr) (*commitlogpb.Entry, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	entry, ok := s.entries[sn]
+	return entry, ok
+}
+
+// EpochEntries implements Reader.
+func (s *Store) EpochEntries(epoch t.EpochNr) []*commitlogpb.Entry {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	entries := make([]*commitlogpb.Entry, len(s.epochs[epoch]))
+	for i, sn := range s.epochs[epoch] {
+		entries[i] = s.entries[sn]
+	}
+	return entries
+}
+
+// FindRequest implements Reader.
+func (s *Store) FindRequest(clientID t.ClientID, reqNo t.ReqNo) (RequestLocation, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	location, ok := s.requests[reqKey{clientID, reqNo}]
+	return location, ok
+}
+
+// index adds an entry to the in-memory indices.
+// No entry with the same sequence number must be stored.
+func (s *Store) index(entry *commitlogpb.Entry) {
+	sn := t.SeqNr(entry.Sn)
+	epoch := t.EpochNr(entry.Epoch)
+
+	s.entries[sn] = entry
+
+	// Keep the entries of an epoch sorted by sequence number.
+	// Entries are usually appended in order, so inserting from the back is cheap.
+	epochSNs := append(s.epochs[epoch], sn)
+	for i := len(epochSNs) - 1; i > 0 && epochSNs[i-1] > sn; i-- {
+		epochSNs[i-1], epochSNs[i] = epochSNs[i], epochSNs[i-1]
+	}
+	s.epochs[epoch] = epochSNs
+
+	if entry.Batch != nil {
+		for i, req := range entry.Batch.Requests {
+			s.requests[reqKey{t.ClientID(req.Req.ClientId), t.ReqNo(req.Req.ReqNo)}] = RequestLocation{
+				Sn:    sn,
+				Epoch: epoch,
+				Index: i,
+			}
+		}
+	}
+}
+
+// remove removes the entry with sequence number sn from the in-memory indices, if it is stored.
+func (s *Store) remove(sn t.SeqNr) {
+	entry, ok := s.entries[sn]
+	if !ok {
+		return
+	}
+	epoch := t.EpochNr(entry.Epoch)
+
+	delete(s.entries, sn)
+
+	for i, epochSN := range s.epochs[epoch] {
+		if epochSN == sn {
+			s.epochs[epoch] = append(s.epochs[epoch][:i], s.epochs[epoch][i+1:]...)
+			break
+		}
+	}
+	if len(s.epochs[epoch]) == 0 {
+		delete(s.epochs, epoch)
+	}
+
+	if entry.Batch != nil {
+		for _, req := range entry.Batch.Requests {
+			key := reqKey{t.ClientID(req.Req.ClientId), t.ReqNo(req.Req.ReqNo)}
+			if s.requests[key].Sn == sn {
+				delete(s.requests, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package commitlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/commitlogpb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func testEntry(sn types.SeqNr, epoch types.EpochNr, clientID types.ClientID, reqNos ...types.ReqNo) *commitlogpb.Entry {
+	batch := &requestpb.Batch{}
+	for _, reqNo := range reqNos {
+		batch.Requests = append(batch.Requests, events.HashedRequest(events.ClientRequest(clientID, reqNo, nil), nil))
+	}
+	return &commitlogpb.Entry{Sn: sn.Pb(), Epoch: epoch.Pb(), Batch: batch}
+}
+
+func TestStoreQueries(t *testing.T) {
+	s := NewStore()
+	require.NoError(t, s.Append(testEntry(1, 0, "c", 2, 3)))
+	require.NoError(t, s.Append(testEntry(0, 0, "c", 0, 1)))
+	require.NoError(t, s.Append(testEntry(2, 1, "d", 0)))
+
+	entry, ok := s.Entry(1)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), entry.Sn)
+	_, ok = s.Entry(3)
+	assert.False(t, ok)
+
+	epochEntries := s.EpochEntries(0)
+	require.Len(t, epochEntries, 2)
+	assert.Equal(t, uint64(0), epochEntries[0].Sn)
+	assert.Equal(t, uint64(1), epochEntries[1].Sn)
+
+	location, ok := s.FindRequest("c", 3)
+	assert.True(t, ok)
+	assert.Equal(t, RequestLocation{Sn: 1, Epoch: 0, Index: 1}, location)
+	_, ok = s.FindRequest("d", 1)
+	assert.False(t, ok)
+
+	require.NoError(t, s.Prune(2))
+	_, ok = s.Entry(1)
+	assert.False(t, ok)
+	_, ok = s.FindRequest("c", 3)
+	assert.False(t, ok)
+	assert.Empty(t, s.EpochEntries(0))
+	_, ok = s.FindRequest("d", 0)
+	assert.True(t, ok)
+}
+
+func TestStoreOnDisk(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := OpenStore(dir)
+	require.NoError(t, err)
+	for sn := types.SeqNr(0); sn < 4; sn++ {
+		require.NoError(t, s.Append(testEntry(sn, 0, "c", types.ReqNo(sn))))
+	}
+	require.NoError(t, s.Prune(2))
+	require.NoError(t, s.Close())
+
+	s, err = OpenStore(dir)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, s.Close()) }()
+
+	assert.Len(t, s.EpochEntries(0), 2)
+	_, ok := s.FindRequest("c", 1)
+	assert.False(t, ok)
+	location, ok := s.FindRequest("c", 3)
+	assert.True(t, ok)
+	assert.Equal(t, types.SeqNr(3), location.Sn)
+}
+
+func TestModuleRetention(t *testing.T) {
+	m := NewModule(NewStore(), 1)
+	for sn := types.SeqNr(0); sn < 6; sn++ {
+		require.NoError(t, m.Append(testEntry(sn, types.EpochNr(sn/2), "c", types.ReqNo(sn))))
+	}
+
+	// The first stable checkpoint is retained.
+	require.NoError(t, m.applyStableCheckpoint(2))
+	_, ok := m.Entry(0)
+	assert.True(t, ok)
+
+	// The second one prunes entries preceding the first one.
+	require.NoError(t, m.applyStableCheckpoint(4))
+	_, ok = m.Entry(1)
+	assert.False(t, ok)
+	_, ok = m.Entry(2)
+	assert.True(t, ok)
+}
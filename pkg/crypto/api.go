@@ -8,13 +8,12 @@ package crypto
 
 import t "github.com/filecoin-project/mir/pkg/types"
 
-// TODO: Augment to support threshold signatures.
-
 // The Crypto interface represents an implementation of the cryptographic primitives inside the MirModule module.
 // It is responsible for producing and verifying cryptographic signatures.
 // It internally stores information about which clients and nodes are associated with which public keys.
 // This information can be updated by the Node through appropriate events.
 // Both clients and nodes are identified only by their IDs.
+// For threshold signatures, see the ThreshCrypto interface of package threshcrypto.
 type Crypto interface {
 
 	// Sign signs the provided data and returns the resulting signature.
@@ -28,3 +27,44 @@ type Crypto interface {
 	// Returns nil on success (i.e., if the given signature is valid) and a non-nil error otherwise.
 	Verify(data [][]byte, signature []byte, nodeID t.NodeID) error
 }
+
+// The KeyRegistry interface can be implemented by a Crypto implementation
+// to allow the MirModule to update the associations of public keys with nodes and clients at runtime,
+// as instructed by RegisterKey and RevokeKey events, as well as to replace the private key used for signing
+// (see MirModule.AddPrivKey).
+// Except for CheckPubKey and CheckKeyPair, which must be safe for concurrent use,
+// the MirModule never calls the methods of KeyRegistry concurrently with any other method of the Crypto implementation.
+type KeyRegistry interface {
+
+	// CheckPubKey returns a non-nil error if pubKey cannot be registered for a node or a client.
+	CheckPubKey(pubKey []byte) error
+
+	// CheckKeyPair returns a non-nil error if privKey cannot be used for signing
+	// or if pubKey is not the public key corresponding to privKey.
+	CheckKeyPair(privKey []byte, pubKey []byte) error
+
+	// SetPrivKey replaces the private key used for signing.
+	SetPrivKey(privKey []byte) error
+
+	// RegisterNodeKey associates a public key with a node ID, replacing any key previously associated with it.
+	RegisterNodeKey(pubKey []byte, nodeID t.NodeID) error
+
+	// DeleteNodeKey removes the public key associated with a node ID.
+	DeleteNodeKey(nodeID t.NodeID)
+
+	// RegisterClientKey associates a public key with a client ID, replacing any key previously associated with it.
+	RegisterClientKey(pubKey []byte, clientID t.ClientID) error
+
+	// DeleteClientKey removes the public key associated with a client ID.
+	DeleteClientKey(clientID t.ClientID)
+}
+
+// The ClientVerifier interface can be implemented by a Crypto implementation
+// that verifies signatures produced by clients.
+// The MirModule uses it for verifying the request signatures of VerifyRequestSig events.
+type ClientVerifier interface {
+
+	// VerifyClientSig verifies a signature produced by the client with ID clientID over data.
+	// Returns nil on success (i.e., if the given signature is valid) and a non-nil error otherwise.
+	VerifyClientSig(data [][]byte, signature []byte, clientID t.ClientID) error
+}
@@ -1,26 +1,146 @@
 // Package crypto provides an implementation of the MirModule module.
-// It supports RSA and ECDSA signatures.
+// It supports RSA, ECDSA (with curves P-256 and P-384) and Ed25519 signatures.
 package crypto
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/commonpb"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/serializing"
 	t "github.com/filecoin-project/mir/pkg/types"
 )
 
+// DefaultSigCacheSize is the number of verified signatures remembered by a MirModule created by New or NewWithMAC.
+const DefaultSigCacheSize = 4096
+
 type MirModule struct {
 	crypto Crypto
+
+	// MAC implementation used for computing and verifying authenticators.
+	// If nil, the MirModule does not support authenticators.
+	mac MAC
+
+	// The current epoch, as announced by the last SetKeyEpoch event, determining the keys in use.
+	keyEpoch t.EpochNr
+
+	// Key changes taking effect in future epochs, sorted by epoch.
+	pendingKeyChanges []*keyChange
+
+	// Private keys added using AddPrivKey, indexed by the corresponding public key,
+	// waiting for the public key to be registered for a node.
+	// Guarded by privKeysLock, as AddPrivKey may be called concurrently with the processing of events.
+	pendingPrivKeys map[string][]byte
+	privKeysLock    sync.Mutex
+
+	// Recently verified valid signatures, which need not be verified again.
+	// If nil, the MirModule verifies every signature.
+	sigCache *sigCache
 }
 
 func New(crypto Crypto) *MirModule {
-	return &MirModule{crypto: crypto}
+	return NewWithSigCache(crypto, nil, DefaultSigCacheSize)
 }
 
+// NewWithMAC returns a new MirModule that, in addition to signatures, supports MAC-based authenticators.
+func NewWithMAC(crypto Crypto, mac MAC) *MirModule {
+	return NewWithSigCache(crypto, mac, DefaultSigCacheSize)
+}
+
+// NewWithSigCache returns a new MirModule remembering the last sigCacheSize valid signatures it verified,
+// such that verifying them again (e.g., when the same signatures are forwarded by multiple nodes) is cheap.
+// If sigCacheSize is 0, the MirModule verifies every signature.
+// mac may be nil, in which case the MirModule does not support authenticators.
+func NewWithSigCache(crypto Crypto, mac MAC, sigCacheSize int) *MirModule {
+	m := &MirModule{crypto: crypto, mac: mac, pendingPrivKeys: make(map[string][]byte)}
+	if sigCacheSize > 0 {
+		m.sigCache = newSigCache(sigCacheSize)
+	}
+	return m
+}
+
+// AddPrivKey prepares the replacement of the private key used for signing, e.g., to rotate a compromised key.
+// The MirModule starts signing with privKey as soon as a RegisterKey event associating pubKey with a node
+// takes effect, such that the other nodes, processing the same RegisterKey event,
+// start verifying the node's signatures with pubKey at the same epoch.
+// Thus, AddPrivKey must be called before the RegisterKey event is applied.
+// The private key is deliberately passed directly to the MirModule rather than in an event,
+// so that it does not end up in event logs.
+// The underlying Crypto implementation must implement KeyRegistry and privKey must correspond to pubKey.
+func (c *MirModule) AddPrivKey(privKey []byte, pubKey []byte) error {
+	registry, ok := c.crypto.(KeyRegistry)
+	if !ok {
+		return fmt.Errorf("key registration not supported by crypto implementation %T", c.crypto)
+	}
+	if err := registry.CheckKeyPair(privKey, pubKey); err != nil {
+		return fmt.Errorf("invalid key pair: %w", err)
+	}
+
+	c.privKeysLock.Lock()
+	defer c.privKeysLock.Unlock()
+	c.pendingPrivKeys[string(pubKey)] = privKey
+	return nil
+}
+
+// SigCacheStats returns statistics about the use of the signature verification cache.
+func (c *MirModule) SigCacheStats() SigCacheStats {
+	if c.sigCache == nil {
+		return SigCacheStats{}
+	}
+	return c.sigCache.stats()
+}
+
+// SupportsAuthenticators returns true if the MirModule has been created with a MAC implementation
+// and thus can compute and verify authenticators.
+func (c *MirModule) SupportsAuthenticators() bool {
+	return c.mac != nil
+}
+
+// ApplyEvents applies the events concurrently, except for the events modifying the keys
+// (RegisterKey, RevokeKey, and SetKeyEpoch), which are applied sequentially, in order with respect to the other events.
+// Thus, the key changes only affect the events following them.
 func (c *MirModule) ApplyEvents(eventsIn *events.EventList) (*events.EventList, error) {
-	return modules.ApplyEventsConcurrently(eventsIn, c.ApplyEvent)
+	eventsOut := events.EmptyList()
+	concurrentEvents := events.EmptyList()
+
+	// applyConcurrentEvents applies the events accumulated since the last key registry event.
+	applyConcurrentEvents := func() error {
+		if concurrentEvents.Len() == 0 {
+			return nil
+		}
+		evsOut, err := modules.ApplyEventsConcurrently(concurrentEvents, c.ApplyEvent)
+		if err != nil {
+			return err
+		}
+		eventsOut.PushBackList(evsOut)
+		concurrentEvents = events.EmptyList()
+		return nil
+	}
+
+	iter := eventsIn.Iterator()
+	for event := iter.Next(); event != nil; event = iter.Next() {
+		if !isKeyRegistryEvent(event) {
+			concurrentEvents.PushBack(event)
+			continue
+		}
+
+		if err := applyConcurrentEvents(); err != nil {
+			return nil, err
+		}
+		evsOut, err := c.applyKeyRegistryEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		eventsOut.PushBackList(evsOut)
+	}
+
+	if err := applyConcurrentEvents(); err != nil {
+		return nil, err
+	}
+	return eventsOut, nil
 }
 
 func (c *MirModule) ApplyEvent(event *eventpb.Event) (*events.EventList, error) {
@@ -28,6 +148,9 @@ func (c *MirModule) ApplyEvent(event *eventpb.Event) (*events.EventList, error)
 	case *eventpb.Event_Init:
 		// no actions on init
 		return events.EmptyList(), nil
+	case *eventpb.Event_RegisterKey, *eventpb.Event_RevokeKey, *eventpb.Event_SetKeyEpoch:
+		// Update the keys used for verifying signatures.
+		return c.applyKeyRegistryEvent(event)
 	case *eventpb.Event_SignRequest:
 		// Compute a signature over the provided data and produce a SignResult event.
 
@@ -48,9 +171,15 @@ func (c *MirModule) ApplyEvent(event *eventpb.Event) (*events.EventList, error)
 		errors := make([]string, len(verifyEvent.Data))
 		allOK := true
 
-		// Verify each signature.
-		for i, data := range verifyEvent.Data {
-			err := c.crypto.Verify(data.Data, verifyEvent.Signatures[i], t.NodeID(verifyEvent.NodeIds[i]))
+		// Verify all signatures.
+		data := make([][][]byte, len(verifyEvent.Data))
+		for i, d := range verifyEvent.Data {
+			data[i] = d.Data
+		}
+		errs := c.verifyNodeSigs(data, verifyEvent.Signatures, t.NodeIDSlice(verifyEvent.NodeIds))
+
+		// Collect the results.
+		for i, err := range errs {
 			if err == nil {
 				results[i] = true
 				errors[i] = ""
@@ -71,6 +200,99 @@ func (c *MirModule) ApplyEvent(event *eventpb.Event) (*events.EventList, error)
 			allOK,
 		)), nil
 
+	case *eventpb.Event_VerifyRequestSig:
+		// Verify the signature of a request using the public key of the client that submitted it.
+
+		verifier, ok := c.crypto.(ClientVerifier)
+		if !ok {
+			return nil, fmt.Errorf("client signatures not supported by crypto implementation %T", c.crypto)
+		}
+
+		// Convenience variable
+		verifyEvent := e.VerifyRequestSig
+
+		err := verifier.VerifyClientSig(
+			serializing.RequestForHash(verifyEvent.Request),
+			verifyEvent.Signature,
+			t.ClientID(verifyEvent.Request.ClientId),
+		)
+
+		// Return result event
+		errorMsg := ""
+		if err != nil {
+			errorMsg = err.Error()
+		}
+		return events.ListOf(events.RequestSigVerified(
+			t.ModuleID(verifyEvent.Origin.Module),
+			verifyEvent.Request,
+			err == nil,
+			errorMsg,
+			verifyEvent.Origin,
+		)), nil
+
+	case *eventpb.Event_ComputeAuthenticator:
+		// Compute a MAC for each of the requested nodes and produce an AuthenticatorComputed event.
+
+		if c.mac == nil {
+			return nil, fmt.Errorf("authenticators not supported: no MAC implementation")
+		}
+
+		// Convenience variable
+		computeEvent := e.ComputeAuthenticator
+		macs := make([][]byte, len(computeEvent.NodeIds))
+
+		// Compute each MAC.
+		for i, nodeID := range computeEvent.NodeIds {
+			mac, err := c.mac.ComputeMAC(computeEvent.Data, t.NodeID(nodeID))
+			if err != nil {
+				return nil, err
+			}
+			macs[i] = mac
+		}
+
+		// Return result event
+		return events.ListOf(events.AuthenticatorComputed(
+			t.ModuleID(computeEvent.Origin.Module),
+			&commonpb.Authenticator{NodeIds: computeEvent.NodeIds, Macs: macs},
+			computeEvent.Origin,
+		)), nil
+
+	case *eventpb.Event_VerifyAuthenticators:
+		// Verify a batch of MACs
+
+		if c.mac == nil {
+			return nil, fmt.Errorf("authenticators not supported: no MAC implementation")
+		}
+
+		// Convenience variables
+		verifyEvent := e.VerifyAuthenticators
+		results := make([]bool, len(verifyEvent.Data))
+		errors := make([]string, len(verifyEvent.Data))
+		allOK := true
+
+		// Verify each MAC.
+		for i, data := range verifyEvent.Data {
+			err := c.mac.VerifyMAC(data.Data, verifyEvent.Macs[i], t.NodeID(verifyEvent.NodeIds[i]))
+			if err == nil {
+				results[i] = true
+				errors[i] = ""
+			} else {
+				results[i] = false
+				errors[i] = err.Error()
+				allOK = false
+			}
+		}
+
+		// Return result event
+		return events.ListOf(events.AuthenticatorsVerified(
+			t.ModuleID(verifyEvent.Origin.Module),
+			results,
+			errors,
+			t.NodeIDSlice(verifyEvent.NodeIds),
+			verifyEvent.Origin,
+			allOK,
+		)), nil
+
 	default:
 		// Complain about all other incoming event types.
 		return nil, fmt.Errorf("unexpected type of MirModule event: %T", event.Type)
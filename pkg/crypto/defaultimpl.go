@@ -5,12 +5,14 @@ SPDX-License-Identifier: Apache-2.0
 */
 
 // Package crypto provides an implementation of the MirModule module.
-// It supports RSA and ECDSA signatures.
+// It supports RSA, ECDSA (with curves P-256 and P-384) and Ed25519 signatures.
 package crypto
 
 import (
 	cstd "crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	crand "crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -24,6 +26,23 @@ import (
 	t "github.com/filecoin-project/mir/pkg/types"
 )
 
+// KeyType identifies a signature scheme (along with its parameters) supported by DefaultImpl.
+type KeyType string
+
+const (
+	// ECDSAP256 stands for ECDSA signatures using the NIST P-256 curve.
+	ECDSAP256 KeyType = "ECDSA-P256"
+
+	// ECDSAP384 stands for ECDSA signatures using the NIST P-384 curve.
+	ECDSAP384 KeyType = "ECDSA-P384"
+
+	// Ed25519 stands for Ed25519 signatures.
+	Ed25519 KeyType = "Ed25519"
+
+	// DefaultKeyType is the type of keys generated by GenerateKeyPair.
+	DefaultKeyType = ECDSAP256
+)
+
 // DefaultImpl represents a generic implementation of the MirModule module that can be used at Node instantiation
 // (when calling mir.NewNode)
 type DefaultImpl struct {
@@ -33,6 +52,9 @@ type DefaultImpl struct {
 
 	// Node public keys used for verifying signatures.
 	nodeKeys map[t.NodeID]interface{}
+
+	// Client public keys used for verifying signatures.
+	clientKeys map[t.ClientID]interface{}
 }
 
 // NewDefaultImpl returns a new initialized instance of a MirModule implementation.
@@ -49,8 +71,9 @@ func NewDefaultImpl(privKey []byte) (*DefaultImpl, error) {
 
 	// If deserialization succeeds, return the pointer to a new initialized instance of MirModule.
 	return &DefaultImpl{
-		privKey:  key,
-		nodeKeys: make(map[t.NodeID]interface{}),
+		privKey:    key,
+		nodeKeys:   make(map[t.NodeID]interface{}),
+		clientKeys: make(map[t.ClientID]interface{}),
 	}, nil
 }
 
@@ -63,11 +86,58 @@ func (c *DefaultImpl) Sign(data [][]byte) ([]byte, error) {
 		return key.Sign(crand.Reader, digest(data), cstd.SHA256)
 	case *ecdsa.PrivateKey:
 		return signEcdsa(key, digest(data))
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, digest(data)), nil
 	default:
 		return nil, fmt.Errorf("unsupported private key type: %T", key)
 	}
 }
 
+// SetPrivKey replaces the private key used for signing.
+// privKey must be the output of SerializePrivKey or GenerateKeyPair.
+// Signatures produced by Sign after SetPrivKey returns are only verifiable using the corresponding public key.
+func (c *DefaultImpl) SetPrivKey(privKey []byte) error {
+
+	// Deserialize the passed private key.
+	key, err := privKeyFromBytes(privKey)
+	if err != nil {
+		return fmt.Errorf("error parsing private key: %w", err)
+	}
+
+	c.privKey = key
+	return nil
+}
+
+// CheckPubKey returns a non-nil error if pubKey is not the output of SerializePubKey for a supported key type.
+func (c *DefaultImpl) CheckPubKey(pubKey []byte) error {
+	if _, err := pubKeyFromBytes(pubKey); err != nil {
+		return fmt.Errorf("error parsing public key: %w", err)
+	}
+	return nil
+}
+
+// CheckKeyPair returns a non-nil error if privKey and pubKey, as output by GenerateKeyPair,
+// cannot be parsed or do not form a key pair.
+func (c *DefaultImpl) CheckKeyPair(privKey []byte, pubKey []byte) error {
+
+	// Deserialize both keys.
+	priv, err := privKeyFromBytes(privKey)
+	if err != nil {
+		return fmt.Errorf("error parsing private key: %w", err)
+	}
+	pub, err := pubKeyFromBytes(pubKey)
+	if err != nil {
+		return fmt.Errorf("error parsing public key: %w", err)
+	}
+
+	// All the supported private key types implement crypto.Signer
+	// and all the supported public key types implement the Equal method.
+	if !priv.(cstd.Signer).Public().(interface{ Equal(cstd.PublicKey) bool }).Equal(pub) {
+		return fmt.Errorf("public key does not correspond to private key")
+	}
+	return nil
+}
+
 // RegisterNodeKey associates a public key with a node ID.
 // pubKey must be the output of SerializePubKey.
 // Calls to Verify will fail until RegisterNodeKey is successfully called with the corresponding node ID.
@@ -93,6 +163,31 @@ func (c *DefaultImpl) DeleteNodeKey(nodeID t.NodeID) {
 	delete(c.nodeKeys, nodeID)
 }
 
+// RegisterClientKey associates a public key with a client ID.
+// pubKey must be the output of SerializePubKey.
+// Calls to VerifyClientSig will fail until RegisterClientKey is successfully called with the corresponding client ID.
+// Returns nil on success, a non-nil error on failure.
+func (c *DefaultImpl) RegisterClientKey(pubKey []byte, clientID t.ClientID) error {
+
+	// Deserialize passed public key
+	key, err := pubKeyFromBytes(pubKey)
+	if err != nil {
+		// If deserialization fails, report error.
+		return fmt.Errorf("error parsing client public key: %w", err)
+	}
+
+	// If deserialization succeeds, save public key under the given client ID.
+	c.clientKeys[clientID] = key
+
+	return nil
+}
+
+// DeleteClientKey removes the public key associated with clientID from the internal state.
+// Any subsequent call to VerifyClientSig(..., clientID) will fail.
+func (c *DefaultImpl) DeleteClientKey(clientID t.ClientID) {
+	delete(c.clientKeys, clientID)
+}
+
 // Verify verifies a signature produced by the node with ID nodeID over data.
 // First, Verify computes a SHA256 hash of the concatenation of all the byte slices in data.
 // Then it verifies the signature over this hash using the public key registered under nodeID.
@@ -109,30 +204,61 @@ func (c *DefaultImpl) Verify(data [][]byte, signature []byte, nodeID t.NodeID) e
 	return c.verifySig(data, signature, pubKey)
 }
 
+// VerifyClientSig verifies a signature produced by the client with ID clientID over data.
+// It works like Verify, but uses the public key registered under clientID using RegisterClientKey.
+func (c *DefaultImpl) VerifyClientSig(data [][]byte, signature []byte, clientID t.ClientID) error {
+
+	pubKey, ok := c.clientKeys[clientID]
+	if !ok {
+		return fmt.Errorf("no public key for client with ID %v", clientID)
+	}
+
+	return c.verifySig(data, signature, pubKey)
+}
+
 // verifySig performs the actual signature verification.
-// It is called by Verify after looking up the appropriate verification key.
+// It is called by Verify and VerifyClientSig after looking up the appropriate verification key.
 func (c *DefaultImpl) verifySig(data [][]byte, signature []byte, pubKey interface{}) error {
 	switch key := pubKey.(type) {
 	case *ecdsa.PublicKey:
 		return verifyEcdsaSignature(key, digest(data), signature)
 	case *rsa.PublicKey:
 		return rsa.VerifyPKCS1v15(key, cstd.SHA256, digest(data), signature)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, digest(data), signature) {
+			return fmt.Errorf("signature verification failed")
+		}
+		return nil
 	default:
 		return fmt.Errorf("unsupported public key type: %T", key)
 	}
 }
 
-// GenerateKeyPair generates a pair of ECDSA keys that can be used for signing and verifying.
+// GenerateKeyPair generates a pair of keys of the DefaultKeyType that can be used for signing and verifying.
 // The randomness parameter should be backed by a high-quality source of entropy such as crypto/rand.Reader.
 // The priv key can be used for creation of a new instance of the crypto module (New function)
 // and the pub key can be passed to DefaultImpl.RegisterNodeKey.
 func GenerateKeyPair(randomness io.Reader) (priv []byte, pub []byte, err error) {
+	return GenerateKeyPairOfType(DefaultKeyType, randomness)
+}
 
-	// Generate ECDSA keys.
+// GenerateKeyPairOfType generates a pair of keys of the given type, otherwise behaving like GenerateKeyPair.
+func GenerateKeyPairOfType(keyType KeyType, randomness io.Reader) (priv []byte, pub []byte, err error) {
+
+	// Generate keys of the requested type.
 	var privKey, pubKey interface{}
-	privKey, pubKey, err = generateEcdsaKeyPair(randomness)
+	switch keyType {
+	case ECDSAP256:
+		privKey, pubKey, err = generateEcdsaKeyPair(elliptic.P256(), randomness)
+	case ECDSAP384:
+		privKey, pubKey, err = generateEcdsaKeyPair(elliptic.P384(), randomness)
+	case Ed25519:
+		pubKey, privKey, err = ed25519.GenerateKey(randomness)
+	default:
+		return nil, nil, fmt.Errorf("unsupported key type: %v", keyType)
+	}
 	if err != nil {
-		return nil, nil, fmt.Errorf("error generating ecdsa key: %w", err)
+		return nil, nil, fmt.Errorf("error generating %v key: %w", keyType, err)
 	}
 
 	// Serialize private key.
@@ -169,7 +295,7 @@ func privKeyFromBytes(raw []byte) (interface{}, error) {
 
 	// Check if key type is supported.
 	switch p := pk.(type) {
-	case *ecdsa.PrivateKey, *rsa.PrivateKey:
+	case *ecdsa.PrivateKey, *rsa.PrivateKey, ed25519.PrivateKey:
 		return p, nil
 	default:
 		return nil, fmt.Errorf("unsupported private key type: %T", p)
@@ -187,7 +313,7 @@ func pubKeyFromBytes(raw []byte) (interface{}, error) {
 
 	// Check if key type is supported.
 	switch p := pk.(type) {
-	case *ecdsa.PublicKey, *rsa.PublicKey:
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
 		return p, nil
 	default:
 		return nil, fmt.Errorf("unsupported public key type: %T", p)
@@ -196,12 +322,13 @@ func pubKeyFromBytes(raw []byte) (interface{}, error) {
 
 // SerializePubKey serializes a public key into a byte slice.
 // The output of this function can be used with DefaultImpl.RegisterNodeKey.
-// Currently, pointers to crypto/ecdsa.PublicKey and crypto/rsa.PublicKey are supported types of pubKey.
+// Currently, pointers to crypto/ecdsa.PublicKey and crypto/rsa.PublicKey, as well as crypto/ed25519.PublicKey,
+// are supported types of pubKey.
 func SerializePubKey(pubKey interface{}) (pubKeyBytes []byte, err error) {
 
 	// Check if the key is of one of the supported types.
 	switch key := pubKey.(type) {
-	case *ecdsa.PublicKey, *rsa.PublicKey:
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
 		// Serialize key if supported.
 		return x509.MarshalPKIXPublicKey(key)
 	default:
@@ -212,12 +339,13 @@ func SerializePubKey(pubKey interface{}) (pubKeyBytes []byte, err error) {
 
 // SerializePrivKey serializes a private key into a byte slice.
 // The output of this function can be passed to New when creating an instance of DefaultImpl.
-// Currently, pointers to crypto/ecdsa.PrivateKey and crypto/rsa.PrivateKey are supported types of pubKey.
+// Currently, pointers to crypto/ecdsa.PrivateKey and crypto/rsa.PrivateKey, as well as crypto/ed25519.PrivateKey,
+// are supported types of privKey.
 func SerializePrivKey(privKey interface{}) (privKeyBytes []byte, err error) {
 
 	// Check if the key is of one of the supported types.
 	switch key := privKey.(type) {
-	case *ecdsa.PrivateKey, *rsa.PrivateKey:
+	case *ecdsa.PrivateKey, *rsa.PrivateKey, ed25519.PrivateKey:
 		// Serialize key if supported.
 		return x509.MarshalPKCS8PrivateKey(key)
 	default:
@@ -259,7 +387,7 @@ func PubKeyFromFile(fileName string) ([]byte, error) {
 
 		// Check if the key is of one of the supported types.
 		switch key := cert.PublicKey.(type) {
-		case *ecdsa.PublicKey, *rsa.PublicKey:
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
 			// Return serialized key if supported.
 			return SerializePubKey(key)
 		default:
@@ -0,0 +1,66 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestKeyTypes(t *testing.T) {
+	data := [][]byte{[]byte("hello"), []byte("world")}
+
+	for _, keyType := range []KeyType{ECDSAP256, ECDSAP384, Ed25519} {
+		t.Run(string(keyType), func(t *testing.T) {
+			priv, pub, err := GenerateKeyPairOfType(keyType, rand.Reader)
+			require.NoError(t, err)
+
+			c, err := NewDefaultImpl(priv)
+			require.NoError(t, err)
+			require.NoError(t, c.RegisterNodeKey(pub, "0"))
+
+			// Serialization round-trips.
+			privKey, err := privKeyFromBytes(priv)
+			require.NoError(t, err)
+			serializedPriv, err := SerializePrivKey(privKey)
+			require.NoError(t, err)
+			assert.Equal(t, priv, serializedPriv)
+
+			signature, err := c.Sign(data)
+			require.NoError(t, err)
+			assert.NoError(t, c.Verify(data, signature, "0"))
+			assert.Error(t, c.Verify([][]byte{[]byte("hello")}, signature, "0"))
+			assert.Error(t, c.Verify(data, signature, "1"))
+		})
+	}
+
+	_, _, err := GenerateKeyPairOfType("unknown", rand.Reader)
+	assert.Error(t, err)
+}
+
+func TestNodePseudoWithKeyTypes(t *testing.T) {
+	nodes := []types.NodeID{"0", "1", "2"}
+	keyTypes := map[types.NodeID]KeyType{"0": Ed25519, "1": ECDSAP384}
+	data := [][]byte{[]byte("hello"), []byte("world")}
+
+	cryptos := make([]Crypto, len(nodes))
+	signatures := make([][]byte, len(nodes))
+	for i, nodeID := range nodes {
+		var err error
+		cryptos[i], err = NodePseudoWithKeyTypes(nodes, keyTypes, nodeID, DefaultPseudoSeed)
+		require.NoError(t, err)
+		signatures[i], err = cryptos[i].Sign(data)
+		require.NoError(t, err)
+	}
+
+	// Each node's signature is accepted by all nodes, regardless of the key types.
+	for i, signature := range signatures {
+		for _, c := range cryptos {
+			assert.NoError(t, c.Verify(data, signature, nodes[i]))
+			assert.Error(t, c.Verify(data, signature, nodes[(i+1)%len(nodes)]))
+		}
+	}
+}
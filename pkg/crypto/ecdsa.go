@@ -73,18 +73,46 @@ func verifyEcdsaSignature(pk *ecdsa.PublicKey, hash []byte, signature []byte) er
 	return nil
 }
 
-func generateEcdsaKeyPair(randomness io.Reader) (*ecdsa.PrivateKey, *ecdsa.PublicKey, error) {
+func generateEcdsaKeyPair(
+	curve elliptic.Curve,
+	randomness io.Reader,
+) (*ecdsa.PrivateKey, *ecdsa.PublicKey, error) {
 
 	if randomness == nil {
 		randomness = rand.Reader
 	}
 
-	// TODO: No clue which curve to use, picked P256 because it was in the documentation example.
-	//       Check whether this is OK.
-	privKey, err := ecdsa.GenerateKey(elliptic.P256(), randomness)
+	privKey, err := ecdsa.GenerateKey(curve, randomness)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return privKey, &privKey.PublicKey, nil
 }
+
+// pseudoEcdsaKeyPair deterministically derives an ECDSA key pair from the bytes read from randomness.
+// Unlike ecdsa.GenerateKey, which may not use all the bytes it reads (or, in recent Go versions, even ignore
+// the given randomness altogether), it always produces the same key pair from the same bytes.
+func pseudoEcdsaKeyPair(
+	curve elliptic.Curve,
+	randomness io.Reader,
+) (*ecdsa.PrivateKey, *ecdsa.PublicKey, error) {
+
+	// Read 8 more bytes than needed to make the bias of the modular reduction negligible.
+	params := curve.Params()
+	b := make([]byte, (params.N.BitLen()+7)/8+8)
+	if _, err := io.ReadFull(randomness, b); err != nil {
+		return nil, nil, err
+	}
+
+	// Compute the private scalar from the range [1, N-1].
+	one := big.NewInt(1)
+	d := new(big.Int).SetBytes(b)
+	d.Mod(d, new(big.Int).Sub(params.N, one))
+	d.Add(d, one)
+
+	privKey := &ecdsa.PrivateKey{D: d}
+	privKey.Curve = curve
+	privKey.X, privKey.Y = curve.ScalarBaseMult(d.FillBytes(make([]byte, (params.BitSize+7)/8)))
+	return privKey, &privKey.PublicKey, nil
+}
@@ -0,0 +1,143 @@
+package crypto
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// keyChange represents the registration or revocation of the public key of a node or a client,
+// taking effect at the start of an epoch.
+type keyChange struct {
+	fromEpoch t.EpochNr
+	owner     *eventpb.KeyOwner
+	pubKey    []byte
+	revoke    bool
+}
+
+// isKeyRegistryEvent returns true if the event modifies the keys used by the MirModule.
+func isKeyRegistryEvent(event *eventpb.Event) bool {
+	switch event.Type.(type) {
+	case *eventpb.Event_RegisterKey, *eventpb.Event_RevokeKey, *eventpb.Event_SetKeyEpoch:
+		return true
+	default:
+		return false
+	}
+}
+
+// applyKeyRegistryEvent applies a RegisterKey, RevokeKey, or SetKeyEpoch event.
+// Key changes for future epochs are stored until a SetKeyEpoch event announces the epoch,
+// while key changes for the current or past epochs take effect immediately.
+func (c *MirModule) applyKeyRegistryEvent(event *eventpb.Event) (*events.EventList, error) {
+	switch e := event.Type.(type) {
+	case *eventpb.Event_RegisterKey:
+		return events.EmptyList(), c.scheduleKeyChange(&keyChange{
+			fromEpoch: t.EpochNr(e.RegisterKey.FromEpoch),
+			owner:     e.RegisterKey.Owner,
+			pubKey:    e.RegisterKey.PubKey,
+			revoke:    false,
+		})
+	case *eventpb.Event_RevokeKey:
+		return events.EmptyList(), c.scheduleKeyChange(&keyChange{
+			fromEpoch: t.EpochNr(e.RevokeKey.FromEpoch),
+			owner:     e.RevokeKey.Owner,
+			pubKey:    nil,
+			revoke:    true,
+		})
+	case *eventpb.Event_SetKeyEpoch:
+		epoch := t.EpochNr(e.SetKeyEpoch.Epoch)
+		if epoch <= c.keyEpoch {
+			// Ignore outdated epochs.
+			return events.EmptyList(), nil
+		}
+		c.keyEpoch = epoch
+
+		// Apply the pending key changes that take effect in the new epoch (or before), in order.
+		for len(c.pendingKeyChanges) > 0 && c.pendingKeyChanges[0].fromEpoch <= epoch {
+			change := c.pendingKeyChanges[0]
+			c.pendingKeyChanges = c.pendingKeyChanges[1:]
+			if err := c.applyKeyChange(change); err != nil {
+				return nil, err
+			}
+		}
+		return events.EmptyList(), nil
+	default:
+		return nil, fmt.Errorf("unexpected type of key registry event: %T", event.Type)
+	}
+}
+
+// scheduleKeyChange applies the key change if it takes effect in the current epoch (or before)
+// and stores it until its epoch otherwise.
+// The registered key is validated immediately, so that an invalid key is rejected before its epoch starts.
+func (c *MirModule) scheduleKeyChange(change *keyChange) error {
+	registry, ok := c.crypto.(KeyRegistry)
+	if !ok {
+		return fmt.Errorf("key registration not supported by crypto implementation %T", c.crypto)
+	}
+
+	if !change.revoke {
+		if err := registry.CheckPubKey(change.pubKey); err != nil {
+			return fmt.Errorf("invalid public key registered for %v: %w", change.owner, err)
+		}
+	}
+
+	if change.fromEpoch <= c.keyEpoch {
+		return c.applyKeyChange(change)
+	}
+
+	// Keep the pending key changes sorted by epoch, preserving the order of the changes for the same epoch.
+	c.pendingKeyChanges = append(c.pendingKeyChanges, change)
+	sort.SliceStable(c.pendingKeyChanges, func(i, j int) bool {
+		return c.pendingKeyChanges[i].fromEpoch < c.pendingKeyChanges[j].fromEpoch
+	})
+	return nil
+}
+
+// applyKeyChange updates the keys of the underlying Crypto implementation, which must implement KeyRegistry.
+// If a private key corresponding to a newly registered node key has been added using AddPrivKey,
+// the node is the owner of the key, and applyKeyChange also makes it sign using the new private key.
+// As cached signatures might have been verified using a key that is no longer valid,
+// it also clears the signature cache.
+func (c *MirModule) applyKeyChange(change *keyChange) error {
+	registry := c.crypto.(KeyRegistry)
+
+	if c.sigCache != nil {
+		c.sigCache.clear()
+	}
+
+	switch owner := change.owner.Type.(type) {
+	case *eventpb.KeyOwner_NodeId:
+		if change.revoke {
+			registry.DeleteNodeKey(t.NodeID(owner.NodeId))
+			return nil
+		}
+		if err := registry.RegisterNodeKey(change.pubKey, t.NodeID(owner.NodeId)); err != nil {
+			return err
+		}
+		return c.usePrivKey(registry, change.pubKey)
+	case *eventpb.KeyOwner_ClientId:
+		if change.revoke {
+			registry.DeleteClientKey(t.ClientID(owner.ClientId))
+			return nil
+		}
+		return registry.RegisterClientKey(change.pubKey, t.ClientID(owner.ClientId))
+	default:
+		return fmt.Errorf("unexpected type of key owner: %T", change.owner.Type)
+	}
+}
+
+// usePrivKey replaces the private key used for signing by the one added for pubKey using AddPrivKey, if any.
+func (c *MirModule) usePrivKey(registry KeyRegistry, pubKey []byte) error {
+	c.privKeysLock.Lock()
+	defer c.privKeysLock.Unlock()
+
+	privKey, ok := c.pendingPrivKeys[string(pubKey)]
+	if !ok {
+		return nil
+	}
+	delete(c.pendingPrivKeys, string(pubKey))
+	return registry.SetPrivKey(privKey)
+}
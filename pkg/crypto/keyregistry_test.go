@@ -0,0 +1,189 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/serializing"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestKeyRotation(t *testing.T) {
+	nodes := []types.NodeID{"0", "1"}
+	data := [][]byte{[]byte("hello"), []byte("world")}
+
+	cryptoImpl, err := NodePseudo(nodes, "0", DefaultPseudoSeed)
+	require.NoError(t, err)
+	m := New(cryptoImpl)
+
+	// verify returns whether the module accepts the signature as produced by node 1.
+	verify := func(signature []byte) bool {
+		evsOut, err := m.ApplyEvents(events.ListOf(events.VerifyNodeSigs(
+			"crypto", [][][]byte{data}, [][]byte{signature}, []types.NodeID{"1"}, &eventpb.SigVerOrigin{Module: "test"},
+		)))
+		require.NoError(t, err)
+		require.Equal(t, 1, evsOut.Len())
+		return evsOut.Slice()[0].Type.(*eventpb.Event_NodeSigsVerified).NodeSigsVerified.AllOk
+	}
+
+	oldImpl, err := NodePseudo(nodes, "1", DefaultPseudoSeed)
+	require.NoError(t, err)
+	oldSig, err := oldImpl.Sign(data)
+	require.NoError(t, err)
+
+	newPriv, newPub, err := GenerateKeyPairOfType(Ed25519, rand.Reader)
+	require.NoError(t, err)
+	newImpl, err := NewDefaultImpl(newPriv)
+	require.NoError(t, err)
+	newSig, err := newImpl.Sign(data)
+	require.NoError(t, err)
+
+	// The new key of node 1 only replaces the old one once epoch 2 starts.
+	_, err = m.ApplyEvents(events.ListOf(events.RegisterKey("crypto", events.NodeKeyOwner("1"), newPub, 2)))
+	require.NoError(t, err)
+	assert.True(t, verify(oldSig))
+	assert.False(t, verify(newSig))
+
+	_, err = m.ApplyEvents(events.ListOf(events.SetKeyEpoch("crypto", 1)))
+	require.NoError(t, err)
+	assert.True(t, verify(oldSig))
+	assert.False(t, verify(newSig))
+
+	_, err = m.ApplyEvents(events.ListOf(events.SetKeyEpoch("crypto", 2)))
+	require.NoError(t, err)
+	assert.False(t, verify(oldSig))
+	assert.True(t, verify(newSig))
+
+	// Revoking the key for a past epoch takes effect immediately,
+	// and only affects the events following the revocation in the same list.
+	evsOut, err := m.ApplyEvents(events.ListOf(
+		events.VerifyNodeSigs("crypto", [][][]byte{data}, [][]byte{newSig}, []types.NodeID{"1"},
+			&eventpb.SigVerOrigin{Module: "test"}),
+		events.RevokeKey("crypto", events.NodeKeyOwner("1"), 0),
+	))
+	require.NoError(t, err)
+	assert.True(t, evsOut.Slice()[0].Type.(*eventpb.Event_NodeSigsVerified).NodeSigsVerified.AllOk)
+	assert.False(t, verify(newSig))
+}
+
+func TestPrivKeyRotation(t *testing.T) {
+	nodes := []types.NodeID{"0", "1"}
+	data := [][]byte{[]byte("hello"), []byte("world")}
+
+	// Node 0 rotates its key, which node 1 uses for verifying its signatures.
+	modules := make([]*MirModule, len(nodes))
+	for i, nodeID := range nodes {
+		cryptoImpl, err := NodePseudo(nodes, nodeID, DefaultPseudoSeed)
+		require.NoError(t, err)
+		modules[i] = New(cryptoImpl)
+	}
+
+	// signAndVerify returns whether node 1 accepts a signature produced by node 0.
+	signAndVerify := func() bool {
+		evsOut, err := modules[0].ApplyEvents(events.ListOf(
+			events.SignRequest("crypto", data, &eventpb.SignOrigin{Module: "test"}),
+		))
+		require.NoError(t, err)
+		signature := evsOut.Slice()[0].Type.(*eventpb.Event_SignResult).SignResult.Signature
+
+		evsOut, err = modules[1].ApplyEvents(events.ListOf(events.VerifyNodeSigs(
+			"crypto", [][][]byte{data}, [][]byte{signature}, []types.NodeID{"0"}, &eventpb.SigVerOrigin{Module: "test"},
+		)))
+		require.NoError(t, err)
+		return evsOut.Slice()[0].Type.(*eventpb.Event_NodeSigsVerified).NodeSigsVerified.AllOk
+	}
+
+	newPriv, newPub, err := GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, otherPub, err := GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	// The private key must correspond to the public key.
+	assert.Error(t, modules[0].AddPrivKey(newPriv, otherPub))
+	require.NoError(t, modules[0].AddPrivKey(newPriv, newPub))
+
+	// Both nodes register the new key of node 0 for epoch 1.
+	for _, m := range modules {
+		_, err = m.ApplyEvents(events.ListOf(events.RegisterKey("crypto", events.NodeKeyOwner("0"), newPub, 1)))
+		require.NoError(t, err)
+	}
+	assert.True(t, signAndVerify())
+
+	// Once epoch 1 starts, node 0 signs with the new key, which node 1 verifies its signatures with.
+	for _, m := range modules {
+		_, err = m.ApplyEvents(events.ListOf(events.SetKeyEpoch("crypto", 1)))
+		require.NoError(t, err)
+	}
+	assert.True(t, signAndVerify())
+
+	// A private key cannot be added to a crypto implementation that does not support key registration.
+	assert.Error(t, New(&DummyCrypto{}).AddPrivKey(newPriv, newPub))
+}
+
+func TestRegisterInvalidKey(t *testing.T) {
+	cryptoImpl, err := NodePseudo([]types.NodeID{"0", "1"}, "0", DefaultPseudoSeed)
+	require.NoError(t, err)
+	m := New(cryptoImpl)
+
+	// A malformed key is rejected when registered, even if it only takes effect in a future epoch.
+	_, err = m.ApplyEvents(events.ListOf(events.RegisterKey("crypto", events.NodeKeyOwner("1"), []byte("invalid"), 5)))
+	assert.Error(t, err)
+
+	_, err = m.ApplyEvents(events.ListOf(events.SetKeyEpoch("crypto", 5)))
+	assert.NoError(t, err)
+
+	// Registering keys with a crypto implementation that does not support it fails.
+	_, pub, err := GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, err = New(&DummyCrypto{}).ApplyEvents(events.ListOf(
+		events.RegisterKey("crypto", events.NodeKeyOwner("1"), pub, 0),
+	))
+	assert.Error(t, err)
+}
+
+func TestClientKeyRegistration(t *testing.T) {
+	cryptoImpl, err := NodePseudo([]types.NodeID{"0"}, "0", DefaultPseudoSeed)
+	require.NoError(t, err)
+	m := New(cryptoImpl)
+
+	clientPriv, clientPub, err := GenerateKeyPair(rand.Reader)
+	require.NoError(t, err)
+	clientImpl, err := NewDefaultImpl(clientPriv)
+	require.NoError(t, err)
+	request := events.ClientRequest("client", 0, []byte("hello"))
+	signature, err := clientImpl.Sign(serializing.RequestForHash(request))
+	require.NoError(t, err)
+
+	// verify returns whether the module accepts the signature of the request.
+	verify := func() bool {
+		evsOut, err := m.ApplyEvents(events.ListOf(
+			events.VerifyRequestSig("crypto", request, signature, &eventpb.SigVerOrigin{Module: "test"}),
+		))
+		require.NoError(t, err)
+		require.Equal(t, 1, evsOut.Len())
+		result := evsOut.Slice()[0]
+		assert.Equal(t, "test", result.DestModule)
+		return result.Type.(*eventpb.Event_RequestSigVerified).RequestSigVerified.Valid
+	}
+
+	assert.False(t, verify())
+
+	_, err = m.ApplyEvents(events.ListOf(events.RegisterKey("crypto", events.ClientKeyOwner("client"), clientPub, 0)))
+	require.NoError(t, err)
+	assert.True(t, verify())
+
+	_, err = m.ApplyEvents(events.ListOf(events.RevokeKey("crypto", events.ClientKeyOwner("client"), 0)))
+	require.NoError(t, err)
+	assert.False(t, verify())
+
+	// Verifying request signatures with a crypto implementation that does not support it fails.
+	_, err = New(&DummyCrypto{}).ApplyEvent(
+		events.VerifyRequestSig("crypto", request, signature, &eventpb.SigVerOrigin{Module: "test"}),
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// The MAC interface represents an implementation of message authentication codes inside the MirModule module.
+// Each MAC is computed using a secret key shared between this node and another node (a pairwise key).
+// Unlike a signature, a MAC only convinces the node it has been computed for
+// and cannot be used to prove the authenticity of a message to a third party.
+// MACs are thus only suitable where non-repudiation is not required, but are much cheaper to compute and verify.
+type MAC interface {
+
+	// ComputeMAC computes a MAC over the provided data using the key shared with the node with ID nodeID.
+	// The data to be authenticated is the concatenation of all the passed byte slices.
+	ComputeMAC(data [][]byte, nodeID t.NodeID) ([]byte, error)
+
+	// VerifyMAC verifies a MAC computed over data by the node with ID nodeID using the key shared with this node.
+	// Returns nil on success (i.e., if the given MAC is valid) and a non-nil error otherwise.
+	VerifyMAC(data [][]byte, mac []byte, nodeID t.NodeID) error
+}
+
+// HMACImpl is an implementation of MAC using HMAC-SHA256.
+type HMACImpl struct {
+
+	// Keys shared with other nodes.
+	keys map[t.NodeID][]byte
+}
+
+// NewHMACImpl returns a new HMACImpl using the given keys.
+// keys[nodeID] must be the key this node shares with the node with ID nodeID.
+func NewHMACImpl(keys map[t.NodeID][]byte) *HMACImpl {
+	return &HMACImpl{keys: keys}
+}
+
+// ComputeMAC computes an HMAC-SHA256 of the concatenation of all the byte slices in data
+// using the key shared with the node with ID nodeID.
+func (m *HMACImpl) ComputeMAC(data [][]byte, nodeID t.NodeID) ([]byte, error) {
+	key, ok := m.keys[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("no MAC key for node %v", nodeID)
+	}
+
+	h := hmac.New(sha256.New, key)
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil), nil
+}
+
+// VerifyMAC recomputes the MAC over data using the key shared with the node with ID nodeID
+// and compares it with the given one.
+func (m *HMACImpl) VerifyMAC(data [][]byte, mac []byte, nodeID t.NodeID) error {
+	expected, err := m.ComputeMAC(data, nodeID)
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal(expected, mac) {
+		return fmt.Errorf("invalid MAC from node %v", nodeID)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestNodePseudoMAC(t *testing.T) {
+	nodes := []types.NodeID{"0", "1", "2"}
+	data := [][]byte{[]byte("hello"), []byte("world")}
+
+	macs := make([]MAC, len(nodes))
+	for i, nodeID := range nodes {
+		var err error
+		macs[i], err = NodePseudoMAC(nodes, nodeID, DefaultPseudoSeed)
+		require.NoError(t, err)
+	}
+
+	// A MAC computed by node 0 for node 1 is only accepted by node 1 and only as coming from node 0.
+	mac, err := macs[0].ComputeMAC(data, "1")
+	require.NoError(t, err)
+	assert.NoError(t, macs[1].VerifyMAC(data, mac, "0"))
+	assert.Error(t, macs[1].VerifyMAC(data, mac, "2"))
+	assert.Error(t, macs[2].VerifyMAC(data, mac, "0"))
+	assert.Error(t, macs[1].VerifyMAC([][]byte{[]byte("hello")}, mac, "0"))
+
+	_, err = NodePseudoMAC(nodes, "3", DefaultPseudoSeed)
+	assert.Error(t, err)
+}
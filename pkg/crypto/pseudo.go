@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package crypto
 
 import (
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"fmt"
 	"io"
 	prand "math/rand"
@@ -20,20 +22,43 @@ var (
 	DefaultPseudoSeed int64 = 12345
 )
 
+// Length of the pairwise keys generated by NodePseudoMAC.
+const macKeyLength = 32
+
 // NodePseudo returns a CryptoImpl module to be used by a Node, generating new keys in a pseudo-random manner.
 // It is initialized and populated deterministically, based on a given configuration and a random seed.
 // NodePseudo is not secure.
 // Intended for testing purposes and assuming a static membership known to all nodes,
 // NodePseudo can be invoked by each Node independently (specifying the same seed, e.g. DefaultPseudoSeed)
 // and generates the same set of keys for the whole system at each node, obviating the exchange of public keys.
-func NodePseudo(nodes []t.NodeID, ownID t.NodeID, seed int64) (Crypto, error) { //nolint:dupl
+func NodePseudo(nodes []t.NodeID, ownID t.NodeID, seed int64) (Crypto, error) {
+	return NodePseudoWithKeyTypes(nodes, nil, ownID, seed)
+}
+
+// NodePseudoWithKeyTypes works like NodePseudo, but generates for each node a key of the type given by keyTypes.
+// For nodes missing in keyTypes (which may be nil), keys of the DefaultKeyType are generated.
+// All nodes must use the same keyTypes to obtain consistent keys.
+func NodePseudoWithKeyTypes( //nolint:dupl
+	nodes []t.NodeID,
+	keyTypes map[t.NodeID]KeyType,
+	ownID t.NodeID,
+	seed int64,
+) (Crypto, error) {
 
 	// Create a new pseudorandom source from the given seed.
 	randomness := prand.New(prand.NewSource(seed)) //nolint:gosec
 
 	// Generate node keys.
 	// All private keys except the own one will be discarded.
-	nodePrivKeys, nodePubKeys, err := generateKeys(len(nodes), randomness)
+	types := make([]KeyType, len(nodes))
+	for i, nodeID := range nodes {
+		if keyType, ok := keyTypes[nodeID]; ok {
+			types[i] = keyType
+		} else {
+			types[i] = DefaultKeyType
+		}
+	}
+	nodePrivKeys, nodePubKeys, err := generateKeys(types, randomness)
 	if err != nil {
 		return nil, err
 	}
@@ -67,17 +92,52 @@ func NodePseudo(nodes []t.NodeID, ownID t.NodeID, seed int64) (Crypto, error) {
 	return c, nil
 }
 
-// generateKeys generates numKeys keys, using the given randomness source.
+// NodePseudoMAC returns a MAC module to be used by a Node, generating pairwise shared keys in a pseudo-random manner.
+// Like NodePseudo, it is initialized deterministically, based on a given configuration and a random seed,
+// and can be invoked by each Node independently to obtain consistent pairwise keys, obviating key exchange.
+// NodePseudoMAC is not secure and is intended for testing purposes only.
+func NodePseudoMAC(nodes []t.NodeID, ownID t.NodeID, seed int64) (MAC, error) {
+
+	// Create a new pseudorandom source from the given seed.
+	randomness := prand.New(prand.NewSource(seed)) //nolint:gosec
+
+	// Generate a key for each (unordered) pair of nodes, including each node with itself, always in the same order.
+	// All keys that do not involve the own node will be discarded.
+	keys := make(map[t.NodeID][]byte)
+	for i := range nodes {
+		for j := i; j < len(nodes); j++ {
+			key := make([]byte, macKeyLength)
+			if _, err := randomness.Read(key); err != nil {
+				return nil, err
+			}
+
+			if nodes[i] == ownID {
+				keys[nodes[j]] = key
+			} else if nodes[j] == ownID {
+				keys[nodes[i]] = key
+			}
+		}
+	}
+
+	// Return error if own ID was not found in the given membership.
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("ownID (%v) not found among nodes", ownID)
+	}
+
+	return NewHMACImpl(keys), nil
+}
+
+// generateKeys deterministically generates a key of each of the given types, using the given randomness source.
 // returns private keys and public keys in two separate arrays, where privKeys[i] and pubKeys[i] represent one key pair.
-func generateKeys(numKeys int, randomness io.Reader) (privKeys [][]byte, pubKeys [][]byte, err error) {
+func generateKeys(keyTypes []KeyType, randomness io.Reader) (privKeys [][]byte, pubKeys [][]byte, err error) {
 
 	// Initialize empty lists of keys.
-	privKeys = make([][]byte, numKeys)
-	pubKeys = make([][]byte, numKeys)
+	privKeys = make([][]byte, len(keyTypes))
+	pubKeys = make([][]byte, len(keyTypes))
 
 	// Generate key pairs.
-	for i := 0; i < numKeys; i++ {
-		if privKeys[i], pubKeys[i], err = GenerateKeyPair(randomness); err != nil {
+	for i, keyType := range keyTypes {
+		if privKeys[i], pubKeys[i], err = generatePseudoKeyPair(keyType, randomness); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -86,6 +146,46 @@ func generateKeys(numKeys int, randomness io.Reader) (privKeys [][]byte, pubKeys
 	return
 }
 
+// generatePseudoKeyPair generates a pair of keys of the given type, like GenerateKeyPairOfType.
+// Unlike GenerateKeyPairOfType, whose output, depending on the Go version,
+// is not fully determined by the given randomness source,
+// generatePseudoKeyPair always generates the same pair of keys when reading the same bytes from randomness.
+func generatePseudoKeyPair(keyType KeyType, randomness io.Reader) (priv []byte, pub []byte, err error) {
+
+	// Derive keys of the requested type from the randomness.
+	var privKey, pubKey interface{}
+	switch keyType {
+	case ECDSAP256:
+		privKey, pubKey, err = pseudoEcdsaKeyPair(elliptic.P256(), randomness)
+	case ECDSAP384:
+		privKey, pubKey, err = pseudoEcdsaKeyPair(elliptic.P384(), randomness)
+	case Ed25519:
+		seed := make([]byte, ed25519.SeedSize)
+		if _, err = io.ReadFull(randomness, seed); err == nil {
+			key := ed25519.NewKeyFromSeed(seed)
+			privKey, pubKey = key, key.Public()
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported key type: %v", keyType)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating %v key: %w", keyType, err)
+	}
+
+	// Serialize private key.
+	if priv, err = SerializePrivKey(privKey); err != nil {
+		return nil, nil, fmt.Errorf("error serializing private key: %w", err)
+	}
+
+	// Serialized public key.
+	if pub, err = SerializePubKey(pubKey); err != nil {
+		return nil, nil, fmt.Errorf("error serializing public key: %w", err)
+	}
+
+	// All output variables have been set, just return.
+	return
+}
+
 // regusterPubKeys populates a CryptoImpl module c with the given nodePubKeys.
 // Each entry in nodes will be associated with the corresponding entry in nodePubKeys
 // by calling c.RegisterNodeKey(nodePubKeys[i], nodes[i]) for 0 <= i < len(nodes).
@@ -0,0 +1,109 @@
+package crypto
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// SigCacheStats contains statistics about the signature verification cache of a MirModule.
+type SigCacheStats struct {
+	Hits   uint64 // number of signatures found in the cache, and thus not verified again
+	Misses uint64 // number of signatures not found in the cache
+	Size   int    // number of signatures currently in the cache
+}
+
+// sigCacheKey identifies a signature of some data by some node.
+type sigCacheKey [sha256.Size]byte
+
+// sigCache remembers the most recently verified valid signatures, evicting the least recently used ones.
+// It is safe for concurrent use.
+type sigCache struct {
+	lock sync.Mutex
+
+	// maximal number of signatures in the cache
+	capacity int
+
+	// the cached signatures, ordered from the most to the least recently used
+	order   *list.List
+	entries map[sigCacheKey]*list.Element
+
+	hits   uint64
+	misses uint64
+}
+
+func newSigCache(capacity int) *sigCache {
+	return &sigCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[sigCacheKey]*list.Element),
+	}
+}
+
+// key returns the cache key of the signature of data by node nodeID.
+func (c *sigCache) key(data [][]byte, signature []byte, nodeID t.NodeID) sigCacheKey {
+	h := sha256.New()
+	h.Write(digest(data))
+	_ = binary.Write(h, binary.BigEndian, uint32(len(signature)))
+	h.Write(signature)
+	h.Write([]byte(nodeID))
+
+	var key sigCacheKey
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+// contains returns true if the signature is in the cache, marking it as the most recently used.
+func (c *sigCache) contains(key sigCacheKey) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return false
+	}
+
+	c.hits++
+	c.order.MoveToFront(element)
+	return true
+}
+
+// add inserts a signature in the cache, evicting the least recently used one if the cache is full.
+func (c *sigCache) add(key sigCacheKey) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(key)
+	if c.order.Len() > c.capacity {
+		delete(c.entries, c.order.Remove(c.order.Back()).(sigCacheKey))
+	}
+}
+
+// clear removes all signatures from the cache.
+func (c *sigCache) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.order.Init()
+	c.entries = make(map[sigCacheKey]*list.Element)
+}
+
+func (c *sigCache) stats() SigCacheStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return SigCacheStats{
+		Hits:   c.hits,
+		Misses: c.misses,
+		Size:   c.order.Len(),
+	}
+}
@@ -0,0 +1,121 @@
+package crypto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// countingCrypto wraps a DefaultImpl, counting the calls to Verify.
+type countingCrypto struct {
+	*DefaultImpl
+	verifyCalls int
+}
+
+func (c *countingCrypto) Verify(data [][]byte, signature []byte, nodeID types.NodeID) error {
+	c.verifyCalls++
+	return c.DefaultImpl.Verify(data, signature, nodeID)
+}
+
+// signedData returns n distinct messages and their signatures by node "1" of the pseudo-random crypto setup.
+func signedData(t *testing.T, n int) ([][][]byte, [][]byte) {
+	signer, err := NodePseudo([]types.NodeID{"0", "1"}, "1", DefaultPseudoSeed)
+	require.NoError(t, err)
+
+	data := make([][][]byte, n)
+	signatures := make([][]byte, n)
+	for i := range data {
+		data[i] = [][]byte{[]byte(fmt.Sprintf("message %d", i))}
+		signatures[i], err = signer.Sign(data[i])
+		require.NoError(t, err)
+	}
+	return data, signatures
+}
+
+// verifyAll has the module verify all signatures as produced by node "1" and returns the individual results.
+func verifyAll(t *testing.T, m *MirModule, data [][][]byte, signatures [][]byte) []bool {
+	nodeIDs := make([]types.NodeID, len(data))
+	for i := range nodeIDs {
+		nodeIDs[i] = "1"
+	}
+
+	evsOut, err := m.ApplyEvents(events.ListOf(
+		events.VerifyNodeSigs("crypto", data, signatures, nodeIDs, &eventpb.SigVerOrigin{Module: "test"}),
+	))
+	require.NoError(t, err)
+	require.Equal(t, 1, evsOut.Len())
+	return evsOut.Slice()[0].Type.(*eventpb.Event_NodeSigsVerified).NodeSigsVerified.Valid
+}
+
+func TestSigCache(t *testing.T) {
+	cryptoImpl, err := NodePseudo([]types.NodeID{"0", "1"}, "0", DefaultPseudoSeed)
+	require.NoError(t, err)
+	counter := &countingCrypto{DefaultImpl: cryptoImpl.(*DefaultImpl)}
+	m := NewWithSigCache(counter, nil, 2)
+
+	data, signatures := signedData(t, 3)
+
+	// Only valid signatures are cached.
+	invalid := append([]byte{}, signatures[0]...)
+	invalid[len(invalid)-1] ^= 1
+	assert.Equal(t, []bool{false}, verifyAll(t, m, data[:1], [][]byte{invalid}))
+	assert.Equal(t, []bool{false}, verifyAll(t, m, data[:1], [][]byte{invalid}))
+	assert.Equal(t, SigCacheStats{Hits: 0, Misses: 2, Size: 0}, m.SigCacheStats())
+
+	assert.Equal(t, []bool{true}, verifyAll(t, m, data[:1], signatures[:1]))
+	assert.Equal(t, []bool{true}, verifyAll(t, m, data[:1], signatures[:1]))
+	assert.Equal(t, SigCacheStats{Hits: 1, Misses: 3, Size: 1}, m.SigCacheStats())
+	assert.Equal(t, 3, counter.verifyCalls)
+
+	// Adding two more signatures evicts the least recently used one.
+	assert.Equal(t, []bool{true}, verifyAll(t, m, data[1:2], signatures[1:2]))
+	assert.Equal(t, []bool{true}, verifyAll(t, m, data[2:3], signatures[2:3]))
+	assert.Equal(t, SigCacheStats{Hits: 1, Misses: 5, Size: 2}, m.SigCacheStats())
+
+	counter.verifyCalls = 0
+	assert.Equal(t, []bool{true}, verifyAll(t, m, data[2:3], signatures[2:3]))
+	assert.Equal(t, 0, counter.verifyCalls)
+	assert.Equal(t, []bool{true}, verifyAll(t, m, data[:1], signatures[:1]))
+	assert.Equal(t, 1, counter.verifyCalls)
+
+	// A signature is bound to the node that produced it.
+	evsOut, err := m.ApplyEvents(events.ListOf(events.VerifyNodeSigs(
+		"crypto", data[:1], signatures[:1], []types.NodeID{"0"}, &eventpb.SigVerOrigin{Module: "test"},
+	)))
+	require.NoError(t, err)
+	assert.False(t, evsOut.Slice()[0].Type.(*eventpb.Event_NodeSigsVerified).NodeSigsVerified.AllOk)
+
+	// Revoking the key of the signer invalidates the cached signatures.
+	_, err = m.ApplyEvents(events.ListOf(events.RevokeKey("crypto", events.NodeKeyOwner("1"), 0)))
+	require.NoError(t, err)
+	assert.Equal(t, 0, m.SigCacheStats().Size)
+	assert.Equal(t, []bool{false}, verifyAll(t, m, data[:1], signatures[:1]))
+}
+
+func TestSigCacheMultipleSigs(t *testing.T) {
+	cryptoImpl, err := NodePseudo([]types.NodeID{"0", "1"}, "0", DefaultPseudoSeed)
+	require.NoError(t, err)
+	counter := &countingCrypto{DefaultImpl: cryptoImpl.(*DefaultImpl)}
+	m := NewWithSigCache(counter, nil, DefaultSigCacheSize)
+
+	data, signatures := signedData(t, 4)
+
+	// Of the signatures of a single event, only those not in the cache are verified.
+	assert.Equal(t, []bool{true, true}, verifyAll(t, m, data[:2], signatures[:2]))
+	assert.Equal(t, []bool{true, true, true, true}, verifyAll(t, m, data, signatures))
+	assert.Equal(t, 4, counter.verifyCalls)
+	assert.Equal(t, SigCacheStats{Hits: 2, Misses: 4, Size: 4}, m.SigCacheStats())
+
+	// Each invalid signature is reported individually, even if the same data has a valid signature in the cache.
+	invalid := append([][]byte{}, signatures...)
+	invalid[2] = signatures[1]
+	assert.Equal(t, []bool{true, true, false, true}, verifyAll(t, m, data, invalid))
+	assert.Equal(t, 5, counter.verifyCalls)
+	assert.Equal(t, SigCacheStats{Hits: 5, Misses: 5, Size: 4}, m.SigCacheStats())
+}
@@ -0,0 +1,35 @@
+package crypto
+
+import (
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// verifyNodeSigs verifies the signatures produced by the nodes with IDs nodeIDs over data,
+// where signatures[i] is expected to be produced by nodeIDs[i] over data[i].
+// It returns, for each signature, nil if it is valid and a non-nil error otherwise.
+// Signatures found in the signature cache are not verified again.
+func (c *MirModule) verifyNodeSigs(data [][][]byte, signatures [][]byte, nodeIDs []t.NodeID) []error {
+	errs := make([]error, len(data))
+
+	// Look up the signatures in the cache, collecting the indices of those still to be verified.
+	keys := make([]sigCacheKey, len(data))
+	pending := make([]int, 0, len(data))
+	for i := range data {
+		if c.sigCache != nil {
+			keys[i] = c.sigCache.key(data[i], signatures[i], nodeIDs[i])
+			if c.sigCache.contains(keys[i]) {
+				continue
+			}
+		}
+		pending = append(pending, i)
+	}
+
+	// Verify the remaining signatures, adding the valid ones to the cache.
+	for _, i := range pending {
+		if errs[i] = c.crypto.Verify(data[i], signatures[i], nodeIDs[i]); errs[i] == nil && c.sigCache != nil {
+			c.sigCache.add(keys[i])
+		}
+	}
+
+	return errs
+}
@@ -60,6 +60,10 @@ type TestConfig struct {
 	// The number of requests sent over the network (by a single DummyClient)
 	NumNetRequests int
 
+	// If not empty, the requests sent over the network are only submitted to this node,
+	// relying on the nodes to gossip them among each other. Otherwise, they are submitted to all nodes.
+	NetRequestsTarget t.NodeID
+
 	// The target module for the clients' requests.
 	FakeRequestsDestModule t.ModuleID
 
@@ -216,7 +220,7 @@ func (d *Deployment) Run(ctx context.Context) (nodeErrors []error, heapObjects i
 			defer clientWg.Done()
 
 			c.Connect(ctx2, d.localRequestReceiverAddrs())
-			submitDummyRequests(ctx2, c, d.TestConfig.NumNetRequests)
+			submitDummyRequests(ctx2, c, d.TestConfig.NumNetRequests, d.TestConfig.NetRequestsTarget)
 			c.Disconnect()
 		}(client)
 	}
@@ -259,9 +263,10 @@ func (d *Deployment) localRequestReceiverAddrs() map[t.NodeID]string {
 	return addrs
 }
 
-// submitDummyRequests submits n dummy requests using client.
+// submitDummyRequests submits n dummy requests using client, either to all nodes or, if target is not empty,
+// only to the target node.
 // It returns when all requests have been submitted or when ctx is done.
-func submitDummyRequests(ctx context.Context, client *dummyclient.DummyClient, n int) {
+func submitDummyRequests(ctx context.Context, client *dummyclient.DummyClient, n int, target t.NodeID) {
 	for i := 0; i < n; i++ {
 		// For each request to be submitted
 
@@ -271,7 +276,14 @@ func submitDummyRequests(ctx context.Context, client *dummyclient.DummyClient, n
 			return
 		default:
 			// Submit the request and check for error.
-			if err := client.SubmitRequest([]byte(fmt.Sprintf("Request %d", i))); err != nil {
+			data := []byte(fmt.Sprintf("Request %d", i))
+			var err error
+			if target != "" {
+				err = client.SubmitRequestToNode(target, data)
+			} else {
+				err = client.SubmitRequest(data)
+			}
+			if err != nil {
 				panic(err)
 			}
 		}
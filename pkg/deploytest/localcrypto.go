@@ -29,5 +29,10 @@ func (cs *localPseudoCryptoSystem) Module(id t.NodeID) modules.Module {
 		panic(fmt.Sprintf("error creating crypto module: %v", err))
 	}
 
-	return mirCrypto.New(cryptoImpl)
+	macImpl, err := mirCrypto.NodePseudoMAC(cs.nodeIDs, id, mirCrypto.DefaultPseudoSeed)
+	if err != nil {
+		panic(fmt.Sprintf("error creating MAC module: %v", err))
+	}
+
+	return mirCrypto.NewWithMAC(cryptoImpl, macImpl)
 }
@@ -4,9 +4,11 @@ import (
 	"errors"
 
 	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/commonpb"
 	"github.com/filecoin-project/mir/pkg/pb/dslpb"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
 	t "github.com/filecoin-project/mir/pkg/types"
 )
 
@@ -86,6 +88,51 @@ func VerifyOneNodeSig[C any](
 	VerifyNodeSigs(m, destModule, [][][]byte{data}, [][]byte{signature}, []t.NodeID{nodeID}, context)
 }
 
+// ComputeAuthenticator emits a request event to compute an authenticator (a vector of MACs, one for each of nodeIDs)
+// over the given data.
+// The response should be processed using UponAuthenticatorComputed with the same context type C.
+// C can be an arbitrary type and does not have to be serializable.
+func ComputeAuthenticator[C any](m Module, destModule t.ModuleID, data [][]byte, nodeIDs []t.NodeID, context *C) {
+	contextID := m.DslHandle().StoreContext(context)
+
+	origin := &eventpb.AuthOrigin{
+		Module: m.ModuleID().Pb(),
+		Type: &eventpb.AuthOrigin_Dsl{
+			Dsl: &dslpb.Origin{
+				ContextID: contextID.Pb(),
+			},
+		},
+	}
+
+	EmitEvent(m, events.ComputeAuthenticator(destModule, data, nodeIDs, origin))
+}
+
+// VerifyAuthenticators emits a MAC verification request event for a batch of MACs,
+// each computed for this node by the corresponding node in nodeIDs.
+// The response should be processed using UponAuthenticatorsVerified with the same context type C.
+// C can be an arbitrary type and does not have to be serializable.
+func VerifyAuthenticators[C any](
+	m Module,
+	destModule t.ModuleID,
+	data [][][]byte,
+	macs [][]byte,
+	nodeIDs []t.NodeID,
+	context *C,
+) {
+	contextID := m.DslHandle().StoreContext(context)
+
+	origin := &eventpb.AuthOrigin{
+		Module: m.ModuleID().Pb(),
+		Type: &eventpb.AuthOrigin_Dsl{
+			Dsl: &dslpb.Origin{
+				ContextID: contextID.Pb(),
+			},
+		},
+	}
+
+	EmitEvent(m, events.VerifyAuthenticators(destModule, data, macs, nodeIDs, origin))
+}
+
 // HashRequest emits a request event to compute hashes of a batch of messages.
 // The response should be processed using UponHashResult with the same context type C.
 // C can be an arbitrary type and does not have to be serializable.
@@ -121,6 +168,13 @@ func UponInit(m Module, handler func() error) {
 	})
 }
 
+// UponNewRequests invokes handler when the module receives new requests from clients.
+func UponNewRequests(m Module, handler func(requests []*requestpb.Request) error) {
+	UponEvent[*eventpb.Event_NewRequests](m, func(ev *eventpb.NewRequests) error {
+		return handler(ev.Requests)
+	})
+}
+
 // UponSignResult invokes handler when the module receives a response to a request made by SignRequest with the same
 // context type C.
 func UponSignResult[C any](m Module, handler func(signature []byte, context *C) error) {
@@ -186,6 +240,59 @@ func UponOneNodeSigVerified[C any](m Module, handler func(nodeID t.NodeID, err e
 	})
 }
 
+// UponAuthenticatorComputed invokes handler when the module receives a response to a request made by
+// ComputeAuthenticator with the same context type C.
+func UponAuthenticatorComputed[C any](
+	m Module,
+	handler func(authenticator *commonpb.Authenticator, context *C) error,
+) {
+	UponEvent[*eventpb.Event_AuthenticatorComputed](m, func(ev *eventpb.AuthenticatorComputed) error {
+		originWrapper, ok := ev.Origin.Type.(*eventpb.AuthOrigin_Dsl)
+		if !ok {
+			return nil
+		}
+
+		contextRaw := m.DslHandle().RecoverAndCleanupContext(ContextID(originWrapper.Dsl.ContextID))
+		context, ok := contextRaw.(*C)
+		if !ok {
+			return nil
+		}
+
+		return handler(ev.Authenticator, context)
+	})
+}
+
+// UponAuthenticatorsVerified invokes handler when the module receives a response to a request made by
+// VerifyAuthenticators with the same context type C.
+func UponAuthenticatorsVerified[C any](
+	m Module,
+	handler func(nodeIDs []t.NodeID, errs []error, allOK bool, context *C) error,
+) {
+	UponEvent[*eventpb.Event_AuthenticatorsVerified](m, func(ev *eventpb.AuthenticatorsVerified) error {
+		originWrapper, ok := ev.Origin.Type.(*eventpb.AuthOrigin_Dsl)
+		if !ok {
+			return nil
+		}
+
+		contextRaw := m.DslHandle().RecoverAndCleanupContext(ContextID(originWrapper.Dsl.ContextID))
+		context, ok := contextRaw.(*C)
+		if !ok {
+			return nil
+		}
+
+		errs := make([]error, len(ev.Valid))
+		for i := range ev.Valid {
+			if ev.Valid[i] {
+				errs[i] = nil
+			} else {
+				errs[i] = errors.New(ev.Errors[i])
+			}
+		}
+
+		return handler(t.NodeIDSlice(ev.NodeIds), errs, ev.AllOk, context)
+	})
+}
+
 // UponHashResult invokes handler when the module receives a response to a request made by HashRequest with the same
 // context type C.
 func UponHashResult[C any](m Module, handler func(hashes [][]byte, context *C) error) {
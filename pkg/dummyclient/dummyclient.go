@@ -9,7 +9,9 @@ package dummyclient
 import (
 	"context"
 	"crypto"
+	"errors"
 	"fmt"
+	"io"
 	"sync"
 
 	"google.golang.org/grpc"
@@ -34,6 +36,9 @@ type DummyClient struct {
 	nextReqNo   t.ReqNo
 	connections map[t.NodeID]requestreceiver.RequestReceiver_ListenClient
 	logger      logging.Logger
+
+	// Wait group tracking the goroutines receiving rejected requests from the nodes (one per connection).
+	rejectionsWg sync.WaitGroup
 }
 
 func NewDummyClient(
@@ -87,6 +92,10 @@ func (dc *DummyClient) Connect(ctx context.Context, membership map[t.NodeID]stri
 				dc.logger.Log(logging.LevelDebug, "Failed to connect to node.", "id", id, "addr", addr)
 			} else {
 				dc.logger.Log(logging.LevelDebug, "Node connected.", "id", id, "addr", addr)
+
+				// Receive reports about rejected requests from the node until the connection is closed.
+				dc.rejectionsWg.Add(1)
+				go dc.receiveRejections(id, connection)
 			}
 
 		}(nodeID, nodeAddr)
@@ -133,6 +142,28 @@ func (dc *DummyClient) SubmitRequest(data []byte) error {
 	return nil
 }
 
+// SubmitRequestToNode submits a request by sending it only to the node with ID nodeID.
+// This is sufficient if the nodes gossip pending requests among each other (e.g., through their mempools).
+// It automatically appends meta-info like client ID and request number.
+// SubmitRequestToNode must not be called concurrently (neither with itself nor with SubmitRequest).
+func (dc *DummyClient) SubmitRequestToNode(nodeID t.NodeID, data []byte) error {
+
+	connection, ok := dc.connections[nodeID]
+	if !ok || connection == nil {
+		return fmt.Errorf("not connected to node %v", nodeID)
+	}
+
+	// Create new request message.
+	reqMsg := events.ClientRequest(dc.ownID, dc.nextReqNo, data)
+	dc.nextReqNo++
+
+	if err := connection.Send(reqMsg); err != nil {
+		return fmt.Errorf("failed sending request to node %v: %w", nodeID, err)
+	}
+
+	return nil
+}
+
 // Disconnect closes all open connections to Mir nodes.
 func (dc *DummyClient) Disconnect() {
 
@@ -140,10 +171,32 @@ func (dc *DummyClient) Disconnect() {
 	for id, connection := range dc.connections {
 		if connection == nil {
 			dc.logger.Log(logging.LevelWarn, fmt.Sprintf("No connection to close to node %v", id))
-		} else if _, err := connection.CloseAndRecv(); err != nil {
+		} else if err := connection.CloseSend(); err != nil {
 			dc.logger.Log(logging.LevelWarn, fmt.Sprintf("Could not close connection to node %v", id))
 		}
 	}
+
+	// Wait until the nodes close their side of the connections.
+	dc.rejectionsWg.Wait()
+}
+
+// receiveRejections logs the rejected requests reported by a node over the given connection.
+// It returns when the connection is closed.
+func (dc *DummyClient) receiveRejections(nodeID t.NodeID, connection requestreceiver.RequestReceiver_ListenClient) {
+	defer dc.rejectionsWg.Done()
+
+	for {
+		rejection, err := connection.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		} else if err != nil {
+			dc.logger.Log(logging.LevelWarn, "Connection to node terminated.", "id", nodeID, "err", err)
+			return
+		}
+
+		dc.logger.Log(logging.LevelWarn, "Request rejected.",
+			"id", nodeID, "reqNo", rejection.ReqNo, "reason", rejection.Reason)
+	}
 }
 
 // Establishes a connection to a single node at address addrString.
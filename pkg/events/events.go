@@ -232,6 +232,141 @@ func NodeSigsVerified(
 	}
 }
 
+// VerifyRequestSig returns an event representing a request to the crypto module
+// for verifying the signature of a request, using the public key registered for the client that submitted it.
+// The origin is an object used to maintain the context for the requesting module and will be included in the
+// RequestSigVerified event produced by the crypto module.
+func VerifyRequestSig(
+	destModule t.ModuleID,
+	request *requestpb.Request,
+	signature []byte,
+	origin *eventpb.SigVerOrigin,
+) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_VerifyRequestSig{VerifyRequestSig: &eventpb.VerifyRequestSig{
+			Request:   request,
+			Signature: signature,
+			Origin:    origin,
+		}},
+	}
+}
+
+// RequestSigVerified returns an event representing the result of the verification of a request signature
+// by the crypto module.
+// If the signature is not valid, errorMsg contains the error produced by the Crypto module.
+func RequestSigVerified(
+	destModule t.ModuleID,
+	request *requestpb.Request,
+	valid bool,
+	errorMsg string,
+	origin *eventpb.SigVerOrigin,
+) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_RequestSigVerified{RequestSigVerified: &eventpb.RequestSigVerified{
+			Request: request,
+			Valid:   valid,
+			Error:   errorMsg,
+			Origin:  origin,
+		}},
+	}
+}
+
+// ComputeAuthenticator returns an event representing a request to the crypto module
+// for computing an authenticator (i.e., a vector of MACs) over the given data, one MAC for each of the given nodes.
+// The data to authenticate is the concatenation of all the byte slices in data.
+// The origin is an object used to maintain the context for the requesting module and will be included in the
+// AuthenticatorComputed event produced by the crypto module.
+func ComputeAuthenticator(
+	destModule t.ModuleID,
+	data [][]byte,
+	nodeIDs []t.NodeID,
+	origin *eventpb.AuthOrigin,
+) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_ComputeAuthenticator{ComputeAuthenticator: &eventpb.ComputeAuthenticator{
+			Data:    data,
+			NodeIds: t.NodeIDSlicePb(nodeIDs),
+			Origin:  origin,
+		}},
+	}
+}
+
+// AuthenticatorComputed returns an event representing the computation of an authenticator by the crypto module.
+// It contains the computed authenticator and the AuthOrigin,
+// an object used to maintain the context for the requesting module,
+// i.e., information about what to do with the contained authenticator.
+func AuthenticatorComputed(
+	destModule t.ModuleID,
+	authenticator *commonpb.Authenticator,
+	origin *eventpb.AuthOrigin,
+) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_AuthenticatorComputed{AuthenticatorComputed: &eventpb.AuthenticatorComputed{
+			Authenticator: authenticator,
+			Origin:        origin,
+		}},
+	}
+}
+
+// VerifyAuthenticators returns an event representing a request to the crypto module
+// for verifying a batch of MACs, each computed by the corresponding node in nodeIDs
+// over the corresponding data (a slice of byte slices, thus [][][]byte) for this node.
+// The origin is an object used to maintain the context for the requesting module and will be included in the
+// AuthenticatorsVerified event produced by the crypto module.
+func VerifyAuthenticators(
+	destModule t.ModuleID,
+	data [][][]byte,
+	macs [][]byte,
+	nodeIDs []t.NodeID,
+	origin *eventpb.AuthOrigin,
+) *eventpb.Event {
+
+	// First, construct a slice of SigVerData objects
+	authData := make([]*eventpb.SigVerData, len(data))
+	for i, d := range data {
+		authData[i] = &eventpb.SigVerData{Data: d}
+	}
+
+	// Then return the actual Event.
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_VerifyAuthenticators{VerifyAuthenticators: &eventpb.VerifyAuthenticators{
+			Data:    authData,
+			Macs:    macs,
+			NodeIds: t.NodeIDSlicePb(nodeIDs),
+			Origin:  origin,
+		}},
+	}
+}
+
+// AuthenticatorsVerified returns an event representing the result of the verification of multiple MACs
+// by the crypto module. Analogously to NodeSigsVerified, it contains the results of the individual verifications
+// and the AuthOrigin of the request.
+// The allOK argument must be set to true if the valid argument only contains true values.
+func AuthenticatorsVerified(
+	destModule t.ModuleID,
+	valid []bool,
+	errors []string,
+	nodeIDs []t.NodeID,
+	origin *eventpb.AuthOrigin,
+	allOk bool,
+) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_AuthenticatorsVerified{AuthenticatorsVerified: &eventpb.AuthenticatorsVerified{
+			Valid:   valid,
+			Errors:  errors,
+			NodeIds: t.NodeIDSlicePb(nodeIDs),
+			Origin:  origin,
+			AllOk:   allOk,
+		}},
+	}
+}
+
 // RequestReady returns an event signifying that a new request is ready to be inserted into the protocol state machine.
 // This normally occurs when the request has been received, persisted, authenticated, and an authenticator is available.
 func RequestReady(destModule t.ModuleID, request *requestpb.Request) *eventpb.Event {
@@ -243,6 +378,20 @@ func RequestReady(destModule t.ModuleID, request *requestpb.Request) *eventpb.Ev
 	}
 }
 
+// RequestRejected returns an event signifying that a request has been rejected by the protocol
+// (e.g., because it exceeded the client's quota) and will not be ordered.
+// The reason is meant to be reported back to the client that submitted the request.
+func RequestRejected(destModule t.ModuleID, request *requestpb.Request, reason string) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_RequestRejected{RequestRejected: &requestpb.RequestRejected{
+			ClientId: request.ClientId,
+			ReqNo:    request.ReqNo,
+			Reason:   reason,
+		}},
+	}
+}
+
 // WALAppend returns an event of appending a new entry to the WAL.
 // This event is produced by the protocol state machine for persisting its state.
 func WALAppend(destModule t.ModuleID, event *eventpb.Event, retentionIndex t.WALRetIndex) *eventpb.Event {
@@ -357,3 +506,48 @@ func NewConfig(destModule t.ModuleID, nodeIDs []t.NodeID) *eventpb.Event {
 		}},
 	}
 }
+
+// RegisterKey returns an event associating the public key pubKey with the given owner from epoch fromEpoch on.
+// The owner can be created using NodeKeyOwner or ClientKeyOwner.
+func RegisterKey(destModule t.ModuleID, owner *eventpb.KeyOwner, pubKey []byte, fromEpoch t.EpochNr) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_RegisterKey{RegisterKey: &eventpb.RegisterKey{
+			Owner:     owner,
+			PubKey:    pubKey,
+			FromEpoch: fromEpoch.Pb(),
+		}},
+	}
+}
+
+// RevokeKey returns an event removing the public key of the given owner from epoch fromEpoch on.
+// The owner can be created using NodeKeyOwner or ClientKeyOwner.
+func RevokeKey(destModule t.ModuleID, owner *eventpb.KeyOwner, fromEpoch t.EpochNr) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_RevokeKey{RevokeKey: &eventpb.RevokeKey{
+			Owner:     owner,
+			FromEpoch: fromEpoch.Pb(),
+		}},
+	}
+}
+
+// SetKeyEpoch returns an event informing the crypto module about the current epoch.
+func SetKeyEpoch(destModule t.ModuleID, epoch t.EpochNr) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: destModule.Pb(),
+		Type: &eventpb.Event_SetKeyEpoch{SetKeyEpoch: &eventpb.SetKeyEpoch{
+			Epoch: epoch.Pb(),
+		}},
+	}
+}
+
+// NodeKeyOwner returns a KeyOwner identifying the node with ID nodeID.
+func NodeKeyOwner(nodeID t.NodeID) *eventpb.KeyOwner {
+	return &eventpb.KeyOwner{Type: &eventpb.KeyOwner_NodeId{NodeId: nodeID.Pb()}}
+}
+
+// ClientKeyOwner returns a KeyOwner identifying the client with ID clientID.
+func ClientKeyOwner(clientID t.ClientID) *eventpb.KeyOwner {
+	return &eventpb.KeyOwner{Type: &eventpb.KeyOwner_ClientId{ClientId: clientID.Pb()}}
+}
@@ -0,0 +1,127 @@
+package iss
+
+import (
+	"time"
+
+	"github.com/filecoin-project/mir/pkg/logging"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/mathutil"
+)
+
+// batchingPolicy determines the size of the batches proposed by this node and the delay between two proposals.
+// If adaptive batching is disabled in the ISS configuration, the policy is static and always returns
+// Config.MaxBatchSize and Config.MaxProposeDelay.
+// If adaptive batching is enabled, the policy adjusts both values, within the bounds given by the configuration,
+// based on how full the proposed batches are, on how many requests are left pending after a batch is cut,
+// and on how far the commits of own proposals lag behind the proposals themselves.
+//
+// The policy only influences proposals made by this node (as a leader) and never the validation of batches
+// proposed by others. It is thus irrelevant for safety.
+// Nevertheless, the policy only relies on information derived from the sequence of applied events
+// (and never, e.g., on the local wall clock), so that the protocol state machine remains deterministic.
+//
+// A single batchingPolicy is shared by all the orderers this node creates (across epochs),
+// so that the learned values are not lost on epoch transitions.
+type batchingPolicy struct {
+
+	// If false, the policy is static and the rest of the fields are not used.
+	adaptive bool
+
+	// Bounds for the batch size.
+	minBatchSize t.NumRequests
+	maxBatchSize t.NumRequests
+
+	// Bounds for the propose delay.
+	minProposeDelay time.Duration
+	maxProposeDelay time.Duration
+
+	// The current values of the batch size and the propose delay.
+	batchSize    t.NumRequests
+	proposeDelay time.Duration
+
+	// Number of own proposals that were still not committed at the last commit of an own proposal.
+	// A non-zero value means that new batches are proposed faster than they are committed.
+	commitLag int
+
+	// Logger for outputting debugging messages.
+	logger logging.Logger
+}
+
+// newBatchingPolicy returns a new batching policy, configured according to the given ISS configuration.
+// The adaptive policy starts with the smallest batch size and the largest propose delay,
+// and adapts from there as proposals are being made.
+func newBatchingPolicy(config *Config, logger logging.Logger) *batchingPolicy {
+	if !config.AdaptiveBatching {
+		return &batchingPolicy{
+			adaptive:     false,
+			batchSize:    config.MaxBatchSize,
+			proposeDelay: config.MaxProposeDelay,
+			logger:       logger,
+		}
+	}
+
+	return &batchingPolicy{
+		adaptive:        true,
+		minBatchSize:    config.MinBatchSize,
+		maxBatchSize:    config.MaxBatchSize,
+		minProposeDelay: config.MinProposeDelay,
+		maxProposeDelay: config.MaxProposeDelay,
+		batchSize:       config.MinBatchSize,
+		proposeDelay:    config.MaxProposeDelay,
+		commitLag:       0,
+		logger:          logger,
+	}
+}
+
+// BatchSize returns the current maximal number of requests in a proposed batch.
+// As in Config.MaxBatchSize, the value zero signifies no limit on batch size.
+func (bp *batchingPolicy) BatchSize() t.NumRequests {
+	return bp.batchSize
+}
+
+// ProposeDelay returns the current maximal time duration between two proposals.
+func (bp *batchingPolicy) ProposeDelay() time.Duration {
+	return bp.proposeDelay
+}
+
+// ObserveProposal adapts the policy to a newly proposed batch.
+// batchSize is the number of requests in the proposed batch
+// and pendingLeft is the number of requests that remained in the orderer's buckets after cutting the batch.
+// - An empty batch means there is nothing to propose. The propose delay is increased to avoid proposing empty
+//   batches too often.
+// - A batch less than half full means the load is low. Both the batch size and the propose delay are decreased,
+//   so that the few requests that arrive do not wait unnecessarily long.
+// - A full batch with more requests still pending (i.e., the buckets fill faster than the orderer proposes)
+//   or while previous proposals are still being committed means the load is high.
+//   The batch size is increased to amortize the cost of agreement over more requests.
+func (bp *batchingPolicy) ObserveProposal(batchSize t.NumRequests, pendingLeft t.NumRequests) {
+	if !bp.adaptive {
+		return
+	}
+
+	switch {
+	case batchSize == 0:
+		bp.proposeDelay = mathutil.Min(2*bp.proposeDelay, bp.maxProposeDelay)
+	case 2*batchSize < bp.batchSize:
+		bp.batchSize = mathutil.Max(bp.batchSize/2, bp.minBatchSize)
+		bp.proposeDelay = mathutil.Max(bp.proposeDelay/2, bp.minProposeDelay)
+	case batchSize >= bp.batchSize && (pendingLeft >= bp.batchSize || bp.commitLag > 0):
+		bp.batchSize = mathutil.Min(2*bp.batchSize, bp.maxBatchSize)
+	default:
+		// Load matches the current parameters. Nothing to adapt.
+		return
+	}
+
+	bp.logger.Log(logging.LevelDebug, "Adapted batching parameters.",
+		"batchSize", bp.batchSize, "proposeDelay", bp.proposeDelay, "commitLag", bp.commitLag)
+}
+
+// ObserveCommit adapts the policy to the commit of an own proposal.
+// uncommitted is the number of own proposals (made in the same segment) that still remain uncommitted.
+func (bp *batchingPolicy) ObserveCommit(uncommitted int) {
+	if !bp.adaptive {
+		return
+	}
+
+	bp.commitLag = uncommitted
+}
@@ -0,0 +1,128 @@
+package iss
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/filecoin-project/mir/pkg/logging"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// testBatchingConfig returns a configuration allowing batch sizes from 4 to 32 and propose delays from 1 to 8 ms.
+func testBatchingConfig(adaptive bool) *Config {
+	return &Config{
+		AdaptiveBatching: adaptive,
+		MinBatchSize:     4,
+		MaxBatchSize:     32,
+		MinProposeDelay:  time.Millisecond,
+		MaxProposeDelay:  8 * time.Millisecond,
+	}
+}
+
+func TestBatchingPolicy(tt *testing.T) {
+	// observation is either a proposal (of batchSize requests, leaving pendingLeft requests pending)
+	// or, if commit is true, the commit of an own proposal, leaving uncommitted own proposals.
+	type observation struct {
+		commit      bool
+		batchSize   t.NumRequests
+		pendingLeft t.NumRequests
+		uncommitted int
+	}
+	proposal := func(batchSize, pendingLeft t.NumRequests) observation {
+		return observation{batchSize: batchSize, pendingLeft: pendingLeft}
+	}
+	commit := func(uncommitted int) observation {
+		return observation{commit: true, uncommitted: uncommitted}
+	}
+
+	testCases := map[string]struct {
+		adaptive     bool
+		observations []observation
+		batchSize    t.NumRequests
+		proposeDelay time.Duration
+	}{
+		"initial": {
+			adaptive:     true,
+			batchSize:    4,
+			proposeDelay: 8 * time.Millisecond,
+		},
+		"static": {
+			adaptive:     false,
+			observations: []observation{proposal(32, 100), proposal(32, 100), proposal(0, 0), proposal(1, 0)},
+			batchSize:    32,
+			proposeDelay: 8 * time.Millisecond,
+		},
+		"full batch with pending requests": {
+			adaptive:     true,
+			observations: []observation{proposal(4, 4), proposal(8, 8)},
+			batchSize:    16,
+			proposeDelay: 8 * time.Millisecond,
+		},
+		"full batch without pending requests": {
+			adaptive:     true,
+			observations: []observation{proposal(4, 3)},
+			batchSize:    4,
+			proposeDelay: 8 * time.Millisecond,
+		},
+		"full batch with commit lag": {
+			adaptive:     true,
+			observations: []observation{commit(2), proposal(4, 0), commit(0), proposal(8, 0)},
+			batchSize:    8,
+			proposeDelay: 8 * time.Millisecond,
+		},
+		"batch size bounded from above": {
+			adaptive: true,
+			observations: []observation{
+				proposal(4, 100), proposal(8, 100), proposal(16, 100), proposal(32, 100), proposal(32, 100),
+			},
+			batchSize:    32,
+			proposeDelay: 8 * time.Millisecond,
+		},
+		"low load": {
+			adaptive:     true,
+			observations: []observation{proposal(4, 100), proposal(8, 100), proposal(16, 100), proposal(3, 0)},
+			batchSize:    16,
+			proposeDelay: 4 * time.Millisecond,
+		},
+		"batch size and delay bounded from below": {
+			adaptive: true,
+			observations: []observation{
+				proposal(4, 100), proposal(8, 100), proposal(1, 0), proposal(1, 0), proposal(1, 0), proposal(1, 0),
+			},
+			batchSize:    4,
+			proposeDelay: time.Millisecond,
+		},
+		"empty batch": {
+			adaptive:     true,
+			observations: []observation{proposal(4, 100), proposal(8, 100), proposal(1, 0), proposal(1, 0), proposal(0, 0)},
+			batchSize:    4,
+			proposeDelay: 4 * time.Millisecond,
+		},
+		"delay bounded from above": {
+			adaptive:     true,
+			observations: []observation{proposal(4, 100), proposal(1, 0), proposal(0, 0), proposal(0, 0), proposal(0, 0)},
+			batchSize:    4,
+			proposeDelay: 8 * time.Millisecond,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		tt.Run(name, func(tt *testing.T) {
+			bp := newBatchingPolicy(testBatchingConfig(tc.adaptive), logging.NilLogger)
+			for _, obs := range tc.observations {
+				if obs.commit {
+					bp.ObserveCommit(obs.uncommitted)
+				} else {
+					bp.ObserveProposal(obs.batchSize, obs.pendingLeft)
+				}
+				assert.GreaterOrEqual(tt, bp.ProposeDelay(), time.Millisecond)
+				assert.LessOrEqual(tt, bp.ProposeDelay(), 8*time.Millisecond)
+			}
+			assert.Equal(tt, tc.batchSize, bp.BatchSize())
+			assert.Equal(tt, tc.proposeDelay, bp.ProposeDelay())
+		})
+	}
+}
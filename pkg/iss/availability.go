@@ -0,0 +1,112 @@
+package iss
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/mir/pkg/contextstore"
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// ============================================================
+// Interaction with the availability layer
+// ============================================================
+
+// applyAvailabilityEvent applies an event produced by the availability layer in response to a request made by ISS.
+// These events only occur if ISS is configured to use the availability layer (see Config.UseAvailabilityLayer).
+func (iss *ISS) applyAvailabilityEvent(event *availabilitypb.Event) (*events.EventList, error) {
+	switch e := event.Type.(type) {
+	case *availabilitypb.Event_NewCert:
+		origin, ok := e.NewCert.Origin.Type.(*availabilitypb.RequestCertOrigin_ContextStore)
+		if !ok {
+			return nil, fmt.Errorf("unexpected origin type of NewCert event: %T", e.NewCert.Origin.Type)
+		}
+		return iss.applyNewCert(e.NewCert.Cert, contextstore.ItemID(origin.ContextStore.ItemID))
+	case *availabilitypb.Event_CertVerified:
+		origin, ok := e.CertVerified.Origin.Type.(*availabilitypb.VerifyCertOrigin_ContextStore)
+		if !ok {
+			return nil, fmt.Errorf("unexpected origin type of CertVerified event: %T", e.CertVerified.Origin.Type)
+		}
+		return iss.applyCertVerified(
+			e.CertVerified.Valid,
+			e.CertVerified.Err,
+			contextstore.ItemID(origin.ContextStore.ItemID),
+		)
+	case *availabilitypb.Event_ProvideTransactions:
+		origin, ok := e.ProvideTransactions.Origin.Type.(*availabilitypb.RequestTransactionsOrigin_ContextStore)
+		if !ok {
+			return nil, fmt.Errorf("unexpected origin type of ProvideTransactions event: %T",
+				e.ProvideTransactions.Origin.Type)
+		}
+		return iss.applyProvideTransactions(
+			e.ProvideTransactions.Txs,
+			contextstore.ItemID(origin.ContextStore.ItemID),
+		), nil
+	default:
+		return nil, fmt.Errorf("unexpected type of availability event: %T", event.Type)
+	}
+}
+
+// applyNewCert passes a new availability certificate to the orderer that requested it,
+// in place of the request batch the orderer would otherwise propose.
+// If the orderer belongs to an old epoch, the certificate is ignored.
+func (iss *ISS) applyNewCert(cert *availabilitypb.Cert, itemID contextstore.ItemID) (*events.EventList, error) {
+	origin := iss.certOrigins.RecoverAndDispose(itemID)
+
+	// The batch itself is left empty, as the certificate is proposed instead.
+	// Since no requests are in the buckets, there are no pending requests left either.
+	return iss.applySBEvent(&isspb.SBEvent{
+		Epoch:    origin.Epoch,
+		Instance: origin.Instance,
+		Event:    SBBatchReadyEvent(&requestpb.Batch{Requests: []*requestpb.HashedRequest{}}, cert, 0),
+	})
+}
+
+// applyCertVerified passes the result of verifying an availability certificate to the orderer that requested it.
+// If the orderer belongs to an old epoch, the result is ignored.
+func (iss *ISS) applyCertVerified(valid bool, err string, itemID contextstore.ItemID) (*events.EventList, error) {
+	origin := iss.certOrigins.RecoverAndDispose(itemID)
+
+	return iss.applySBEvent(&isspb.SBEvent{
+		Epoch:    origin.Epoch,
+		Instance: origin.Instance,
+		Event:    SBCertVerifiedEvent(valid, err, origin.Origin),
+	})
+}
+
+// applyProvideTransactions completes a delivered commit log entry with the transactions
+// referred to by its availability certificate and continues processing the entry as any other delivered entry.
+// The committed transactions are also announced to the mempool, so they are not included in any further batch.
+func (iss *ISS) applyProvideTransactions(txs [][]byte, itemID contextstore.ItemID) *events.EventList {
+	entry := iss.pendingLogEntries.RecoverAndDispose(itemID)
+	entry.Batch = iss.txsToBatch(txs, entry.Sn)
+	return iss.hashLogEntry(entry).PushBack(mpevents.TransactionsCommitted(mempoolModuleName, txs))
+}
+
+// txsToBatch converts transactions obtained from the availability layer to a request batch.
+// Each transaction is expected to be a serialized requestpb.Request.
+// Transactions that cannot be deserialized are left out of the batch.
+// Since the transactions are determined by the agreed-upon certificate,
+// all correct nodes leave out the same transactions.
+// The requests in the batch do not carry any digests.
+func (iss *ISS) txsToBatch(txs [][]byte, sn t.SeqNr) *requestpb.Batch {
+	batch := &requestpb.Batch{Requests: make([]*requestpb.HashedRequest, 0, len(txs))}
+
+	for _, tx := range txs {
+		req := &requestpb.Request{}
+		if err := proto.Unmarshal(tx, req); err != nil {
+			iss.logger.Log(logging.LevelWarn, "Ignoring malformed transaction.", "sn", sn, "err", err)
+			continue
+		}
+		batch.Requests = append(batch.Requests, events.HashedRequest(req, nil))
+	}
+
+	return batch
+}
@@ -0,0 +1,102 @@
+package iss
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// benchmarkSystemSizes are the numbers of nodes for which the ISS critical paths are benchmarked.
+var benchmarkSystemSizes = []int{16, 32, 64}
+
+// newBenchmarkISS returns a new ISS instance in epoch 0 of a system with numNodes nodes.
+func newBenchmarkISS(b *testing.B, numNodes int) *ISS {
+	membership := make([]types.NodeID, numNodes)
+	for i := range membership {
+		membership[i] = types.NewNodeIDFromInt(i)
+	}
+
+	iss, err := New(membership[0], DefaultConfig(membership), logging.NilLogger)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return iss
+}
+
+// reportThroughput reports the number of operations per second since start as a custom benchmark metric.
+func reportThroughput(b *testing.B, start time.Time, unit string) {
+	b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), unit)
+}
+
+// BenchmarkValidateSBMessage measures the throughput of validating messages received by the orderers.
+func BenchmarkValidateSBMessage(b *testing.B) {
+	for _, numNodes := range benchmarkSystemSizes {
+		b.Run(fmt.Sprintf("nodes=%d", numNodes), func(b *testing.B) {
+			iss := newBenchmarkISS(b, numNodes)
+
+			// Prepare a message for each orderer.
+			messages := make([]*isspb.SBMessage, len(iss.epoch.Orderers))
+			for i := range messages {
+				messages[i] = &isspb.SBMessage{
+					Epoch:    0,
+					Instance: uint64(i),
+					Msg:      PbftPrepareSBMessage(pbftPrepareMsg(0, 0, []byte{0})),
+				}
+			}
+
+			b.ResetTimer()
+			start := time.Now()
+			for i := 0; i < b.N; i++ {
+				from := iss.epoch.Membership[i%numNodes]
+				if err := iss.epoch.validateSBMessage(messages[i%len(messages)], from); err != nil {
+					b.Fatal(err)
+				}
+			}
+			reportThroughput(b, start, "msgs/s")
+		})
+	}
+}
+
+// BenchmarkHandleHashedRequest measures the throughput of adding new requests to the buckets.
+// Batches are periodically cut from all the buckets, so that the buckets do not grow indefinitely.
+func BenchmarkHandleHashedRequest(b *testing.B) {
+	const numClients = 64
+	const cutPeriod = 1024
+
+	for _, numNodes := range benchmarkSystemSizes {
+		b.Run(fmt.Sprintf("nodes=%d", numNodes), func(b *testing.B) {
+			iss := newBenchmarkISS(b, numNodes)
+
+			// Generate all requests in advance.
+			requests := make([]*requestpb.HashedRequest, b.N)
+			for i := range requests {
+				requests[i] = events.HashedRequest(
+					events.ClientRequest(
+						types.NewClientIDFromInt(i%numClients),
+						types.ReqNo(i/numClients),
+						[]byte{byte(i)},
+					),
+					[]byte(fmt.Sprintf("%032d", i)),
+				)
+			}
+
+			b.ResetTimer()
+			start := time.Now()
+			for i, req := range requests {
+				iss.handleHashedRequest(req)
+				if i%cutPeriod == cutPeriod-1 {
+					for _, buckets := range iss.epoch.ordererBuckets {
+						buckets.CutBatch(cutPeriod)
+					}
+				}
+			}
+			reportThroughput(b, start, "reqs/s")
+		})
+	}
+}
@@ -31,7 +31,7 @@ type requestBucket struct {
 	// New requests are added to the "back" of this list, new batches are cut from the "front".
 	reqList list.List
 
-	// Map index of the list elements, index by (a string representation of) requests.
+	// Map index of the list elements, indexed by requests (including their digests).
 	// This is required for efficiently removing requests from the list.
 	// On removal, the list element corresponding to the request being removed
 	// is first looked up in the list (constant time) and then unlinked from the list (constant time)
@@ -47,7 +47,7 @@ type requestBucket struct {
 	// TODO: Implement garbage collection. It might be helpful
 	//       to make the hash function only take client ID and request Nr as arguments,
 	//       instead of the whole request.
-	reqMap map[string]*list.Element
+	reqMap map[reqMapKey]*list.Element
 
 	// TODO: Make sure the system works well even if a malicious client tries to submit conflicting requests.
 	//       If any conflicting requests end up in a bucket, make sure to garbage-collect them.
@@ -60,7 +60,7 @@ type requestBucket struct {
 func newRequestBucket(id int, logger logging.Logger) *requestBucket {
 	return &requestBucket{
 		ID:      id,
-		reqMap:  make(map[string]*list.Element),
+		reqMap:  make(map[reqMapKey]*list.Element),
 		reqList: list.List{},
 		logger:  logger,
 	}
@@ -82,7 +82,7 @@ func (b *requestBucket) Len() int {
 func (b *requestBucket) Add(req *requestpb.HashedRequest) bool {
 
 	// Compute map key of request.
-	key := reqStrKey(req)
+	key := reqKey(req)
 
 	// If request has already been added to the bucket, do not add it again.
 	// It is important to check for the presence of the entry in reqMap (using the second return value)
@@ -106,23 +106,25 @@ func (b *requestBucket) Add(req *requestpb.HashedRequest) bool {
 func (b *requestBucket) Remove(req *requestpb.HashedRequest) {
 
 	// Look up the corresponding element in the reqMap.
-	reqKey := reqStrKey(req)
-	element, ok := b.reqMap[reqKey]
+	key := reqKey(req)
+	element, ok := b.reqMap[key]
 
 	if !ok {
 		// Request has never been added or has been garbage-collected.
 		// We still mark it as removed, so it cannot be added later using Add().
-		b.logger.Log(logging.LevelDebug, "Request to remove not in bucket.", "reqKey", reqKey)
-		b.reqMap[reqKey] = nil
+		b.logger.Log(logging.LevelDebug, "Request to remove not in bucket.",
+			"clientID", key.clientID, "reqNo", key.reqNo)
+		b.reqMap[key] = nil
 	} else if element == nil {
 		// Request has already been removed.
-		b.logger.Log(logging.LevelWarn, "Request to remove already removed.", "reqKey", reqKey)
+		b.logger.Log(logging.LevelWarn, "Request to remove already removed.",
+			"clientID", key.clientID, "reqNo", key.reqNo)
 	} else {
 		// Request present.
 		// Remove it from the list and set its map entry to nil
 		// (important: keep the nil map entry until client watermarks move past this request)
-		b.reqList.Remove(b.reqMap[reqKey])
-		b.reqMap[reqKey] = nil
+		b.reqList.Remove(element)
+		b.reqMap[key] = nil
 	}
 }
 
@@ -131,7 +133,14 @@ func (b *requestBucket) Remove(req *requestpb.HashedRequest) {
 // as well as resurrected requests that have not been removed since resurrection.
 func (b *requestBucket) Contains(req *requestpb.HashedRequest) bool {
 	// We check against nil on purpose, as we are not interested in removed requests here.
-	return b.reqMap[reqStrKey(req)] != nil
+	return b.reqMap[reqKey(req)] != nil
+}
+
+// Known returns true if the given request has been added to the bucket, even if it has been removed since.
+// Such a request would not be added again by Add().
+func (b *requestBucket) Known(req *requestpb.HashedRequest) bool {
+	_, ok := b.reqMap[reqKey(req)]
+	return ok
 }
 
 // RemoveFirst removes the first up to n requests from the bucket and appends them to the accumulator acc.
@@ -154,7 +163,7 @@ func (b *requestBucket) RemoveFirst(n int, acc []*requestpb.HashedRequest) []*re
 func (b *requestBucket) Resurrect(req *requestpb.HashedRequest) {
 
 	// Compute map key of request.
-	key := reqStrKey(req)
+	key := reqKey(req)
 
 	// If request is not in the reqMap or request already is in the bucket, panic. This must never happen.
 	if element, ok := b.reqMap[key]; !ok || element == nil {
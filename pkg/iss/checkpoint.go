@@ -18,9 +18,11 @@ import (
 
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/commonpb"
 	"github.com/filecoin-project/mir/pkg/pb/isspb"
 	"github.com/filecoin-project/mir/pkg/serializing"
 	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/maputil"
 )
 
 // checkpointTracker represents the state associated with a single instance of the checkpoint protocol
@@ -48,12 +50,22 @@ type checkpointTracker struct {
 	// Application snapshot data associated with this checkpoint.
 	appSnapshot []byte
 
-	// Hash of the application snapshot data associated with this checkpoint.
+	// The requests delivered to the application at this checkpoint.
+	clientProgress *isspb.ClientProgress
+
+	// Hash of the application snapshot data and the client progress associated with this checkpoint.
 	appSnapshotHash []byte
 
-	// Set of (potentially invalid) nodes' signatures.
+	// Signatures of nodes from which a Checkpoint message has been received and authenticated,
+	// either by verifying the signature itself or, with MAC authentication, the MAC of the message.
 	signatures map[t.NodeID][]byte
 
+	// Signatures of received Checkpoint messages whose authentication is in progress.
+	// A signature is only moved to signatures once its message has been authenticated
+	// and is discarded otherwise, so that a forged message does not prevent
+	// the genuine message of the same node from being accepted later.
+	unverifiedSigs map[t.NodeID][]byte
+
 	// Set of nodes from which a valid Checkpoint messages has been received.
 	confirmations map[t.NodeID]struct{}
 
@@ -62,6 +74,18 @@ type checkpointTracker struct {
 
 	// Time interval for repeated retransmission of checkpoint messages.
 	resendPeriod t.TimeDuration
+
+	// If set to true, Checkpoint messages carry authenticators and only the MAC of a received Checkpoint message
+	// is verified on reception. The signatures are only verified, as a single batch,
+	// once the Checkpoint messages with a valid MAC form a strong quorum.
+	macAuthentication bool
+
+	// Set of nodes from which a Checkpoint message with a valid MAC has been received,
+	// but whose signature has not yet been verified. Only used with MAC authentication.
+	macConfirmations map[t.NodeID]struct{}
+
+	// Set to true while the signatures of nodes from macConfirmations are being verified.
+	verifyingSigs bool
 }
 
 // newCheckpointTracker allocates and returns a new instance of a checkpointTracker associated with sequence number sn.
@@ -70,27 +94,35 @@ func newCheckpointTracker(
 	sn t.SeqNr,
 	epoch t.EpochNr,
 	resendPeriod t.TimeDuration,
+	macAuthentication bool,
 	logger logging.Logger,
 ) *checkpointTracker {
 	return &checkpointTracker{
-		Logger:          logger,
-		ownID:           ownID,
-		seqNr:           sn,
-		epoch:           epoch,
-		resendPeriod:    resendPeriod,
-		signatures:      make(map[t.NodeID][]byte),
-		confirmations:   make(map[t.NodeID]struct{}),
-		pendingMessages: make(map[t.NodeID]*isspb.Checkpoint),
-		// the membership field will be set later by iss.startCheckpoint
+		Logger:            logger,
+		ownID:             ownID,
+		seqNr:             sn,
+		epoch:             epoch,
+		resendPeriod:      resendPeriod,
+		macAuthentication: macAuthentication,
+		signatures:        make(map[t.NodeID][]byte),
+		unverifiedSigs:    make(map[t.NodeID][]byte),
+		confirmations:     make(map[t.NodeID]struct{}),
+		macConfirmations:  make(map[t.NodeID]struct{}),
+		pendingMessages:   make(map[t.NodeID]*isspb.Checkpoint),
+		// the membership and clientProgress fields will be set later by Start
 		// the appSnapshot field will be set by ProcessAppSnapshot
 	}
 }
 
 // Start initiates the checkpoint protocol among nodes in membership.
-// The checkpoint to be produced encompasses all currently delivered sequence numbers.
+// The checkpoint to be produced encompasses all currently delivered sequence numbers,
+// with clientProgress describing the requests delivered up to that point.
 // If Start is called during epoch transition,
 // it must be called with the old epoch's membership.
-func (ct *checkpointTracker) Start(membership []t.NodeID) *events.EventList {
+func (ct *checkpointTracker) Start(membership []t.NodeID, clientProgress *isspb.ClientProgress) *events.EventList {
+
+	// Save the client progress, which is checkpointed together with the application state.
+	ct.clientProgress = clientProgress
 
 	// Save the membership this instance of the checkpoint protocol will use.
 	// This is required in case where the membership changes before the checkpoint sub-protocol finishes.
@@ -108,8 +140,12 @@ func (ct *checkpointTracker) ProcessAppSnapshot(snapshot []byte) *events.EventLi
 	// Save received snapshot
 	ct.appSnapshot = snapshot
 
-	// Initiate computing the hash of the snapshot
-	hashEvent := events.HashRequest(hasherModuleName, [][][]byte{{snapshot}}, AppSnapshotHashOrigin(ct.epoch))
+	// Initiate computing the hash of the snapshot, together with the client progress.
+	hashEvent := events.HashRequest(
+		hasherModuleName,
+		[][][]byte{serializing.CheckpointForHash(snapshot, ct.clientProgress)},
+		AppSnapshotHashOrigin(ct.epoch),
+	)
 
 	return events.ListOf(hashEvent)
 }
@@ -133,17 +169,21 @@ func (ct *checkpointTracker) ProcessCheckpointSignResult(signature []byte) *even
 	ct.confirmations[ct.ownID] = struct{}{}
 
 	// Write Checkpoint to WAL
-	persistEvent := PersistCheckpointEvent(ct.seqNr, ct.appSnapshot, ct.appSnapshotHash, signature)
+	persistEvent := PersistCheckpointEvent(ct.seqNr, ct.appSnapshot, ct.clientProgress, ct.appSnapshotHash, signature)
 	walEvent := events.WALAppend(walModuleName, persistEvent, t.WALRetIndex(ct.epoch))
 
 	// Send a checkpoint message to all nodes after persisting checkpoint to the WAL.
-	m := CheckpointMessage(ct.epoch, ct.seqNr, ct.appSnapshotHash, signature)
-	walEvent.FollowUp(events.TimerRepeat(
-		"timer",
-		[]*eventpb.Event{events.SendMessage(netModuleName, m, ct.membership)},
-		ct.resendPeriod,
-		t.TimerRetIndex(ct.epoch)),
-	)
+	// With MAC authentication, the message is only sent when its authenticator has been computed.
+	if ct.macAuthentication {
+		walEvent.FollowUp(events.ComputeAuthenticator(
+			cryptoModuleName,
+			serializing.CheckpointForSig(ct.epoch, ct.seqNr, ct.appSnapshotHash),
+			ct.membership,
+			CheckpointAuthSendOrigin(ct.epoch),
+		))
+	} else {
+		walEvent.FollowUp(ct.sendCheckpointMessage(nil))
+	}
 
 	// Apply pending Checkpoint messages
 	for s, m := range ct.pendingMessages {
@@ -155,6 +195,23 @@ func (ct *checkpointTracker) ProcessCheckpointSignResult(signature []byte) *even
 	return events.ListOf(walEvent)
 }
 
+// ProcessAuthenticatorComputed starts the periodic sending of the own Checkpoint message
+// with the computed authenticator attached.
+func (ct *checkpointTracker) ProcessAuthenticatorComputed(authenticator *commonpb.Authenticator) *events.EventList {
+	return events.ListOf(ct.sendCheckpointMessage(authenticator))
+}
+
+// sendCheckpointMessage returns an event repeatedly sending the own Checkpoint message to all nodes.
+func (ct *checkpointTracker) sendCheckpointMessage(authenticator *commonpb.Authenticator) *eventpb.Event {
+	m := CheckpointMessage(ct.epoch, ct.seqNr, ct.appSnapshotHash, ct.signatures[ct.ownID], authenticator)
+	return events.TimerRepeat(
+		"timer",
+		[]*eventpb.Event{events.SendMessage(netModuleName, m, ct.membership)},
+		ct.resendPeriod,
+		t.TimerRetIndex(ct.epoch),
+	)
+}
+
 func (ct *checkpointTracker) applyMessage(msg *isspb.Checkpoint, source t.NodeID) *events.EventList {
 
 	// If checkpoint is already stable, ignore message.
@@ -176,14 +233,37 @@ func (ct *checkpointTracker) applyMessage(msg *isspb.Checkpoint, source t.NodeID
 	// TODO: Only accept messages from nodes in membership.
 	//       This might be more tricky than it seems, especially when the membership is not yet initialized.
 
-	// Ignore duplicate messages.
+	// Ignore duplicate messages, as well as messages received while a message from the same node is being verified.
 	if _, ok := ct.signatures[source]; ok {
 		return events.EmptyList()
 	}
-	ct.signatures[source] = msg.Signature
+	if _, ok := ct.unverifiedSigs[source]; ok {
+		return events.EmptyList()
+	}
 
-	// Verify signature of the sender.
 	sigData := serializing.CheckpointForSig(ct.epoch, ct.seqNr, ct.appSnapshotHash)
+
+	// With MAC authentication, only verify the MAC the sender computed for this node.
+	// The signature is verified later, together with the signatures of other nodes.
+	if ct.macAuthentication {
+		mac, ok := authenticatorMAC(msg.Authenticator, ct.ownID)
+		if !ok {
+			ct.Log(logging.LevelWarn, "Ignoring Checkpoint message. No MAC for this node.", "source", source)
+			return events.EmptyList()
+		}
+		ct.unverifiedSigs[source] = msg.Signature
+
+		return events.ListOf(events.VerifyAuthenticators(
+			cryptoModuleName,
+			[][][]byte{sigData},
+			[][]byte{mac},
+			[]t.NodeID{source},
+			CheckpointAuthReceiveOrigin(ct.epoch, source),
+		))
+	}
+	ct.unverifiedSigs[source] = msg.Signature
+
+	// Verify signature of the sender.
 	verifySigEvent := events.VerifyNodeSigs(
 		cryptoModuleName,
 		[][][]byte{sigData},
@@ -197,13 +277,14 @@ func (ct *checkpointTracker) applyMessage(msg *isspb.Checkpoint, source t.NodeID
 
 func (ct *checkpointTracker) ProcessSigVerified(valid bool, err string, source t.NodeID) *events.EventList {
 
-	if !valid {
+	signature, ok := ct.authenticated(valid, source)
+	if !ok {
 		ct.Log(logging.LevelWarn, "Ignoring Checkpoint message. Invalid signature.", "source", source, "error", err)
-		ct.signatures[source] = nil
 		return events.EmptyList()
 	}
 
 	// Note the reception of a valid Checkpoint message from node `source`.
+	ct.signatures[source] = signature
 	ct.confirmations[source] = struct{}{}
 
 	// If, after having applied this message, the checkpoint became stable, produce the necessary events.
@@ -214,6 +295,92 @@ func (ct *checkpointTracker) ProcessSigVerified(valid bool, err string, source t
 	return events.EmptyList()
 }
 
+// authenticated removes the signature of the Checkpoint message received from source from unverifiedSigs
+// and returns it, along with true, if the message has been successfully authenticated.
+// If the authentication failed (or no message from source is being verified), it returns false.
+func (ct *checkpointTracker) authenticated(valid bool, source t.NodeID) ([]byte, bool) {
+	signature, ok := ct.unverifiedSigs[source]
+	delete(ct.unverifiedSigs, source)
+	return signature, ok && valid
+}
+
+// ProcessMACVerified processes the result of verifying the MAC of a Checkpoint message received from source.
+// When the nodes whose MAC has been verified form a strong quorum, their signatures are verified.
+func (ct *checkpointTracker) ProcessMACVerified(valid bool, err string, source t.NodeID) *events.EventList {
+
+	signature, ok := ct.authenticated(valid, source)
+	if !ok {
+		ct.Log(logging.LevelWarn, "Ignoring Checkpoint message. Invalid MAC.", "source", source, "error", err)
+		return events.EmptyList()
+	}
+
+	if ct.stable() {
+		return events.EmptyList()
+	}
+
+	ct.signatures[source] = signature
+	ct.macConfirmations[source] = struct{}{}
+	return ct.verifyConfirmedSigs()
+}
+
+// ProcessCertSigsVerified processes the result of verifying the signatures of nodes
+// whose Checkpoint messages had a valid MAC.
+// Only nodes with a valid signature count towards the stability of the checkpoint.
+func (ct *checkpointTracker) ProcessCertSigsVerified(
+	valid []bool,
+	errs []string,
+	nodeIDs []t.NodeID,
+) *events.EventList {
+	ct.verifyingSigs = false
+
+	for i, nodeID := range nodeIDs {
+		if valid[i] {
+			ct.confirmations[nodeID] = struct{}{}
+		} else {
+			ct.Log(logging.LevelWarn, "Ignoring Checkpoint message. Invalid signature.",
+				"source", nodeID, "error", errs[i])
+			delete(ct.signatures, nodeID)
+		}
+	}
+
+	if ct.stable() {
+		return ct.announceStable()
+	}
+
+	// If some signatures were invalid, wait for more Checkpoint messages
+	// (or verify the ones with a valid MAC that arrived in the meantime).
+	return ct.verifyConfirmedSigs()
+}
+
+// verifyConfirmedSigs requests the verification of the signatures of the nodes in macConfirmations
+// if, together with the already verified signatures, they are enough to make the checkpoint stable.
+func (ct *checkpointTracker) verifyConfirmedSigs() *events.EventList {
+	if ct.verifyingSigs || len(ct.confirmations)+len(ct.macConfirmations) < strongQuorum(len(ct.membership)) {
+		return events.EmptyList()
+	}
+
+	sigData := serializing.CheckpointForSig(ct.epoch, ct.seqNr, ct.appSnapshotHash)
+	data := make([][][]byte, 0, len(ct.macConfirmations))
+	signatures := make([][]byte, 0, len(ct.macConfirmations))
+	nodeIDs := make([]t.NodeID, 0, len(ct.macConfirmations))
+	maputil.IterateSorted(ct.macConfirmations, func(nodeID t.NodeID, _ struct{}) bool {
+		data = append(data, sigData)
+		signatures = append(signatures, ct.signatures[nodeID])
+		nodeIDs = append(nodeIDs, nodeID)
+		return true
+	})
+
+	ct.macConfirmations = make(map[t.NodeID]struct{})
+	ct.verifyingSigs = true
+	return events.ListOf(events.VerifyNodeSigs(
+		cryptoModuleName,
+		data,
+		signatures,
+		nodeIDs,
+		CheckpointCertSigVerOrigin(ct.epoch),
+	))
+}
+
 func (ct *checkpointTracker) stable() bool {
 	return ct.appSnapshot != nil && len(ct.confirmations) >= strongQuorum(len(ct.membership))
 }
@@ -228,10 +395,11 @@ func (ct *checkpointTracker) announceStable() *events.EventList {
 
 	// Create a stable checkpoint object.
 	stableCheckpoint := &isspb.StableCheckpoint{
-		Epoch:       ct.epoch.Pb(),
-		Sn:          ct.seqNr.Pb(),
-		AppSnapshot: ct.appSnapshot,
-		Cert:        cert,
+		Epoch:          ct.epoch.Pb(),
+		Sn:             ct.seqNr.Pb(),
+		AppSnapshot:    ct.appSnapshot,
+		Cert:           cert,
+		ClientProgress: ct.clientProgress,
 	}
 
 	// First persist the checkpoint in the WAL, then announce it to the protocol.
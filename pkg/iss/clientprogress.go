@@ -0,0 +1,126 @@
+package iss
+
+import (
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/maputil"
+)
+
+// clientWatermarks tracks the delivered requests of a single client.
+// All requests with request numbers below lowWatermark have been delivered.
+// Of the requests with higher request numbers, exactly those in delivered have been delivered.
+// As clients are expected to use consecutive request numbers,
+// the low watermark keeps advancing and the delivered set remains small.
+// Moreover, the delivered set never contains request numbers more than the window size above the low watermark.
+type clientWatermarks struct {
+	lowWatermark t.ReqNo
+	delivered    map[t.ReqNo]struct{}
+}
+
+// clientProgress tracks, for each client, the requests that have been delivered to the application,
+// so that each request is delivered at most once, even if it is committed multiple times.
+// This happens with the availability layer, where the mempool of each node may include
+// the same request in a different availability certificate.
+// It could also happen if a faulty leader proposed a request that has already been committed.
+// Since the requests are marked as delivered in the order of the sequence numbers they are committed at,
+// all correct nodes deliver the same requests.
+// The client progress is part of each checkpoint and is restored together with the application state.
+type clientProgress struct {
+	clients map[t.ClientID]*clientWatermarks
+
+	// Maximal number of request numbers above its low watermark for which a client's delivered requests are tracked.
+	// When a client's request with a higher request number is delivered,
+	// the low watermark is advanced, such that the requests left below it are never delivered.
+	windowSize t.ReqNo
+}
+
+func newClientProgress(windowSize int) *clientProgress {
+	return &clientProgress{clients: make(map[t.ClientID]*clientWatermarks), windowSize: t.ReqNo(windowSize)}
+}
+
+// clientProgressFromPb returns the client progress represented by cpPb (which may be nil, representing no progress).
+func clientProgressFromPb(cpPb *isspb.ClientProgress, windowSize int) *clientProgress {
+	cp := newClientProgress(windowSize)
+	for _, dr := range cpPb.GetClients() {
+		cw := &clientWatermarks{lowWatermark: t.ReqNo(dr.LowWm), delivered: make(map[t.ReqNo]struct{})}
+		for _, reqNo := range dr.Delivered {
+			cw.delivered[t.ReqNo(reqNo)] = struct{}{}
+		}
+		cp.clients[t.ClientID(dr.ClientId)] = cw
+	}
+	return cp
+}
+
+// Pb returns a protobuf representation of the client progress, with clients and request numbers sorted.
+func (cp *clientProgress) Pb() *isspb.ClientProgress {
+	clients := make([]*isspb.DeliveredReqs, 0, len(cp.clients))
+	maputil.IterateSorted(cp.clients, func(clientID t.ClientID, cw *clientWatermarks) bool {
+		delivered := make([]uint64, 0, len(cw.delivered))
+		maputil.IterateSorted(cw.delivered, func(reqNo t.ReqNo, _ struct{}) bool {
+			delivered = append(delivered, reqNo.Pb())
+			return true
+		})
+		clients = append(clients, &isspb.DeliveredReqs{
+			ClientId:  clientID.Pb(),
+			LowWm:     cw.lowWatermark.Pb(),
+			Delivered: delivered,
+		})
+		return true
+	})
+	return &isspb.ClientProgress{Clients: clients}
+}
+
+// Deliver marks the request with request number reqNo of client clientID as delivered.
+// It returns false if the request already has been delivered and true otherwise.
+func (cp *clientProgress) Deliver(clientID t.ClientID, reqNo t.ReqNo) bool {
+	cw, ok := cp.clients[clientID]
+	if !ok {
+		cw = &clientWatermarks{lowWatermark: 0, delivered: make(map[t.ReqNo]struct{})}
+		cp.clients[clientID] = cw
+	}
+
+	if reqNo < cw.lowWatermark {
+		return false
+	}
+	if _, ok := cw.delivered[reqNo]; ok {
+		return false
+	}
+	cw.delivered[reqNo] = struct{}{}
+
+	// If the request is beyond the window, move the window, giving up on the requests left below it.
+	if reqNo >= cw.lowWatermark+cp.windowSize {
+		newLowWatermark := reqNo - cp.windowSize + 1
+		for delivered := range cw.delivered {
+			if delivered < newLowWatermark {
+				delete(cw.delivered, delivered)
+			}
+		}
+		cw.lowWatermark = newLowWatermark
+	}
+
+	// Advance the low watermark past all consecutive delivered requests.
+	for _, ok := cw.delivered[cw.lowWatermark]; ok; _, ok = cw.delivered[cw.lowWatermark] {
+		delete(cw.delivered, cw.lowWatermark)
+		cw.lowWatermark++
+	}
+
+	return true
+}
+
+// FilterBatch returns a batch containing the requests of the given batch that have not yet been delivered
+// (including those that appear multiple times in the given batch) and marks them as delivered.
+// If no request is filtered out, FilterBatch returns the given batch itself.
+func (cp *clientProgress) FilterBatch(batch *requestpb.Batch) *requestpb.Batch {
+	filtered := make([]*requestpb.HashedRequest, 0, len(batch.Requests))
+	for _, req := range batch.Requests {
+		if cp.Deliver(t.ClientID(req.Req.ClientId), t.ReqNo(req.Req.ReqNo)) {
+			filtered = append(filtered, req)
+		}
+	}
+
+	if len(filtered) == len(batch.Requests) {
+		return batch
+	}
+	return &requestpb.Batch{Requests: filtered}
+}
@@ -0,0 +1,138 @@
+package iss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/serializing"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestClientProgress(t *testing.T) {
+	cp := newClientProgress(16)
+
+	// Requests can be delivered out of order, but only once.
+	assert.True(t, cp.Deliver("client", 1))
+	assert.True(t, cp.Deliver("client", 0))
+	assert.False(t, cp.Deliver("client", 0))
+	assert.False(t, cp.Deliver("client", 1))
+	assert.True(t, cp.Deliver("client", 3))
+	assert.False(t, cp.Deliver("client", 3))
+	assert.True(t, cp.Deliver("other", 0))
+
+	// Only the requests above the low watermark are stored.
+	assert.Equal(t, types.ReqNo(2), cp.clients["client"].lowWatermark)
+	assert.Len(t, cp.clients["client"].delivered, 1)
+	assert.True(t, cp.Deliver("client", 2))
+	assert.Equal(t, types.ReqNo(4), cp.clients["client"].lowWatermark)
+	assert.Empty(t, cp.clients["client"].delivered)
+}
+
+func TestClientProgressFilterBatch(t *testing.T) {
+	request := func(clientID types.ClientID, reqNo types.ReqNo) *requestpb.HashedRequest {
+		return events.HashedRequest(events.ClientRequest(clientID, reqNo, []byte{0}), nil)
+	}
+	cp := newClientProgress(16)
+
+	// A batch without duplicates is not modified.
+	batch := &requestpb.Batch{Requests: []*requestpb.HashedRequest{request("a", 0), request("b", 0)}}
+	assert.Same(t, batch, cp.FilterBatch(batch))
+
+	// Requests delivered before and repeated requests are left out.
+	batch = &requestpb.Batch{Requests: []*requestpb.HashedRequest{
+		request("a", 0), request("a", 1), request("b", 0), request("a", 1), request("b", 1),
+	}}
+	assert.Equal(t, []*requestpb.HashedRequest{batch.Requests[1], batch.Requests[4]}, cp.FilterBatch(batch).Requests)
+}
+
+func TestClientProgressWindow(t *testing.T) {
+	cp := newClientProgress(4)
+
+	// Requests beyond the window move the window, and the requests left below it are never delivered.
+	assert.True(t, cp.Deliver("client", 1))
+	assert.True(t, cp.Deliver("client", 3))
+	assert.True(t, cp.Deliver("client", 5))
+	assert.Equal(t, types.ReqNo(2), cp.clients["client"].lowWatermark)
+	assert.False(t, cp.Deliver("client", 0))
+	assert.False(t, cp.Deliver("client", 1))
+	assert.True(t, cp.Deliver("client", 2))
+	assert.Equal(t, types.ReqNo(4), cp.clients["client"].lowWatermark)
+
+	// The delivered requests above the low watermark never exceed the window.
+	assert.True(t, cp.Deliver("client", 100))
+	assert.Equal(t, types.ReqNo(97), cp.clients["client"].lowWatermark)
+	assert.Len(t, cp.clients["client"].delivered, 1)
+	assert.False(t, cp.Deliver("client", 96))
+	assert.True(t, cp.Deliver("client", 97))
+}
+
+func TestClientProgressPb(t *testing.T) {
+	cp := newClientProgress(16)
+	for _, reqNo := range []types.ReqNo{0, 1, 5, 3} {
+		cp.Deliver("b", reqNo)
+	}
+	cp.Deliver("a", 0)
+
+	// The protobuf representation is sorted, so that it is the same at all nodes.
+	cpPb := cp.Pb()
+	assert.Equal(t, "a", cpPb.Clients[0].ClientId)
+	assert.Equal(t, "b", cpPb.Clients[1].ClientId)
+	assert.Equal(t, uint64(2), cpPb.Clients[1].LowWm)
+	assert.Equal(t, []uint64{3, 5}, cpPb.Clients[1].Delivered)
+
+	// The restored client progress does not deliver any request again.
+	restored := clientProgressFromPb(cpPb, 16)
+	assert.Equal(t, cp.clients, restored.clients)
+	assert.False(t, restored.Deliver("b", 3))
+	assert.True(t, restored.Deliver("b", 4))
+	assert.True(t, restored.Deliver("c", 0))
+
+	// A missing client progress represents no delivered requests.
+	assert.Empty(t, clientProgressFromPb(nil, 16).clients)
+}
+
+func TestStableCheckpointRestoresClientProgress(t *testing.T) {
+	membership := []types.NodeID{"0", "1", "2", "3"}
+	iss, err := New(membership[0], DefaultConfig(membership), logging.NilLogger)
+	require.NoError(t, err)
+
+	delivered := newClientProgress(16)
+	delivered.Deliver("client", 0)
+	delivered.Deliver("client", 2)
+	chkp := &isspb.StableCheckpoint{
+		Epoch:          5,
+		Sn:             20,
+		AppSnapshot:    []byte("snapshot"),
+		Cert:           map[string][]byte{"1": []byte("sig1"), "2": []byte("sig2")},
+		ClientProgress: delivered.Pb(),
+	}
+
+	// The checkpointed state, including the client progress, is hashed before the certificate is verified.
+	evts := iss.applyStableCheckpointMessage(chkp, "1")
+	require.Equal(t, 1, evts.Len())
+	hashRequest := evts.Slice()[0].GetHashRequest()
+	require.NotNil(t, hashRequest)
+	assert.Equal(t, serializing.CheckpointForHash(chkp.AppSnapshot, chkp.ClientProgress), hashRequest.Data[0].Data)
+
+	// Each signature of the certificate is verified against the hash.
+	evts = iss.applyStableCheckpointHashResult([]byte("hash"), chkp)
+	require.Equal(t, 1, evts.Len())
+	verify := evts.Slice()[0].GetVerifyNodeSigs()
+	require.NotNil(t, verify)
+	assert.Equal(t, types.NodeIDSlicePb([]types.NodeID{"1", "2"}), verify.NodeIds)
+	for _, data := range verify.Data {
+		assert.Equal(t, serializing.CheckpointForSig(5, 20, []byte("hash")), data.Data)
+	}
+
+	// Installing the checkpoint restores the requests delivered up to it.
+	iss.applyStableCheckpointSigVerResult(true, chkp)
+	assert.False(t, iss.delivered.Deliver("client", 0))
+	assert.False(t, iss.delivered.Deliver("client", 2))
+	assert.True(t, iss.delivered.Deliver("client", 1))
+}
@@ -0,0 +1,51 @@
+package iss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// rejectedRequests returns the request numbers of all requests rejected by the given events.
+func rejectedRequests(evts *events.EventList) []uint64 {
+	reqNos := make([]uint64, 0)
+	for _, event := range evts.Slice() {
+		if rejected := event.GetRequestRejected(); rejected != nil {
+			reqNos = append(reqNos, rejected.ReqNo)
+		}
+	}
+	return reqNos
+}
+
+func TestOutstandingRequestQuota(t *testing.T) {
+	membership := []types.NodeID{"0", "1", "2", "3"}
+	config := DefaultConfig(membership)
+	config.ClientQuota.MaxOutstandingRequests = 2
+	iss, err := New(membership[0], config, logging.NilLogger)
+	require.NoError(t, err)
+
+	request := func(reqNo types.ReqNo) *requestpb.HashedRequest {
+		return events.HashedRequest(events.ClientRequest("client", reqNo, []byte{0}), []byte{byte(reqNo)})
+	}
+
+	// The client can have two requests outstanding.
+	assert.Empty(t, rejectedRequests(iss.handleHashedRequest(request(0))))
+	assert.Empty(t, rejectedRequests(iss.handleHashedRequest(request(1))))
+
+	// Retransmissions of outstanding requests are ignored rather than rejected.
+	assert.Empty(t, rejectedRequests(iss.handleHashedRequest(request(0))))
+	assert.Empty(t, rejectedRequests(iss.handleHashedRequest(request(1))))
+
+	// A third request is rejected.
+	assert.Equal(t, []uint64{2}, rejectedRequests(iss.handleHashedRequest(request(2))))
+
+	// Other clients are not affected.
+	otherRequest := events.HashedRequest(events.ClientRequest("other", 0, []byte{0}), []byte{0})
+	assert.Empty(t, rejectedRequests(iss.handleHashedRequest(otherRequest)))
+}
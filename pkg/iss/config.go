@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/filecoin-project/mir/pkg/clientquota"
 	t "github.com/filecoin-project/mir/pkg/types"
 )
 
@@ -56,6 +57,33 @@ type Config struct {
 	// Must not be negative.
 	MaxProposeDelay time.Duration
 
+	// If set to true, each orderer adapts the size of the batches it proposes and the delay between its proposals
+	// to the observed load, instead of statically using MaxBatchSize and MaxProposeDelay.
+	// The batch size then varies between MinBatchSize and MaxBatchSize
+	// and the propose delay between MinProposeDelay and MaxProposeDelay.
+	// Adaptive batching only affects the proposals of this node and does not need to be enabled uniformly
+	// across all nodes.
+	AdaptiveBatching bool
+
+	// The lower bound on the batch size with adaptive batching.
+	// Only used if AdaptiveBatching is true, in which case it must be positive and not greater than MaxBatchSize.
+	MinBatchSize t.NumRequests
+
+	// The lower bound on the delay between two proposals of an orderer with adaptive batching.
+	// Only used if AdaptiveBatching is true,
+	// in which case it must be positive and not greater than MaxProposeDelay.
+	MinProposeDelay time.Duration
+
+	// If set to true, ISS decouples the ordering of requests from their dissemination.
+	// Orderers then do not propose request batches, but availability certificates obtained from the availability layer
+	// (expected as the "availability" module) and verify the certificates of received proposals before accepting them.
+	// On delivery of a certificate, ISS retrieves the transactions it refers to from the availability layer.
+	// New requests are not added to the buckets, but forwarded to the "mempool" module in a NewRequests event.
+	// Each transaction is expected to be a serialized requestpb.Request.
+	// The transactions of each delivered certificate are announced to the mempool in a TransactionsCommitted event.
+	// ClientQuota.MaxOutstandingRequests is not enforced and AdaptiveBatching must be false in this mode.
+	UseAvailabilityLayer bool
+
 	// Total number of buckets used by ISS.
 	// In each epoch, these buckets are re-distributed evenly among the orderers.
 	// Must be greater than 0.
@@ -75,18 +103,42 @@ type Config struct {
 	// Must be positive.
 	RequestNAckTimeout int
 
-	// Maximal number of bytes used for message backlogging buffers
+	// Maximal number of bytes used for the ISS message backlogging buffers
 	// (only message payloads are counted towards MsgBufCapacity).
 	// On reception of a message that the node is not yet ready to process
 	// (e.g., a message from a future epoch received from another node that already transitioned to that epoch),
 	// the message is stored in a buffer for later processing (e.g., when this node also transitions to that epoch).
 	// This total buffer capacity is evenly split among multiple buffers, one for each node,
 	// so that one misbehaving node cannot exhaust the whole buffer space.
-	// The most recently received messages that together do not exceed the capacity are stored.
+	// When a buffer is full, messages from the nearest epochs are preferentially kept
+	// and, among messages from the same epoch, the most recently received ones.
+	// The numbers of dropped messages can be obtained through ISS.DroppedMessages.
 	// If the capacity is set to 0, all messages that cannot yet be processed are dropped on reception.
 	// Must not be negative.
 	MsgBufCapacity int
 
+	// Same as MsgBufCapacity, but for the message backlogging buffers of each PBFT orderer,
+	// which store messages from future views.
+	// When a buffer is full, messages from the nearest views are preferentially kept.
+	// Must not be negative.
+	PBFTMsgBufCapacity int
+
+	// Per-client limits on the submitted requests.
+	// ISS enforces ClientQuota.MaxRequestBytes on reception of new requests
+	// and ClientQuota.MaxOutstandingRequests on insertion of requests in the buckets.
+	// Rejected requests are reported to the "clients" module through RequestRejected events.
+	// ClientQuota.Rate is not enforced by ISS, since rate limiting depends on wall-clock time
+	// and ISS must remain deterministic. Rate limiting is performed by the RequestReceiver instead.
+	ClientQuota clientquota.Params
+
+	// Number of request numbers, above the lowest request number not yet delivered,
+	// for which ISS keeps track of which requests of a client have been delivered (and must not be delivered again).
+	// When a client's request with a request number beyond this window is delivered,
+	// the window moves and the client's requests left below it will never be delivered.
+	// This bounds the state ISS keeps (and includes in checkpoints) for a client that skips request numbers.
+	// Must be positive.
+	ClientRequestWindow int
+
 	// Number of most recent epochs that are older than the latest stable checkpoint.
 	RetainedEpochs int
 
@@ -104,6 +156,33 @@ type Config struct {
 	PBFTViewChangeBatchTimeout   time.Duration
 	PBFTViewChangeSegmentTimeout time.Duration
 	PBFTViewChangeResendPeriod   time.Duration
+
+	// Time a PBFT orderer waits for a missing Preprepare message from one node
+	// before asking the next node known to have it.
+	PBFTFetchTimeout time.Duration
+
+	// If set to true, the normal-case messages of the PBFT orderers (Preprepare, Prepare, and Commit)
+	// are authenticated using authenticators (vectors of MACs computed with pairwise shared keys)
+	// produced and verified by the crypto module, which then must support them.
+	// These messages are not signed and otherwise rely on the transport for authentication.
+	// The authenticators are thus an additional cost, only worth paying with a transport that does not authenticate
+	// the communicating nodes.
+	// Messages that need to be verifiable by third parties (e.g. view change and checkpoint messages) are always signed.
+	PBFTMACAuthentication bool
+
+	// If set to true, Checkpoint messages carry authenticators in addition to their signatures.
+	// A node then only verifies the MAC of each received Checkpoint message and verifies the signatures
+	// (which it needs for the stable checkpoint certificate) as a single batch,
+	// only once it has received a strong quorum of Checkpoint messages with valid MACs.
+	// This replaces the verification of each received Checkpoint message's signature
+	// by a much cheaper MAC verification.
+	// The crypto module must support authenticators.
+	CheckpointMACAuthentication bool
+}
+
+// usesMACs returns true if the configuration requires the crypto module to support authenticators.
+func (c *Config) usesMACs() bool {
+	return c.PBFTMACAuthentication || c.CheckpointMACAuthentication
 }
 
 // CheckConfig checks whether the given configuration satisfies all necessary constraints.
@@ -142,6 +221,23 @@ func CheckConfig(c *Config) error {
 		return fmt.Errorf("negative MaxProposeDelay: %v", c.MaxProposeDelay)
 	}
 
+	// With adaptive batching, the batch size and the propose delay must be bounded from both sides.
+	if c.AdaptiveBatching {
+		if c.MinBatchSize == 0 || c.MaxBatchSize == 0 || c.MinBatchSize > c.MaxBatchSize {
+			return fmt.Errorf("invalid batch size bounds for adaptive batching: MinBatchSize: %d, MaxBatchSize: %d",
+				c.MinBatchSize, c.MaxBatchSize)
+		}
+		if c.MinProposeDelay <= 0 || c.MinProposeDelay > c.MaxProposeDelay {
+			return fmt.Errorf("invalid propose delay bounds for adaptive batching: "+
+				"MinProposeDelay: %v, MaxProposeDelay: %v", c.MinProposeDelay, c.MaxProposeDelay)
+		}
+	}
+
+	// Adaptive batching relies on the size of the proposed batches, which ISS does not know with the availability layer.
+	if c.UseAvailabilityLayer && c.AdaptiveBatching {
+		return fmt.Errorf("adaptive batching cannot be used together with the availability layer")
+	}
+
 	// There must be at least one bucket.
 	if c.NumBuckets <= 0 {
 		return fmt.Errorf("non-positive number of buckets: %d", c.NumBuckets)
@@ -162,10 +258,30 @@ func CheckConfig(c *Config) error {
 		return fmt.Errorf("negative MsgBufCapacity: %d", c.MsgBufCapacity)
 	}
 
+	// PBFTMsgBufCapacity must not be negative.
+	if c.PBFTMsgBufCapacity < 0 {
+		return fmt.Errorf("negative PBFTMsgBufCapacity: %d", c.PBFTMsgBufCapacity)
+	}
+
+	// Client quota parameters must be valid.
+	if err := clientquota.CheckParams(&c.ClientQuota); err != nil {
+		return fmt.Errorf("invalid client quota: %w", err)
+	}
+
+	// ClientRequestWindow must be positive.
+	if c.ClientRequestWindow <= 0 {
+		return fmt.Errorf("non-positive ClientRequestWindow: %d", c.ClientRequestWindow)
+	}
+
 	if c.CatchUpTimerPeriod <= 0 {
 		return fmt.Errorf("non-positive CatchUpTimerPeriod: %d", c.CatchUpTimerPeriod)
 	}
 
+	// PBFTFetchTimeout must be positive.
+	if c.PBFTFetchTimeout <= 0 {
+		return fmt.Errorf("non-positive PBFTFetchTimeout: %v", c.PBFTFetchTimeout)
+	}
+
 	// If all checks passed, return nil error.
 	return nil
 }
@@ -188,10 +304,16 @@ func DefaultConfig(membership []t.NodeID) *Config {
 		SegmentLength:                segmentLength,
 		MaxBatchSize:                 4,
 		MaxProposeDelay:              maxProposeDelay,
+		AdaptiveBatching:             false,
+		MinBatchSize:                 1,
+		MinProposeDelay:              maxProposeDelay / 10,
+		UseAvailabilityLayer:         false,
 		NumBuckets:                   len(membership),
 		LeaderPolicy:                 &SimpleLeaderPolicy{Membership: membership},
 		RequestNAckTimeout:           16,
 		MsgBufCapacity:               32 * 1024 * 1024, // 32 MiB
+		PBFTMsgBufCapacity:           32 * 1024 * 1024, // 32 MiB
+		ClientRequestWindow:          1024,
 		RetainedEpochs:               1,
 		CatchUpTimerPeriod:           maxProposeDelay, // maxProposeDelay is picked quite arbitrarily, could be anything
 		PBFTDoneResendPeriod:         maxProposeDelay,
@@ -200,5 +322,6 @@ func DefaultConfig(membership []t.NodeID) *Config {
 		PBFTViewChangeBatchTimeout:   4 * maxProposeDelay,
 		PBFTViewChangeSegmentTimeout: 2 * time.Duration(segmentLength) * maxProposeDelay,
 		PBFTViewChangeResendPeriod:   maxProposeDelay, // maxProposeDelay is picked quite arbitrarily, could be anything
+		PBFTFetchTimeout:             maxProposeDelay, // maxProposeDelay is picked quite arbitrarily, could be anything
 	}
 }
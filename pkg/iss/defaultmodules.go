@@ -49,6 +49,16 @@ func DefaultModules(orig modules.Modules) (modules.Modules, error) {
 		return nil, fmt.Errorf("no default Net implementation")
 	}
 
+	// If no module is specified to report rejected requests to clients, the rejections are ignored.
+	if m["clients"] == nil {
+		m["clients"] = modules.NullPassive{}
+	}
+
+	// If no commit log module is specified, delivered entries are not stored for later queries.
+	if m["commitlog"] == nil {
+		m["commitlog"] = modules.NullPassive{}
+	}
+
 	// If the WAL is not specified, no write-ahead log will be written
 	// and the node will not be able to restart.
 	if m["wal"] == nil {
@@ -57,3 +67,23 @@ func DefaultModules(orig modules.Modules) (modules.Modules, error) {
 
 	return m, nil
 }
+
+// authenticatorSupporter is implemented by crypto modules that can tell whether they support authenticators
+// (i.e., the ComputeAuthenticator and VerifyAuthenticators events).
+type authenticatorSupporter interface {
+	SupportsAuthenticators() bool
+}
+
+// CheckModules checks whether the given modules satisfy the requirements of the given ISS configuration.
+// In particular, if the configuration enables MAC authentication, the crypto module must support authenticators.
+// CheckModules is intended to be called on the output of DefaultModules.
+func CheckModules(c *Config, m modules.Modules) error {
+	if c.usesMACs() {
+		crypto, ok := m["crypto"].(authenticatorSupporter)
+		if !ok || !crypto.SupportsAuthenticators() {
+			return fmt.Errorf("MAC authentication enabled, but the crypto module does not support authenticators")
+		}
+	}
+
+	return nil
+}
@@ -21,6 +21,53 @@ type epochInfo struct {
 
 	// Checkpoint sub-protocol state.
 	Checkpoint *checkpointTracker
+
+	// --------------------------------------------------------------------------------
+	// Indices computed once at the start of the epoch.
+	// They are used on the critical path of message validation and request handling,
+	// where recomputing them for each message or request would be prohibitively expensive.
+	// --------------------------------------------------------------------------------
+
+	// Set representation of Membership, for efficiently checking whether a node is a member.
+	membershipSet map[t.NodeID]struct{}
+
+	// For each bucket ID, the instance number of the orderer to which the bucket is assigned in this epoch.
+	bucketOrderers []t.SBInstanceNr
+
+	// For each orderer (indexed the same way as Orderers), the group of buckets assigned to it in this epoch.
+	ordererBuckets []bucketGroup
+}
+
+// newEpochInfo returns a new epochInfo for the given epoch and membership, with no orderers.
+// Orderers are added using addOrderer.
+// numBuckets is the total number of buckets that will be assigned to the orderers.
+func newEpochInfo(nr t.EpochNr, membership []t.NodeID, checkpoint *checkpointTracker, numBuckets int) *epochInfo {
+	return &epochInfo{
+		Nr:             nr,
+		Membership:     membership,
+		Orderers:       make([]sbInstance, 0),
+		Checkpoint:     checkpoint,
+		membershipSet:  membershipSet(membership),
+		bucketOrderers: make([]t.SBInstanceNr, numBuckets),
+		ordererBuckets: make([]bucketGroup, 0),
+	}
+}
+
+// addOrderer adds a new orderer to the epoch, to which the given group of buckets is assigned,
+// and updates the bucket and orderer indices accordingly.
+// The instance number of the new orderer is its position in the list of orderers.
+func (e *epochInfo) addOrderer(orderer sbInstance, buckets bucketGroup) {
+	instanceNr := t.SBInstanceNr(len(e.Orderers))
+	e.Orderers = append(e.Orderers, orderer)
+	e.ordererBuckets = append(e.ordererBuckets, buckets)
+	for _, bucket := range buckets {
+		e.bucketOrderers[bucket.ID] = instanceNr
+	}
+}
+
+// bucketOrderer returns the instance number of the orderer to which the bucket with ID bID is assigned in this epoch.
+func (e *epochInfo) bucketOrderer(bID int) t.SBInstanceNr {
+	return e.bucketOrderers[bID]
 }
 
 // validateSBMessage checks whether an SBMessage is valid in this epoch.
@@ -34,14 +81,12 @@ func (e *epochInfo) validateSBMessage(message *isspb.SBMessage, from t.NodeID) e
 	}
 
 	// Message must refer to a valid SB instance.
-	if int(message.Instance) > len(e.Orderers) {
+	if message.Instance >= uint64(len(e.Orderers)) {
 		return fmt.Errorf("invalid SB instance number: %d", message.Instance)
 	}
 
 	// Message must be sent by a node in the current membership.
-	// TODO: This lookup is extremely inefficient, computing the membership set on each message validation.
-	//       Cache the output of membershipSet() throughout the epoch.
-	if _, ok := membershipSet(e.Membership)[from]; !ok {
+	if _, ok := e.membershipSet[from]; !ok {
 		return fmt.Errorf("sender of SB message not in the membership: %v", from)
 	}
 
@@ -16,13 +16,19 @@ package iss
 import (
 	"encoding/binary"
 	"fmt"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 
+	aevents "github.com/filecoin-project/mir/pkg/availability/events"
+	"github.com/filecoin-project/mir/pkg/clientquota"
+	clevents "github.com/filecoin-project/mir/pkg/commitlog/events"
+	"github.com/filecoin-project/mir/pkg/contextstore"
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/logging"
 	"github.com/filecoin-project/mir/pkg/messagebuffer"
 	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/pb/isspb"
 	"github.com/filecoin-project/mir/pkg/pb/messagepb"
@@ -46,6 +52,13 @@ var (
 	hasherModuleName t.ModuleID = "hasher"
 	cryptoModuleName t.ModuleID = "crypto"
 	timerModuleName  t.ModuleID = "timer"
+	clientModuleName t.ModuleID = "clients"
+
+	commitLogModuleName t.ModuleID = "commitlog"
+
+	// Only used if Config.UseAvailabilityLayer is set.
+	mempoolModuleName      t.ModuleID = "mempool"
+	availabilityModuleName t.ModuleID = "availability"
 )
 
 // ============================================================
@@ -80,6 +93,11 @@ type CommitLogEntry struct {
 	// The delivered request batch.
 	Batch *requestpb.Batch
 
+	// If ISS uses the availability layer, the availability certificate that was agreed upon for this entry.
+	// Batch then contains the transactions retrieved from the availability layer using this certificate.
+	// Otherwise, Cert is nil.
+	Cert *availabilitypb.Cert
+
 	// The digest (hash) of the batch.
 	Digest []byte
 
@@ -118,6 +136,14 @@ type ISS struct {
 	// This is mostly for debugging - not to be confused with the commit log.
 	logger logging.Logger
 
+	// Determines the size of batches proposed by this node and the delay between proposals.
+	// Shared by all the orderers created by this node, so that the state of an adaptive policy survives epochs.
+	batching *batchingPolicy
+
+	// Tracks the requests of each client that have been added to the buckets but not yet committed,
+	// enforcing the MaxOutstandingRequests client quota.
+	outstandingRequests *clientquota.OutstandingTracker
+
 	// --------------------------------------------------------------------------------
 	// These fields might change from epoch to epoch. Modified only by initEpoch()
 	// --------------------------------------------------------------------------------
@@ -136,10 +162,6 @@ type ISS struct {
 	// Highest epoch numbers indicated in Checkpoint messages from each node.
 	nodeEpochMap map[t.NodeID]t.EpochNr
 
-	// Index of orderers based on the buckets they are assigned.
-	// For each bucket ID, this map stores the orderer to which the bucket is assigned in the current epoch.
-	bucketOrderers map[int]sbInstance
-
 	// --------------------------------------------------------------------------------
 	// These fields are modified throughout an epoch.
 	// TODO: Move them into `epochInfo`?
@@ -152,6 +174,9 @@ type ISS struct {
 	// The buffer is checked after each epoch transition.
 	messageBuffers map[t.NodeID]*messagebuffer.MessageBuffer
 
+	// Counts of the messages dropped by the message buffers of ISS and of all the orderers.
+	droppedMessages *messagebuffer.DropCounters
+
 	// The final log of committed batches.
 	// For each sequence number, it holds the committed batch (or the special abort value).
 	// Each Deliver event of an orderer translates to inserting an entry in the commitLog.
@@ -171,9 +196,20 @@ type ISS struct {
 	// This field drives the in-order delivery of the log entries to the application.
 	nextDeliveredSN t.SeqNr
 
+	// Tracks the requests delivered to the application, so that no request is delivered twice.
+	// Note that, like the buckets, this state is not part of checkpoints
+	// and thus not restored when the node installs a stable checkpoint from another node.
+	delivered *clientProgress
+
 	// The first sequence number to be delivered in the new epoch.
 	newEpochSN t.SeqNr
 
+	// Contexts of requests made to the availability layer. Only used if Config.UseAvailabilityLayer is set.
+	// certOrigins holds the orderers (and their own context) on behalf of which certificates are requested or verified.
+	// pendingLogEntries holds the delivered log entries that wait for the transactions referred to by their certificates.
+	certOrigins       contextstore.ContextStore[*isspb.SBCertOrigin]
+	pendingLogEntries contextstore.ContextStore[*CommitLogEntry]
+
 	// Stores the stable checkpoint with the highest sequence number observed so far.
 	// If no stable checkpoint has been observed yet, lastStableCheckpoint is initialized to a stable checkpoint value
 	// corresponding to the initial state and associated with sequence number 0.
@@ -193,29 +229,39 @@ func New(ownID t.NodeID, config *Config, logger logging.Logger) (*ISS, error) {
 		return nil, fmt.Errorf("invalid ISS configuration: %w", err)
 	}
 
+	// Counters of dropped messages, shared by ISS and all the orderers.
+	droppedMessages := messagebuffer.NewDropCounters()
+
 	// Initialize a new ISS object.
 	iss := &ISS{
 		// Static fields
-		ownID:   ownID,
-		buckets: newBuckets(config.NumBuckets, logger),
-		logger:  logger,
+		ownID:               ownID,
+		buckets:             newBuckets(config.NumBuckets, logger),
+		logger:              logger,
+		batching:            newBatchingPolicy(config, logging.Decorate(logger, "Batching: ")),
+		outstandingRequests: clientquota.NewOutstandingTracker(config.ClientQuota.MaxOutstandingRequests),
 
 		// Fields modified only by initEpoch
-		config:         config,
-		epochs:         make(map[t.EpochNr]*epochInfo),
-		nodeEpochMap:   make(map[t.NodeID]t.EpochNr),
-		bucketOrderers: nil,
+		config:       config,
+		epochs:       make(map[t.EpochNr]*epochInfo),
+		nodeEpochMap: make(map[t.NodeID]t.EpochNr),
 
 		// Fields modified throughout an epoch
 		commitLog:          make(map[t.SeqNr]*CommitLogEntry),
 		unhashedLogEntries: make(map[t.SeqNr]*CommitLogEntry),
 		nextDeliveredSN:    0,
+		delivered:          newClientProgress(config.ClientRequestWindow),
 		newEpochSN:         0,
+		certOrigins:        contextstore.NewSequentialContextStore[*isspb.SBCertOrigin](),
+		pendingLogEntries:  contextstore.NewSequentialContextStore[*CommitLogEntry](),
 		messageBuffers: messagebuffer.NewBuffers(
 			removeNodeID(config.Membership, ownID), // Create a message buffer for everyone except for myself.
 			config.MsgBufCapacity,
+			classifyBufferedMessage,
+			droppedMessages,
 			logging.Decorate(logger, "Msgbuf: "),
 		),
+		droppedMessages: droppedMessages,
 		lastStableCheckpoint: &isspb.StableCheckpoint{
 			Epoch: 0,
 			Sn:    0,
@@ -258,6 +304,10 @@ func (iss *ISS) ApplyEvent(event *eventpb.Event) (*events.EventList, error) {
 		return iss.applySignResult(e.SignResult)
 	case *eventpb.Event_NodeSigsVerified:
 		return iss.applyNodeSigsVerified(e.NodeSigsVerified)
+	case *eventpb.Event_AuthenticatorComputed:
+		return iss.applyAuthenticatorComputed(e.AuthenticatorComputed)
+	case *eventpb.Event_AuthenticatorsVerified:
+		return iss.applyAuthenticatorsVerified(e.AuthenticatorsVerified)
 	case *eventpb.Event_NewRequests:
 		return iss.applyNewRequests(e.NewRequests.Requests)
 	case *eventpb.Event_AppSnapshot:
@@ -282,6 +332,8 @@ func (iss *ISS) ApplyEvent(event *eventpb.Event) (*events.EventList, error) {
 
 	case *eventpb.Event_MessageReceived:
 		return iss.applyMessageReceived(e.MessageReceived), nil
+	case *eventpb.Event_Availability:
+		return iss.applyAvailabilityEvent(e.Availability)
 	default:
 		return nil, fmt.Errorf("unknown protocol (ISS) event type: %T", event.Type)
 	}
@@ -290,6 +342,16 @@ func (iss *ISS) ApplyEvent(event *eventpb.Event) (*events.EventList, error) {
 // The ImplementsModule method only serves the purpose of indicating that this is a Module and must not be called.
 func (iss *ISS) ImplementsModule() {}
 
+// DroppedMessages returns the numbers of messages dropped due to message buffer overflow,
+// indexed by sending node and message type.
+// Messages dropped by the ISS buffers (from future epochs) are counted under their type,
+// while those dropped by the buffers of the PBFT orderers (from future views) are counted under "PBFT:" + their type.
+// Unlike the other methods of ISS, DroppedMessages can be called concurrently with the processing of events,
+// so that the operator can inspect the counts at any time.
+func (iss *ISS) DroppedMessages() map[t.NodeID]map[string]uint64 {
+	return iss.droppedMessages.Counts()
+}
+
 // ============================================================
 // Event application
 // ============================================================
@@ -332,6 +394,9 @@ func (iss *ISS) applyHashResult(result *eventpb.HashResult) (*events.EventList,
 	case *isspb.ISSHashOrigin_AppSnapshotEpoch:
 		// Hash originates from delivering an event of the application creating a state snapshot
 		return iss.applyAppSnapshotHashResult(result.Digests[0], t.EpochNr(origin.AppSnapshotEpoch)), nil
+	case *isspb.ISSHashOrigin_StableCheckpoint:
+		// Hash originates from a received StableCheckpoint message, the certificate of which is yet to be verified.
+		return iss.applyStableCheckpointHashResult(result.Digests[0], origin.StableCheckpoint), nil
 	default:
 		panic(fmt.Sprintf("unknown origin of hash result: %T", origin))
 	}
@@ -396,6 +461,13 @@ func (iss *ISS) applyNodeSigsVerified(result *eventpb.NodeSigsVerified) (*events
 			t.NodeID(result.NodeIds[0]),
 			t.EpochNr(origin.CheckpointEpoch),
 		), nil
+	case *isspb.ISSSigVerOrigin_CheckpointCert:
+		return iss.applyCheckpointCertSigVerResult(
+			result.Valid,
+			result.Errors,
+			t.NodeIDSlice(result.NodeIds),
+			t.EpochNr(origin.CheckpointCert),
+		), nil
 	case *isspb.ISSSigVerOrigin_StableCheckpoint:
 		return iss.applyStableCheckpointSigVerResult(result.AllOk, origin.StableCheckpoint), nil
 	default:
@@ -408,16 +480,35 @@ func (iss *ISS) applyNodeSigsVerified(result *eventpb.NodeSigsVerified) (*events
 // and can be processed.
 func (iss *ISS) applyNewRequests(requests []*requestpb.Request) (*events.EventList, error) {
 	// Request received from a client. Have the digest computed.
+	eventsOut := events.EmptyList()
 
-	requestData := make([][][]byte, len(requests))
-	for i, request := range requests {
-		requestData[i] = serializing.RequestForHash(request)
+	// Reject requests that exceed the size quota right away, without even computing their digests.
+	acceptedRequests := make([]*requestpb.Request, 0, len(requests))
+	requestData := make([][][]byte, 0, len(requests))
+	for _, request := range requests {
+		if err := clientquota.CheckSize(iss.config.ClientQuota.MaxRequestBytes, request); err != nil {
+			eventsOut.PushBack(iss.rejectRequest(request, err))
+			continue
+		}
+		acceptedRequests = append(acceptedRequests, request)
+		requestData = append(requestData, serializing.RequestForHash(request))
 	}
 
-	return events.ListOf(events.HashRequest(
+	// If no request is left, there is nothing to hash.
+	if len(acceptedRequests) == 0 {
+		return eventsOut, nil
+	}
+
+	// When using the availability layer, requests are not added to the buckets.
+	// Instead, they are handed over to the mempool, from where the availability layer picks them up.
+	if iss.config.UseAvailabilityLayer {
+		return eventsOut.PushBack(events.NewClientRequests(mempoolModuleName, acceptedRequests)), nil
+	}
+
+	return eventsOut.PushBack(events.HashRequest(
 		"hasher",
 		requestData,
-		RequestHashOrigin(requests),
+		RequestHashOrigin(acceptedRequests),
 	)), nil
 
 }
@@ -438,27 +529,42 @@ func (iss *ISS) handleHashedRequest(request *requestpb.HashedRequest) *events.Ev
 	// Get bucket to which the new request maps.
 	bucket := iss.buckets.RequestBucket(request)
 
-	// Add request to its bucket if it has not been added yet.
-	if !bucket.Add(request) {
-		// If the request already has been added, do nothing and return, as if the event did not exist.
-		// Returning here is important, because the rest of this function
-		// must only be executed once for a request in an epoch (to prevent request duplication).
+	// If the request already has been added, do nothing and return, as if the event did not exist.
+	// Returning here is important, because the rest of this function
+	// must only be executed once for a request in an epoch (to prevent request duplication).
+	// This check must precede the quota check, so that a client retransmitting a pending request
+	// does not have the retransmission rejected (and reported as such) for exceeding its quota.
+	if bucket.Known(request) {
 		return events.EmptyList()
 	}
 
+	// Reject the request if its client already has too many requests outstanding.
+	// This prevents a single client from filling the buckets and starving other clients.
+	clientID := t.ClientID(request.Req.ClientId)
+	if err := iss.outstandingRequests.Check(clientID); err != nil {
+		return events.ListOf(iss.rejectRequest(request.Req, err))
+	}
+
+	// Add request to its bucket.
+	bucket.Add(request)
+
+	// Count the request towards its client's outstanding requests until it is committed.
+	iss.outstandingRequests.Add(clientID, t.ReqNo(request.Req.ReqNo))
+
 	// Count number of requests in all the buckets assigned to the same instance as the bucket of the received request.
 	// These are all the requests pending to be proposed by the instance.
-	// TODO: This is extremely inefficient and on the critical path
-	//       (done on every request reception and TotalRequests loops over all buckets in the segment).
-	//       Maintain a counter of requests in assigned buckets instead.
-	pendingRequests := iss.buckets.Select(iss.bucketOrderers[bucket.ID].Segment().BucketIDs).TotalRequests()
+	// The group of buckets assigned to the instance is precomputed at the start of the epoch,
+	// so this only sums up the lengths of the few buckets in the group.
+	instanceNr := iss.epoch.bucketOrderer(bucket.ID)
+	pendingRequests := iss.epoch.ordererBuckets[instanceNr].TotalRequests()
 
 	// If there are enough pending requests to fill a batch,
 	// announce the total number of pending requests to the corresponding orderer.
 	// Note that this deprives the orderer from the information about the number of pending requests,
-	// as long as there are fewer of them than MaxBatchSize, but the orderer (so far) should not need this information.
-	if pendingRequests >= iss.config.MaxBatchSize {
-		eventsOut.PushBackList(iss.bucketOrderers[bucket.ID].ApplyEvent(SBPendingRequestsEvent(pendingRequests)))
+	// as long as there are fewer of them than the current batch size,
+	// but the orderer (so far) should not need this information.
+	if pendingRequests >= iss.batching.BatchSize() {
+		eventsOut.PushBackList(iss.epoch.Orderers[instanceNr].ApplyEvent(SBPendingRequestsEvent(pendingRequests)))
 	}
 
 	return eventsOut
@@ -521,6 +627,21 @@ func (iss *ISS) applyCheckpointSigVerResult(valid bool, err string, node t.NodeI
 	return iss.epoch.Checkpoint.ProcessSigVerified(valid, err, node)
 }
 
+// applyCheckpointCertSigVerResult applies the result of verifying the signatures of Checkpoint messages
+// that have been authenticated by MACs.
+// It passes the results to the appropriate CheckpointTracker (identified by the event's associated epoch number).
+func (iss *ISS) applyCheckpointCertSigVerResult(
+	valid []bool,
+	errs []string,
+	nodeIDs []t.NodeID,
+	epoch t.EpochNr,
+) *events.EventList {
+	if iss.epoch.Nr != epoch {
+		return events.EmptyList()
+	}
+	return iss.epoch.Checkpoint.ProcessCertSigsVerified(valid, errs, nodeIDs)
+}
+
 // applySBEvent applies an event triggered by or addressed to an orderer (i.e., instance of Sequenced Broadcast),
 // if that event belongs to the current epoch.
 // TODO: Update this comment when the TODO below is addressed.
@@ -545,7 +666,12 @@ func (iss *ISS) applySBEvent(event *isspb.SBEvent) (*events.EventList, error) {
 	} else if epoch, ok := iss.epochs[epochNr]; ok {
 		// If the event is from a known epoch, apply it in relation to the corresponding orderer instance.
 		// Since the event is locally generated, we do not need to check whether the orderer exists in the epoch.
-		return iss.applySBInstanceEvent(event.Event, epoch.Orderers[event.Instance]), nil
+		return iss.applySBInstanceEvent(
+			event.Event,
+			epochNr,
+			t.SBInstanceNr(event.Instance),
+			epoch.Orderers[event.Instance],
+		), nil
 	} else {
 		// If the event is from an old epoch, ignore it.
 		// This might potentially happen if the epoch advanced while the event has been waiting in some buffer.
@@ -567,6 +693,13 @@ func (iss *ISS) applyStableCheckpoint(stableCheckpoint *isspb.StableCheckpoint)
 			"replacingSn", iss.lastStableCheckpoint.Sn)
 		iss.lastStableCheckpoint = stableCheckpoint
 
+		// Let the commit log know it can prune the entries covered by the checkpoint.
+		eventsOut.PushBack(clevents.StableCheckpoint(
+			commitLogModuleName,
+			t.EpochNr(stableCheckpoint.Epoch),
+			t.SeqNr(stableCheckpoint.Sn),
+		))
+
 		// Prune old entries from WAL, old periodic timers, and ISS state pertaining to old epochs.
 		// The state to prune is determined according to the retention index
 		// which is derived from the epoch number the new
@@ -578,6 +711,11 @@ func (iss *ISS) applyStableCheckpoint(stableCheckpoint *isspb.StableCheckpoint)
 			eventsOut.PushBack(events.WALTruncate(walModuleName, t.WALRetIndex(pruneIndex)))
 			eventsOut.PushBack(events.TimerGarbageCollect(timerModuleName, t.TimerRetIndex(pruneIndex)))
 
+			// Prune the batches stored by the availability layer (and, transitively, by the mempool).
+			if iss.config.UseAvailabilityLayer {
+				eventsOut.PushBack(aevents.ForgetBatchesBefore(availabilityModuleName, t.RetentionIndex(pruneIndex)))
+			}
+
 			// Prune epoch state.
 			for epoch := range iss.epochs {
 				if epoch < t.EpochNr(pruneIndex) {
@@ -686,10 +824,29 @@ func (iss *ISS) applyCheckpointMessage(message *isspb.Checkpoint, source t.NodeI
 }
 
 // applyStableCheckpointMessage processes a received StableCheckpoint message
-// by creating a request for verifying the signatures in the included checkpoint certificate.
-// The actual processing then happens in applyStableCheckpointSigVerResult.
+// by creating a request for hashing the checkpointed state (the application snapshot and the client progress),
+// the hash of which the signatures in the included checkpoint certificate are computed over.
+// The processing continues in applyStableCheckpointHashResult.
 func (iss *ISS) applyStableCheckpointMessage(chkp *isspb.StableCheckpoint, _ t.NodeID) *events.EventList {
 
+	// Ignore stable checkpoints that are not far enough ahead of the current state of the local node
+	// (see also applyStableCheckpointSigVerResult).
+	if t.EpochNr(chkp.Epoch) <= iss.epoch.Nr+1 {
+		return events.EmptyList()
+	}
+
+	return events.ListOf(events.HashRequest(
+		hasherModuleName,
+		[][][]byte{serializing.CheckpointForHash(chkp.AppSnapshot, chkp.ClientProgress)},
+		StableCheckpointHashOrigin(chkp),
+	))
+}
+
+// applyStableCheckpointHashResult creates a request for verifying the signatures
+// in the checkpoint certificate of a received StableCheckpoint message, given the hash of the checkpointed state.
+// The actual processing then happens in applyStableCheckpointSigVerResult.
+func (iss *ISS) applyStableCheckpointHashResult(digest []byte, chkp *isspb.StableCheckpoint) *events.EventList {
+
 	// Extract signatures and the signing node IDs from the received message.
 	// TODO: Using underlying protobuf type for node ID explicitly here (nodeID string).
 	//       Modify the code to only use the abstract type.
@@ -702,9 +859,14 @@ func (iss *ISS) applyStableCheckpointMessage(chkp *isspb.StableCheckpoint, _ t.N
 	})
 
 	// Request verification of signatures in the checkpoint certificate
+	sigData := serializing.CheckpointForSig(t.EpochNr(chkp.Epoch), t.SeqNr(chkp.Sn), digest)
+	data := make([][][]byte, len(signatures))
+	for i := range data {
+		data[i] = sigData
+	}
 	return events.ListOf(events.VerifyNodeSigs(
 		"crypto",
-		[][][]byte{serializing.CheckpointForSig(t.EpochNr(chkp.Epoch), t.SeqNr(chkp.Sn), chkp.AppSnapshot)},
+		data,
 		signatures,
 		nodeIDs,
 		StableCheckpointSigVerOrigin(chkp),
@@ -743,6 +905,9 @@ func (iss *ISS) applyStableCheckpointSigVerResult(signaturesOK bool, chkp *isspb
 	iss.nextDeliveredSN = t.SeqNr(chkp.Sn)
 	iss.newEpochSN = iss.nextDeliveredSN
 
+	// Restore the requests delivered up to the checkpoint, so that none of them is delivered again.
+	iss.delivered = clientProgressFromPb(chkp.ClientProgress, iss.config.ClientRequestWindow)
+
 	// Initialize a new ISS epoch instance for the new stable
 	// checkpoint to continue participating in the protocol
 	// starting with that epoch after installing the state
@@ -752,6 +917,9 @@ func (iss *ISS) applyStableCheckpointSigVerResult(signaturesOK bool, chkp *isspb
 	// Update the last stable checkpoint stored in the global ISS structure.
 	iss.lastStableCheckpoint = chkp
 
+	// Inform the crypto module about the new epoch, so that it uses the keys valid in that epoch.
+	eventsOut.PushBack(events.SetKeyEpoch(cryptoModuleName, t.EpochNr(chkp.Epoch)))
+
 	// Create an event to request the application module for
 	// restoring its state from the snapshot received in the new
 	// stable checkpoint message.
@@ -789,7 +957,23 @@ func (iss *ISS) applySBMessage(message *isspb.SBMessage, from t.NodeID) *events.
 			return events.EmptyList()
 		}
 
-		return iss.applySBInstanceEvent(SBMessageReceivedEvent(message.Msg, from), epoch.Orderers[message.Instance])
+		// If the message needs to be authenticated by a MAC, only apply it after the MAC has been verified.
+		if iss.config.PBFTMACAuthentication && macAuthenticated(message.Msg) {
+			verifyEvent, err := iss.verifySBMessageMAC(message, from)
+			if err != nil {
+				iss.logger.Log(logging.LevelWarn, "Ignoring unauthenticated SB message.",
+					"type", fmt.Sprintf("%T", message.Msg.Type), "from", from, "error", err)
+				return events.EmptyList()
+			}
+			return events.ListOf(verifyEvent)
+		}
+
+		return iss.applySBInstanceEvent(
+			SBMessageReceivedEvent(message.Msg, from),
+			epochNr,
+			t.SBInstanceNr(message.Instance),
+			epoch.Orderers[message.Instance],
+		)
 	} else {
 		// Ignore old messages
 		iss.logger.Log(logging.LevelDebug, "Ignoring message from old epoch.",
@@ -817,17 +1001,19 @@ func (iss *ISS) initEpoch(newEpoch t.EpochNr) {
 	iss.logger.Log(logging.LevelInfo, "New epoch", "epochNr", newEpoch)
 
 	// Set the new epoch number and re-initialize list of orderers.
-	epoch := &epochInfo{
-		Nr:         newEpoch,
-		Membership: iss.config.Membership, // TODO: Make a proper copy once reconfiguration is supported.
-		Checkpoint: newCheckpointTracker(
+	epoch := newEpochInfo(
+		newEpoch,
+		iss.config.Membership, // TODO: Make a proper copy once reconfiguration is supported.
+		newCheckpointTracker(
 			iss.ownID,
 			iss.nextDeliveredSN,
 			newEpoch,
 			t.TimeDuration(iss.config.CheckpointResendPeriod),
+			iss.config.CheckpointMACAuthentication,
 			logging.Decorate(iss.logger, "CT: ", "epoch", newEpoch),
 		),
-	}
+		len(*iss.buckets),
+	)
 	iss.epochs[newEpoch] = epoch
 	iss.epoch = epoch
 
@@ -839,10 +1025,6 @@ func (iss *ISS) initEpoch(newEpoch t.EpochNr) {
 	// Compute the assignment of buckets to orderers (each leader will correspond to one orderer).
 	leaderBuckets := iss.buckets.Distribute(leaders, newEpoch)
 
-	// Initialize index of orderers based on the buckets they are assigned.
-	// Given a bucket, this index helps locate the orderer to which the bucket is assigned.
-	iss.bucketOrderers = make(map[int]sbInstance)
-
 	// Create new segments of the commit log, one per leader selected by the leader selection policy.
 	// Instantiate one orderer (SB instance) for each segment.
 	for i, leader := range leaders {
@@ -859,23 +1041,28 @@ func (iss *ISS) initEpoch(newEpoch t.EpochNr) {
 		}
 		iss.newEpochSN += t.SeqNr(len(seg.SeqNrs))
 
+		// Select the buckets assigned to the segment.
+		segBuckets := iss.buckets.Select(seg.BucketIDs)
+
 		// Instantiate a new PBFT orderer.
 		// TODO: When more protocols are implemented, make this configurable, so other orderer types can be chosen.
 		sbInst := newPbftInstance(
 			iss.ownID,
 			seg,
-			iss.buckets.Select(seg.BucketIDs).TotalRequests(),
+			segBuckets.TotalRequests(),
 			newPBFTConfig(iss.config),
-			&sbEventService{epoch: newEpoch, instance: t.SBInstanceNr(i)},
+			iss.batching,
+			iss.droppedMessages,
+			&sbEventService{
+				epoch:             newEpoch,
+				instance:          t.SBInstanceNr(i),
+				macAuthentication: iss.config.PBFTMACAuthentication,
+			},
 			logging.Decorate(iss.logger, "PBFT: ", "epoch", newEpoch, "instance", i))
 
-		// Add the orderer to the list of orderers.
-		iss.epoch.Orderers = append(iss.epoch.Orderers, sbInst)
-
-		// Populate index of orderers based on the buckets they are assigned.
-		for _, bID := range seg.BucketIDs {
-			iss.bucketOrderers[bID] = sbInst
-		}
+		// Add the orderer to the list of orderers,
+		// populating the index of orderers based on the buckets they are assigned.
+		iss.epoch.addOrderer(sbInst, segBuckets)
 	}
 }
 
@@ -883,6 +1070,13 @@ func (iss *ISS) initEpoch(newEpoch t.EpochNr) {
 func (iss *ISS) initOrderers() *events.EventList {
 	eventsOut := events.EmptyList()
 
+	// Associate the batches stored by the availability layer from now on with the new epoch,
+	// so that they can be garbage-collected when the epoch is not retained any more.
+	// This must happen before the orderers, when initialized, request any new availability certificates.
+	if iss.config.UseAvailabilityLayer {
+		eventsOut.PushBack(aevents.SetRetentionIndex(availabilityModuleName, t.RetentionIndex(iss.epoch.Nr)))
+	}
+
 	sbInit := SBInitEvent()
 	for _, orderer := range iss.epoch.Orderers {
 		eventsOut.PushBackList(orderer.ApplyEvent(sbInit))
@@ -911,12 +1105,25 @@ func (iss *ISS) processCommitted() *events.EventList {
 
 		// TODO: Once system configuration requests are introduced, apply them here.
 
+		// Leave out the requests that have already been delivered.
+		// With the availability layer, the same request can be committed multiple times,
+		// as the mempools of different nodes include it in different availability certificates.
+		batch := iss.delivered.FilterBatch(iss.commitLog[iss.nextDeliveredSN].Batch)
+
 		// Create a new Deliver event.
-		eventsOut.PushBack(events.Deliver(appModuleName, iss.nextDeliveredSN, iss.commitLog[iss.nextDeliveredSN].Batch))
+		eventsOut.PushBack(events.Deliver(appModuleName, iss.nextDeliveredSN, batch))
+
+		// Announce the delivered entry to the commit log, where it can be queried later.
+		// Note that all entries are delivered in the epoch they belong to,
+		// as the epoch only advances after all its entries have been delivered.
+		eventsOut.PushBack(clevents.Entry(
+			commitLogModuleName,
+			commitLogEntryPb(iss.commitLog[iss.nextDeliveredSN], iss.epoch.Nr),
+		))
 
 		// Output debugging information.
 		iss.logger.Log(logging.LevelDebug, "Delivering entry.",
-			"sn", iss.nextDeliveredSN, "nReq", len(iss.commitLog[iss.nextDeliveredSN].Batch.Requests))
+			"sn", iss.nextDeliveredSN, "nReq", len(batch.Requests))
 
 		// Remove just delivered batch from the temporary
 		// store of batches that were agreed upon out-of-order.
@@ -932,6 +1139,9 @@ func (iss *ISS) processCommitted() *events.EventList {
 		// Initialize the internal data structures for the new epoch.
 		iss.initEpoch(iss.epoch.Nr + 1)
 
+		// Inform the crypto module about the new epoch, so that it uses the keys valid in that epoch.
+		eventsOut.PushBack(events.SetKeyEpoch(cryptoModuleName, iss.epoch.Nr))
+
 		// Look up a (or create a new) checkpoint tracker and start the checkpointing protocol.
 		// This must happen after initialization of the new epoch,
 		// as the sequence number the checkpoint will be associated with (iss.nextDeliveredSN)
@@ -939,7 +1149,8 @@ func (iss *ISS) processCommitted() *events.EventList {
 		// The checkpoint tracker might already exist if a corresponding message has been already received.
 		// iss.nextDeliveredSN is the first sequence number *not* included in the checkpoint,
 		// i.e., as sequence numbers start at 0, the checkpoint includes the first iss.nextDeliveredSN sequence numbers.
-		eventsOut.PushBackList(iss.epoch.Checkpoint.Start(iss.config.Membership))
+		// The checkpoint also includes the requests delivered so far, which is exactly the requests in the checkpoint.
+		eventsOut.PushBackList(iss.epoch.Checkpoint.Start(iss.config.Membership, iss.delivered.Pb()))
 
 		// Give the init signals to the newly instantiated orderers.
 		// TODO: Currently this probably sends the Init event to old orderers as well.
@@ -984,11 +1195,21 @@ func (iss *ISS) applyBufferedMessages() *events.EventList {
 func (iss *ISS) removeFromBuckets(requests []*requestpb.HashedRequest) {
 
 	// Remove each request from its bucket.
+	// The request also stops counting towards its client's outstanding requests.
 	for _, req := range requests {
 		iss.buckets.RequestBucket(req).Remove(req)
+		iss.outstandingRequests.Remove(t.ClientID(req.Req.ClientId), t.ReqNo(req.Req.ReqNo))
 	}
 }
 
+// rejectRequest logs the rejection of a request that violates its client's quota
+// and returns an event reporting the rejection (with its reason) to the client.
+func (iss *ISS) rejectRequest(req *requestpb.Request, reason error) *eventpb.Event {
+	iss.logger.Log(logging.LevelWarn, "Rejecting request.",
+		"clId", req.ClientId, "reqNo", req.ReqNo, "reason", reason)
+	return events.RequestRejected(clientModuleName, req, reason.Error())
+}
+
 // bufferedMessageFilter decides, given a message, whether it is appropriate to apply the message, discard it,
 // or keep it in the buffer, returning the appropriate value of type messagebuffer.Applicable.
 func (iss *ISS) bufferedMessageFilter(_ t.NodeID, message proto.Message) messagebuffer.Applicable {
@@ -1021,6 +1242,25 @@ func (iss *ISS) bufferedMessageFilter(_ t.NodeID, message proto.Message) message
 	// Thus, the messagebuffer.Invalid option is not used.
 }
 
+// classifyBufferedMessage returns the epoch of a message stored in the ISS message buffers as its rank,
+// so that messages from nearer epochs are preferentially kept, and the message type for counting dropped messages.
+// For SB messages, the type of the contained orderer message is used.
+func classifyBufferedMessage(message proto.Message) (uint64, string) {
+	switch msg := message.(type) {
+	case *isspb.SBMessage:
+		return msg.Epoch, sbMessageType(msg.Msg)
+	case *isspb.Checkpoint:
+		return msg.Epoch, "Checkpoint"
+	default:
+		panic(fmt.Errorf("cannot extract epoch from message type: %T", message))
+	}
+}
+
+// sbMessageType returns a short name of the type of an orderer message (e.g. "PbftPrepare").
+func sbMessageType(msg *isspb.SBInstanceMessage) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", msg.GetType()), "*isspb.SBInstanceMessage_")
+}
+
 // ============================================================
 // Auxiliary functions
 // ============================================================
@@ -1038,9 +1278,21 @@ func sequenceNumbers(start t.SeqNr, step t.SeqNr, length int) []t.SeqNr {
 	return seqNrs
 }
 
-// reqStrKey takes a request reference and transforms it to a string for using as a map key.
-func reqStrKey(req *requestpb.HashedRequest) string {
-	return fmt.Sprintf("%v-%d.%v", req.Req.ClientId, req.Req.ReqNo, req.Digest)
+// reqMapKey identifies a request (including its digest) when used as a map key.
+// Unlike a string representation of the request, it is cheap to compute, as it does not involve any formatting.
+type reqMapKey struct {
+	clientID t.ClientID
+	reqNo    t.ReqNo
+	digest   string
+}
+
+// reqKey takes a request reference and transforms it to a reqMapKey for using as a map key.
+func reqKey(req *requestpb.HashedRequest) reqMapKey {
+	return reqMapKey{
+		clientID: t.ClientID(req.Req.ClientId),
+		reqNo:    t.ReqNo(req.Req.ReqNo),
+		digest:   string(req.Digest),
+	}
 }
 
 // membershipSet takes a list of node IDs and returns a map of empty structs with an entry for each node ID in the list.
@@ -1071,13 +1323,15 @@ func newPBFTConfig(issConfig *Config) *PBFTConfig {
 	return &PBFTConfig{
 		Membership:               issConfig.Membership,
 		MaxProposeDelay:          issConfig.MaxProposeDelay,
-		MsgBufCapacity:           issConfig.MsgBufCapacity,
+		MsgBufCapacity:           issConfig.PBFTMsgBufCapacity,
 		MaxBatchSize:             issConfig.MaxBatchSize,
 		DoneResendPeriod:         issConfig.PBFTDoneResendPeriod,
 		CatchUpDelay:             issConfig.PBFTCatchUpDelay,
 		ViewChangeBatchTimeout:   issConfig.PBFTViewChangeBatchTimeout,
 		ViewChangeSegmentTimeout: issConfig.PBFTViewChangeSegmentTimeout,
 		ViewChangeResendPeriod:   issConfig.PBFTViewChangeResendPeriod,
+		FetchTimeout:             issConfig.PBFTFetchTimeout,
+		UseAvailabilityLayer:     issConfig.UseAvailabilityLayer,
 	}
 }
 
@@ -1115,10 +1369,19 @@ func serializeLogEntryForHashing(entry *CommitLogEntry) [][]byte {
 	}
 
 	// Encode the batch content.
+	// If the batch has been retrieved from the availability layer, its requests carry no digests.
+	// The availability certificate (that determines the batch) is hashed instead.
+	// Committed certificates are always well-formed, as orderers ignore Preprepares with malformed certificates.
 	batchData := serializing.BatchForHash(entry.Batch)
+	if entry.Cert != nil {
+		var err error
+		if batchData, err = serializing.CertForHash(entry.Cert); err != nil {
+			panic(fmt.Errorf("malformed committed availability certificate: %w", err))
+		}
+	}
 
 	// Put everything together in a slice and return it.
-	data := make([][]byte, 0, len(entry.Batch.Requests)+3)
+	data := make([][]byte, 0, len(batchData)+3)
 	data = append(data, snBuf, suspectBuf, []byte{aborted})
 	data = append(data, batchData...)
 	return data
@@ -0,0 +1,188 @@
+package iss
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/commonpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// ============================================================
+// MAC-based authentication of orderer messages
+// ============================================================
+
+// If the PBFTMACAuthentication configuration parameter is set,
+// the normal-case messages of the orderers are authenticated by authenticators (vectors of MACs) instead of relying
+// on the transport. ISS handles the authentication transparently for the orderers:
+// when an orderer sends such a message, the sbEventService first requests an authenticator from the crypto module
+// and ISS only sends the message (with the authenticator attached) when the authenticator has been computed.
+// On reception, ISS only applies such a message to the orderer after the crypto module verified the MAC
+// that the sender computed for this node.
+//
+// If the CheckpointMACAuthentication configuration parameter is set, Checkpoint messages carry an authenticator too.
+// The checkpoint tracker then only verifies the MACs of received Checkpoint messages
+// and defers the verification of their signatures (needed for the stable checkpoint certificate)
+// until a strong quorum of Checkpoint messages with valid MACs has been received.
+// See checkpoint.go for details.
+
+// applyAuthenticatorComputed applies the AuthenticatorComputed event to the state of the ISS protocol state machine.
+// Such an event means that an authenticator requested by this module has been computed by the crypto module
+// and the corresponding message can be sent.
+func (iss *ISS) applyAuthenticatorComputed(result *eventpb.AuthenticatorComputed) (*events.EventList, error) {
+	// We know already that the AuthOrigin is of type ISS, since ISS produces no other types
+	// and all AuthenticatorComputed events with a different origin would not have been routed here.
+	issOrigin := result.Origin.Type.(*eventpb.AuthOrigin_Iss).Iss
+
+	switch origin := issOrigin.Type.(type) {
+	case *isspb.ISSAuthOrigin_SbSend:
+		// Attach the authenticator to the message and send it.
+		message := origin.SbSend.Msg
+		message.Authenticator = result.Authenticator
+		return events.ListOf(events.SendMessage(
+			netModuleName,
+			Message(&isspb.ISSMessage{Type: &isspb.ISSMessage_Sb{Sb: message}}),
+			t.NodeIDSlice(origin.SbSend.Destinations),
+		)), nil
+	case *isspb.ISSAuthOrigin_CheckpointSend:
+		if iss.epoch.Nr != t.EpochNr(origin.CheckpointSend) {
+			return events.EmptyList(), nil
+		}
+		return iss.epoch.Checkpoint.ProcessAuthenticatorComputed(result.Authenticator), nil
+	default:
+		return nil, fmt.Errorf("unexpected origin of computed authenticator: %T", origin)
+	}
+}
+
+// applyAuthenticatorsVerified applies the AuthenticatorsVerified event to the state of the ISS protocol state machine.
+// Such an event means that the MAC of a received message has been verified by the crypto module
+// and, if valid, the message can be applied to the orderer it is destined to.
+func (iss *ISS) applyAuthenticatorsVerified(result *eventpb.AuthenticatorsVerified) (*events.EventList, error) {
+	// We know already that the AuthOrigin is of type ISS, since ISS produces no other types
+	// and all AuthenticatorsVerified events with a different origin would not have been routed here.
+	issOrigin := result.Origin.Type.(*eventpb.AuthOrigin_Iss).Iss
+
+	switch origin := issOrigin.Type.(type) {
+	case *isspb.ISSAuthOrigin_SbReceive:
+		message := origin.SbReceive.Msg
+		from := t.NodeID(origin.SbReceive.From)
+
+		// Ignore messages with an invalid MAC.
+		if !result.AllOk {
+			iss.logger.Log(logging.LevelWarn, "Ignoring SB message with invalid MAC.",
+				"type", fmt.Sprintf("%T", message.Msg.Type), "from", from, "error", result.Errors[0])
+			return events.EmptyList(), nil
+		}
+
+		// The epoch of the message might have been garbage-collected while verifying the MAC.
+		epochNr := t.EpochNr(message.Epoch)
+		epoch, ok := iss.epochs[epochNr]
+		if !ok {
+			iss.logger.Log(logging.LevelDebug, "Ignoring message from old epoch.",
+				"from", from, "epoch", epochNr, "type", fmt.Sprintf("%T", message.Msg.Type))
+			return events.EmptyList(), nil
+		}
+
+		return iss.applySBInstanceEvent(
+			SBMessageReceivedEvent(message.Msg, from),
+			epochNr,
+			t.SBInstanceNr(message.Instance),
+			epoch.Orderers[message.Instance],
+		), nil
+	case *isspb.ISSAuthOrigin_CheckpointReceive:
+		if iss.epoch.Nr != t.EpochNr(origin.CheckpointReceive.Epoch) {
+			return events.EmptyList(), nil
+		}
+		// A Checkpoint message only has one MAC to verify.
+		return iss.epoch.Checkpoint.ProcessMACVerified(
+			result.Valid[0],
+			result.Errors[0],
+			t.NodeID(origin.CheckpointReceive.From),
+		), nil
+	default:
+		return nil, fmt.Errorf("unexpected origin of verified authenticators: %T", origin)
+	}
+}
+
+// verifySBMessageMAC returns an event requesting the verification of the MAC
+// the sender of a (validated) SB message computed for this node.
+// If the message carries no MAC for this node or is malformed, verifySBMessageMAC returns an error.
+func (iss *ISS) verifySBMessageMAC(message *isspb.SBMessage, from t.NodeID) (*eventpb.Event, error) {
+	mac, ok := authenticatorMAC(message.GetAuthenticator(), iss.ownID)
+	if !ok {
+		return nil, fmt.Errorf("no MAC for this node")
+	}
+
+	data, err := serializeSBMessageForAuth(message)
+	if err != nil {
+		return nil, err
+	}
+	return events.VerifyAuthenticators(
+		cryptoModuleName,
+		[][][]byte{data},
+		[][]byte{mac},
+		[]t.NodeID{from},
+		SBAuthReceiveOrigin(message, from),
+	), nil
+}
+
+// authenticatorMAC returns the MAC computed for the node with ID nodeID contained in the given authenticator.
+// The second return value is false if the authenticator (which may be nil) contains no MAC for nodeID.
+func authenticatorMAC(authenticator *commonpb.Authenticator, nodeID t.NodeID) ([]byte, bool) {
+	for i, id := range authenticator.GetNodeIds() {
+		if t.NodeID(id) == nodeID && i < len(authenticator.GetMacs()) {
+			return authenticator.Macs[i], true
+		}
+	}
+	return nil, false
+}
+
+// macAuthenticated returns true if the given orderer message is to be authenticated by MACs
+// when MAC authentication is enabled.
+// These are the normal-case messages of PBFT, which need not be verifiable by third parties.
+func macAuthenticated(message *isspb.SBInstanceMessage) bool {
+	switch message.Type.(type) {
+	case *isspb.SBInstanceMessage_PbftPreprepare, *isspb.SBInstanceMessage_PbftPrepare,
+		*isspb.SBInstanceMessage_PbftCommit:
+		return true
+	default:
+		return false
+	}
+}
+
+// serializeSBMessageForAuth returns the data over which the MACs of an authenticated SB message are computed.
+// Besides the message content, the data includes the epoch, the orderer instance and the message type,
+// so that a MAC cannot be reused for a different message.
+// serializeSBMessageForAuth returns an error if the message is a Preprepare with a malformed availability certificate.
+func serializeSBMessageForAuth(message *isspb.SBMessage) ([][]byte, error) {
+	data := [][]byte{t.EpochNr(message.Epoch).Bytes(), t.SBInstanceNr(message.Instance).Bytes()}
+
+	switch msg := message.Msg.Type.(type) {
+	case *isspb.SBInstanceMessage_PbftPreprepare:
+		preprepareData, err := serializePreprepareForHashing(msg.PbftPreprepare)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, []byte("preprepare"), t.PBFTViewNr(msg.PbftPreprepare.View).Bytes())
+		return append(data, preprepareData...), nil
+	case *isspb.SBInstanceMessage_PbftPrepare:
+		return append(data,
+			[]byte("prepare"),
+			t.SeqNr(msg.PbftPrepare.Sn).Bytes(),
+			t.PBFTViewNr(msg.PbftPrepare.View).Bytes(),
+			msg.PbftPrepare.Digest,
+		), nil
+	case *isspb.SBInstanceMessage_PbftCommit:
+		return append(data,
+			[]byte("commit"),
+			t.SeqNr(msg.PbftCommit.Sn).Bytes(),
+			t.PBFTViewNr(msg.PbftCommit.View).Bytes(),
+			msg.PbftCommit.Digest,
+		), nil
+	default:
+		panic(fmt.Sprintf("message type does not support MAC authentication: %T", msg))
+	}
+}
@@ -0,0 +1,235 @@
+package iss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mirCrypto "github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/commonpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// flatten returns the given events along with all their (transitive) follow-up events
+// and the events wrapped in WALAppend and TimerRepeat events.
+func flatten(evts *events.EventList) []*eventpb.Event {
+	var result []*eventpb.Event
+	var add func(event *eventpb.Event)
+	add = func(event *eventpb.Event) {
+		result = append(result, event)
+		if walAppend := event.GetWalAppend(); walAppend != nil {
+			add(walAppend.Event)
+		}
+		for _, e := range event.GetTimerRepeat().GetEvents() {
+			add(e)
+		}
+		for _, e := range event.GetNext() {
+			add(e)
+		}
+	}
+	for _, event := range evts.Slice() {
+		add(event)
+	}
+	return result
+}
+
+// findEvent returns the first of the (flattened) events for which the predicate holds, or nil if there is none.
+func findEvent(evts *events.EventList, predicate func(event *eventpb.Event) bool) *eventpb.Event {
+	for _, event := range flatten(evts) {
+		if predicate(event) {
+			return event
+		}
+	}
+	return nil
+}
+
+func TestSBMessageMACAuthentication(t *testing.T) {
+	iss, instance, sn := newTestOrdererWithConfig(t, func(config *Config) {
+		config.PBFTMACAuthentication = true
+	})
+
+	batch := &requestpb.Batch{Requests: []*requestpb.HashedRequest{
+		events.HashedRequest(events.ClientRequest("client", 0, []byte{0}), []byte{0}),
+	}}
+	message := func(authenticator *commonpb.Authenticator) *isspb.SBMessage {
+		return &isspb.SBMessage{
+			Epoch:         0,
+			Instance:      instance,
+			Msg:           PbftPreprepareSBMessage(pbftPreprepareMsg(sn, 0, batch, nil, false)),
+			Authenticator: authenticator,
+		}
+	}
+
+	// Messages without a MAC for this node are ignored.
+	assert.Equal(t, 0, iss.applySBMessage(message(nil), "0").Len())
+	otherAuth := &commonpb.Authenticator{NodeIds: []string{"2", "3"}, Macs: [][]byte{{2}, {3}}}
+	assert.Equal(t, 0, iss.applySBMessage(message(otherAuth), "0").Len())
+
+	// Otherwise, the MAC for this node is verified before applying the message.
+	auth := &commonpb.Authenticator{NodeIds: []string{"1", "2", "3"}, Macs: [][]byte{{1}, {2}, {3}}}
+	evts := iss.applySBMessage(message(auth), "0")
+	require.Equal(t, 1, evts.Len())
+	verify := evts.Slice()[0].GetVerifyAuthenticators()
+	require.NotNil(t, verify)
+	assert.Equal(t, [][]byte{{1}}, verify.Macs)
+	assert.Equal(t, []string{"0"}, verify.NodeIds)
+	assert.False(t, hashRequested(evts))
+
+	// A message with an invalid MAC is not applied.
+	evts, err := iss.ApplyEvent(events.AuthenticatorsVerified(
+		issModuleName, []bool{false}, []string{"invalid MAC"}, []types.NodeID{"0"}, verify.Origin, false,
+	))
+	require.NoError(t, err)
+	assert.False(t, hashRequested(evts))
+
+	// A message with a valid MAC is applied to the orderer, which starts processing the Preprepare.
+	evts, err = iss.ApplyEvent(events.AuthenticatorsVerified(
+		issModuleName, []bool{true}, []string{""}, []types.NodeID{"0"}, verify.Origin, true,
+	))
+	require.NoError(t, err)
+	assert.True(t, hashRequested(evts))
+}
+
+func TestSBMessageAuthenticatorComputed(t *testing.T) {
+	iss, instance, sn := newTestOrdererWithConfig(t, func(config *Config) {
+		config.PBFTMACAuthentication = true
+	})
+
+	message := &isspb.SBMessage{
+		Epoch:    0,
+		Instance: instance,
+		Msg:      PbftPrepareSBMessage(pbftPrepareMsg(sn, 0, []byte{0})),
+	}
+	auth := &commonpb.Authenticator{NodeIds: []string{"0", "2"}, Macs: [][]byte{{0}, {2}}}
+
+	// The message is only sent when the authenticator has been computed, and it is sent with the authenticator.
+	evts, err := iss.ApplyEvent(events.AuthenticatorComputed(
+		issModuleName, auth, SBAuthSendOrigin(message, []types.NodeID{"0", "2"}),
+	))
+	require.NoError(t, err)
+	require.Equal(t, 1, evts.Len())
+	send := evts.Slice()[0].GetSendMessage()
+	require.NotNil(t, send)
+	assert.Equal(t, []string{"0", "2"}, send.Destinations)
+	assert.Equal(t, auth, send.Msg.GetIss().GetSb().GetAuthenticator())
+}
+
+func TestCheckpointMACAuthentication(t *testing.T) {
+	membership := []types.NodeID{"0", "1", "2", "3"}
+	ct := newCheckpointTracker("0", 10, 1, 1000, true, logging.NilLogger)
+	ct.Start(membership, &isspb.ClientProgress{})
+	ct.ProcessAppSnapshot([]byte("snapshot"))
+	ct.ProcessAppSnapshotHash([]byte("hash"))
+
+	// The own Checkpoint message is only sent with an authenticator attached.
+	evts := ct.ProcessCheckpointSignResult([]byte("sig0"))
+	assert.Nil(t, findEvent(evts, func(e *eventpb.Event) bool { return e.GetSendMessage() != nil }))
+	compute := findEvent(evts, func(e *eventpb.Event) bool { return e.GetComputeAuthenticator() != nil })
+	require.NotNil(t, compute)
+	assert.Equal(t, types.NodeIDSlicePb(membership), compute.GetComputeAuthenticator().NodeIds)
+
+	auth := &commonpb.Authenticator{NodeIds: []string{"1", "2", "3"}, Macs: [][]byte{{1}, {2}, {3}}}
+	send := findEvent(
+		ct.ProcessAuthenticatorComputed(auth),
+		func(e *eventpb.Event) bool { return e.GetSendMessage() != nil },
+	)
+	require.NotNil(t, send)
+	assert.Equal(t, auth, send.GetSendMessage().Msg.GetIss().GetCheckpoint().GetAuthenticator())
+	assert.Equal(t, []byte("sig0"), send.GetSendMessage().Msg.GetIss().GetCheckpoint().GetSignature())
+
+	checkpoint := func(node string, mac []byte) *isspb.Checkpoint {
+		var auth *commonpb.Authenticator
+		if mac != nil {
+			auth = &commonpb.Authenticator{NodeIds: []string{"0"}, Macs: [][]byte{mac}}
+		}
+		return &isspb.Checkpoint{
+			Epoch:           1,
+			Sn:              10,
+			AppSnapshotHash: []byte("hash"),
+			Signature:       []byte("sig" + node),
+			Authenticator:   auth,
+		}
+	}
+
+	// A Checkpoint message without a MAC for this node is ignored.
+	assert.Equal(t, 0, ct.applyMessage(checkpoint("1", nil), "1").Len())
+
+	// A forged Checkpoint message with an invalid MAC does not prevent the genuine message from being accepted.
+	forged := checkpoint("1", []byte("forged"))
+	forged.Signature = []byte("forged")
+	assert.Equal(t, 1, ct.applyMessage(forged, "1").Len())
+	assert.Equal(t, 0, ct.applyMessage(checkpoint("1", []byte("1")), "1").Len())
+	assert.Equal(t, 0, ct.ProcessMACVerified(false, "invalid MAC", "1").Len())
+
+	// Received Checkpoint messages only have their MAC verified.
+	for _, node := range []types.NodeID{"1", "2", "3"} {
+		evts := ct.applyMessage(checkpoint(string(node), []byte(node)), node)
+		require.Equal(t, 1, evts.Len())
+		verify := evts.Slice()[0].GetVerifyAuthenticators()
+		require.NotNil(t, verify)
+		assert.Equal(t, [][]byte{[]byte(node)}, verify.Macs)
+	}
+
+	// A strong quorum of valid MACs (including this node) triggers a single verification of their signatures.
+	assert.Equal(t, 0, ct.ProcessMACVerified(true, "", "1").Len())
+	evts = ct.ProcessMACVerified(true, "", "3")
+	require.Equal(t, 1, evts.Len())
+	verifySigs := evts.Slice()[0].GetVerifyNodeSigs()
+	require.NotNil(t, verifySigs)
+	assert.Equal(t, []string{"1", "3"}, verifySigs.NodeIds)
+	assert.Equal(t, [][]byte{[]byte("sig1"), []byte("sig3")}, verifySigs.Signatures)
+
+	// While the signatures are being verified, further valid MACs are only recorded.
+	assert.Equal(t, 0, ct.ProcessMACVerified(true, "", "2").Len())
+
+	// If a signature turns out invalid, the signatures of the remaining nodes with a valid MAC are verified.
+	evts = ct.ProcessCertSigsVerified([]bool{true, false}, []string{"", "invalid"}, []types.NodeID{"1", "3"})
+	require.Equal(t, 1, evts.Len())
+	verifySigs = evts.Slice()[0].GetVerifyNodeSigs()
+	require.NotNil(t, verifySigs)
+	assert.Equal(t, []string{"2"}, verifySigs.NodeIds)
+	assert.False(t, ct.stable())
+
+	// When a strong quorum of valid signatures is reached, the checkpoint becomes stable,
+	// with only the valid signatures in its certificate.
+	evts = ct.ProcessCertSigsVerified([]bool{true}, []string{""}, []types.NodeID{"2"})
+	assert.True(t, ct.stable())
+	persist := findEvent(evts, func(e *eventpb.Event) bool {
+		return e.GetIss().GetPersistStableCheckpoint() != nil
+	})
+	require.NotNil(t, persist)
+	assert.Equal(t,
+		map[string][]byte{"0": []byte("sig0"), "1": []byte("sig1"), "2": []byte("sig2")},
+		persist.GetIss().GetPersistStableCheckpoint().StableCheckpoint.Cert,
+	)
+}
+
+func TestCheckModules(t *testing.T) {
+	membership := []types.NodeID{"0", "1", "2", "3"}
+	cryptoImpl, err := mirCrypto.NodePseudo(membership, "0", mirCrypto.DefaultPseudoSeed)
+	require.NoError(t, err)
+	macImpl, err := mirCrypto.NodePseudoMAC(membership, "0", mirCrypto.DefaultPseudoSeed)
+	require.NoError(t, err)
+
+	withoutMACs := modules.Modules{"crypto": mirCrypto.New(cryptoImpl)}
+	withMACs := modules.Modules{"crypto": mirCrypto.NewWithMAC(cryptoImpl, macImpl)}
+
+	config := DefaultConfig(membership)
+	assert.NoError(t, CheckModules(config, withoutMACs))
+
+	config.PBFTMACAuthentication = true
+	assert.Error(t, CheckModules(config, withoutMACs))
+	assert.NoError(t, CheckModules(config, withMACs))
+
+	config.PBFTMACAuthentication = false
+	config.CheckpointMACAuthentication = true
+	assert.Error(t, CheckModules(config, withoutMACs))
+	assert.NoError(t, CheckModules(config, withMACs))
+}
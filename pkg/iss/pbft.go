@@ -12,6 +12,8 @@ package iss
 import (
 	"fmt"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/logging"
 	"github.com/filecoin-project/mir/pkg/messagebuffer"
@@ -47,6 +49,10 @@ type pbftInstance struct {
 	// Tracks the state related to proposing batches.
 	proposal pbftProposalState
 
+	// Determines the size of proposed batches and the delay between proposals.
+	// Shared with other orderers (and ISS), see batchingPolicy.
+	batching *batchingPolicy
+
 	// For each view, slots contains one pbftSlot per sequence number this orderer is responsible for.
 	// Each slot tracks the state of the agreement protocol for one sequence number.
 	slots map[t.PBFTViewNr]map[t.SeqNr]*pbftSlot
@@ -54,6 +60,10 @@ type pbftInstance struct {
 	// Tracks the state of the segment-local checkpoint.
 	segmentCheckpoint *pbftSegmentChkp
 
+	// Schedules requests for missing Preprepare messages,
+	// both when constructing a NewView message and when catching up with the segment-local checkpoint.
+	fetcher *pbftFetcher
+
 	// Logger for outputting debugging messages.
 	logger logging.Logger
 
@@ -84,6 +94,8 @@ type pbftInstance struct {
 //                       assigned to the new instance (segment.BucketIDs) and ready to be proposed by this PBFT orderer.
 //                       This is required for the orderer to know whether it make proposals right away.
 // - config:             PBFT-specific configuration parameters.
+// - batching:           Policy determining the size of proposed batches and the delay between proposals.
+// - dropped:            Counters of messages dropped by the message buffers, shared with ISS.
 // - eventService:       Event creator object enabling the orderer to produce events.
 //                       All events this orderer creates will be created using the methods of the eventService.
 //                       The eventService must be configured to produce events associated with this PBFT orderer,
@@ -94,6 +106,8 @@ func newPbftInstance(
 	segment *segment,
 	numPendingRequests t.NumRequests,
 	config *PBFTConfig,
+	batching *batchingPolicy,
+	dropped *messagebuffer.DropCounters,
 	eventService *sbEventService,
 	logger logging.Logger) *pbftInstance {
 
@@ -102,8 +116,10 @@ func newPbftInstance(
 		ownID:             ownID,
 		segment:           segment,
 		config:            config,
+		batching:          batching,
 		slots:             make(map[t.PBFTViewNr]map[t.SeqNr]*pbftSlot),
 		segmentCheckpoint: newPbftSegmentChkp(),
+		fetcher:           newPbftFetcher(ownID, t.TimeDuration(config.FetchTimeout), eventService),
 		proposal: pbftProposalState{
 			proposalsMade:      0,
 			numPendingRequests: numPendingRequests,
@@ -113,8 +129,9 @@ func newPbftInstance(
 		},
 		messageBuffers: messagebuffer.NewBuffers(
 			removeNodeID(config.Membership, ownID), // Create a message buffer for everyone except for myself.
-			config.MsgBufCapacity,                  // TODO: Configure this separately for ISS buffers and PBFT buffers.
-			//       Even better, share the same buffers with ISS.
+			config.MsgBufCapacity,
+			classifyPbftBufferedMessage,
+			dropped,
 			logging.Decorate(logger, "Msgbuf: "),
 		),
 		logger:           logger,
@@ -142,6 +159,8 @@ func (pbft *pbftInstance) ApplyEvent(event *isspb.SBInstanceEvent) *events.Event
 		return pbft.applyViewChangeBatchTimeout(e.PbftViewChangeBatchTimeout)
 	case *isspb.SBInstanceEvent_PbftViewChangeSegTimeout:
 		return pbft.applyViewChangeSegmentTimeout(t.PBFTViewNr(e.PbftViewChangeSegTimeout))
+	case *isspb.SBInstanceEvent_PbftFetchTimeout:
+		return pbft.applyFetchTimeout(e.PbftFetchTimeout)
 	case *isspb.SBInstanceEvent_PendingRequests:
 		return pbft.applyPendingRequests(t.NumRequests(e.PendingRequests.NumRequests))
 	case *isspb.SBInstanceEvent_BatchReady:
@@ -152,6 +171,8 @@ func (pbft *pbftInstance) ApplyEvent(event *isspb.SBInstanceEvent) *events.Event
 		return pbft.applySignResult(e.SignResult)
 	case *isspb.SBInstanceEvent_NodeSigsVerified:
 		return pbft.applyNodeSigsVerified(e.NodeSigsVerified)
+	case *isspb.SBInstanceEvent_CertVerified:
+		return pbft.applyCertVerified(e.CertVerified)
 	case *isspb.SBInstanceEvent_PbftPersistPreprepare:
 		return pbft.applyPbftPersistPreprepare(e.PbftPersistPreprepare)
 	case *isspb.SBInstanceEvent_MessageReceived:
@@ -206,6 +227,7 @@ func (pbft *pbftInstance) applyNodeSigsVerified(result *isspb.SBNodeSigsVerified
 			"type", fmt.Sprintf("%T", result.Origin.Type),
 			"errors", result.Errors,
 		)
+		return events.EmptyList()
 	}
 
 	// Depending on the origin of the sign result, continue processing where the signature verification was needed.
@@ -275,10 +297,10 @@ func (pbft *pbftInstance) canPropose() bool {
 		pbft.proposal.proposalsMade < len(pbft.segment.SeqNrs) &&
 
 		// Either the batch timeout must have passed, or there must be enough requests for a full batch.
-		// The value 0 for config.MaxBatchSize means no limit on batch size,
+		// The batch size 0 means no limit on batch size,
 		// i.e., a proposal cannot be triggered just by the number of pending requests.
 		(pbft.proposal.proposalTimeout > pbft.proposal.proposalsMade ||
-			(pbft.config.MaxBatchSize != 0 && pbft.proposal.numPendingRequests >= pbft.config.MaxBatchSize)) &&
+			(pbft.batching.BatchSize() != 0 && pbft.proposal.numPendingRequests >= pbft.batching.BatchSize())) &&
 
 		// No proposals can be made while in view change.
 		!pbft.inViewChange
@@ -296,7 +318,7 @@ func (pbft *pbftInstance) applyInit() *events.EventList {
 
 	// Set up timer for the first proposal.
 	return eventsOut.PushBack(pbft.eventService.TimerDelay(
-		t.TimeDuration(pbft.config.MaxProposeDelay),
+		t.TimeDuration(pbft.batching.ProposeDelay()),
 		pbft.eventService.SBEvent(PbftProposeTimeout(1)),
 	))
 
@@ -326,7 +348,7 @@ func (pbft *pbftInstance) applyPendingRequests(numRequests t.NumRequests) *event
 	pbft.proposal.numPendingRequests = numRequests
 
 	if pbft.canPropose() {
-		// Start a new proposal if applicable (i.e. if the number of pending requests reached config.MaxBatchSize).
+		// Start a new proposal if applicable (i.e. if the number of pending requests reached the batch size).
 		return pbft.requestNewBatch()
 	}
 
@@ -431,3 +453,19 @@ func computeTimeout(timeout t.TimeDuration, view t.PBFTViewNr) t.TimeDuration {
 	}
 	return timeout
 }
+
+// classifyPbftBufferedMessage returns the view of a message stored in the PBFT message buffers as its rank,
+// so that messages from nearer views are preferentially kept, and the message type for counting dropped messages.
+func classifyPbftBufferedMessage(message proto.Message) (uint64, string) {
+	msgType := "PBFT:" + string(message.ProtoReflect().Descriptor().Name())
+	switch msg := message.(type) {
+	case *isspbftpb.Preprepare:
+		return msg.View, msgType
+	case *isspbftpb.Prepare:
+		return msg.View, msgType
+	case *isspbftpb.Commit:
+		return msg.View, msgType
+	default:
+		panic(fmt.Errorf("cannot extract view from message type: %T", message))
+	}
+}
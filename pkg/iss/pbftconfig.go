@@ -19,6 +19,7 @@ type PBFTConfig struct {
 	// When MaxProposeDelay has elapsed since the last proposal,
 	// the protocol tries to propose a new request batch, even if the batch is not full (or even completely empty).
 	// Must not be negative.
+	// With adaptive batching, this is only an upper bound on the delay, see batchingPolicy.
 	MaxProposeDelay time.Duration
 
 	// When a node has committed all batches in a segment, it will periodically send the Done message
@@ -31,7 +32,7 @@ type PBFTConfig struct {
 
 	// Maximal number of bytes used for message backlogging buffers
 	// (only message payloads are counted towards MsgBufCapacity).
-	// Same as Config.MsgBufCapacity, but used only for one instance of PBFT.
+	// Same as Config.PBFTMsgBufCapacity.
 	// Must not be negative.
 	MsgBufCapacity int
 
@@ -39,6 +40,7 @@ type PBFTConfig struct {
 	// As soon as the number of pending requests reaches MaxBatchSize,
 	// the PBFT instance may decide to immediately propose a new request batch.
 	// Setting MaxBatchSize to zero signifies no limit on batch size.
+	// With adaptive batching, this is only an upper bound on the batch size, see batchingPolicy.
 	MaxBatchSize t.NumRequests
 
 	// Per-batch view change timeout for view 0.
@@ -56,4 +58,12 @@ type PBFTConfig struct {
 	// ViewChange messages need to be resent periodically to preserve liveness.
 	// Otherwise, the system could get stuck if a ViewChange message is dropped by the network.
 	ViewChangeResendPeriod time.Duration
+
+	// Time to wait for a requested missing Preprepare message from one node before asking another node.
+	// Applies both to Preprepares needed for a NewView message and to catching up with a segment-level checkpoint.
+	FetchTimeout time.Duration
+
+	// If true, the leader proposes availability certificates instead of request batches (and so must other leaders).
+	// Same as Config.UseAvailabilityLayer.
+	UseAvailabilityLayer bool
 }
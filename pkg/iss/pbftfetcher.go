@@ -0,0 +1,191 @@
+package iss
+
+import (
+	"bytes"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
+)
+
+// pbftFetchKey identifies a Preprepare message being fetched from other nodes.
+// The same Preprepare can be fetched for two different purposes (distinguished by the catchUp flag):
+// - for re-proposing it in a NewView message (catchUp == false), or
+// - for catching up with a segment-level checkpoint (catchUp == true).
+// The two purposes use different request messages and the responses are processed differently.
+type pbftFetchKey struct {
+	sn      t.SeqNr
+	digest  string
+	catchUp bool
+}
+
+// pbftFetch tracks the fetching of a single missing Preprepare message.
+type pbftFetch struct {
+
+	// IDs of the nodes known to have the Preprepare message.
+	candidates []t.NodeID
+
+	// Index in candidates of the node to ask first.
+	first int
+
+	// Number of nodes asked so far.
+	// Each FetchTimeout event carries the number of nodes asked at the time of its creation,
+	// so that timeouts of requests that have already been superseded by a request to another node can be ignored.
+	attempt uint64
+}
+
+// pbftFetcher schedules requests for Preprepare messages that a PBFT orderer is missing.
+// Instead of asking all the nodes known to have a Preprepare at once, the pbftFetcher asks only one of them.
+// If the Preprepare does not arrive within a timeout, the next node is asked, and so on, wrapping around if necessary.
+// The first node to ask is chosen based on the sequence number,
+// so that the requests for different sequence numbers are spread across the nodes that have the Preprepares.
+// Requests for the same Preprepare are deduplicated,
+// even if the Preprepare is needed repeatedly (e.g. in multiple subsequent view changes).
+type pbftFetcher struct {
+
+	// The ID of this node. It is never asked for a Preprepare.
+	ownID t.NodeID
+
+	// Time to wait for a Preprepare from one node before asking the next one.
+	timeout t.TimeDuration
+
+	// Event creator object of the PBFT orderer this pbftFetcher belongs to.
+	eventService *sbEventService
+
+	// Preprepare messages currently being fetched.
+	fetches map[pbftFetchKey]*pbftFetch
+}
+
+// newPbftFetcher returns a new pbftFetcher with no ongoing fetches.
+func newPbftFetcher(ownID t.NodeID, timeout t.TimeDuration, eventService *sbEventService) *pbftFetcher {
+	return &pbftFetcher{
+		ownID:        ownID,
+		timeout:      timeout,
+		eventService: eventService,
+		fetches:      make(map[pbftFetchKey]*pbftFetch),
+	}
+}
+
+// Fetch starts fetching the Preprepare message with the given sequence number and digest
+// from the nodes in candidates (except for this node).
+// If delay is zero, the first node is asked right away. Otherwise, the first node is only asked after delay.
+// If the Preprepare is already being fetched, only the candidates not yet known are added to the ongoing fetch.
+// The caller is responsible for calling Done when the Preprepare is obtained.
+func (f *pbftFetcher) Fetch(
+	sn t.SeqNr,
+	digest []byte,
+	catchUp bool,
+	candidates []t.NodeID,
+	delay t.TimeDuration,
+) *events.EventList {
+
+	key := pbftFetchKey{sn: sn, digest: string(digest), catchUp: catchUp}
+
+	// If the Preprepare is already being fetched, only extend the list of nodes to ask.
+	if fetch, ok := f.fetches[key]; ok {
+		fetch.addCandidates(candidates, f.ownID)
+		return events.EmptyList()
+	}
+
+	fetch := &pbftFetch{candidates: make([]t.NodeID, 0, len(candidates))}
+	fetch.addCandidates(candidates, f.ownID)
+
+	// If there is nobody to ask, there is nothing to do.
+	if len(fetch.candidates) == 0 {
+		return events.EmptyList()
+	}
+
+	fetch.first = int(sn) % len(fetch.candidates)
+	f.fetches[key] = fetch
+
+	if delay > 0 {
+		return events.ListOf(f.timeoutEvent(key, fetch, delay))
+	}
+	return f.ask(key, fetch)
+}
+
+// Timeout handles the expiration of a fetch timeout.
+// If the corresponding Preprepare is still being fetched and no other node has been asked since the timeout was set up,
+// Timeout asks the next node. If the Preprepare is not needed any more (stillNeeded is false), the fetch is stopped.
+func (f *pbftFetcher) Timeout(timeout *isspbftpb.FetchTimeout, stillNeeded bool) *events.EventList {
+	key := pbftFetchKey{sn: t.SeqNr(timeout.Sn), digest: string(timeout.Digest), catchUp: timeout.CatchUp}
+
+	// Ignore timeouts of finished fetches and of requests that have already been superseded.
+	fetch, ok := f.fetches[key]
+	if !ok || fetch.attempt != timeout.Attempt {
+		return events.EmptyList()
+	}
+
+	if !stillNeeded {
+		delete(f.fetches, key)
+		return events.EmptyList()
+	}
+
+	return f.ask(key, fetch)
+}
+
+// Done stops fetching the Preprepare message with the given sequence number and digest.
+func (f *pbftFetcher) Done(sn t.SeqNr, digest []byte, catchUp bool) {
+	delete(f.fetches, pbftFetchKey{sn: sn, digest: string(digest), catchUp: catchUp})
+}
+
+// ask sends a request for the fetched Preprepare to the next node and sets up a timeout for the response.
+func (f *pbftFetcher) ask(key pbftFetchKey, fetch *pbftFetch) *events.EventList {
+
+	// Choose the node to ask.
+	nodeID := fetch.candidates[(fetch.first+int(fetch.attempt))%len(fetch.candidates)]
+	fetch.attempt++
+
+	// Create the request message.
+	var msg *isspb.SBInstanceMessage
+	if key.catchUp {
+		msg = PbftCatchUpRequestSBMessage(key.sn, []byte(key.digest))
+	} else {
+		msg = PbftPreprepareRequestSBMessage(pbftPreprepareRequestMsg(key.sn, []byte(key.digest)))
+	}
+
+	return events.ListOf(
+		f.eventService.SendMessage(msg, []t.NodeID{nodeID}),
+		f.timeoutEvent(key, fetch, f.timeout),
+	)
+}
+
+// timeoutEvent returns a timer event that will produce a FetchTimeout event for the current attempt after delay.
+func (f *pbftFetcher) timeoutEvent(key pbftFetchKey, fetch *pbftFetch, delay t.TimeDuration) *eventpb.Event {
+	return f.eventService.TimerDelay(
+		delay,
+		f.eventService.SBEvent(PbftFetchTimeout(key.sn, []byte(key.digest), key.catchUp, fetch.attempt)),
+	)
+}
+
+// addCandidates adds the given node IDs (except for ownID) to the candidates of the fetch, skipping duplicates.
+func (fetch *pbftFetch) addCandidates(nodeIDs []t.NodeID, ownID t.NodeID) {
+	for _, nodeID := range nodeIDs {
+		if nodeID != ownID && !sliceutil.Contains(fetch.candidates, nodeID) {
+			fetch.candidates = append(fetch.candidates, nodeID)
+		}
+	}
+}
+
+// applyFetchTimeout handles the expiration of the timeout of a request for a missing Preprepare message.
+// If the Preprepare is still missing, the next node known to have it is asked for it.
+func (pbft *pbftInstance) applyFetchTimeout(timeout *isspbftpb.FetchTimeout) *events.EventList {
+	return pbft.fetcher.Timeout(timeout, pbft.preprepareMissing(t.SeqNr(timeout.Sn), timeout.Digest, timeout.CatchUp))
+}
+
+// preprepareMissing returns true if the Preprepare message with the given sequence number and digest is still needed,
+// for catching up with the segment-local checkpoint if catchUp is true, or for sending a NewView message otherwise.
+func (pbft *pbftInstance) preprepareMissing(sn t.SeqNr, digest []byte, catchUp bool) bool {
+	if catchUp {
+		return !pbft.slots[pbft.view][sn].Committed
+	}
+
+	state, view := pbft.latestPendingVCState()
+	return state != nil &&
+		view >= pbft.view &&
+		state.preprepares[sn] == nil &&
+		bytes.Equal(state.reproposals[sn], digest)
+}
@@ -0,0 +1,59 @@
+package iss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// fetchRequestDestinations returns the destinations of all messages sent by the given events.
+func fetchRequestDestinations(evts *events.EventList) []string {
+	destinations := make([]string, 0)
+	for _, event := range evts.Slice() {
+		if sendMessage := event.GetSendMessage(); sendMessage != nil {
+			destinations = append(destinations, sendMessage.Destinations...)
+		}
+	}
+	return destinations
+}
+
+func TestPbftFetcher(t *testing.T) {
+	f := newPbftFetcher("0", 1, &sbEventService{})
+	nodes := []types.NodeID{"0", "1", "2", "3"}
+	digest := []byte{42}
+
+	// Only one node (other than the own node) is asked, chosen based on the sequence number.
+	assert.Equal(t, []string{"3"}, fetchRequestDestinations(f.Fetch(2, digest, false, nodes, 0)))
+
+	// Repeated fetches of the same Preprepare are deduplicated.
+	assert.Empty(t, fetchRequestDestinations(f.Fetch(2, digest, false, nodes, 0)))
+
+	// On timeout, the next node is asked, wrapping around.
+	timeout := &isspbftpb.FetchTimeout{Sn: 2, Digest: digest, Attempt: 1}
+	assert.Equal(t, []string{"1"}, fetchRequestDestinations(f.Timeout(timeout, true)))
+
+	// A timeout of a superseded request is ignored.
+	assert.Empty(t, fetchRequestDestinations(f.Timeout(timeout, true)))
+
+	// After the fetch is done, its timeouts are ignored.
+	f.Done(2, digest, false)
+	timeout.Attempt = 2
+	assert.Empty(t, fetchRequestDestinations(f.Timeout(timeout, true)))
+
+	// With a delay, nobody is asked before the first timeout.
+	evts := f.Fetch(3, digest, true, nodes, 5)
+	require.Equal(t, 1, evts.Len())
+	assert.Empty(t, fetchRequestDestinations(evts))
+	timeout = &isspbftpb.FetchTimeout{Sn: 3, Digest: digest, CatchUp: true, Attempt: 0}
+	assert.Equal(t, []string{"1"}, fetchRequestDestinations(f.Timeout(timeout, true)))
+
+	// A fetch that is not needed any more is stopped on timeout.
+	timeout.Attempt = 1
+	assert.Empty(t, fetchRequestDestinations(f.Timeout(timeout, false)))
+	assert.Empty(t, f.fetches)
+}
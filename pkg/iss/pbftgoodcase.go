@@ -7,6 +7,7 @@ import (
 
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/pb/isspb"
 	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
@@ -29,7 +30,8 @@ type pbftProposalState struct {
 
 	// Saves the number of pending requests that are available to be included in the next proposal,
 	// as reported by ISS through the PendingRequests event.
-	// When this value exceeds config.MaxBatchSize, a proposal might be made before the config.MaxProposeDelay.
+	// When this value exceeds the current batch size, a proposal might be made before the propose delay elapses.
+	// (See batchingPolicy for how the batch size and the propose delay are determined.)
 	numPendingRequests t.NumRequests
 
 	// Flag indicating whether a new batch has been requested from ISS.
@@ -93,7 +95,7 @@ func (pbft *pbftInstance) requestNewBatch() *events.EventList {
 
 	// Emit the CutBatch event.
 	// Operation continues on reception of the BatchReady event.
-	return events.ListOf(pbft.eventService.SBEvent(SBCutBatchEvent(pbft.config.MaxBatchSize)))
+	return events.ListOf(pbft.eventService.SBEvent(SBCutBatchEvent(pbft.batching.BatchSize())))
 }
 
 // applyBatchReady processes a new batch ready to be proposed.
@@ -105,11 +107,20 @@ func (pbft *pbftInstance) applyBatchReady(batch *isspb.SBBatchReady) *events.Eve
 	pbft.proposal.batchRequested = false
 
 	if pbft.proposal.batchRequestedView == pbft.view {
-		// If the protocol is still in the same PBFT view as when the batch was requested, propose the received batch.
-		eventsOut.PushBackList(pbft.propose(batch.Batch))
+		// If the protocol is still in the same PBFT view as when the batch was requested, propose the received batch
+		// (or the availability certificate, if ISS provided one instead of the batch).
+		eventsOut.PushBackList(pbft.propose(batch.Batch, batch.Cert))
+
+		// Let the batching policy adapt to the proposed batch and the requests that are still pending.
+		pbft.batching.ObserveProposal(
+			t.NumRequests(len(batch.Batch.Requests)),
+			t.NumRequests(batch.PendingRequestsLeft),
+		)
 	} else {
 		// If the PBFT view advanced since the batch was requested,
 		// do not propose the batch and resurrect the requests it contains.
+		// An availability certificate is dropped, as the mempool makes the transactions it refers to
+		// available for inclusion in further batches again if they are not committed in time.
 		eventsOut.PushBack(pbft.eventService.SBEvent(SBResurrectBatchEvent(batch.Batch)))
 	}
 
@@ -120,9 +131,11 @@ func (pbft *pbftInstance) applyBatchReady(batch *isspb.SBBatchReady) *events.Eve
 }
 
 // propose proposes a new request batch by sending a Preprepare message.
+// If cert is not nil, the proposal refers to the batch through the availability certificate cert
+// and the batch itself (which is then empty) only serves as a placeholder.
 // propose assumes that the state of the PBFT orderer allows sending a new proposal
 // and does not perform any checks in this regard.
-func (pbft *pbftInstance) propose(batch *requestpb.Batch) *events.EventList {
+func (pbft *pbftInstance) propose(batch *requestpb.Batch, cert *availabilitypb.Cert) *events.EventList {
 
 	// Update proposal counter.
 	sn := pbft.segment.SeqNrs[pbft.proposal.proposalsMade]
@@ -130,10 +143,10 @@ func (pbft *pbftInstance) propose(batch *requestpb.Batch) *events.EventList {
 
 	// Log debug message.
 	pbft.logger.Log(logging.LevelDebug, "Proposing.",
-		"sn", sn, "batchSize", len(batch.Requests))
+		"sn", sn, "batchSize", len(batch.Requests), "withCert", cert != nil)
 
 	// Create the proposal (consisting of a Preprepare message).
-	preprepare := pbftPreprepareMsg(sn, pbft.view, batch, false)
+	preprepare := pbftPreprepareMsg(sn, pbft.view, batch, cert, false)
 
 	// Create a Preprepare message send Event.
 	// No need for periodic re-transmission.
@@ -145,7 +158,7 @@ func (pbft *pbftInstance) propose(batch *requestpb.Batch) *events.EventList {
 
 	// Set up a new timer for the next proposal.
 	timerEvent := pbft.eventService.TimerDelay(
-		t.TimeDuration(pbft.config.MaxProposeDelay),
+		t.TimeDuration(pbft.batching.ProposeDelay()),
 		pbft.eventService.SBEvent(PbftProposeTimeout(uint64(pbft.proposal.proposalsMade+1))),
 	)
 
@@ -194,18 +207,59 @@ func (pbft *pbftInstance) applyMsgPreprepare(preprepare *isspbftpb.Preprepare, f
 	// TODO: Check whether the batch contains any duplicate requests.
 	//       If it doesn't, mark the contained requests as "in flight" before continuing.
 
+	// Check that the content of the Preprepare is well-formed before saving it.
+	data, err := pbft.serializeReceivedPreprepare(preprepare)
+	if err != nil {
+		pbft.logger.Log(logging.LevelWarn, "Ignoring invalid Preprepare message.",
+			"sn", sn, "from", from, "err", err)
+		return events.EmptyList()
+	}
+
 	// Save the received preprepare message.
 	slot.Preprepare = preprepare
 
 	// Request the computation of the hash of the Preprepare message.
-	return events.ListOf(pbft.eventService.HashRequest(
-		[][][]byte{serializePreprepareForHashing(preprepare)},
-		preprepareHashOrigin(preprepare)),
-	)
+	return events.ListOf(pbft.eventService.HashRequest([][][]byte{data}, preprepareHashOrigin(preprepare)))
+}
+
+// serializeReceivedPreprepare checks that the content of a Preprepare message received from another node
+// is well-formed and, if so, returns the serialized Preprepare to be hashed (see serializePreprepareForHashing).
+// Only aborted Preprepares are allowed to contain neither a batch nor a certificate.
+// Otherwise, the Preprepare must refer to the proposed batch through an availability certificate
+// (in which case the batch included in the Preprepare must be empty) if and only if the availability layer is used.
+// This prevents a faulty leader from proposing a batch that the other nodes would not verify through the
+// availability layer, or a certificate that nodes without an availability layer would not be able to process.
+func (pbft *pbftInstance) serializeReceivedPreprepare(preprepare *isspbftpb.Preprepare) ([][]byte, error) {
+	if preprepare.Batch == nil {
+		return nil, fmt.Errorf("missing batch")
+	}
+
+	switch {
+	case preprepare.Aborted:
+		if preprepare.Cert != nil || len(preprepare.Batch.Requests) != 0 {
+			return nil, fmt.Errorf("aborted Preprepare with a non-empty proposal")
+		}
+	case preprepare.Cert != nil:
+		if !pbft.config.UseAvailabilityLayer {
+			return nil, fmt.Errorf("availability certificate proposed without the availability layer being used")
+		}
+		if len(preprepare.Batch.Requests) != 0 {
+			return nil, fmt.Errorf("availability certificate proposed along with a non-empty batch")
+		}
+	default:
+		if pbft.config.UseAvailabilityLayer {
+			return nil, fmt.Errorf("batch proposed without an availability certificate")
+		}
+	}
+
+	data, err := serializePreprepareForHashing(preprepare)
+	if err != nil {
+		return nil, fmt.Errorf("malformed availability certificate: %w", err)
+	}
+	return data, nil
 }
 
 func (pbft *pbftInstance) applyPreprepareHashResult(digest []byte, preprepare *isspbftpb.Preprepare) *events.EventList {
-	eventsOut := events.EmptyList()
 
 	// Convenience variable.
 	sn := t.SeqNr(preprepare.Sn)
@@ -215,13 +269,63 @@ func (pbft *pbftInstance) applyPreprepareHashResult(digest []byte, preprepare *i
 		return events.EmptyList()
 	}
 
-	// Save the digest of the Preprepare message and mark the slot as preprepared.
+	// Save the digest of the Preprepare message.
 	slot := pbft.slots[pbft.view][sn]
 	slot.Digest = digest
+
+	// If the Preprepare refers to the proposed batch through an availability certificate,
+	// the certificate must be verified before the slot can be marked as preprepared.
+	// Operation continues on reception of the CertVerified event.
+	if preprepare.Cert != nil && !preprepare.Aborted {
+		return events.ListOf(pbft.eventService.SBEvent(
+			SBVerifyCertEvent(preprepare.Cert, preprepareCertOrigin(preprepare)),
+		))
+	}
+
+	return pbft.acceptPreprepare(slot, sn)
+}
+
+// applyCertVerified processes the result of verifying the availability certificate contained in a Preprepare message.
+// If the certificate is valid, the corresponding slot is marked as preprepared. Otherwise, the Preprepare is ignored.
+func (pbft *pbftInstance) applyCertVerified(result *isspb.SBCertVerified) *events.EventList {
+
+	// Convenience variables.
+	preprepare := result.Origin.Type.(*isspb.SBInstanceCertOrigin_PbftPreprepare).PbftPreprepare
+	sn := t.SeqNr(preprepare.Sn)
+
+	// Stop processing the Preprepare if view advanced in the meantime.
+	if t.PBFTViewNr(preprepare.View) < pbft.view {
+		return events.EmptyList()
+	}
+
+	// Do not accept a Preprepare with an invalid certificate.
+	// The leader proposing it is faulty and will eventually be replaced by the view change protocol.
+	if !result.Valid {
+		pbft.logger.Log(logging.LevelWarn, "Ignoring Preprepare message. Invalid availability certificate.",
+			"sn", sn, "view", preprepare.View, "err", result.Err)
+		return events.EmptyList()
+	}
+
+	// The slot might have been preprepared or committed in the meantime (e.g. through catching up).
+	// Nothing to do then.
+	slot := pbft.slots[pbft.view][sn]
+	if slot.Preprepared || slot.Committed {
+		return events.EmptyList()
+	}
+
+	return pbft.acceptPreprepare(slot, sn)
+}
+
+// acceptPreprepare marks the slot as preprepared and sends a Prepare message for the digest of its Preprepare.
+// The slot's Preprepare and Digest fields must already be set.
+func (pbft *pbftInstance) acceptPreprepare(slot *pbftSlot, sn t.SeqNr) *events.EventList {
+	eventsOut := events.EmptyList()
+
+	// Mark the slot as preprepared.
 	slot.Preprepared = true
 
 	// Send (and persist) a Prepare message.
-	eventsOut.PushBackList(pbft.sendPrepare(pbftPrepareMsg(sn, pbft.view, digest)))
+	eventsOut.PushBackList(pbft.sendPrepare(pbftPrepareMsg(sn, pbft.view, slot.Digest)))
 
 	// Advance the state of the pbftSlot even more if necessary
 	// (potentially sending a Commit message or even delivering).
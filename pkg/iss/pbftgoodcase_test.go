@@ -0,0 +1,120 @@
+package iss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
+	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// hashRequested returns true if the given events contain a request for hashing.
+func hashRequested(evts *events.EventList) bool {
+	for _, event := range evts.Slice() {
+		if event.GetHashRequest() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// newTestOrderer returns an ISS instance of node "1" in a system of 4 nodes with initialized orderers,
+// along with the number of an orderer led by node "0" and the first sequence number of its segment.
+func newTestOrderer(t *testing.T, useAvailabilityLayer bool) (*ISS, uint64, types.SeqNr) {
+	return newTestOrdererWithConfig(t, func(config *Config) {
+		config.UseAvailabilityLayer = useAvailabilityLayer
+	})
+}
+
+// newTestOrdererWithConfig is like newTestOrderer, but lets the caller adapt the default configuration.
+func newTestOrdererWithConfig(t *testing.T, configure func(config *Config)) (*ISS, uint64, types.SeqNr) {
+	membership := []types.NodeID{"0", "1", "2", "3"}
+	config := DefaultConfig(membership)
+	configure(config)
+	iss, err := New("1", config, logging.NilLogger)
+	require.NoError(t, err)
+	iss.initOrderers()
+
+	for i, orderer := range iss.epoch.Orderers {
+		if orderer.Segment().Leader == "0" {
+			return iss, uint64(i), orderer.Segment().SeqNrs[0]
+		}
+	}
+	t.Fatal("no orderer led by node 0")
+	return nil, 0, 0
+}
+
+func TestPreprepareValidation(t *testing.T) {
+	batch := &requestpb.Batch{Requests: []*requestpb.HashedRequest{
+		events.HashedRequest(events.ClientRequest("client", 0, []byte{0}), []byte{0}),
+	}}
+	emptyBatch := &requestpb.Batch{Requests: []*requestpb.HashedRequest{}}
+	cert := &availabilitypb.Cert{Type: &availabilitypb.Cert_Msc{Msc: &mscpb.Cert{BatchId: []byte("batch")}}}
+
+	testCases := map[string]struct {
+		useAvailabilityLayer bool
+		batch                *requestpb.Batch
+		cert                 *availabilitypb.Cert
+		valid                bool
+	}{
+		"batch":                     {false, batch, nil, true},
+		"missing batch":             {false, nil, nil, false},
+		"certificate without layer": {false, emptyBatch, cert, false},
+		"certificate":               {true, emptyBatch, cert, true},
+		"batch with layer":          {true, batch, nil, false},
+		"certificate and batch":     {true, batch, cert, false},
+		"certificate without type":  {true, emptyBatch, &availabilitypb.Cert{}, false},
+		"empty msc certificate":     {true, emptyBatch, &availabilitypb.Cert{Type: &availabilitypb.Cert_Msc{}}, false},
+		"empty AVID certificate":    {true, emptyBatch, &availabilitypb.Cert{Type: &availabilitypb.Cert_Avid{}}, false},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			iss, instance, sn := newTestOrderer(t, tc.useAvailabilityLayer)
+			message := &isspb.SBMessage{
+				Epoch:    0,
+				Instance: instance,
+				Msg:      PbftPreprepareSBMessage(pbftPreprepareMsg(sn, 0, tc.batch, tc.cert, false)),
+			}
+
+			// An invalid Preprepare is ignored and a valid one is hashed.
+			assert.Equal(t, tc.valid, hashRequested(iss.applySBMessage(message, "0")))
+
+			// An invalid Preprepare does not prevent a subsequent valid one from being accepted.
+			if !tc.valid {
+				validPreprepare := pbftPreprepareMsg(sn, 0, batch, nil, false)
+				if tc.useAvailabilityLayer {
+					validPreprepare = pbftPreprepareMsg(sn, 0, emptyBatch, cert, false)
+				}
+				message.Msg = PbftPreprepareSBMessage(validPreprepare)
+				assert.True(t, hashRequested(iss.applySBMessage(message, "0")))
+			}
+		})
+	}
+}
+
+func TestMalformedPreprepareInNewView(t *testing.T) {
+	pbft := &pbftInstance{
+		segment: &segment{Leader: "0", Membership: testVCMembership, SeqNrs: []types.SeqNr{0}},
+		config:  &PBFTConfig{UseAvailabilityLayer: true},
+		logger:  logging.NilLogger,
+	}
+
+	newView := pbftNewViewMsg(
+		1,
+		[]types.NodeID{"0", "1", "2"},
+		[]*isspbftpb.SignedViewChange{testViewChange(nil), testViewChange(nil), testViewChange(nil)},
+		[]types.SeqNr{0},
+		[]*isspbftpb.Preprepare{pbftPreprepareMsg(0, 1, &requestpb.Batch{}, &availabilitypb.Cert{}, false)},
+	)
+	assert.Error(t, pbft.validateNewView(newView))
+}
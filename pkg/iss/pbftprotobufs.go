@@ -17,6 +17,7 @@ SPDX-License-Identifier: Apache-2.0
 package iss
 
 import (
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	"github.com/filecoin-project/mir/pkg/pb/isspb"
 	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
 	"github.com/filecoin-project/mir/pkg/pb/requestpb"
@@ -81,6 +82,17 @@ func PbftViewChangeSegmentTimeout(view t.PBFTViewNr) *isspb.SBInstanceEvent {
 	}}
 }
 
+func PbftFetchTimeout(sn t.SeqNr, digest []byte, catchUp bool, attempt uint64) *isspb.SBInstanceEvent {
+	return &isspb.SBInstanceEvent{Type: &isspb.SBInstanceEvent_PbftFetchTimeout{
+		PbftFetchTimeout: &isspbftpb.FetchTimeout{
+			Sn:      sn.Pb(),
+			Digest:  digest,
+			CatchUp: catchUp,
+			Attempt: attempt,
+		},
+	}}
+}
+
 // ============================================================
 // SB Instance Messages
 // ============================================================
@@ -159,12 +171,19 @@ func PbftCatchUpResponseSBMessage(preprepare *isspbftpb.Preprepare) *isspb.SBIns
 // of enforcing that all fields are explicitly set and none is forgotten.
 // Should the structure of the message change (e.g. by augmenting it by new fields),
 // using this function ensures that these the message is always constructed properly.
-func pbftPreprepareMsg(sn t.SeqNr, view t.PBFTViewNr, batch *requestpb.Batch, aborted bool) *isspbftpb.Preprepare {
+func pbftPreprepareMsg(
+	sn t.SeqNr,
+	view t.PBFTViewNr,
+	batch *requestpb.Batch,
+	cert *availabilitypb.Cert,
+	aborted bool,
+) *isspbftpb.Preprepare {
 	return &isspbftpb.Preprepare{
 		Sn:      sn.Pb(),
 		View:    view.Pb(),
 		Batch:   batch,
 		Aborted: aborted,
+		Cert:    cert,
 	}
 }
 
@@ -245,6 +264,12 @@ func missingPreprepareHashOrigin(preprepare *isspbftpb.Preprepare) *isspb.SBInst
 	}}
 }
 
+func preprepareCertOrigin(preprepare *isspbftpb.Preprepare) *isspb.SBInstanceCertOrigin {
+	return &isspb.SBInstanceCertOrigin{Type: &isspb.SBInstanceCertOrigin_PbftPreprepare{
+		PbftPreprepare: preprepare,
+	}}
+}
+
 func viewChangeSignOrigin(viewChange *isspbftpb.ViewChange) *isspb.SBInstanceSignOrigin {
 	return &isspb.SBInstanceSignOrigin{Type: &isspb.SBInstanceSignOrigin_PbftViewChange{
 		PbftViewChange: viewChange,
@@ -290,7 +315,9 @@ func catchUpResponseHashOrigin(preprepare *isspbftpb.Preprepare) *isspb.SBInstan
 // Note that the view number is *not* serialized, as hashes must be consistent across views.
 // Even though the preprepare argument is a protocol buffer, this function is required to guarantee
 // that the serialization is deterministic, since the protobuf native serialization does not provide this guarantee.
-func serializePreprepareForHashing(preprepare *isspbftpb.Preprepare) [][]byte {
+// serializePreprepareForHashing returns an error if the availability certificate contained in the Preprepare
+// is malformed. This can only happen for Preprepares received from faulty nodes.
+func serializePreprepareForHashing(preprepare *isspbftpb.Preprepare) ([][]byte, error) {
 
 	// Encode boolean Aborted field as one byte.
 	aborted := byte(0)
@@ -301,13 +328,23 @@ func serializePreprepareForHashing(preprepare *isspbftpb.Preprepare) [][]byte {
 	// Encode the batch content.
 	batchData := serializing.BatchForHash(preprepare.Batch)
 
+	// Encode the availability certificate, if any.
+	var certData [][]byte
+	if preprepare.Cert != nil {
+		var err error
+		if certData, err = serializing.CertForHash(preprepare.Cert); err != nil {
+			return nil, err
+		}
+	}
+
 	// Put everything together in a slice and return it.
 	// Note that we do not include the view number,
 	// as the view change protocol might compare hashes of Preprepares across vies.
-	data := make([][]byte, 0, len(preprepare.Batch.Requests)+3)
+	data := make([][]byte, 0, len(batchData)+len(certData)+2)
 	data = append(data, t.SeqNr(preprepare.Sn).Bytes(), []byte{aborted})
 	data = append(data, batchData...)
-	return data
+	data = append(data, certData...)
+	return data, nil
 }
 
 func serializeViewChangeForSigning(vc *isspbftpb.ViewChange) [][]byte {
@@ -5,7 +5,6 @@ import (
 
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/logging"
-	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
 	t "github.com/filecoin-project/mir/pkg/types"
 	"github.com/filecoin-project/mir/pkg/util/maputil"
@@ -163,7 +162,7 @@ func (pbft *pbftInstance) sendDoneMessages() *events.EventList {
 // applyMsgDone applies a received Done message.
 // Once enough Done messages have been applied, makes the protocol
 // - stop participating in view changes and
-// - set up a timer for fetching missing batches.
+// - start fetching missing batches.
 func (pbft *pbftInstance) applyMsgDone(doneMsg *isspbftpb.Done, from t.NodeID) *events.EventList {
 
 	// Register Done message.
@@ -175,44 +174,32 @@ func (pbft *pbftInstance) applyMsgDone(doneMsg *isspbftpb.Done, from t.NodeID) *
 		return events.EmptyList()
 	}
 
-	// If this was the last Done message required for a quorum, set up a timer to ask for the missing committed batches.
-	// In case the requests get lost, they need to be periodically retransmitted.
-	// Also, we still want to give the node some time to deliver the requests naturally before trying to catch up.
-	// Thus, we pack a TimerRepeat Event (that triggers the first "repetition" immediately) inside a TimerDelay.
+	// If this was the last Done message required for a quorum, start fetching the missing committed batches.
+	// We still want to give the node some time to deliver the batches naturally before trying to catch up.
+	// Thus, the fetcher only starts asking other nodes after CatchUpDelay.
 	// We also set the catchingUp flag to prevent this code from executing more than once per PBFT instance.
 	pbft.segmentCheckpoint.catchingUp = true
-	return events.ListOf(pbft.eventService.TimerDelay(
-		t.TimeDuration(pbft.config.CatchUpDelay),
-		pbft.eventService.TimerRepeat(
-			t.TimeDuration(pbft.config.CatchUpDelay),
-			pbft.catchUpRequests(doneNodes, pbft.segmentCheckpoint.Digests())...,
-		),
-	))
-
-	// TODO: Requesting all missing batches from all the nodes known to have them right away is quite an overkill,
-	//       resulting in a huge waste of resources. Be smarter about it by, for example, only asking a few nodes first.
+	return pbft.catchUp(doneNodes, pbft.segmentCheckpoint.Digests())
 }
 
-// catchUpRequests assembles and returns a list of Events representing requests for retransmission of committed batches.
-// The list contains one request for each slot of the segment that has not yet been committed.
-func (pbft *pbftInstance) catchUpRequests(nodes []t.NodeID, digests map[t.SeqNr][]byte) []*eventpb.Event {
+// catchUp starts fetching the committed batches (more precisely, the corresponding Preprepare messages)
+// for all slots of the segment that have not yet been committed.
+// Each batch is requested from one of the given nodes at a time, see pbftFetcher.
+func (pbft *pbftInstance) catchUp(nodes []t.NodeID, digests map[t.SeqNr][]byte) *events.EventList {
 
-	catchUpRequests := make([]*eventpb.Event, 0)
+	eventsOut := events.EmptyList()
 
 	// Deterministically iterate through all the (sequence number, batch) pairs received in a quorum of Done messages.
 	maputil.IterateSorted(digests, func(sn t.SeqNr, digest []byte) bool {
 
-		// If no batch has been committed for the sequence number, create a retransmission request.
+		// If no batch has been committed for the sequence number, start fetching it.
 		if !pbft.slots[pbft.view][sn].Committed {
-			catchUpRequests = append(catchUpRequests, pbft.eventService.SendMessage(
-				PbftCatchUpRequestSBMessage(sn, digest),
-				nodes,
-			))
+			eventsOut.PushBackList(pbft.fetcher.Fetch(sn, digest, true, nodes, t.TimeDuration(pbft.config.CatchUpDelay)))
 		}
 		return true
 	})
 
-	return catchUpRequests
+	return eventsOut
 }
 
 // applyMsgCatchUpRequest applies a request for retransmitting a missing committed batch.
@@ -246,11 +233,15 @@ func (pbft *pbftInstance) applyMsgCatchUpResponse(preprepare *isspbftpb.Preprepa
 		return events.EmptyList()
 	}
 
+	// Ignore malformed Preprepares.
+	data, err := pbft.serializeReceivedPreprepare(preprepare)
+	if err != nil {
+		pbft.logger.Log(logging.LevelWarn, "Ignoring invalid CatchUpResponse.", "sn", preprepare.Sn, "err", err)
+		return events.EmptyList()
+	}
+
 	// Request a hash of the received preprepare message.
-	hashRequest := pbft.eventService.HashRequest(
-		[][][]byte{serializePreprepareForHashing(preprepare)},
-		catchUpResponseHashOrigin(preprepare),
-	)
+	hashRequest := pbft.eventService.HashRequest([][][]byte{data}, catchUpResponseHashOrigin(preprepare))
 	return events.ListOf(hashRequest)
 }
 
@@ -287,9 +278,10 @@ func (pbft *pbftInstance) applyCatchUpResponseHashResult(
 
 	pbft.logger.Log(logging.LevelDebug, "Catching up with segment-level checkpoint.", "sn", sn)
 
-	// Add the missing batch, updating the corresponding Preprepare's view.
+	// Add the missing batch, updating the corresponding Preprepare's view, and stop fetching it from other nodes.
 	// Note that copying a Preprepare with an updated view preserves its hash.
 	slot.catchUp(copyPreprepareToNewView(preprepare, pbft.view), digest)
+	pbft.fetcher.Done(sn, digest, true)
 
 	// If all batches have been committed (i.e. this is the last batch to commit),
 	// send a Done message to all other nodes.
@@ -303,6 +295,7 @@ func (pbft *pbftInstance) applyCatchUpResponseHashResult(
 	eventsOut.PushBack(pbft.eventService.SBEvent(SBDeliverEvent(
 		sn,
 		slot.Preprepare.Batch,
+		slot.Preprepare.Cert,
 		slot.Preprepare.Aborted,
 	)))
 
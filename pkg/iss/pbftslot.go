@@ -93,6 +93,12 @@ func (slot *pbftSlot) advanceState(pbft *pbftInstance, sn t.SeqNr) *events.Event
 		// Mark slot as committed.
 		slot.Committed = true
 
+		// If this node proposed the batch, report the number of own proposals still waiting to be committed
+		// to the batching policy.
+		if pbft.ownID == pbft.segment.Leader && pbft.view == 0 {
+			pbft.batching.ObserveCommit(pbft.proposal.proposalsMade - pbft.numCommitted(pbft.view))
+		}
+
 		// Set a new batch timeout (unless the segment is finished with a stable checkpoint).
 		// Note that we set a new batch timeout even if everything has already been committed.
 		// In such a case, we expect a quorum of other nodes to also commit everything and send a Done message
@@ -119,6 +125,7 @@ func (slot *pbftSlot) advanceState(pbft *pbftInstance, sn t.SeqNr) *events.Event
 		eventsOut.PushBack(pbft.eventService.SBEvent(SBDeliverEvent(
 			sn,
 			slot.Preprepare.Batch,
+			slot.Preprepare.Cert,
 			slot.Preprepare.Aborted,
 		)))
 
@@ -17,7 +17,7 @@ import (
 // The Preprepare message produced by this function has the same digest as the original preprepare,
 // since the view number is not used for hash computation.
 func copyPreprepareToNewView(preprepare *isspbftpb.Preprepare, view t.PBFTViewNr) *isspbftpb.Preprepare {
-	return pbftPreprepareMsg(t.SeqNr(preprepare.Sn), view, preprepare.Batch, preprepare.Aborted)
+	return pbftPreprepareMsg(t.SeqNr(preprepare.Sn), view, preprepare.Batch, preprepare.Cert, preprepare.Aborted)
 }
 
 // ============================================================
@@ -122,6 +122,13 @@ func (pbft *pbftInstance) applyViewChangeSignResult(signature []byte, viewChange
 // The only thing it does is request verification of the signature.
 func (pbft *pbftInstance) applyMsgSignedViewChange(svc *isspbftpb.SignedViewChange, from t.NodeID) *events.EventList {
 	viewChange := svc.ViewChange
+
+	// Ignore message if it does not contain a ViewChange.
+	if viewChange == nil {
+		pbft.logger.Log(logging.LevelWarn, "Ignoring SignedViewChange without ViewChange.", "sender", from)
+		return events.EmptyList()
+	}
+
 	return events.ListOf(pbft.eventService.VerifyNodeSigs(
 		[][][]byte{serializeViewChangeForSigning(viewChange)},
 		[][]byte{svc.Signature},
@@ -168,7 +175,10 @@ func (pbft *pbftInstance) applyVerifiedViewChange(svc *isspbftpb.SignedViewChang
 	}
 
 	// Update the view change state by the received ViewChange message.
-	state.AddSignedViewChange(svc, from)
+	if err := state.AddSignedViewChange(svc, from); err != nil {
+		pbft.logger.Log(logging.LevelWarn, "Ignoring invalid ViewChange.", "sender", from, "vcView", vcView, "err", err)
+		return events.EmptyList()
+	}
 
 	pbft.logger.Log(logging.LevelDebug, "Added ViewChange.", "numViewChanges", len(state.signedViewChanges))
 
@@ -219,7 +229,7 @@ func (pbft *pbftInstance) applyEmptyPreprepareHashResult(digests [][]byte, view
 
 	// If some Preprepares for re-proposing are still missing, fetch them from other nodes.
 	pbft.logger.Log(logging.LevelDebug, "Some Preprepares missing. Asking for retransmission.")
-	return state.askForMissingPreprepares(pbft.eventService)
+	return state.askForMissingPreprepares(pbft.fetcher)
 }
 
 func (pbft *pbftInstance) applyMsgPreprepareRequest(
@@ -247,16 +257,24 @@ func (pbft *pbftInstance) applyMsgMissingPreprepare(preprepare *isspbftpb.Prepre
 	// Ignore preprepare if received in the meantime or if view has already advanced.
 	// This check is technically redundant, as it is (and must be) performed also after the Preprepare is hashed.
 	// However, it might prevent some unnecessary hash computation if performed here as well.
+	// Also ignore it if no view change is pending (a faulty node might have sent it on its own).
 	state, view := pbft.latestPendingVCState()
+	if state == nil {
+		return events.EmptyList()
+	}
 	if pp, ok := state.preprepares[t.SeqNr(preprepare.Sn)]; (ok && pp != nil) || view < pbft.view {
 		return events.EmptyList()
 	}
 
+	// Ignore malformed Preprepares.
+	data, err := pbft.serializeReceivedPreprepare(preprepare)
+	if err != nil {
+		pbft.logger.Log(logging.LevelWarn, "Ignoring invalid missing Preprepare.", "sn", preprepare.Sn, "err", err)
+		return events.EmptyList()
+	}
+
 	// Request a hash of the received preprepare message.
-	hashRequest := pbft.eventService.HashRequest(
-		[][][]byte{serializePreprepareForHashing(preprepare)},
-		missingPreprepareHashOrigin(preprepare),
-	)
+	hashRequest := pbft.eventService.HashRequest([][][]byte{data}, missingPreprepareHashOrigin(preprepare))
 	return events.ListOf(hashRequest)
 }
 
@@ -274,14 +292,19 @@ func (pbft *pbftInstance) applyMissingPreprepareHashResult(
 
 	// Ignore preprepare if received in the meantime or if view has already advanced.
 	// (Such a situation can occur if missing Preprepares arrive late.)
+	if state == nil {
+		return events.EmptyList()
+	}
 	if pp, ok := state.preprepares[t.SeqNr(preprepare.Sn)]; (ok && pp != nil) || view < pbft.view {
 		return events.EmptyList()
 	}
 
-	// Add the missing preprepare message if its digest matches, updating its view.
+	// Add the missing preprepare message if its digest matches, updating its view,
+	// and stop fetching it from other nodes.
 	// Note that copying a preprepare with an updated view preserves its hash.
 	if bytes.Equal(state.reproposals[sn], digest) && state.preprepares[sn] == nil {
 		state.preprepares[sn] = copyPreprepareToNewView(preprepare, view)
+		pbft.fetcher.Done(sn, digest, false)
 	}
 
 	pbft.logger.Log(logging.LevelDebug, "Received missing Preprepare message.", "sn", sn)
@@ -332,6 +355,21 @@ func (pbft *pbftInstance) applyMsgNewView(newView *isspbftpb.NewView, from t.Nod
 
 	// Ignore message if the sender is not the primary of the view.
 	if from != primaryNode(pbft.segment, t.PBFTViewNr(newView.View)) {
+		pbft.logger.Log(logging.LevelWarn, "Ignoring NewView from non-primary.", "from", from, "view", newView.View)
+		return events.EmptyList()
+	}
+
+	// Ignore message if old.
+	if t.PBFTViewNr(newView.View) < pbft.view {
+		pbft.logger.Log(logging.LevelDebug, "Ignoring NewView from old view.",
+			"from", from, "view", newView.View, "localView", pbft.view)
+		return events.EmptyList()
+	}
+
+	// Perform all checks that require neither verifying signatures nor computing hashes right away,
+	// so that no resources are wasted on malformed NewView messages.
+	if err := pbft.validateNewView(newView); err != nil {
+		pbft.logger.Log(logging.LevelWarn, "Rejecting invalid NewView.", "from", from, "view", newView.View, "reason", err)
 		return events.EmptyList()
 	}
 
@@ -354,9 +392,13 @@ func (pbft *pbftInstance) applyMsgNewView(newView *isspbftpb.NewView, from t.Nod
 
 func (pbft *pbftInstance) applyVerifiedNewView(newView *isspbftpb.NewView) *events.EventList {
 	// Serialize obtained Preprepare messages for hashing.
+	// This cannot fail, as the Preprepares have already been checked by validateNewView.
 	dataToHash := make([][][]byte, len(newView.Preprepares))
 	for i, preprepare := range newView.Preprepares { // Preprepares in a NewView message are sorted by sequence number.
-		dataToHash[i] = serializePreprepareForHashing(preprepare)
+		var err error
+		if dataToHash[i], err = serializePreprepareForHashing(preprepare); err != nil {
+			panic(fmt.Errorf("invalid Preprepare in validated NewView: %w", err))
+		}
 	}
 
 	// Request hashes of the Preprepare messages.
@@ -373,53 +415,142 @@ func (pbft *pbftInstance) applyNewViewHashResult(digests [][]byte, newView *issp
 		return events.EmptyList()
 	}
 
+	// Check that the NewView message re-proposes exactly the batches that follow from the contained ViewChanges.
+	if err := pbft.checkNewViewReproposals(newView, digests); err != nil {
+		pbft.logger.Log(logging.LevelWarn, "Rejecting invalid NewView.", "view", newView.View, "reason", err)
+		return events.EmptyList()
+	}
+
+	// If all the checks passed, enter the new view.
+	// At this point, the NewView message has been fully validated:
+	// - its structure by validateNewView (before verifying signatures),
+	// - the signatures of the contained ViewChange messages (by the crypto module), and
+	// - the re-proposed Preprepare messages by checkNewViewReproposals (after hashing them).
+	eventsOut := pbft.initView(msgView)
+
+	// Apply all the Preprepares contained in the NewView
+	primary := primaryNode(pbft.segment, msgView)
+	for _, preprepare := range newView.Preprepares {
+		eventsOut.PushBackList(pbft.applyMsgPreprepare(preprepare, primary))
+	}
+	return eventsOut
+}
+
+// validateNewView performs the checks of a NewView message that require neither verifying signatures
+// nor computing hashes. It returns nil if the NewView message is well-formed, i.e., if it contains
+// - signed ViewChange messages for the new view from a strong quorum of distinct members of the segment,
+// - only well-formed ViewChange messages (see pbftViewChangeState.validateViewChange), and
+// - one Preprepare message (with the new view number) for each sequence number of the segment,
+//   in increasing order of sequence numbers.
+// Otherwise, validateNewView returns the reason for which the NewView message is considered invalid.
+func (pbft *pbftInstance) validateNewView(newView *isspbftpb.NewView) error {
+
+	// Check the ViewChange messages.
+	if len(newView.ViewChangeSenders) != len(newView.SignedViewChanges) {
+		return fmt.Errorf("%d ViewChange senders for %d ViewChanges",
+			len(newView.ViewChangeSenders), len(newView.SignedViewChanges))
+	}
+	if len(newView.SignedViewChanges) < strongQuorum(len(pbft.segment.Membership)) {
+		return fmt.Errorf("not enough ViewChanges: %d", len(newView.SignedViewChanges))
+	}
+
+	membership := membershipSet(pbft.segment.Membership)
+	senders := make(map[t.NodeID]struct{}, len(newView.ViewChangeSenders))
+	vcState := newPbftViewChangeState(pbft.segment.SeqNrs, pbft.segment.Membership, pbft.logger)
+	for i, signedViewChange := range newView.SignedViewChanges {
+		sender := t.NodeID(newView.ViewChangeSenders[i])
+		if _, ok := membership[sender]; !ok {
+			return fmt.Errorf("sender of ViewChange not in membership: %v", sender)
+		}
+		if _, ok := senders[sender]; ok {
+			return fmt.Errorf("duplicate ViewChange from %v", sender)
+		}
+		senders[sender] = struct{}{}
+
+		if err := vcState.validateViewChange(signedViewChange.ViewChange); err != nil {
+			return fmt.Errorf("invalid ViewChange from %v: %w", sender, err)
+		}
+		if signedViewChange.ViewChange.View != newView.View {
+			return fmt.Errorf("wrong view %d of ViewChange from %v", signedViewChange.ViewChange.View, sender)
+		}
+	}
+
+	// Check the Preprepare messages.
+	seqNrs := make([]t.SeqNr, len(pbft.segment.SeqNrs))
+	copy(seqNrs, pbft.segment.SeqNrs)
+	sort.Slice(seqNrs, func(i, j int) bool { return seqNrs[i] < seqNrs[j] })
+
+	if len(newView.PreprepareSeqNrs) != len(seqNrs) || len(newView.Preprepares) != len(seqNrs) {
+		return fmt.Errorf("%d Preprepares with %d sequence numbers for a segment of length %d",
+			len(newView.Preprepares), len(newView.PreprepareSeqNrs), len(seqNrs))
+	}
+	for i, preprepare := range newView.Preprepares {
+		if preprepare == nil || preprepare.Batch == nil {
+			return fmt.Errorf("missing Preprepare or batch for sequence number %d", seqNrs[i])
+		}
+		if t.SeqNr(newView.PreprepareSeqNrs[i]) != seqNrs[i] || t.SeqNr(preprepare.Sn) != seqNrs[i] {
+			return fmt.Errorf("got Preprepare for sequence number %d (listed as %d) in place of %d",
+				preprepare.Sn, newView.PreprepareSeqNrs[i], seqNrs[i])
+		}
+		if preprepare.View != newView.View {
+			return fmt.Errorf("wrong view %d of Preprepare for sequence number %d", preprepare.View, preprepare.Sn)
+		}
+		if _, err := pbft.serializeReceivedPreprepare(preprepare); err != nil {
+			return fmt.Errorf("invalid Preprepare for sequence number %d: %w", preprepare.Sn, err)
+		}
+	}
+
+	return nil
+}
+
+// checkNewViewReproposals recomputes the re-proposals from the ViewChange messages contained in a NewView message
+// and checks them against the Preprepare messages contained in the same NewView message,
+// digests being the digests of those Preprepare messages.
+// The NewView message must already have passed validateNewView.
+// checkNewViewReproposals returns nil if the Preprepare messages match the re-proposals, i.e.,
+// if, for each sequence number, the Preprepare is
+// - a valid "aborted" Preprepare if nothing could have been committed in a previous view, or
+// - a Preprepare with the digest of the batch that could have been committed in a previous view.
+// Otherwise, checkNewViewReproposals returns the reason for which the NewView message is considered invalid.
+func (pbft *pbftInstance) checkNewViewReproposals(newView *isspbftpb.NewView, digests [][]byte) error {
+
 	// Create a temporary view change state object
 	// to use for reconstructing the re-proposals from the obtained view change messages.
-	vcState := newPbftViewChangeState(pbft.segment.SeqNrs, pbft.segment.Membership)
+	vcState := newPbftViewChangeState(pbft.segment.SeqNrs, pbft.segment.Membership, pbft.logger)
 
-	// Feed all obtained ViewChange messages to the view chnage state.
+	// Feed all obtained ViewChange messages to the view change state.
 	for i, signedViewChange := range newView.SignedViewChanges {
-		vcState.AddSignedViewChange(signedViewChange, t.NodeID(newView.ViewChangeSenders[i]))
+		if err := vcState.AddSignedViewChange(signedViewChange, t.NodeID(newView.ViewChangeSenders[i])); err != nil {
+			return fmt.Errorf("invalid ViewChange from %v: %w", newView.ViewChangeSenders[i], err)
+		}
 	}
 
-	// If the obtained ViewChange messages are not sufficient to infer all re-proposals, ignore NewView message.
+	// The obtained ViewChange messages must be sufficient to infer all re-proposals.
 	if !vcState.EnoughViewChanges() {
-		return events.EmptyList()
+		return fmt.Errorf("not enough ViewChanges to determine all re-proposals")
 	}
 
 	// Verify if the re-proposed hashes match the obtained Preprepares.
+	// Preprepares in a validated NewView message are sorted by sequence number.
 	i := 0
-	prepreparesMatching := true
+	var err error
 	maputil.IterateSorted(vcState.reproposals, func(sn t.SeqNr, digest []byte) (cont bool) {
 
-		// If the expected digest is empty, it means that the corresponding Preprepare is an "aborted" one.
-		// In this case, check the Preprepare directly.
 		if len(digest) == 0 {
-			prepreparesMatching = validEmptyPreprepare(newView.Preprepares[i], msgView, sn)
-		} else {
-			prepreparesMatching = bytes.Equal(digest, digests[i])
+			// If the expected digest is empty, it means that the corresponding Preprepare is an "aborted" one.
+			// In this case, check the Preprepare directly.
+			if !validEmptyPreprepare(newView.Preprepares[i], t.PBFTViewNr(newView.View), sn) {
+				err = fmt.Errorf("invalid aborted Preprepare for sequence number %d", sn)
+			}
+		} else if !bytes.Equal(digest, digests[i]) {
+			err = fmt.Errorf("digest mismatch of Preprepare for sequence number %d", sn)
 		}
 
 		i++
-		return prepreparesMatching
+		return err == nil
 	})
 
-	// If the NewVeiw contains mismatching Preprepares, ignore the message.
-	if !prepreparesMatching {
-		pbft.logger.Log(logging.LevelWarn, "Hash mismatch in received NewView. Ignoring.", "view", newView.View)
-		return events.EmptyList()
-	}
-
-	// If all the checks passed, (TODO: make sure all the checks of the NewView message have been performed!)
-	// enter the new view.
-	eventsOut := pbft.initView(msgView)
-
-	// Apply all the Preprepares contained in the NewView
-	primary := primaryNode(pbft.segment, msgView)
-	for _, preprepare := range newView.Preprepares {
-		eventsOut.PushBackList(pbft.applyMsgPreprepare(preprepare, primary))
-	}
-	return eventsOut
+	return err
 }
 
 // ============================================================
@@ -430,7 +561,8 @@ func validEmptyPreprepare(preprepare *isspbftpb.Preprepare, view t.PBFTViewNr, s
 	return preprepare.Aborted &&
 		t.SeqNr(preprepare.Sn) == sn &&
 		t.PBFTViewNr(preprepare.View) == view &&
-		len(preprepare.Batch.Requests) == 0
+		len(preprepare.Batch.GetRequests()) == 0 &&
+		preprepare.Cert == nil
 }
 
 // viewChangeState returns the state of the view change sub-protocol associated with the given view,
@@ -443,7 +575,11 @@ func (pbft *pbftInstance) getViewChangeState(view t.PBFTViewNr) *pbftViewChangeS
 	}
 
 	// If no view change state is yet associated with this view, allocate a new one and return it.
-	pbft.viewChangeStates[view] = newPbftViewChangeState(pbft.segment.SeqNrs, pbft.segment.Membership)
+	pbft.viewChangeStates[view] = newPbftViewChangeState(
+		pbft.segment.SeqNrs,
+		pbft.segment.Membership,
+		logging.Decorate(pbft.logger, "VC: ", "view", view),
+	)
 
 	return pbft.viewChangeStates[view]
 }
@@ -575,32 +711,34 @@ func reconstructQSet(entries []*isspbftpb.QSetEntry) (viewChangeQSet, error) {
 
 }
 
+// reconstructPSetQSet reconstructs the P sets and Q sets from the given ViewChange messages, indexed by sender.
+// Malformed ViewChange messages are left out, logging a warning.
+// Note that ViewChange messages are normally validated before being added to the view change state,
+// so no malformed messages are expected here.
 func reconstructPSetQSet(
 	signedViewChanges map[t.NodeID]*isspbftpb.SignedViewChange,
+	logger logging.Logger,
 ) (map[t.NodeID]viewChangePSet, map[t.NodeID]viewChangeQSet) {
 	pSets := make(map[t.NodeID]viewChangePSet)
 	qSets := make(map[t.NodeID]viewChangeQSet)
 
-	for nodeID, svc := range signedViewChanges {
-		var err error
-		var pSet viewChangePSet
-		var qSet viewChangeQSet
-
-		pSet, err = reconstructPSet(svc.ViewChange.PSet)
+	maputil.IterateSorted(signedViewChanges, func(nodeID t.NodeID, svc *isspbftpb.SignedViewChange) bool {
+		pSet, err := reconstructPSet(svc.ViewChange.PSet)
 		if err != nil {
-			// TODO: Log warning here
-			continue
+			logger.Log(logging.LevelWarn, "Ignoring ViewChange with invalid P set.", "from", nodeID, "err", err)
+			return true
 		}
 
-		qSet, err = reconstructQSet(svc.ViewChange.QSet)
+		qSet, err := reconstructQSet(svc.ViewChange.QSet)
 		if err != nil {
-			// TODO: Log warning here
-			continue
+			logger.Log(logging.LevelWarn, "Ignoring ViewChange with invalid Q set.", "from", nodeID, "err", err)
+			return true
 		}
 
 		pSets[nodeID] = pSet
 		qSets[nodeID] = qSet
-	}
+		return true
+	})
 
 	return pSets, qSets
 }
@@ -658,6 +796,19 @@ func (pbft *pbftInstance) getPSetQSet() (pSet viewChangePSet, qSet viewChangeQSe
 // NewView message construction
 // ============================================================
 
+// reproposal computes the digest of the batch to be re-proposed for sequence number sn in the new view,
+// based on the P sets and Q sets of the ViewChange messages, following the rules of PBFT:
+// - (B) If a strong quorum of nodes did not prepare anything for sn, a special "null" batch is re-proposed
+//   and reproposal returns an empty (non-nil) slice.
+// - (A) Otherwise, a batch with digest d prepared in view v in some P set is re-proposed if
+//   (A1) a strong quorum of P sets does not contain any conflicting entry for sn
+//        (i.e., one with a higher view, or the same view and a different digest) and
+//   (A2) a weak quorum of Q sets contains an entry for d with a view of at least v.
+//   In this case, reproposal also returns the (sorted) IDs of the nodes from the A2 quorum,
+//   which are known to have the corresponding Preprepare message.
+// If the reproposal cannot (yet) be determined, reproposal returns nil.
+// Candidate P set entries are examined in a fixed order (by decreasing view, then by digest),
+// so the result does not depend on map iteration order.
 func reproposal(
 	pSets map[t.NodeID]viewChangePSet,
 	qSets map[t.NodeID]viewChangeQSet,
@@ -671,20 +822,38 @@ func reproposal(
 
 	}
 
-	for _, pSet := range pSets {
-		if entry, ok := pSet[sn]; ok {
-			a2, prepreparedIDs := enoughPrepreparesA2(qSets, sn, entry.Digest, t.PBFTViewNr(entry.View), numNodes)
-			if noPrepareConflictsA1(pSets, sn, entry.Digest, t.PBFTViewNr(entry.View), numNodes) && a2 {
+	for _, entry := range sortedPSetEntries(pSets, sn) {
+		a2, prepreparedIDs := enoughPrepreparesA2(qSets, sn, entry.Digest, t.PBFTViewNr(entry.View), numNodes)
+		if noPrepareConflictsA1(pSets, sn, entry.Digest, t.PBFTViewNr(entry.View), numNodes) && a2 {
 
-				return entry.Digest, prepreparedIDs
+			return entry.Digest, prepreparedIDs
 
-			}
 		}
 	}
 
 	return nil, nil
 }
 
+// sortedPSetEntries returns the entries for sequence number sn from all the given P sets,
+// sorted by decreasing view number and, for equal views, by digest.
+func sortedPSetEntries(pSets map[t.NodeID]viewChangePSet, sn t.SeqNr) []*isspbftpb.PSetEntry {
+	entries := make([]*isspbftpb.PSetEntry, 0, len(pSets))
+	for _, pSet := range pSets {
+		if entry, ok := pSet[sn]; ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i int, j int) bool {
+		if entries[i].View != entries[j].View {
+			return entries[i].View > entries[j].View
+		}
+		return bytes.Compare(entries[i].Digest, entries[j].Digest) < 0
+	})
+
+	return entries
+}
+
 func noPrepareConflictsA1(
 	pSets map[t.NodeID]viewChangePSet,
 	sn t.SeqNr,
@@ -719,14 +888,15 @@ func enoughPrepreparesA2(
 	numPrepares := 0
 	nodeIDs := make([]t.NodeID, 0, numNodes)
 
-	for nodeID, qSet := range qSets {
+	maputil.IterateSorted(qSets, func(nodeID t.NodeID, qSet viewChangeQSet) bool {
 		if snEntry, ok := qSet[sn]; ok {
-			if snEntry[string(digest)] >= view {
+			if entryView, ok := snEntry[string(digest)]; ok && entryView >= view {
 				numPrepares++
 				nodeIDs = append(nodeIDs, nodeID)
 			}
 		}
-	}
+		return true
+	})
 
 	return numPrepares >= weakQuorum(numNodes), nodeIDs
 }
@@ -0,0 +1,111 @@
+package iss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+var testVCMembership = []types.NodeID{"0", "1", "2", "3"}
+
+// testViewChange returns a SignedViewChange for view 1 in which sequence number 0 has been prepared
+// (and preprepared) in view 0 with the given digest, if the digest is not nil.
+func testViewChange(digest []byte) *isspbftpb.SignedViewChange {
+	vc := &isspbftpb.ViewChange{View: 1}
+	if digest != nil {
+		vc.PSet = []*isspbftpb.PSetEntry{{Sn: 0, View: 0, Digest: digest}}
+		vc.QSet = []*isspbftpb.QSetEntry{{Sn: 0, View: 0, Digest: digest}}
+	}
+	return &isspbftpb.SignedViewChange{ViewChange: vc}
+}
+
+func TestReproposal(t *testing.T) {
+	digest := []byte{1}
+
+	// A batch prepared by a single node must not be re-proposed if no other node preprepared it.
+	// (A2 requires a weak quorum of nodes that preprepared it, a Preprepare of a different batch does not count.)
+	vcState := newPbftViewChangeState([]types.SeqNr{0}, testVCMembership, logging.NilLogger)
+	otherPreprepared := testViewChange(nil)
+	otherPreprepared.ViewChange.QSet = []*isspbftpb.QSetEntry{{Sn: 0, View: 0, Digest: []byte{2}}}
+	assert.NoError(t, vcState.AddSignedViewChange(testViewChange(digest), "0"))
+	assert.NoError(t, vcState.AddSignedViewChange(otherPreprepared, "1"))
+	assert.NoError(t, vcState.AddSignedViewChange(testViewChange(nil), "2"))
+	assert.Nil(t, vcState.reproposals[0])
+
+	// With a strong quorum of nodes that did not prepare anything, nothing is re-proposed.
+	assert.NoError(t, vcState.AddSignedViewChange(testViewChange(nil), "3"))
+	assert.Equal(t, []byte{}, vcState.reproposals[0])
+
+	// A batch prepared by two nodes must be re-proposed and fetched from those nodes.
+	vcState = newPbftViewChangeState([]types.SeqNr{0}, testVCMembership, logging.NilLogger)
+	assert.NoError(t, vcState.AddSignedViewChange(testViewChange(digest), "2"))
+	assert.NoError(t, vcState.AddSignedViewChange(testViewChange(nil), "3"))
+	assert.NoError(t, vcState.AddSignedViewChange(testViewChange(digest), "0"))
+	assert.Equal(t, digest, vcState.reproposals[0])
+	assert.Equal(t, []types.NodeID{"0", "2"}, vcState.prepreparedIDs[0])
+
+	// Malformed ViewChanges are rejected.
+	vcState = newPbftViewChangeState([]types.SeqNr{0}, testVCMembership, logging.NilLogger)
+	assert.Error(t, vcState.AddSignedViewChange(testViewChange([]byte{}), "0"))
+	badView := testViewChange(digest)
+	badView.ViewChange.PSet[0].View = 1
+	assert.Error(t, vcState.AddSignedViewChange(badView, "0"))
+	badSn := testViewChange(digest)
+	badSn.ViewChange.QSet[0].Sn = 1
+	assert.Error(t, vcState.AddSignedViewChange(badSn, "0"))
+}
+
+func TestValidateNewView(t *testing.T) {
+	pbft := &pbftInstance{
+		segment: &segment{Leader: "0", Membership: testVCMembership, SeqNrs: []types.SeqNr{0}},
+		logger:  logging.NilLogger,
+	}
+
+	newView := func() *isspbftpb.NewView {
+		return pbftNewViewMsg(
+			1,
+			[]types.NodeID{"0", "1", "2"},
+			[]*isspbftpb.SignedViewChange{testViewChange(nil), testViewChange(nil), testViewChange(nil)},
+			[]types.SeqNr{0},
+			[]*isspbftpb.Preprepare{pbftPreprepareMsg(0, 1, &requestpb.Batch{}, nil, true)},
+		)
+	}
+	assert.NoError(t, pbft.validateNewView(newView()))
+
+	// Not enough ViewChanges.
+	nv := newView()
+	nv.ViewChangeSenders = nv.ViewChangeSenders[:2]
+	nv.SignedViewChanges = nv.SignedViewChanges[:2]
+	assert.Error(t, pbft.validateNewView(nv))
+
+	// Duplicate ViewChange sender.
+	nv = newView()
+	nv.ViewChangeSenders[2] = "1"
+	assert.Error(t, pbft.validateNewView(nv))
+
+	// Sender outside the membership.
+	nv = newView()
+	nv.ViewChangeSenders[2] = "4"
+	assert.Error(t, pbft.validateNewView(nv))
+
+	// ViewChange for a different view.
+	nv = newView()
+	nv.SignedViewChanges[0].ViewChange.View = 2
+	assert.Error(t, pbft.validateNewView(nv))
+
+	// Preprepare for a different view.
+	nv = newView()
+	nv.Preprepares[0].View = 0
+	assert.Error(t, pbft.validateNewView(nv))
+
+	// Missing Preprepare.
+	nv = newView()
+	nv.Preprepares = nil
+	nv.PreprepareSeqNrs = nil
+	assert.Error(t, pbft.validateNewView(nv))
+}
@@ -1,7 +1,10 @@
 package iss
 
 import (
+	"fmt"
+
 	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
 	"github.com/filecoin-project/mir/pkg/pb/isspbftpb"
 	"github.com/filecoin-project/mir/pkg/pb/requestpb"
 	t "github.com/filecoin-project/mir/pkg/types"
@@ -30,10 +33,16 @@ type pbftViewChangeState struct {
 	// in which case the value is set to a Preprepare with an empty batch and the "aborted" flag set.
 	preprepares map[t.SeqNr]*isspbftpb.Preprepare
 
+	// For each sequence number with a non-empty reproposal,
+	// the IDs of the nodes that reported having preprepared the reproposed batch (sorted).
+	// These nodes are asked for the corresponding Preprepare message if it is missing.
 	prepreparedIDs map[t.SeqNr][]t.NodeID
+
+	// Logger for outputting warnings about malformed ViewChange messages.
+	logger logging.Logger
 }
 
-func newPbftViewChangeState(seqNrs []t.SeqNr, membership []t.NodeID) *pbftViewChangeState {
+func newPbftViewChangeState(seqNrs []t.SeqNr, membership []t.NodeID, logger logging.Logger) *pbftViewChangeState {
 	reproposals := make(map[t.SeqNr][]byte)
 	preprepares := make(map[t.SeqNr]*isspbftpb.Preprepare)
 	for _, sn := range seqNrs {
@@ -47,6 +56,7 @@ func newPbftViewChangeState(seqNrs []t.SeqNr, membership []t.NodeID) *pbftViewCh
 		reproposals:       reproposals,
 		prepreparedIDs:    make(map[t.SeqNr][]t.NodeID),
 		preprepares:       preprepares,
+		logger:            logger,
 	}
 }
 
@@ -59,14 +69,22 @@ func (vcState *pbftViewChangeState) EnoughViewChanges() bool {
 	return true
 }
 
-func (vcState *pbftViewChangeState) AddSignedViewChange(svc *isspbftpb.SignedViewChange, from t.NodeID) {
+// AddSignedViewChange adds a SignedViewChange message sent by node from to the view change state.
+// Duplicate ViewChange messages and ViewChange messages received after enough ViewChanges are ignored.
+// If the ViewChange message is malformed (see validateViewChange), it is not added
+// and AddSignedViewChange returns the reason for which the message is considered invalid.
+func (vcState *pbftViewChangeState) AddSignedViewChange(svc *isspbftpb.SignedViewChange, from t.NodeID) error {
 
 	if vcState.EnoughViewChanges() {
-		return
+		return nil
 	}
 
 	if _, ok := vcState.signedViewChanges[from]; ok {
-		return
+		return nil
+	}
+
+	if err := vcState.validateViewChange(svc.ViewChange); err != nil {
+		return err
 	}
 
 	vcState.signedViewChanges[from] = svc
@@ -74,18 +92,68 @@ func (vcState *pbftViewChangeState) AddSignedViewChange(svc *isspbftpb.SignedVie
 	if len(vcState.signedViewChanges) >= strongQuorum(vcState.numNodes) {
 		vcState.updateReproposals()
 	}
+
+	return nil
+}
+
+// validateViewChange checks whether a ViewChange message is well-formed with respect to the segment.
+// Its P set and Q set must not contain conflicting entries and each entry must
+// refer to a sequence number of the segment, refer to a view lower than the one of the ViewChange message,
+// and contain a non-empty digest.
+func (vcState *pbftViewChangeState) validateViewChange(vc *isspbftpb.ViewChange) error {
+	if vc == nil {
+		return fmt.Errorf("missing ViewChange")
+	}
+
+	if _, err := reconstructPSet(vc.PSet); err != nil {
+		return err
+	}
+	if _, err := reconstructQSet(vc.QSet); err != nil {
+		return err
+	}
+
+	for _, entry := range vc.PSet {
+		if err := vcState.validateSetEntry(entry.Sn, entry.View, entry.Digest, vc.View); err != nil {
+			return fmt.Errorf("invalid Pset entry: %w", err)
+		}
+	}
+	for _, entry := range vc.QSet {
+		if err := vcState.validateSetEntry(entry.Sn, entry.View, entry.Digest, vc.View); err != nil {
+			return fmt.Errorf("invalid Qset entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateSetEntry checks a single P set or Q set entry of a ViewChange message for view vcView.
+func (vcState *pbftViewChangeState) validateSetEntry(sn uint64, view uint64, digest []byte, vcView uint64) error {
+	if _, ok := vcState.reproposals[t.SeqNr(sn)]; !ok {
+		return fmt.Errorf("sequence number %d not in segment", sn)
+	}
+	if view >= vcView {
+		return fmt.Errorf("view %d of sequence number %d not lower than ViewChange view %d", view, sn, vcView)
+	}
+	if len(digest) == 0 {
+		return fmt.Errorf("empty digest for sequence number %d", sn)
+	}
+	return nil
 }
 
-// TODO: Make sure this procedure is fully deterministic (map iterations etc...)
+// updateReproposals computes the reproposals for all sequence numbers for which they have not yet been determined.
+// The result only depends on the set of ViewChange messages received,
+// and not on the order in which they have been received or on the order of map iterations.
+// This is important, as all correct nodes must compute the same reproposals when validating a NewView message.
 func (vcState *pbftViewChangeState) updateReproposals() {
 
-	pSets, qSets := reconstructPSetQSet(vcState.signedViewChanges)
+	pSets, qSets := reconstructPSetQSet(vcState.signedViewChanges, vcState.logger)
 
-	for sn, r := range vcState.reproposals {
+	maputil.IterateSorted(vcState.reproposals, func(sn t.SeqNr, r []byte) bool {
 		if r == nil {
 			vcState.reproposals[sn], vcState.prepreparedIDs[sn] = reproposal(pSets, qSets, sn, vcState.numNodes)
 		}
-	}
+		return true
+	})
 }
 
 func (vcState *pbftViewChangeState) SetEmptyPreprepares(view t.PBFTViewNr) [][][]byte {
@@ -101,11 +169,17 @@ func (vcState *pbftViewChangeState) SetEmptyPreprepares(view t.PBFTViewNr) [][][
 				sn,
 				view,
 				&requestpb.Batch{Requests: []*requestpb.HashedRequest{}},
+				nil,
 				true,
 			)
 
 			// Serialize the newly created Preprepare message for hashing.
-			dataToHash = append(dataToHash, serializePreprepareForHashing(vcState.preprepares[sn]))
+			// This cannot fail, as the Preprepare contains no availability certificate.
+			data, err := serializePreprepareForHashing(vcState.preprepares[sn])
+			if err != nil {
+				panic(fmt.Errorf("cannot serialize empty Preprepare: %w", err))
+			}
+			dataToHash = append(dataToHash, data)
 		}
 		return true
 	})
@@ -136,7 +210,7 @@ func (vcState *pbftViewChangeState) SetEmptyPreprepareDigests(digests [][]byte)
 }
 
 func (vcState *pbftViewChangeState) SetLocalPreprepares(pbft *pbftInstance, view t.PBFTViewNr) {
-	for sn, digest := range vcState.reproposals {
+	maputil.IterateSorted(vcState.reproposals, func(sn t.SeqNr, digest []byte) bool {
 		if vcState.preprepares[sn] == nil && digest != nil && len(digest) > 0 {
 			if preprepare := pbft.lookUpPreprepare(sn, digest); preprepare != nil {
 				// The re-proposed Preprepare must have an updated view.
@@ -144,7 +218,8 @@ func (vcState *pbftViewChangeState) SetLocalPreprepares(pbft *pbftInstance, view
 				vcState.preprepares[sn] = copyPreprepareToNewView(preprepare, view)
 			}
 		}
-	}
+		return true
+	})
 }
 
 // askForMissingPreprepares requests the Preprepare messages that are part of a new view.
@@ -152,20 +227,19 @@ func (vcState *pbftViewChangeState) SetLocalPreprepares(pbft *pbftInstance, view
 // and thus the new primary has to re-propose the corresponding batch by including the corresponding Preprepare message
 // the NewView message. However, the new primary might not have all the corresponding Preprepare messages,
 // in which case it calls this function.
-// Note that the requests for missing Preprepare messages need not necessarily be periodically re-transmitted.
-// If they are dropped, the new primary will simply never send a NewView message
+// Each missing Preprepare is requested from one of the nodes that reported having prepared it at a time,
+// the fetcher asking the next one if the Preprepare does not arrive in time.
+// If the requests fail nevertheless, the new primary will simply never send a NewView message
 // and will be succeeded by another primary after another view change.
-func (vcState *pbftViewChangeState) askForMissingPreprepares(eventService *sbEventService) *events.EventList {
+func (vcState *pbftViewChangeState) askForMissingPreprepares(fetcher *pbftFetcher) *events.EventList {
 
 	eventsOut := events.EmptyList()
-	for sn, digest := range vcState.reproposals {
+	maputil.IterateSorted(vcState.reproposals, func(sn t.SeqNr, digest []byte) bool {
 		if len(digest) > 0 && vcState.preprepares[sn] == nil {
-			eventsOut.PushBack(eventService.SendMessage(
-				PbftPreprepareRequestSBMessage(pbftPreprepareRequestMsg(sn, digest)),
-				removeNodeID(vcState.prepreparedIDs[sn], "1"), // TODO be smarter about this eventually, not asking everyone at once.
-			))
+			eventsOut.PushBackList(fetcher.Fetch(sn, digest, false, vcState.prepreparedIDs[sn], 0))
 		}
-	}
+		return true
+	})
 	return eventsOut
 }
 
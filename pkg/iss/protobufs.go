@@ -16,6 +16,10 @@ SPDX-License-Identifier: Apache-2.0
 package iss
 
 import (
+	"github.com/filecoin-project/mir/pkg/contextstore"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/commitlogpb"
+	"github.com/filecoin-project/mir/pkg/pb/commonpb"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/pb/isspb"
 	"github.com/filecoin-project/mir/pkg/pb/messagepb"
@@ -46,7 +50,17 @@ func SigVerOrigin(origin *isspb.ISSSigVerOrigin) *eventpb.SigVerOrigin {
 	return &eventpb.SigVerOrigin{Module: issModuleName.Pb(), Type: &eventpb.SigVerOrigin_Iss{Iss: origin}}
 }
 
-func PersistCheckpointEvent(sn t.SeqNr, appSnapshot, appSnapshotHash, signature []byte) *eventpb.Event {
+func AuthOrigin(origin *isspb.ISSAuthOrigin) *eventpb.AuthOrigin {
+	return &eventpb.AuthOrigin{Module: issModuleName.Pb(), Type: &eventpb.AuthOrigin_Iss{Iss: origin}}
+}
+
+func PersistCheckpointEvent(
+	sn t.SeqNr,
+	appSnapshot []byte,
+	clientProgress *isspb.ClientProgress,
+	appSnapshotHash []byte,
+	signature []byte,
+) *eventpb.Event {
 	return Event(
 		issModuleName,
 		&isspb.ISSEvent{Type: &isspb.ISSEvent_PersistCheckpoint{PersistCheckpoint: &isspb.PersistCheckpoint{
@@ -54,6 +68,7 @@ func PersistCheckpointEvent(sn t.SeqNr, appSnapshot, appSnapshotHash, signature
 			AppSnapshot:     appSnapshot,
 			AppSnapshotHash: appSnapshotHash,
 			Signature:       signature,
+			ClientProgress:  clientProgress,
 		}}},
 	)
 }
@@ -117,6 +132,12 @@ func AppSnapshotHashOrigin(epoch t.EpochNr) *eventpb.HashOrigin {
 	return HashOrigin(&isspb.ISSHashOrigin{Type: &isspb.ISSHashOrigin_AppSnapshotEpoch{AppSnapshotEpoch: epoch.Pb()}})
 }
 
+func StableCheckpointHashOrigin(stableCheckpoint *isspb.StableCheckpoint) *eventpb.HashOrigin {
+	return HashOrigin(&isspb.ISSHashOrigin{Type: &isspb.ISSHashOrigin_StableCheckpoint{
+		StableCheckpoint: stableCheckpoint,
+	}})
+}
+
 func CheckpointSignOrigin(epoch t.EpochNr) *eventpb.SignOrigin {
 	return SignOrigin(&isspb.ISSSignOrigin{Type: &isspb.ISSSignOrigin_CheckpointEpoch{CheckpointEpoch: epoch.Pb()}})
 }
@@ -131,6 +152,10 @@ func StableCheckpointSigVerOrigin(stableCheckpoint *isspb.StableCheckpoint) *eve
 	}})
 }
 
+func CheckpointCertSigVerOrigin(epoch t.EpochNr) *eventpb.SigVerOrigin {
+	return SigVerOrigin(&isspb.ISSSigVerOrigin{Type: &isspb.ISSSigVerOrigin_CheckpointCert{CheckpointCert: epoch.Pb()}})
+}
+
 func SBSignOrigin(epoch t.EpochNr, instance t.SBInstanceNr, origin *isspb.SBInstanceSignOrigin) *eventpb.SignOrigin {
 	return SignOrigin(&isspb.ISSSignOrigin{Type: &isspb.ISSSignOrigin_Sb{Sb: &isspb.SBSignOrigin{
 		Epoch:    epoch.Pb(),
@@ -151,6 +176,62 @@ func SBSigVerOrigin(
 	}}})
 }
 
+func SBAuthSendOrigin(message *isspb.SBMessage, destinations []t.NodeID) *eventpb.AuthOrigin {
+	return AuthOrigin(&isspb.ISSAuthOrigin{Type: &isspb.ISSAuthOrigin_SbSend{SbSend: &isspb.SBAuthSendOrigin{
+		Msg:          message,
+		Destinations: t.NodeIDSlicePb(destinations),
+	}}})
+}
+
+func SBAuthReceiveOrigin(message *isspb.SBMessage, from t.NodeID) *eventpb.AuthOrigin {
+	return AuthOrigin(&isspb.ISSAuthOrigin{Type: &isspb.ISSAuthOrigin_SbReceive{SbReceive: &isspb.SBAuthReceiveOrigin{
+		Msg:  message,
+		From: from.Pb(),
+	}}})
+}
+
+func CheckpointAuthSendOrigin(epoch t.EpochNr) *eventpb.AuthOrigin {
+	return AuthOrigin(&isspb.ISSAuthOrigin{Type: &isspb.ISSAuthOrigin_CheckpointSend{CheckpointSend: epoch.Pb()}})
+}
+
+func CheckpointAuthReceiveOrigin(epoch t.EpochNr, from t.NodeID) *eventpb.AuthOrigin {
+	return AuthOrigin(&isspb.ISSAuthOrigin{Type: &isspb.ISSAuthOrigin_CheckpointReceive{
+		CheckpointReceive: &isspb.CheckpointAuthReceiveOrigin{
+			Epoch: epoch.Pb(),
+			From:  from.Pb(),
+		},
+	}})
+}
+
+func SBCertOrigin(epoch t.EpochNr, instance t.SBInstanceNr, origin *isspb.SBInstanceCertOrigin) *isspb.SBCertOrigin {
+	return &isspb.SBCertOrigin{
+		Epoch:    epoch.Pb(),
+		Instance: instance.Pb(),
+		Origin:   origin,
+	}
+}
+
+func RequestCertOrigin(itemID contextstore.ItemID) *availabilitypb.RequestCertOrigin {
+	return &availabilitypb.RequestCertOrigin{
+		Module: issModuleName.Pb(),
+		Type:   &availabilitypb.RequestCertOrigin_ContextStore{ContextStore: contextstore.Origin(itemID)},
+	}
+}
+
+func VerifyCertOrigin(itemID contextstore.ItemID) *availabilitypb.VerifyCertOrigin {
+	return &availabilitypb.VerifyCertOrigin{
+		Module: issModuleName.Pb(),
+		Type:   &availabilitypb.VerifyCertOrigin_ContextStore{ContextStore: contextstore.Origin(itemID)},
+	}
+}
+
+func RequestTransactionsOrigin(itemID contextstore.ItemID) *availabilitypb.RequestTransactionsOrigin {
+	return &availabilitypb.RequestTransactionsOrigin{
+		Module: issModuleName.Pb(),
+		Type:   &availabilitypb.RequestTransactionsOrigin_ContextStore{ContextStore: contextstore.Origin(itemID)},
+	}
+}
+
 // ------------------------------------------------------------
 // SB Instance Events
 
@@ -158,12 +239,18 @@ func SBInitEvent() *isspb.SBInstanceEvent {
 	return &isspb.SBInstanceEvent{Type: &isspb.SBInstanceEvent_Init{Init: &isspb.SBInit{}}}
 }
 
-func SBDeliverEvent(sn t.SeqNr, batch *requestpb.Batch, aborted bool) *isspb.SBInstanceEvent {
+func SBDeliverEvent(
+	sn t.SeqNr,
+	batch *requestpb.Batch,
+	cert *availabilitypb.Cert,
+	aborted bool,
+) *isspb.SBInstanceEvent {
 	return &isspb.SBInstanceEvent{Type: &isspb.SBInstanceEvent_Deliver{
 		Deliver: &isspb.SBDeliver{
 			Sn:      sn.Pb(),
 			Batch:   batch,
 			Aborted: aborted,
+			Cert:    cert,
 		},
 	}}
 }
@@ -191,10 +278,15 @@ func SBCutBatchEvent(maxSize t.NumRequests) *isspb.SBInstanceEvent {
 	}}}
 }
 
-func SBBatchReadyEvent(batch *requestpb.Batch, pendingReqsLeft t.NumRequests) *isspb.SBInstanceEvent {
+func SBBatchReadyEvent(
+	batch *requestpb.Batch,
+	cert *availabilitypb.Cert,
+	pendingReqsLeft t.NumRequests,
+) *isspb.SBInstanceEvent {
 	return &isspb.SBInstanceEvent{Type: &isspb.SBInstanceEvent_BatchReady{BatchReady: &isspb.SBBatchReady{
 		Batch:               batch,
 		PendingRequestsLeft: pendingReqsLeft.Pb(),
+		Cert:                cert,
 	}}}
 }
 
@@ -216,6 +308,21 @@ func SBSignResultEvent(signature []byte, origin *isspb.SBInstanceSignOrigin) *is
 	}}}
 }
 
+func SBVerifyCertEvent(cert *availabilitypb.Cert, origin *isspb.SBInstanceCertOrigin) *isspb.SBInstanceEvent {
+	return &isspb.SBInstanceEvent{Type: &isspb.SBInstanceEvent_VerifyCert{VerifyCert: &isspb.SBVerifyCert{
+		Cert:   cert,
+		Origin: origin,
+	}}}
+}
+
+func SBCertVerifiedEvent(valid bool, err string, origin *isspb.SBInstanceCertOrigin) *isspb.SBInstanceEvent {
+	return &isspb.SBInstanceEvent{Type: &isspb.SBInstanceEvent_CertVerified{CertVerified: &isspb.SBCertVerified{
+		Valid:  valid,
+		Err:    err,
+		Origin: origin,
+	}}}
+}
+
 func SBNodeSigsVerifiedEvent(
 	valid []bool,
 	errors []string,
@@ -234,6 +341,21 @@ func SBNodeSigsVerifiedEvent(
 	}}
 }
 
+// ------------------------------------------------------------
+// Commit log
+
+func commitLogEntryPb(entry *CommitLogEntry, epoch t.EpochNr) *commitlogpb.Entry {
+	return &commitlogpb.Entry{
+		Sn:      entry.Sn.Pb(),
+		Epoch:   epoch.Pb(),
+		Batch:   entry.Batch,
+		Digest:  entry.Digest,
+		Aborted: entry.Aborted,
+		Suspect: entry.Suspect.Pb(),
+		Cert:    entry.Cert,
+	}
+}
+
 // ============================================================
 // Messages
 // ============================================================
@@ -250,12 +372,19 @@ func SBMessage(epoch t.EpochNr, instance t.SBInstanceNr, msg *isspb.SBInstanceMe
 	}}})
 }
 
-func CheckpointMessage(epoch t.EpochNr, sn t.SeqNr, appSnapshotHash, signature []byte) *messagepb.Message {
+func CheckpointMessage(
+	epoch t.EpochNr,
+	sn t.SeqNr,
+	appSnapshotHash,
+	signature []byte,
+	authenticator *commonpb.Authenticator,
+) *messagepb.Message {
 	return Message(&isspb.ISSMessage{Type: &isspb.ISSMessage_Checkpoint{Checkpoint: &isspb.Checkpoint{
 		Epoch:           epoch.Pb(),
 		Sn:              sn.Pb(),
 		AppSnapshotHash: appSnapshotHash,
 		Signature:       signature,
+		Authenticator:   authenticator,
 	}}})
 }
 
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package iss
 
 import (
+	"fmt"
+
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
 	"github.com/filecoin-project/mir/pkg/pb/isspb"
@@ -34,11 +36,32 @@ import (
 type sbEventService struct {
 	epoch    t.EpochNr
 	instance t.SBInstanceNr
+
+	// If set to true, messages that support authentication by MACs are not sent directly.
+	// Instead, an authenticator is first computed by the crypto module and ISS sends the message afterwards.
+	macAuthentication bool
 }
 
 // SendMessage creates an event for sending a message that will be processed
 // by the corresponding orderer instance at each of the destination.
+// If the message needs to be authenticated by MACs, the returned event is a request for computing an authenticator,
+// and the message is only sent when the authenticator has been computed.
 func (ec *sbEventService) SendMessage(message *isspb.SBInstanceMessage, destinations []t.NodeID) *eventpb.Event {
+	if ec.macAuthentication && macAuthenticated(message) {
+		sbMessage := &isspb.SBMessage{Epoch: ec.epoch.Pb(), Instance: ec.instance.Pb(), Msg: message}
+
+		// Messages created by this node are always well-formed.
+		data, err := serializeSBMessageForAuth(sbMessage)
+		if err != nil {
+			panic(fmt.Errorf("cannot serialize own message for authentication: %w", err))
+		}
+		return events.ComputeAuthenticator(
+			cryptoModuleName,
+			data,
+			destinations,
+			SBAuthSendOrigin(sbMessage, destinations),
+		)
+	}
 	return events.SendMessage(netModuleName, SBMessage(ec.epoch, ec.instance, message), destinations)
 }
 
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package iss
 
 import (
+	aevents "github.com/filecoin-project/mir/pkg/availability/events"
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/pb/isspb"
 	"github.com/filecoin-project/mir/pkg/pb/requestpb"
@@ -38,17 +39,25 @@ type sbInstance interface {
 
 // applySBInstanceEvent applies one event produced by an orderer to the ISS state, potentially altering its state
 // and producing a (potentially empty) list of events to be applied to other modules.
+// The epoch and instance numbers identify the orderer in case ISS needs to route a later response back to it.
 func (iss *ISS) applySBInstanceEvent(
 	event *isspb.SBInstanceEvent,
+	epochNr t.EpochNr,
+	instanceNr t.SBInstanceNr,
 	instance sbInstance,
 ) *events.EventList {
 	switch e := event.Type.(type) {
 	case *isspb.SBInstanceEvent_Deliver:
 		return iss.applySBInstDeliver(instance, e.Deliver)
 	case *isspb.SBInstanceEvent_CutBatch:
-		return iss.applySBInstCutBatch(instance, t.NumRequests(e.CutBatch.MaxSize))
+		if iss.config.UseAvailabilityLayer {
+			return iss.applySBInstRequestCert(epochNr, instanceNr)
+		}
+		return iss.applySBInstCutBatch(epochNr, instanceNr, instance, t.NumRequests(e.CutBatch.MaxSize))
 	case *isspb.SBInstanceEvent_ResurrectBatch:
 		return iss.applySBInstResurrectBatch(e.ResurrectBatch)
+	case *isspb.SBInstanceEvent_VerifyCert:
+		return iss.applySBInstVerifyCert(epochNr, instanceNr, e.VerifyCert)
 	default:
 		return instance.ApplyEvent(event)
 	}
@@ -71,11 +80,30 @@ func (iss *ISS) applySBInstDeliver(instance sbInstance, deliver *isspb.SBDeliver
 	unhashedEntry := &CommitLogEntry{
 		Sn:      t.SeqNr(deliver.Sn),
 		Batch:   deliver.Batch,
+		Cert:    deliver.Cert,
 		Digest:  nil,
 		Aborted: deliver.Aborted,
 		Suspect: instance.Segment().Leader,
 	}
 
+	// If the orderer delivered an availability certificate, the batch it refers to first needs to be retrieved
+	// from the availability layer. Operation continues on reception of the ProvideTransactions event.
+	if unhashedEntry.Cert != nil && !unhashedEntry.Aborted {
+		return events.ListOf(aevents.RequestTransactions(
+			availabilityModuleName,
+			unhashedEntry.Cert,
+			RequestTransactionsOrigin(iss.pendingLogEntries.Store(unhashedEntry)),
+		))
+	}
+
+	return iss.hashLogEntry(unhashedEntry)
+}
+
+// hashLogEntry saves a commit log entry with a complete batch
+// and requests the computation of its hash, after which the entry can be inserted in the commitLog.
+// Operation continues on reception of the HashResult event.
+func (iss *ISS) hashLogEntry(unhashedEntry *CommitLogEntry) *events.EventList {
+
 	// Save the preliminary hash entry to a map where it can be looked up when the hash result arrives.
 	iss.unhashedLogEntries[unhashedEntry.Sn] = unhashedEntry
 
@@ -91,13 +119,18 @@ func (iss *ISS) applySBInstDeliver(instance sbInstance, deliver *isspb.SBDeliver
 
 // applySBInstCutBatch processes a request by an orderer for a new request batch that the orderer will propose.
 // applySBInstCutBatch removes up to maxBatchSize requests from the buckets currently assigned to the orderer
-// with ID instanceID, constructs a batch containing those requests, and submits the batch to the orderer
-// via a BatchReady event.
+// with number instanceNr in epoch epochNr, constructs a batch containing those requests,
+// and submits the batch to the orderer via a BatchReady event.
 // If there are no requests in the corresponding buckets, applySBInstCutBatch still provides an empty batch immediately.
-func (iss *ISS) applySBInstCutBatch(instance sbInstance, maxBatchSize t.NumRequests) *events.EventList {
+func (iss *ISS) applySBInstCutBatch(
+	epochNr t.EpochNr,
+	instanceNr t.SBInstanceNr,
+	instance sbInstance,
+	maxBatchSize t.NumRequests,
+) *events.EventList {
 
-	// Look up the relevant buckets, based on the orderer's segment.
-	buckets := iss.buckets.Select(instance.Segment().BucketIDs)
+	// Look up the relevant buckets, precomputed based on the orderer's segment at the start of the epoch.
+	buckets := iss.epochs[epochNr].ordererBuckets[instanceNr]
 
 	// Create a new batch, removing its requests from their buckets.
 	batch := buckets.CutBatch(maxBatchSize)
@@ -106,7 +139,33 @@ func (iss *ISS) applySBInstCutBatch(instance sbInstance, maxBatchSize t.NumReque
 	requestsLeft := buckets.TotalRequests()
 
 	// Notify submit the new batch to the orderer.
-	return instance.ApplyEvent(SBBatchReadyEvent(batch, requestsLeft))
+	return instance.ApplyEvent(SBBatchReadyEvent(batch, nil, requestsLeft))
+}
+
+// applySBInstRequestCert replaces applySBInstCutBatch when ISS uses the availability layer.
+// Instead of cutting a batch from the buckets, it requests a new availability certificate
+// that the orderer will propose in place of the batch.
+// Operation continues on reception of the NewCert event.
+func (iss *ISS) applySBInstRequestCert(epochNr t.EpochNr, instanceNr t.SBInstanceNr) *events.EventList {
+	return events.ListOf(aevents.RequestCert(
+		availabilityModuleName,
+		RequestCertOrigin(iss.certOrigins.Store(SBCertOrigin(epochNr, instanceNr, nil))),
+	))
+}
+
+// applySBInstVerifyCert processes a request by an orderer to verify an availability certificate
+// and forwards it to the availability layer.
+// Operation continues on reception of the CertVerified event.
+func (iss *ISS) applySBInstVerifyCert(
+	epochNr t.EpochNr,
+	instanceNr t.SBInstanceNr,
+	verifyCert *isspb.SBVerifyCert,
+) *events.EventList {
+	return events.ListOf(aevents.VerifyCert(
+		availabilityModuleName,
+		verifyCert.Cert,
+		VerifyCertOrigin(iss.certOrigins.Store(SBCertOrigin(epochNr, instanceNr, verifyCert.Origin))),
+	))
 }
 
 // applySBInstResurrectBatch resurrects requests contained in a batch that was cut, but could not been proposed
@@ -1,6 +1,8 @@
 package dsl
 
 import (
+	"errors"
+
 	"github.com/filecoin-project/mir/pkg/dsl"
 	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
 	"github.com/filecoin-project/mir/pkg/pb/eventpb"
@@ -15,6 +17,7 @@ func RequestBatch[C any](m dsl.Module, dest t.ModuleID, context *C) {
 	contextID := m.DslHandle().StoreContext(context)
 
 	origin := &mppb.RequestBatchOrigin{
+		Module: m.ModuleID().Pb(),
 		Type: &mppb.RequestBatchOrigin_Dsl{
 			Dsl: dsl.Origin(contextID),
 		},
@@ -34,6 +37,7 @@ func RequestTransactions[C any](m dsl.Module, dest t.ModuleID, txIDs []t.TxID, c
 	contextID := m.DslHandle().StoreContext(context)
 
 	origin := &mppb.RequestTransactionsOrigin{
+		Module: m.ModuleID().Pb(),
 		Type: &mppb.RequestTransactionsOrigin_Dsl{
 			Dsl: dsl.Origin(contextID),
 		},
@@ -53,6 +57,7 @@ func RequestTransactionIDs[C any](m dsl.Module, dest t.ModuleID, txs [][]byte, c
 	contextID := m.DslHandle().StoreContext(context)
 
 	origin := &mppb.RequestTransactionIDsOrigin{
+		Module: m.ModuleID().Pb(),
 		Type: &mppb.RequestTransactionIDsOrigin_Dsl{
 			Dsl: dsl.Origin(contextID),
 		},
@@ -72,6 +77,7 @@ func RequestBatchID[C any](m dsl.Module, dest t.ModuleID, txIDs []t.TxID, contex
 	contextID := m.DslHandle().StoreContext(context)
 
 	origin := &mppb.RequestBatchIDOrigin{
+		Module: m.ModuleID().Pb(),
 		Type: &mppb.RequestBatchIDOrigin_Dsl{
 			Dsl: dsl.Origin(contextID),
 		},
@@ -85,6 +91,49 @@ func BatchIDResponse(m dsl.Module, dest t.ModuleID, batchID t.BatchID, origin *m
 	dsl.EmitEvent(m, mpevents.BatchIDResponse(dest, batchID, origin))
 }
 
+// SetRetentionIndex informs the mempool about the retention index
+// to associate with all the transactions it includes in batches from now on.
+func SetRetentionIndex(m dsl.Module, dest t.ModuleID, retIdx t.RetentionIndex) {
+	dsl.EmitEvent(m, mpevents.SetRetentionIndex(dest, retIdx))
+}
+
+// ForgetTransactionsBefore lets the mempool garbage-collect all the transactions
+// included in batches associated with a retention index smaller than retIdx.
+func ForgetTransactionsBefore(m dsl.Module, dest t.ModuleID, retIdx t.RetentionIndex) {
+	dsl.EmitEvent(m, mpevents.ForgetTransactionsBefore(dest, retIdx))
+}
+
+// TransactionsCommitted informs the mempool that the given transactions have been committed.
+func TransactionsCommitted(m dsl.Module, dest t.ModuleID, txs [][]byte) {
+	dsl.EmitEvent(m, mpevents.TransactionsCommitted(dest, txs))
+}
+
+// VerifyTransactions allows other modules to have transactions validated by the application.
+func VerifyTransactions[C any](m dsl.Module, dest t.ModuleID, txs [][]byte, context *C) {
+	contextID := m.DslHandle().StoreContext(context)
+
+	origin := &mppb.VerifyTransactionsOrigin{
+		Module: m.ModuleID().Pb(),
+		Type: &mppb.VerifyTransactionsOrigin_Dsl{
+			Dsl: dsl.Origin(contextID),
+		},
+	}
+
+	dsl.EmitEvent(m, mpevents.VerifyTransactions(dest, txs, origin))
+}
+
+// TransactionsVerified is a response to a VerifyTransactions event.
+func TransactionsVerified(
+	m dsl.Module,
+	dest t.ModuleID,
+	valid []bool,
+	errs []string,
+	priorities []uint64,
+	origin *mppb.VerifyTransactionsOrigin,
+) {
+	dsl.EmitEvent(m, mpevents.TransactionsVerified(dest, valid, errs, priorities, origin))
+}
+
 // Module-specific dsl functions for processing events.
 
 // UponEvent registers a handler for the given mempool event type.
@@ -197,3 +246,69 @@ func UponBatchIDResponse[C any](m dsl.Module, handler func(batchID t.BatchID, co
 		return handler(t.BatchID(ev.BatchId), context)
 	})
 }
+
+// UponSetRetentionIndex registers a handler for the SetRetentionIndex events.
+func UponSetRetentionIndex(m dsl.Module, handler func(retIdx t.RetentionIndex) error) {
+	UponEvent[*mppb.Event_SetRetentionIndex](m, func(ev *mppb.SetRetentionIndex) error {
+		return handler(t.RetentionIndex(ev.RetentionIndex))
+	})
+}
+
+// UponForgetTransactionsBefore registers a handler for the ForgetTransactionsBefore events.
+func UponForgetTransactionsBefore(m dsl.Module, handler func(retIdx t.RetentionIndex) error) {
+	UponEvent[*mppb.Event_ForgetTransactionsBefore](m, func(ev *mppb.ForgetTransactionsBefore) error {
+		return handler(t.RetentionIndex(ev.RetentionIndex))
+	})
+}
+
+// UponTransactionsCommitted registers a handler for the TransactionsCommitted events.
+func UponTransactionsCommitted(m dsl.Module, handler func(txs [][]byte) error) {
+	UponEvent[*mppb.Event_TransactionsCommitted](m, func(ev *mppb.TransactionsCommitted) error {
+		return handler(ev.Txs)
+	})
+}
+
+// UponVerifyTransactions registers a handler for the VerifyTransactions events.
+func UponVerifyTransactions(m dsl.Module, handler func(txs [][]byte, origin *mppb.VerifyTransactionsOrigin) error) {
+	UponEvent[*mppb.Event_VerifyTransactions](m, func(ev *mppb.VerifyTransactions) error {
+		return handler(ev.Txs, ev.Origin)
+	})
+}
+
+// UponTransactionsVerified registers a handler for the TransactionsVerified events.
+// For each transaction, errs contains nil if the transaction is valid and the reason of its rejection otherwise,
+// and priorities contains the application-defined priority of the transaction.
+func UponTransactionsVerified[C any](
+	m dsl.Module,
+	handler func(errs []error, priorities []uint64, allOK bool, context *C) error,
+) {
+	UponEvent[*mppb.Event_TransactionsVerified](m, func(ev *mppb.TransactionsVerified) error {
+		originWrapper, ok := ev.Origin.Type.(*mppb.VerifyTransactionsOrigin_Dsl)
+		if !ok {
+			return nil
+		}
+
+		contextRaw := m.DslHandle().RecoverAndCleanupContext(dsl.ContextID(originWrapper.Dsl.ContextID))
+		context, ok := contextRaw.(*C)
+		if !ok {
+			return nil
+		}
+
+		errs := make([]error, len(ev.Valid))
+		allOK := true
+		for i, valid := range ev.Valid {
+			if !valid {
+				errs[i] = errors.New(ev.Errors[i])
+				allOK = false
+			}
+		}
+
+		// A validator not assigning priorities is treated as assigning the same priority to all transactions.
+		priorities := ev.Priorities
+		if len(priorities) != len(ev.Valid) {
+			priorities = make([]uint64, len(ev.Valid))
+		}
+
+		return handler(errs, priorities, allOK, context)
+	})
+}
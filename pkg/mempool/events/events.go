@@ -115,3 +115,71 @@ func BatchIDResponse(dest t.ModuleID, batchID t.BatchID, origin *mppb.RequestBat
 		},
 	})
 }
+
+// SetRetentionIndex informs the mempool about the retention index
+// to associate with all the transactions it includes in batches from now on.
+func SetRetentionIndex(dest t.ModuleID, retIdx t.RetentionIndex) *eventpb.Event {
+	return Event(dest, &mppb.Event{
+		Type: &mppb.Event_SetRetentionIndex{
+			SetRetentionIndex: &mppb.SetRetentionIndex{
+				RetentionIndex: retIdx.Pb(),
+			},
+		},
+	})
+}
+
+// ForgetTransactionsBefore lets the mempool garbage-collect all the transactions
+// included in batches associated with a retention index smaller than retIdx.
+func ForgetTransactionsBefore(dest t.ModuleID, retIdx t.RetentionIndex) *eventpb.Event {
+	return Event(dest, &mppb.Event{
+		Type: &mppb.Event_ForgetTransactionsBefore{
+			ForgetTransactionsBefore: &mppb.ForgetTransactionsBefore{
+				RetentionIndex: retIdx.Pb(),
+			},
+		},
+	})
+}
+
+// TransactionsCommitted informs the mempool that the given transactions have been committed,
+// such that it does not include them in any further batch.
+func TransactionsCommitted(dest t.ModuleID, txs [][]byte) *eventpb.Event {
+	return Event(dest, &mppb.Event{
+		Type: &mppb.Event_TransactionsCommitted{
+			TransactionsCommitted: &mppb.TransactionsCommitted{
+				Txs: txs,
+			},
+		},
+	})
+}
+
+// VerifyTransactions is used to have transactions validated by the application.
+func VerifyTransactions(dest t.ModuleID, txs [][]byte, origin *mppb.VerifyTransactionsOrigin) *eventpb.Event {
+	return Event(dest, &mppb.Event{
+		Type: &mppb.Event_VerifyTransactions{
+			VerifyTransactions: &mppb.VerifyTransactions{
+				Txs:    txs,
+				Origin: origin,
+			},
+		},
+	})
+}
+
+// TransactionsVerified is a response to a VerifyTransactions event.
+func TransactionsVerified(
+	dest t.ModuleID,
+	valid []bool,
+	errors []string,
+	priorities []uint64,
+	origin *mppb.VerifyTransactionsOrigin,
+) *eventpb.Event {
+	return Event(dest, &mppb.Event{
+		Type: &mppb.Event_TransactionsVerified{
+			TransactionsVerified: &mppb.TransactionsVerified{
+				Valid:      valid,
+				Errors:     errors,
+				Priorities: priorities,
+				Origin:     origin,
+			},
+		},
+	})
+}
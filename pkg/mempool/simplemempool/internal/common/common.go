@@ -0,0 +1,247 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/txpool"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// ModuleConfig sets the module ids. All replicas are expected to use identical module configurations.
+type ModuleConfig struct {
+	Self      t.ModuleID // id of this module
+	Hasher    t.ModuleID
+	Validator t.ModuleID // module validating transactions on behalf of the application
+	Clients   t.ModuleID // module to which rejections of invalid requests are reported
+	Net       t.ModuleID // module for sending gossip messages to the mempools of other nodes
+	Timer     t.ModuleID // module triggering periodic gossip and the requeueing of uncommitted batches
+}
+
+// DefaultModuleConfig returns a valid module config with default names for all modules.
+func DefaultModuleConfig() *ModuleConfig {
+	return &ModuleConfig{
+		Self:      "mempool",
+		Hasher:    "hasher",
+		Validator: "validator",
+		Clients:   "clients",
+		Net:       "net",
+		Timer:     "timer",
+	}
+}
+
+// ModuleParams sets the values for the parameters of an instance of the protocol.
+type ModuleParams struct {
+	// Maximal number of transactions in a batch.
+	MaxTransactionsInBatch int
+
+	// Maximal total size of the transactions in a batch, in bytes.
+	// A single transaction exceeding this size is still included in a batch on its own.
+	MaxPayloadInBatch int
+
+	// If true, pending transactions are included in batches in the order of their application-defined priority
+	// (highest first, see txvalidator.Prioritizer). Otherwise, they are included in the order of their arrival.
+	PriorityOrdering bool
+
+	// Time after which the transactions of a batch that have not been committed yet are made pending again,
+	// so that they can be included in further batches.
+	// This way, no transactions are lost if the consensus layer drops their batch
+	// (e.g., the availability certificate referring to it, on a view change).
+	RequeueTimeout t.TimeDuration
+
+	// Maximal number of pending transactions. Zero means no limit.
+	// When the limit is reached, a new transaction evicts the pending transaction with the lowest priority,
+	// provided the new transaction has a higher priority. Otherwise, the new transaction is rejected.
+	MaxTransactionsInPool int
+
+	// Maximal total size of the pending transactions, in bytes. Zero means no limit.
+	// The limit is enforced the same way as MaxTransactionsInPool.
+	MaxPayloadInPool int
+
+	// Other nodes with which pending transactions are gossiped. An empty list disables gossip.
+	GossipPeers []t.NodeID
+
+	// Period with which new transactions are announced to the gossip peers.
+	GossipPeriod t.TimeDuration
+
+	// Maximal total size of the transactions provided to and accepted from a single gossip peer per GossipPeriod,
+	// in bytes. Requests from a peer that has exhausted its quota are ignored until the quota is replenished,
+	// as are transactions provided by such a peer.
+	MaxGossipPayloadPerPeer int
+
+	// Maximal number of transaction IDs accepted from the announcements of a single gossip peer per GossipPeriod.
+	// Further announced IDs are ignored.
+	MaxAnnouncedTxsPerPeer int
+
+	// Maximal number of transactions announced by a single gossip peer
+	// that have been requested, but not received yet.
+	// Further transactions announced by the peer are ignored until some of its missing transactions are received.
+	MaxMissingTxsPerPeer int
+}
+
+// DefaultModuleParams returns valid module parameters with default values.
+func DefaultModuleParams() *ModuleParams {
+	return &ModuleParams{
+		MaxTransactionsInBatch:  10,
+		MaxPayloadInBatch:       1024 * 1024,
+		PriorityOrdering:        false,
+		RequeueTimeout:          t.TimeDuration(10 * time.Second),
+		MaxTransactionsInPool:   0,
+		MaxPayloadInPool:        0,
+		GossipPeers:             nil,
+		GossipPeriod:            t.TimeDuration(100 * time.Millisecond),
+		MaxGossipPayloadPerPeer: 1024 * 1024,
+		MaxAnnouncedTxsPerPeer:  10000,
+		MaxMissingTxsPerPeer:    10000,
+	}
+}
+
+// CheckParams checks whether the given module parameters satisfy all necessary constraints.
+func CheckParams(params *ModuleParams) error {
+	if params.MaxTransactionsInBatch <= 0 {
+		return fmt.Errorf("non-positive MaxTransactionsInBatch: %d", params.MaxTransactionsInBatch)
+	}
+
+	if params.MaxPayloadInBatch <= 0 {
+		return fmt.Errorf("non-positive MaxPayloadInBatch: %d", params.MaxPayloadInBatch)
+	}
+
+	// RequeueTimeout must be positive, as batched transactions would otherwise be requeued immediately.
+	if params.RequeueTimeout <= 0 {
+		return fmt.Errorf("non-positive RequeueTimeout: %d", params.RequeueTimeout)
+	}
+
+	if params.MaxTransactionsInPool < 0 {
+		return fmt.Errorf("negative MaxTransactionsInPool: %d", params.MaxTransactionsInPool)
+	}
+
+	if params.MaxPayloadInPool < 0 {
+		return fmt.Errorf("negative MaxPayloadInPool: %d", params.MaxPayloadInPool)
+	}
+
+	// The remaining parameters only matter if gossip is enabled.
+	if len(params.GossipPeers) == 0 {
+		return nil
+	}
+
+	peers := make(map[t.NodeID]struct{}, len(params.GossipPeers))
+	for _, nodeID := range params.GossipPeers {
+		if _, ok := peers[nodeID]; ok {
+			return fmt.Errorf("duplicate node in GossipPeers: %v", nodeID)
+		}
+		peers[nodeID] = struct{}{}
+	}
+
+	// GossipPeriod must be positive, as the periodic gossip timer would otherwise fire continuously.
+	if params.GossipPeriod <= 0 {
+		return fmt.Errorf("non-positive GossipPeriod: %d", params.GossipPeriod)
+	}
+
+	if params.MaxGossipPayloadPerPeer <= 0 {
+		return fmt.Errorf("non-positive MaxGossipPayloadPerPeer: %d", params.MaxGossipPayloadPerPeer)
+	}
+
+	if params.MaxAnnouncedTxsPerPeer <= 0 {
+		return fmt.Errorf("non-positive MaxAnnouncedTxsPerPeer: %d", params.MaxAnnouncedTxsPerPeer)
+	}
+
+	if params.MaxMissingTxsPerPeer <= 0 {
+		return fmt.Errorf("non-positive MaxMissingTxsPerPeer: %d", params.MaxMissingTxsPerPeer)
+	}
+
+	return nil
+}
+
+// State represents the common state used by all parts of the simple mempool implementation.
+type State struct {
+	// All transactions stored in the mempool, indexed by their IDs.
+	TxByID map[t.TxID][]byte
+
+	// Pending transactions, i.e., those that have not yet been included in any batch or committed,
+	// and batched transactions, i.e., those that have been included in a batch, but not committed yet.
+	Pool *txpool.Pool
+
+	// Retention index associated with the transactions included in batches from now on.
+	RetentionIndex t.RetentionIndex
+
+	// IDs of the transactions included in batches, indexed by the retention index associated with them.
+	BatchedTxIDs map[t.RetentionIndex][]t.TxID
+
+	// IDs of the transactions added to the pool since they were last announced to the gossip peers.
+	// Only tracked if gossip is enabled.
+	NewTxIDs []t.TxID
+
+	gossip bool
+}
+
+// NewState returns a new empty State.
+func NewState(params *ModuleParams) *State {
+	return &State{
+		TxByID:         make(map[t.TxID][]byte),
+		Pool:           txpool.New(params.PriorityOrdering, params.MaxTransactionsInPool, params.MaxPayloadInPool),
+		RetentionIndex: 0,
+		BatchedTxIDs:   make(map[t.RetentionIndex][]t.TxID),
+		NewTxIDs:       make([]t.TxID, 0),
+		gossip:         len(params.GossipPeers) > 0,
+	}
+}
+
+// AddTransaction adds a new transaction to the pool of pending transactions
+// and returns the transactions it evicted from the pool (if any).
+// If the transaction is already stored in the mempool or does not fit in the pool,
+// AddTransaction returns an error and the state is not modified.
+func (s *State) AddTransaction(tx *txpool.Tx) ([]*txpool.Tx, error) {
+	if _, ok := s.TxByID[tx.ID]; ok {
+		return nil, txpool.ErrAlreadyPresent
+	}
+
+	replaced, evicted, err := s.Pool.Add(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.TxByID[tx.ID] = tx.Data
+	if replaced != nil {
+		delete(s.TxByID, replaced.ID)
+	}
+	for _, evictedTx := range evicted {
+		delete(s.TxByID, evictedTx.ID)
+	}
+
+	if s.gossip {
+		s.NewTxIDs = append(s.NewTxIDs, tx.ID)
+	}
+
+	return evicted, nil
+}
+
+// EvictedTxRejected returns an event reporting to the clients module
+// the rejection of a request whose transaction has been evicted from the pool.
+func EvictedTxRejected(mc *ModuleConfig, tx *txpool.Tx) *eventpb.Event {
+	return events.RequestRejected(
+		mc.Clients,
+		&requestpb.Request{ClientId: tx.ClientID.Pb(), ReqNo: tx.ReqNo.Pb()},
+		"evicted from the mempool by a transaction with a higher priority",
+	)
+}
+
+// TxIDData returns, for each of the given transactions, the binary data that is hashed to compute its ID.
+func TxIDData(txs [][]byte) [][][]byte {
+	data := make([][][]byte, len(txs))
+	for i, tx := range txs {
+		data[i] = [][]byte{tx}
+	}
+	return data
+}
+
+// BatchIDData is the binary data that is hashed to compute the ID of a batch.
+func BatchIDData(txIDs []t.TxID) [][]byte {
+	data := make([][]byte, len(txIDs))
+	for i, txID := range txIDs {
+		data[i] = txID.Bytes()
+	}
+	return data
+}
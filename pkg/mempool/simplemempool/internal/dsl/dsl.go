@@ -0,0 +1,78 @@
+package smpdsl
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	mpdsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/mempoolpb/simplemempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Module-specific dsl functions for processing events.
+
+func UponSimpleMempoolMessageReceived(m dsl.Module, handler func(from t.NodeID, msg *simplemempoolpb.Message) error) {
+	dsl.UponMessageReceived(m, func(from t.NodeID, msg *messagepb.Message) error {
+		smpMsgWrapper, ok := msg.Type.(*messagepb.Message_SimpleMempool)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, smpMsgWrapper.SimpleMempool)
+	})
+}
+
+func UponAnnounceTxIDsMessageReceived(m dsl.Module, handler func(from t.NodeID, txIDs []t.TxID) error) {
+	UponSimpleMempoolMessageReceived(m, func(from t.NodeID, msg *simplemempoolpb.Message) error {
+		announceTxIDsMsgWrapper, ok := msg.Type.(*simplemempoolpb.Message_AnnounceTxIds)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, t.TxIDSlice(announceTxIDsMsgWrapper.AnnounceTxIds.TxIds))
+	})
+}
+
+func UponRequestTxsMessageReceived(m dsl.Module, handler func(from t.NodeID, txIDs []t.TxID) error) {
+	UponSimpleMempoolMessageReceived(m, func(from t.NodeID, msg *simplemempoolpb.Message) error {
+		requestTxsMsgWrapper, ok := msg.Type.(*simplemempoolpb.Message_RequestTxs)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, t.TxIDSlice(requestTxsMsgWrapper.RequestTxs.TxIds))
+	})
+}
+
+func UponProvideTxsMessageReceived(m dsl.Module, handler func(from t.NodeID, txs [][]byte) error) {
+	UponSimpleMempoolMessageReceived(m, func(from t.NodeID, msg *simplemempoolpb.Message) error {
+		provideTxsMsgWrapper, ok := msg.Type.(*simplemempoolpb.Message_ProvideTxs)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, provideTxsMsgWrapper.ProvideTxs.Txs)
+	})
+}
+
+func UponEvent[EvWrapper simplemempoolpb.Event_TypeWrapper[Ev], Ev any](m dsl.Module, handler func(ev *Ev) error) {
+	mpdsl.UponEvent[*mppb.Event_SimpleMempool](m, func(ev *simplemempoolpb.Event) error {
+		evWrapper, ok := ev.Type.(EvWrapper)
+		if !ok {
+			return nil
+		}
+		return handler(evWrapper.Unwrap())
+	})
+}
+
+func UponGossipTick(m dsl.Module, handler func() error) {
+	UponEvent[*simplemempoolpb.Event_GossipTick](m, func(ev *simplemempoolpb.GossipTick) error {
+		return handler()
+	})
+}
+
+func UponRequeueBatch(m dsl.Module, handler func(batchNr uint64) error) {
+	UponEvent[*simplemempoolpb.Event_RequeueBatch](m, func(ev *simplemempoolpb.RequeueBatch) error {
+		return handler(ev.BatchNr)
+	})
+}
@@ -0,0 +1,57 @@
+package computeids
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	mpdsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/common"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// IncludeComputationOfTransactionAndBatchIDs registers event handlers for processing
+// mempoolpb.RequestTransactionIDs and mempoolpb.RequestBatchID events.
+// The ID of a transaction is the hash of the transaction
+// and the ID of a batch is the hash of the concatenated IDs of the transactions in the batch.
+func IncludeComputationOfTransactionAndBatchIDs(m dsl.Module, mc *common.ModuleConfig) {
+
+	// When IDs of transactions are requested, have them computed by the hasher.
+	mpdsl.UponRequestTransactionIDs(m, func(txs [][]byte, origin *mppb.RequestTransactionIDsOrigin) error {
+		dsl.HashRequest(m, mc.Hasher, common.TxIDData(txs), &computeTxIDsContext{origin})
+		return nil
+	})
+
+	// When the hasher computes the transaction IDs, pass them to the requesting module.
+	dsl.UponHashResult(m, func(hashes [][]byte, context *computeTxIDsContext) error {
+		txIDs := make([]t.TxID, len(hashes))
+		for i, hash := range hashes {
+			txIDs[i] = t.TxID(hash)
+		}
+
+		mpdsl.TransactionIDsResponse(m, t.ModuleID(context.origin.Module), txIDs, context.origin)
+		return nil
+	})
+
+	// When the ID of a batch is requested, have it computed by the hasher.
+	mpdsl.UponRequestBatchID(m, func(txIDs []t.TxID, origin *mppb.RequestBatchIDOrigin) error {
+		dsl.HashOneMessage(m, mc.Hasher, common.BatchIDData(txIDs), &computeBatchIDContext{origin})
+		return nil
+	})
+
+	// When the hasher computes the batch ID, pass it to the requesting module.
+	dsl.UponOneHashResult(m, func(hash []byte, context *computeBatchIDContext) error {
+		mpdsl.BatchIDResponse(m, t.ModuleID(context.origin.Module), t.BatchID(hash), context.origin)
+		return nil
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Context data structures                                                                                            //
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type computeTxIDsContext struct {
+	origin *mppb.RequestTransactionIDsOrigin
+}
+
+type computeBatchIDContext struct {
+	origin *mppb.RequestBatchIDOrigin
+}
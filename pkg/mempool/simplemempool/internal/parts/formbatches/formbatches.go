@@ -0,0 +1,186 @@
+package formbatches
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/events"
+	mpdsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/common"
+	smpdsl "github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/protobuf"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/txpool"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// IncludeBatchCreation registers event handlers for processing NewRequests, mempoolpb.RequestBatch
+// and mempoolpb.TransactionsCommitted events.
+// Each new request is validated by the application and, if valid, stored as a pending transaction,
+// consisting of the serialized requestpb.Request.
+// Pending transactions are included in batches in the order of their arrival or of their priority.
+// The transactions of a batch that have not been committed within params.RequeueTimeout are made pending again.
+func IncludeBatchCreation(
+	m dsl.Module,
+	mc *common.ModuleConfig,
+	params *common.ModuleParams,
+	commonState *common.State,
+) {
+
+	// When new requests are received, serialize them and have the resulting transactions validated.
+	dsl.UponNewRequests(m, func(requests []*requestpb.Request) error {
+		txs := make([][]byte, len(requests))
+		for i, req := range requests {
+			tx, err := proto.Marshal(req)
+			if err != nil {
+				return fmt.Errorf("could not serialize request: %w", err)
+			}
+			txs[i] = tx
+		}
+
+		mpdsl.VerifyTransactions(m, mc.Validator, txs, &verifyNewTxsContext{requests, txs})
+		return nil
+	})
+
+	// When new transactions are validated, report the rejection of the invalid ones to the clients
+	// and have the IDs of the valid ones computed.
+	mpdsl.UponTransactionsVerified(m, func(errs []error, priorities []uint64, _ bool, context *verifyNewTxsContext) error {
+		validTxs := &computeIDsOfNewTxsContext{
+			requests:   make([]*requestpb.Request, 0, len(context.txs)),
+			txs:        make([][]byte, 0, len(context.txs)),
+			priorities: make([]uint64, 0, len(context.txs)),
+		}
+		for i, err := range errs {
+			if err != nil {
+				dsl.EmitEvent(m, events.RequestRejected(mc.Clients, context.requests[i], err.Error()))
+				continue
+			}
+
+			validTxs.requests = append(validTxs.requests, context.requests[i])
+			validTxs.txs = append(validTxs.txs, context.txs[i])
+			validTxs.priorities = append(validTxs.priorities, priorities[i])
+		}
+
+		if len(validTxs.txs) > 0 {
+			dsl.HashRequest(m, mc.Hasher, common.TxIDData(validTxs.txs), validTxs)
+		}
+		return nil
+	})
+
+	// When the IDs of new transactions are computed, add the transactions to the pool of pending transactions.
+	// Transactions already present in the mempool are ignored.
+	// Transactions that do not fit in the pool, as well as the ones they evict, are reported to the clients.
+	dsl.UponHashResult(m, func(hashes [][]byte, context *computeIDsOfNewTxsContext) error {
+		for i, hash := range hashes {
+			txID := t.TxID(hash)
+			if _, ok := commonState.TxByID[txID]; ok {
+				continue
+			}
+
+			req := context.requests[i]
+			evicted, err := commonState.AddTransaction(&txpool.Tx{
+				ID:       txID,
+				Data:     context.txs[i],
+				Priority: context.priorities[i],
+				ClientID: t.ClientID(req.ClientId),
+				ReqNo:    t.ReqNo(req.ReqNo),
+			})
+			if err != nil {
+				dsl.EmitEvent(m, events.RequestRejected(mc.Clients, req, err.Error()))
+				continue
+			}
+			for _, evictedTx := range evicted {
+				dsl.EmitEvent(m, common.EvictedTxRejected(mc, evictedTx))
+			}
+		}
+		return nil
+	})
+
+	// When a batch is requested, respond immediately with (a potentially empty batch of) pending transactions,
+	// respecting the limits on the size of the batch.
+	// The transactions are taken from the pool in the order of their arrival or priority, depending on the parameters.
+	mpdsl.UponRequestBatch(m, func(origin *mppb.RequestBatchOrigin) error {
+		batchNr, batch := commonState.Pool.CutBatch(params.MaxTransactionsInBatch, params.MaxPayloadInBatch)
+
+		txIDs := make([]t.TxID, len(batch))
+		txs := make([][]byte, len(batch))
+		for i, tx := range batch {
+			txIDs[i] = tx.ID
+			txs[i] = tx.Data
+		}
+
+		// Keep the batched transactions until they are garbage-collected.
+		commonState.BatchedTxIDs[commonState.RetentionIndex] = append(
+			commonState.BatchedTxIDs[commonState.RetentionIndex],
+			txIDs...,
+		)
+
+		// Make sure the transactions are not lost if the batch is not committed.
+		if len(batch) > 0 {
+			dsl.EmitEvent(m, events.TimerDelay(
+				mc.Timer,
+				[]*eventpb.Event{protobuf.RequeueBatch(mc.Self, batchNr)},
+				params.RequeueTimeout,
+			))
+		}
+
+		mpdsl.NewBatch(m, t.ModuleID(origin.Module), txIDs, txs, origin)
+		return nil
+	})
+
+	// When the transactions of a batch have not been committed in time, make them pending again.
+	// This happens if the batch has been dropped by the consensus layer (or is taking unusually long to commit).
+	// In the latter case, the transactions might be committed twice, which the consensus layer must tolerate.
+	smpdsl.UponRequeueBatch(m, func(batchNr uint64) error {
+		commonState.Pool.RequeueBatch(batchNr)
+		return nil
+	})
+
+	// When transactions are committed, have their IDs computed in order to remove them.
+	mpdsl.UponTransactionsCommitted(m, func(txs [][]byte) error {
+		dsl.HashRequest(m, mc.Hasher, common.TxIDData(txs), &computeIDsOfCommittedTxsContext{})
+		return nil
+	})
+
+	// When the IDs of committed transactions are computed, remove them from the pool,
+	// so that they are neither included in any further batch nor requeued.
+	// Transactions that have already been included in a batch remain stored until they are garbage-collected.
+	dsl.UponHashResult(m, func(hashes [][]byte, context *computeIDsOfCommittedTxsContext) error {
+		for _, hash := range hashes {
+			txID := t.TxID(hash)
+			if commonState.Pool.IsBatched(txID) {
+				commonState.Pool.Remove(txID)
+				// The batch might have already been garbage-collected while the transaction was not committed.
+				// Make sure the transaction is garbage-collected with the current retention index in any case.
+				commonState.BatchedTxIDs[commonState.RetentionIndex] = append(
+					commonState.BatchedTxIDs[commonState.RetentionIndex],
+					txID,
+				)
+			} else if commonState.Pool.Remove(txID) != nil {
+				delete(commonState.TxByID, txID)
+			}
+		}
+		return nil
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Context data structures                                                                                            //
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type verifyNewTxsContext struct {
+	requests []*requestpb.Request
+	txs      [][]byte
+}
+
+type computeIDsOfNewTxsContext struct {
+	requests   []*requestpb.Request
+	txs        [][]byte
+	priorities []uint64
+}
+
+type computeIDsOfCommittedTxsContext struct{}
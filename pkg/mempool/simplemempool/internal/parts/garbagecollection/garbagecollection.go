@@ -0,0 +1,40 @@
+package garbagecollection
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	mpdsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/common"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// IncludeGarbageCollection registers event handlers for processing
+// mempoolpb.SetRetentionIndex and mempoolpb.ForgetTransactionsBefore events.
+func IncludeGarbageCollection(m dsl.Module, commonState *common.State) {
+
+	// When the availability layer announces a new retention index,
+	// associate all transactions included in batches from now on with it.
+	mpdsl.UponSetRetentionIndex(m, func(retIdx t.RetentionIndex) error {
+		if retIdx > commonState.RetentionIndex {
+			commonState.RetentionIndex = retIdx
+		}
+		return nil
+	})
+
+	// When the availability layer does not need old batches any more, remove their transactions.
+	// Transactions that have not been committed are kept, as they are still needed for further batches.
+	mpdsl.UponForgetTransactionsBefore(m, func(retIdx t.RetentionIndex) error {
+		for idx, txIDs := range commonState.BatchedTxIDs {
+			if idx >= retIdx {
+				continue
+			}
+
+			for _, txID := range txIDs {
+				if !commonState.Pool.Contains(txID) && !commonState.Pool.IsBatched(txID) {
+					delete(commonState.TxByID, txID)
+				}
+			}
+			delete(commonState.BatchedTxIDs, idx)
+		}
+		return nil
+	})
+}
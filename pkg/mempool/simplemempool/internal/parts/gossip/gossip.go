@@ -0,0 +1,335 @@
+package gossip
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/events"
+	mpdsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/common"
+	smpdsl "github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/protobuf"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/txpool"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+	"github.com/filecoin-project/mir/pkg/util/maputil"
+	"github.com/filecoin-project/mir/pkg/util/sliceutil"
+)
+
+// maxRequestAttempts is the number of times a missing transaction is requested
+// (each time from the next peer that announced it) before giving up.
+const maxRequestAttempts = 10
+
+// State represents the state related to this part of the module.
+type State struct {
+	// Transactions announced by gossip peers that this node has requested, but not yet received.
+	MissingTxs map[t.TxID]*MissingTx
+
+	// Number of missing transactions first announced by each gossip peer.
+	MissingByAnnouncer map[t.NodeID]int
+
+	// Total size of the transactions that can still be provided to each gossip peer in the current gossip period.
+	Quotas map[t.NodeID]int
+
+	// Number of announced transaction IDs that can still be accepted from each gossip peer
+	// in the current gossip period.
+	AnnounceQuotas map[t.NodeID]int
+
+	// Total size of the provided transactions that can still be accepted from each gossip peer
+	// in the current gossip period.
+	ReceiveQuotas map[t.NodeID]int
+
+	// Number of the current gossip period.
+	Period uint64
+}
+
+// MissingTx keeps track of the requests for a transaction announced by gossip peers.
+// The first announcer is the peer the missing transaction is accounted to.
+type MissingTx struct {
+	Announcers    []t.NodeID
+	Attempts      int
+	LastRequested uint64 // gossip period in which the transaction was last requested
+}
+
+// IncludeGossip registers event handlers for gossiping pending transactions with the mempools of other nodes.
+// Periodically, each node announces the IDs of the transactions newly added to its pool to its gossip peers.
+// A peer requests the announced transactions it does not have from the announcer
+// and adds the provided transactions to its own pool (after computing their IDs and having them validated),
+// from where they are announced further.
+// This way, clients can submit each request to a single node.
+// The amount of transaction data each node provides to and accepts from a single peer per gossip period is limited
+// by params.MaxGossipPayloadPerPeer.
+// Requests exceeding the limit are ignored and retried by the requesting peer in the next gossip period,
+// possibly from another peer that announced the same transactions.
+// Provided transactions exceeding the limit are ignored and requested again in a later gossip period.
+// The number of transaction IDs accepted from a single peer's announcements per gossip period
+// is limited by params.MaxAnnouncedTxsPerPeer
+// and the number of missing transactions announced by a single peer by params.MaxMissingTxsPerPeer.
+// Announcements exceeding these limits are ignored.
+func IncludeGossip(
+	m dsl.Module,
+	mc *common.ModuleConfig,
+	params *common.ModuleParams,
+	commonState *common.State,
+) {
+	if len(params.GossipPeers) == 0 {
+		return
+	}
+
+	state := &State{
+		MissingTxs:         make(map[t.TxID]*MissingTx),
+		MissingByAnnouncer: make(map[t.NodeID]int),
+		Quotas:             make(map[t.NodeID]int),
+		AnnounceQuotas:     make(map[t.NodeID]int),
+		ReceiveQuotas:      make(map[t.NodeID]int),
+	}
+	isPeer := make(map[t.NodeID]struct{}, len(params.GossipPeers))
+	for _, nodeID := range params.GossipPeers {
+		isPeer[nodeID] = struct{}{}
+	}
+
+	// replenishQuotas resets the quotas of all peers at the start of each gossip period.
+	replenishQuotas := func() {
+		for _, nodeID := range params.GossipPeers {
+			state.Quotas[nodeID] = params.MaxGossipPayloadPerPeer
+			state.AnnounceQuotas[nodeID] = params.MaxAnnouncedTxsPerPeer
+			state.ReceiveQuotas[nodeID] = params.MaxGossipPayloadPerPeer
+		}
+	}
+	replenishQuotas()
+
+	// forgetMissing stops keeping track of a missing transaction.
+	forgetMissing := func(txID t.TxID) {
+		state.MissingByAnnouncer[state.MissingTxs[txID].Announcers[0]]--
+		delete(state.MissingTxs, txID)
+	}
+
+	// Start gossiping periodically.
+	dsl.UponInit(m, func() error {
+		dsl.EmitEvent(m, events.TimerRepeat(
+			mc.Timer,
+			[]*eventpb.Event{protobuf.GossipTick(mc.Self)},
+			params.GossipPeriod,
+			0,
+		))
+		return nil
+	})
+
+	// At the start of each gossip period, announce the new transactions that are still pending,
+	// replenish the quotas of all peers, and retry the requests for transactions that have not been received.
+	smpdsl.UponGossipTick(m, func() error {
+		newTxIDs := make([]t.TxID, 0, len(commonState.NewTxIDs))
+		for _, txID := range commonState.NewTxIDs {
+			if commonState.Pool.Contains(txID) {
+				newTxIDs = append(newTxIDs, txID)
+			}
+		}
+		commonState.NewTxIDs = commonState.NewTxIDs[:0]
+		if len(newTxIDs) > 0 {
+			dsl.SendMessage(m, mc.Net, protobuf.AnnounceTxIDsMessage(mc.Self, newTxIDs), params.GossipPeers)
+		}
+
+		state.Period++
+		replenishQuotas()
+
+		// Iterate in a deterministic order, so that the requests are reproducible.
+		retries := make(map[t.NodeID][]t.TxID)
+		maputil.IterateSorted(state.MissingTxs, func(txID t.TxID, missing *MissingTx) bool {
+			if _, ok := commonState.TxByID[txID]; ok || missing.Attempts >= maxRequestAttempts {
+				forgetMissing(txID)
+				return true
+			}
+
+			// Give the peer asked last at least one full gossip period to respond.
+			if missing.LastRequested+1 >= state.Period {
+				return true
+			}
+
+			announcer := missing.Announcers[missing.Attempts%len(missing.Announcers)]
+			retries[announcer] = append(retries[announcer], txID)
+			missing.Attempts++
+			missing.LastRequested = state.Period
+			return true
+		})
+		maputil.IterateSorted(retries, func(nodeID t.NodeID, txIDs []t.TxID) bool {
+			dsl.SendMessage(m, mc.Net, protobuf.RequestTxsMessage(mc.Self, txIDs), []t.NodeID{nodeID})
+			return true
+		})
+
+		return nil
+	})
+
+	// When a peer announces new transactions, request the ones this node does not have and has not yet requested,
+	// as long as the peer's quotas allow.
+	smpdsl.UponAnnounceTxIDsMessageReceived(m, func(from t.NodeID, txIDs []t.TxID) error {
+		if _, ok := isPeer[from]; !ok {
+			return nil
+		}
+
+		if len(txIDs) > state.AnnounceQuotas[from] {
+			txIDs = txIDs[:state.AnnounceQuotas[from]]
+		}
+		state.AnnounceQuotas[from] -= len(txIDs)
+
+		toRequest := make([]t.TxID, 0, len(txIDs))
+		for _, txID := range txIDs {
+			if _, ok := commonState.TxByID[txID]; ok {
+				continue
+			}
+
+			if missing, ok := state.MissingTxs[txID]; ok {
+				if !sliceutil.Contains(missing.Announcers, from) {
+					missing.Announcers = append(missing.Announcers, from)
+				}
+				continue
+			}
+
+			if state.MissingByAnnouncer[from] >= params.MaxMissingTxsPerPeer {
+				continue
+			}
+			state.MissingByAnnouncer[from]++
+			state.MissingTxs[txID] = &MissingTx{
+				Announcers:    []t.NodeID{from},
+				Attempts:      1,
+				LastRequested: state.Period,
+			}
+			toRequest = append(toRequest, txID)
+		}
+
+		if len(toRequest) > 0 {
+			dsl.SendMessage(m, mc.Net, protobuf.RequestTxsMessage(mc.Self, toRequest), []t.NodeID{from})
+		}
+		return nil
+	})
+
+	// When a peer requests transactions, provide the ones that are still pending, as long as the peer's quota allows.
+	// The quota may be exceeded by the last transaction provided,
+	// so that transactions larger than the quota can still be gossiped.
+	smpdsl.UponRequestTxsMessageReceived(m, func(from t.NodeID, txIDs []t.TxID) error {
+		if _, ok := isPeer[from]; !ok {
+			return nil
+		}
+
+		txs := make([][]byte, 0, len(txIDs))
+		for _, txID := range txIDs {
+			if state.Quotas[from] <= 0 {
+				break
+			}
+			if !commonState.Pool.Contains(txID) {
+				continue
+			}
+
+			tx := commonState.TxByID[txID]
+			txs = append(txs, tx)
+			state.Quotas[from] -= len(tx)
+		}
+
+		if len(txs) > 0 {
+			dsl.SendMessage(m, mc.Net, protobuf.ProvideTxsMessage(mc.Self, txs), []t.NodeID{from})
+		}
+		return nil
+	})
+
+	// When a peer provides transactions, have their IDs computed in order to check that they have been requested.
+	// Malformed transactions and transactions exceeding the peer's quota are ignored.
+	// As with providing transactions, the quota may be exceeded by the last transaction accepted.
+	smpdsl.UponProvideTxsMessageReceived(m, func(from t.NodeID, txs [][]byte) error {
+		if _, ok := isPeer[from]; !ok {
+			return nil
+		}
+
+		context := &computeIDsOfGossipedTxsContext{
+			requests: make([]*requestpb.Request, 0, len(txs)),
+			txs:      make([][]byte, 0, len(txs)),
+		}
+		for _, tx := range txs {
+			if state.ReceiveQuotas[from] <= 0 {
+				break
+			}
+			state.ReceiveQuotas[from] -= len(tx)
+
+			req := &requestpb.Request{}
+			if err := proto.Unmarshal(tx, req); err != nil {
+				continue
+			}
+			context.requests = append(context.requests, req)
+			context.txs = append(context.txs, tx)
+		}
+
+		if len(context.txs) > 0 {
+			dsl.HashRequest(m, mc.Hasher, common.TxIDData(context.txs), context)
+		}
+		return nil
+	})
+
+	// When the IDs of provided transactions are computed, have the requested ones validated.
+	// Transactions that have not been requested (or have already been received) are ignored.
+	dsl.UponHashResult(m, func(hashes [][]byte, context *computeIDsOfGossipedTxsContext) error {
+		requestedTxs := &verifyGossipedTxsContext{
+			txIDs:    make([]t.TxID, 0, len(hashes)),
+			requests: make([]*requestpb.Request, 0, len(hashes)),
+			txs:      make([][]byte, 0, len(hashes)),
+		}
+		for i, hash := range hashes {
+			txID := t.TxID(hash)
+			if _, ok := state.MissingTxs[txID]; !ok {
+				continue
+			}
+			forgetMissing(txID)
+
+			requestedTxs.txIDs = append(requestedTxs.txIDs, txID)
+			requestedTxs.requests = append(requestedTxs.requests, context.requests[i])
+			requestedTxs.txs = append(requestedTxs.txs, context.txs[i])
+		}
+
+		if len(requestedTxs.txs) > 0 {
+			mpdsl.VerifyTransactions(m, mc.Validator, requestedTxs.txs, requestedTxs)
+		}
+		return nil
+	})
+
+	// When the gossiped transactions are validated, add the valid ones to the pool.
+	// As the requests have not been submitted to this node, their rejection is not reported to the clients,
+	// but the eviction of other transactions is.
+	mpdsl.UponTransactionsVerified(m,
+		func(errs []error, priorities []uint64, _ bool, context *verifyGossipedTxsContext) error {
+			for i, err := range errs {
+				if err != nil {
+					continue
+				}
+
+				req := context.requests[i]
+				evicted, err := commonState.AddTransaction(&txpool.Tx{
+					ID:       context.txIDs[i],
+					Data:     context.txs[i],
+					Priority: priorities[i],
+					ClientID: t.ClientID(req.ClientId),
+					ReqNo:    t.ReqNo(req.ReqNo),
+				})
+				if err != nil {
+					continue
+				}
+				for _, evictedTx := range evicted {
+					dsl.EmitEvent(m, common.EvictedTxRejected(mc, evictedTx))
+				}
+			}
+			return nil
+		},
+	)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Context data structures                                                                                            //
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type computeIDsOfGossipedTxsContext struct {
+	requests []*requestpb.Request
+	txs      [][]byte
+}
+
+type verifyGossipedTxsContext struct {
+	txIDs    []t.TxID
+	requests []*requestpb.Request
+	txs      [][]byte
+}
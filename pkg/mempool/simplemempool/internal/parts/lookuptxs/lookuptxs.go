@@ -0,0 +1,26 @@
+package lookuptxs
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	mpdsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/common"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// IncludeTransactionLookupByID registers event handlers for processing mempoolpb.RequestTransactions events.
+func IncludeTransactionLookupByID(m dsl.Module, commonState *common.State) {
+
+	// When transactions are requested, look them up and respond right away,
+	// indicating which of the transactions are present in the mempool.
+	mpdsl.UponRequestTransactions(m, func(txIDs []t.TxID, origin *mppb.RequestTransactionsOrigin) error {
+		present := make([]bool, len(txIDs))
+		txs := make([][]byte, len(txIDs))
+		for i, txID := range txIDs {
+			txs[i], present[i] = commonState.TxByID[txID]
+		}
+
+		mpdsl.TransactionsResponse(m, t.ModuleID(origin.Module), present, txs, origin)
+		return nil
+	})
+}
@@ -0,0 +1,21 @@
+package verifytxs
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	mpdsl "github.com/filecoin-project/mir/pkg/mempool/dsl"
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/common"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+)
+
+// IncludeVerificationOfTransactions registers event handlers for processing mempoolpb.VerifyTransactions events
+// emitted by other modules, e.g., by the availability layer before vouching for a batch received from another node.
+func IncludeVerificationOfTransactions(m dsl.Module, mc *common.ModuleConfig) {
+
+	// When another module requests the validation of transactions, forward the request to the validator.
+	// The validator responds directly to the requesting module, as the origin is preserved.
+	mpdsl.UponVerifyTransactions(m, func(txs [][]byte, origin *mppb.VerifyTransactionsOrigin) error {
+		dsl.EmitEvent(m, mpevents.VerifyTransactions(mc.Validator, txs, origin))
+		return nil
+	})
+}
@@ -0,0 +1,75 @@
+package protobuf
+
+import (
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/mempoolpb/simplemempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+func Message(moduleID t.ModuleID, msg *simplemempoolpb.Message) *messagepb.Message {
+	return &messagepb.Message{
+		DestModule: moduleID.Pb(),
+		Type: &messagepb.Message_SimpleMempool{
+			SimpleMempool: msg,
+		},
+	}
+}
+
+func AnnounceTxIDsMessage(moduleID t.ModuleID, txIDs []t.TxID) *messagepb.Message {
+	return Message(moduleID, &simplemempoolpb.Message{
+		Type: &simplemempoolpb.Message_AnnounceTxIds{
+			AnnounceTxIds: &simplemempoolpb.AnnounceTxIDsMessage{
+				TxIds: t.TxIDSlicePb(txIDs),
+			},
+		},
+	})
+}
+
+func RequestTxsMessage(moduleID t.ModuleID, txIDs []t.TxID) *messagepb.Message {
+	return Message(moduleID, &simplemempoolpb.Message{
+		Type: &simplemempoolpb.Message_RequestTxs{
+			RequestTxs: &simplemempoolpb.RequestTxsMessage{
+				TxIds: t.TxIDSlicePb(txIDs),
+			},
+		},
+	})
+}
+
+func ProvideTxsMessage(moduleID t.ModuleID, txs [][]byte) *messagepb.Message {
+	return Message(moduleID, &simplemempoolpb.Message{
+		Type: &simplemempoolpb.Message_ProvideTxs{
+			ProvideTxs: &simplemempoolpb.ProvideTxsMessage{
+				Txs: txs,
+			},
+		},
+	})
+}
+
+func Event(moduleID t.ModuleID, ev *simplemempoolpb.Event) *eventpb.Event {
+	return mpevents.Event(moduleID, &mppb.Event{
+		Type: &mppb.Event_SimpleMempool{
+			SimpleMempool: ev,
+		},
+	})
+}
+
+func GossipTick(moduleID t.ModuleID) *eventpb.Event {
+	return Event(moduleID, &simplemempoolpb.Event{
+		Type: &simplemempoolpb.Event_GossipTick{
+			GossipTick: &simplemempoolpb.GossipTick{},
+		},
+	})
+}
+
+func RequeueBatch(moduleID t.ModuleID, batchNr uint64) *eventpb.Event {
+	return Event(moduleID, &simplemempoolpb.Event{
+		Type: &simplemempoolpb.Event_RequeueBatch{
+			RequeueBatch: &simplemempoolpb.RequeueBatch{
+				BatchNr: batchNr,
+			},
+		},
+	})
+}
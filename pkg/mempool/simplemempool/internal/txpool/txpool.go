@@ -0,0 +1,353 @@
+// Package txpool implements the pool of pending transactions of the simple mempool.
+// Each transaction has an application-defined priority (e.g., a fee).
+// Transactions are taken out of the pool for inclusion in batches either in the order of their arrival
+// or in the order of their priority (highest first).
+// When the pool reaches its capacity, transactions with the lowest priority are evicted to make space for new ones.
+// Transactions included in a batch stay in the pool as batched transactions until they are removed,
+// so that they can be requeued (i.e., made pending again) if the batch is not committed.
+package txpool
+
+import (
+	"container/heap"
+	"errors"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+var (
+	// ErrAlreadyPresent is returned when adding a transaction that is already in the pool.
+	ErrAlreadyPresent = errors.New("transaction already in the pool")
+
+	// ErrTooLarge is returned when adding a transaction that alone exceeds the capacity of the pool.
+	ErrTooLarge = errors.New("transaction exceeds the capacity of the pool")
+
+	// ErrPoolFull is returned when adding a transaction to a full pool
+	// that does not contain enough transactions with a lower priority to evict.
+	ErrPoolFull = errors.New("pool full of transactions with a priority not lower than the transaction's")
+
+	// ErrReplacementUnderpriced is returned when adding a transaction with the same client ID and request number
+	// as a transaction in the pool, but without a higher priority.
+	ErrReplacementUnderpriced = errors.New("replacement transaction priority not higher than the replaced one's")
+
+	// ErrReplacementBatched is returned when adding a transaction with the same client ID and request number
+	// as a transaction that has already been included in a batch.
+	ErrReplacementBatched = errors.New("transaction to be replaced already included in a batch")
+)
+
+// Tx is a transaction in the pool.
+type Tx struct {
+	ID       t.TxID
+	Data     []byte
+	Priority uint64
+	ClientID t.ClientID
+	ReqNo    t.ReqNo
+
+	// Arrival order of the transaction in the pool.
+	seqNr uint64
+
+	// Positions of the transaction in the heaps of the pool.
+	cutIndex   int
+	evictIndex int
+
+	// Number of the last batch the transaction has been included in.
+	batchNr uint64
+}
+
+// reqKey identifies a transaction by the request it originates from.
+type reqKey struct {
+	clientID t.ClientID
+	reqNo    t.ReqNo
+}
+
+func (tx *Tx) key() reqKey {
+	return reqKey{tx.ClientID, tx.ReqNo}
+}
+
+// Pool is a pool of pending and batched transactions. It is not safe for concurrent use.
+// Only pending transactions count towards the capacity of the pool.
+type Pool struct {
+	maxTxs   int
+	maxBytes int
+
+	txs      map[t.TxID]*Tx
+	txsByKey map[reqKey]*Tx // both pending and batched transactions
+	numBytes int
+
+	nextSeqNr uint64
+
+	// Batched transactions, indexed by their IDs, and the transactions of each batch, indexed by batch number.
+	// A transaction in a batch is only still batched in that batch if it is in batched and has the batch's number.
+	batched     map[t.TxID]*Tx
+	batches     map[uint64][]*Tx
+	nextBatchNr uint64
+
+	// cutHeap has the transaction to be included in a batch next on top.
+	cutHeap *txHeap
+
+	// evictHeap has the transaction to be evicted next on top.
+	evictHeap *txHeap
+}
+
+// New returns a new empty Pool.
+// If priorityOrdering is true, transactions are taken out of the pool by CutBatch in the order of their priority,
+// otherwise in the order of their arrival.
+// The pool holds at most maxTxs transactions with a total size of at most maxBytes.
+// A zero maxTxs or maxBytes means no limit.
+func New(priorityOrdering bool, maxTxs int, maxBytes int) *Pool {
+	return &Pool{
+		maxTxs:   maxTxs,
+		maxBytes: maxBytes,
+		txs:      make(map[t.TxID]*Tx),
+		txsByKey: make(map[reqKey]*Tx),
+		batched:  make(map[t.TxID]*Tx),
+		batches:  make(map[uint64][]*Tx),
+		cutHeap: &txHeap{
+			less: func(a, b *Tx) bool {
+				if priorityOrdering && a.Priority != b.Priority {
+					return a.Priority > b.Priority
+				}
+				return a.seqNr < b.seqNr
+			},
+			index: func(tx *Tx) *int { return &tx.cutIndex },
+		},
+		evictHeap: &txHeap{
+			// Among transactions with the same priority, the most recent one is evicted first.
+			less: func(a, b *Tx) bool {
+				if a.Priority != b.Priority {
+					return a.Priority < b.Priority
+				}
+				return a.seqNr > b.seqNr
+			},
+			index: func(tx *Tx) *int { return &tx.evictIndex },
+		},
+	}
+}
+
+// Len returns the number of pending transactions in the pool.
+func (p *Pool) Len() int {
+	return len(p.txs)
+}
+
+// Bytes returns the total size of the pending transactions in the pool.
+func (p *Pool) Bytes() int {
+	return p.numBytes
+}
+
+// Contains returns true if the transaction with ID txID is pending in the pool.
+func (p *Pool) Contains(txID t.TxID) bool {
+	_, ok := p.txs[txID]
+	return ok
+}
+
+// IsBatched returns true if the transaction with ID txID has been included in a batch
+// and has neither been removed nor requeued since.
+func (p *Pool) IsBatched(txID t.TxID) bool {
+	_, ok := p.batched[txID]
+	return ok
+}
+
+// Add adds a transaction to the pool as a pending transaction.
+// If the pool contains a pending transaction with the same client ID and request number,
+// tx replaces it if tx has a higher priority, in which case the replaced transaction is returned.
+// A batched transaction cannot be replaced.
+// If the pool is full, transactions with a lower priority than tx are evicted and returned.
+// If tx cannot be added, Add returns an error and the pool is not modified.
+func (p *Pool) Add(tx *Tx) (replaced *Tx, evicted []*Tx, err error) {
+	if _, ok := p.txs[tx.ID]; ok {
+		return nil, nil, ErrAlreadyPresent
+	}
+	if _, ok := p.batched[tx.ID]; ok {
+		return nil, nil, ErrAlreadyPresent
+	}
+	if p.maxBytes > 0 && len(tx.Data) > p.maxBytes {
+		return nil, nil, ErrTooLarge
+	}
+
+	numTxs := len(p.txs) + 1
+	numBytes := p.numBytes + len(tx.Data)
+
+	replaced = p.txsByKey[tx.key()]
+	if replaced != nil {
+		// A batched transaction may still be committed, in which case both versions would be committed.
+		if _, ok := p.batched[replaced.ID]; ok {
+			return nil, nil, ErrReplacementBatched
+		}
+		if tx.Priority <= replaced.Priority {
+			return nil, nil, ErrReplacementUnderpriced
+		}
+		numTxs--
+		numBytes -= len(replaced.Data)
+	}
+
+	// Select transactions to evict until tx fits in the pool.
+	// The selected transactions are only taken out of the eviction heap,
+	// so they can be put back if it turns out that tx cannot be added.
+	popped := make([]*Tx, 0)
+	for p.exceedsCapacity(numTxs, numBytes) {
+		if p.evictHeap.Len() == 0 || p.evictHeap.txs[0].Priority >= tx.Priority {
+			for _, poppedTx := range popped {
+				heap.Push(p.evictHeap, poppedTx)
+			}
+			return nil, nil, ErrPoolFull
+		}
+
+		lowest := heap.Pop(p.evictHeap).(*Tx)
+		popped = append(popped, lowest)
+		if lowest != replaced {
+			evicted = append(evicted, lowest)
+			numTxs--
+			numBytes -= len(lowest.Data)
+		}
+	}
+	for _, poppedTx := range popped {
+		heap.Push(p.evictHeap, poppedTx)
+	}
+
+	if replaced != nil {
+		p.remove(replaced)
+	}
+	for _, evictedTx := range evicted {
+		p.remove(evictedTx)
+	}
+
+	tx.seqNr = p.nextSeqNr
+	p.nextSeqNr++
+	p.insert(tx)
+
+	return replaced, evicted, nil
+}
+
+// Remove removes the pending or batched transaction with ID txID from the pool and returns it.
+// If the transaction is not in the pool, Remove returns nil.
+func (p *Pool) Remove(txID t.TxID) *Tx {
+	if tx, ok := p.batched[txID]; ok {
+		delete(p.batched, txID)
+		p.forgetKey(tx)
+		return tx
+	}
+
+	tx, ok := p.txs[txID]
+	if !ok {
+		return nil
+	}
+	p.remove(tx)
+	return tx
+}
+
+// CutBatch takes up to maxTxs pending transactions with a total size of at most maxBytes out of the pool,
+// in the order determined by the pool's ordering, and returns them along with the number of the batch they form.
+// If the first transaction alone is larger than maxBytes, it is still returned on its own.
+// The returned transactions stay in the pool as batched transactions until they are removed or requeued.
+func (p *Pool) CutBatch(maxTxs int, maxBytes int) (uint64, []*Tx) {
+	batchNr := p.nextBatchNr
+	p.nextBatchNr++
+
+	batch := make([]*Tx, 0)
+	batchBytes := 0
+	for len(batch) < maxTxs && p.cutHeap.Len() > 0 {
+		next := p.cutHeap.txs[0]
+		if len(batch) > 0 && batchBytes+len(next.Data) > maxBytes {
+			break
+		}
+
+		batch = append(batch, next)
+		batchBytes += len(next.Data)
+		p.unqueue(next)
+		next.batchNr = batchNr
+		p.batched[next.ID] = next
+	}
+
+	if len(batch) > 0 {
+		p.batches[batchNr] = batch
+	}
+	return batchNr, batch
+}
+
+// RequeueBatch makes the transactions of the batch with number batchNr that are still batched pending again
+// and returns them. Requeued transactions keep their original position in the pool's ordering.
+// Since requeued transactions have been accepted in the pool before,
+// RequeueBatch does not enforce the capacity of the pool.
+func (p *Pool) RequeueBatch(batchNr uint64) []*Tx {
+	requeued := make([]*Tx, 0)
+	for _, tx := range p.batches[batchNr] {
+		if p.batched[tx.ID] != tx || tx.batchNr != batchNr {
+			continue
+		}
+
+		delete(p.batched, tx.ID)
+		p.insert(tx)
+		requeued = append(requeued, tx)
+	}
+	delete(p.batches, batchNr)
+	return requeued
+}
+
+func (p *Pool) forgetKey(tx *Tx) {
+	if p.txsByKey[tx.key()] == tx {
+		delete(p.txsByKey, tx.key())
+	}
+}
+
+func (p *Pool) exceedsCapacity(numTxs int, numBytes int) bool {
+	return (p.maxTxs > 0 && numTxs > p.maxTxs) || (p.maxBytes > 0 && numBytes > p.maxBytes)
+}
+
+func (p *Pool) insert(tx *Tx) {
+	p.txs[tx.ID] = tx
+	p.txsByKey[tx.key()] = tx
+	p.numBytes += len(tx.Data)
+	heap.Push(p.cutHeap, tx)
+	heap.Push(p.evictHeap, tx)
+}
+
+// remove removes a pending transaction from the pool.
+func (p *Pool) remove(tx *Tx) {
+	p.unqueue(tx)
+	p.forgetKey(tx)
+}
+
+// unqueue removes a pending transaction from the pool,
+// but keeps track of its client ID and request number, e.g., because it is being included in a batch.
+func (p *Pool) unqueue(tx *Tx) {
+	delete(p.txs, tx.ID)
+	p.numBytes -= len(tx.Data)
+	heap.Remove(p.cutHeap, tx.cutIndex)
+	heap.Remove(p.evictHeap, tx.evictIndex)
+}
+
+// txHeap implements heap.Interface for transactions, ordered by less.
+// Each transaction keeps track of its position in the heap using the index function.
+type txHeap struct {
+	txs   []*Tx
+	less  func(a, b *Tx) bool
+	index func(tx *Tx) *int
+}
+
+func (h *txHeap) Len() int {
+	return len(h.txs)
+}
+
+func (h *txHeap) Less(i, j int) bool {
+	return h.less(h.txs[i], h.txs[j])
+}
+
+func (h *txHeap) Swap(i, j int) {
+	h.txs[i], h.txs[j] = h.txs[j], h.txs[i]
+	*h.index(h.txs[i]) = i
+	*h.index(h.txs[j]) = j
+}
+
+func (h *txHeap) Push(x any) {
+	tx := x.(*Tx)
+	*h.index(tx) = len(h.txs)
+	h.txs = append(h.txs, tx)
+}
+
+func (h *txHeap) Pop() any {
+	n := len(h.txs)
+	tx := h.txs[n-1]
+	h.txs[n-1] = nil
+	h.txs = h.txs[:n-1]
+	*h.index(tx) = -1
+	return tx
+}
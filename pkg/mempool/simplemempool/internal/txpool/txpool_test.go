@@ -0,0 +1,173 @@
+package txpool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+func newTx(clientID string, reqNo int, priority uint64, size int) *Tx {
+	return &Tx{
+		ID:       t.TxID(fmt.Sprintf("%s-%d-%d-%d", clientID, reqNo, priority, size)),
+		Data:     make([]byte, size),
+		Priority: priority,
+		ClientID: t.ClientID(clientID),
+		ReqNo:    t.ReqNo(reqNo),
+	}
+}
+
+func ids(txs []*Tx) []t.TxID {
+	txIDs := make([]t.TxID, len(txs))
+	for i, tx := range txs {
+		txIDs[i] = tx.ID
+	}
+	return txIDs
+}
+
+func TestCutBatch(tt *testing.T) {
+	txs := []*Tx{newTx("c", 0, 1, 10), newTx("c", 1, 3, 10), newTx("c", 2, 2, 10), newTx("c", 3, 3, 10)}
+
+	fifo := New(false, 0, 0)
+	prio := New(true, 0, 0)
+	for _, tx := range txs {
+		_, _, err := fifo.Add(tx)
+		require.NoError(tt, err)
+		txCopy := *tx
+		_, _, err = prio.Add(&txCopy)
+		require.NoError(tt, err)
+	}
+
+	_, batch := fifo.CutBatch(3, 100)
+	assert.Equal(tt, ids(txs[:3]), ids(batch))
+	_, batch = prio.CutBatch(3, 100)
+	assert.Equal(tt, ids([]*Tx{txs[1], txs[3], txs[2]}), ids(batch))
+
+	// The byte limit applies, unless the batch would be empty.
+	_, batch = prio.CutBatch(3, 5)
+	assert.Equal(tt, ids([]*Tx{txs[0]}), ids(batch))
+	assert.Equal(tt, 0, prio.Len())
+	assert.Equal(tt, 0, prio.Bytes())
+	assert.True(tt, prio.IsBatched(txs[0].ID))
+}
+
+func TestRequeueBatch(tt *testing.T) {
+	p := New(false, 0, 0)
+	txs := []*Tx{newTx("c", 0, 1, 10), newTx("c", 1, 1, 10), newTx("c", 2, 1, 10)}
+	for _, tx := range txs {
+		_, _, err := p.Add(tx)
+		require.NoError(tt, err)
+	}
+
+	batchNr, batch := p.CutBatch(2, 100)
+	assert.Equal(tt, ids(txs[:2]), ids(batch))
+	assert.Equal(tt, 1, p.Len())
+
+	// A batched transaction cannot be added again.
+	_, _, err := p.Add(txs[0])
+	assert.ErrorIs(tt, err, ErrAlreadyPresent)
+
+	// Committed transactions are not requeued.
+	assert.Equal(tt, txs[1], p.Remove(txs[1].ID))
+	assert.Equal(tt, []*Tx{txs[0]}, p.RequeueBatch(batchNr))
+	assert.False(tt, p.IsBatched(txs[0].ID))
+	assert.Equal(tt, 2, p.Len())
+	assert.Equal(tt, 20, p.Bytes())
+
+	// Requeued transactions keep their position in the pool's ordering.
+	batchNr, batch = p.CutBatch(2, 100)
+	assert.Equal(tt, ids([]*Tx{txs[0], txs[2]}), ids(batch))
+
+	// A batch is only requeued once.
+	assert.Len(tt, p.RequeueBatch(batchNr), 2)
+	assert.Empty(tt, p.RequeueBatch(batchNr))
+
+	// A transaction that has been included in another batch since is not requeued with the old one.
+	batchNr, _ = p.CutBatch(1, 100)
+	assert.Len(tt, p.RequeueBatch(batchNr), 1)
+	newBatchNr, _ := p.CutBatch(1, 100)
+	assert.Empty(tt, p.RequeueBatch(batchNr))
+	assert.Len(tt, p.RequeueBatch(newBatchNr), 1)
+}
+
+func TestEviction(tt *testing.T) {
+	p := New(true, 3, 100)
+
+	low := newTx("a", 0, 1, 10)
+	mid := newTx("b", 0, 2, 10)
+	high := newTx("c", 0, 3, 10)
+	for _, tx := range []*Tx{low, mid, high} {
+		_, _, err := p.Add(tx)
+		require.NoError(tt, err)
+	}
+
+	// A transaction with the lowest priority is rejected from a full pool.
+	_, _, err := p.Add(newTx("d", 0, 1, 10))
+	assert.ErrorIs(tt, err, ErrPoolFull)
+	assert.Equal(tt, 3, p.Len())
+
+	// A transaction with a higher priority evicts the one with the lowest priority.
+	_, evicted, err := p.Add(newTx("d", 0, 2, 10))
+	require.NoError(tt, err)
+	assert.Equal(tt, []*Tx{low}, evicted)
+
+	// A large transaction evicts as many transactions as necessary to fit.
+	_, evicted, err = p.Add(newTx("e", 0, 5, 85))
+	require.NoError(tt, err)
+	assert.Len(tt, evicted, 2)
+	assert.True(tt, p.Contains(high.ID))
+	assert.Equal(tt, 95, p.Bytes())
+
+	_, _, err = p.Add(newTx("f", 0, 10, 101))
+	assert.ErrorIs(tt, err, ErrTooLarge)
+}
+
+func TestReplacement(tt *testing.T) {
+	p := New(false, 0, 0)
+
+	orig := newTx("a", 0, 2, 10)
+	_, _, err := p.Add(orig)
+	require.NoError(tt, err)
+
+	_, _, err = p.Add(newTx("a", 0, 2, 20))
+	assert.ErrorIs(tt, err, ErrReplacementUnderpriced)
+
+	replacement := newTx("a", 0, 3, 20)
+	replaced, evicted, err := p.Add(replacement)
+	require.NoError(tt, err)
+	assert.Equal(tt, orig, replaced)
+	assert.Empty(tt, evicted)
+	assert.Equal(tt, 1, p.Len())
+	assert.Equal(tt, 20, p.Bytes())
+
+	assert.Equal(tt, replacement, p.Remove(replacement.ID))
+	assert.Nil(tt, p.Remove(replacement.ID))
+}
+
+func TestReplacementOfBatchedTx(tt *testing.T) {
+	p := New(false, 0, 0)
+
+	orig := newTx("a", 0, 2, 10)
+	_, _, err := p.Add(orig)
+	require.NoError(tt, err)
+	batchNr, _ := p.CutBatch(1, 100)
+
+	// A batched transaction cannot be replaced, as it may still be committed.
+	_, _, err = p.Add(newTx("a", 0, 3, 10))
+	assert.ErrorIs(tt, err, ErrReplacementBatched)
+
+	// Once requeued, it can be replaced again.
+	p.RequeueBatch(batchNr)
+	replaced, _, err := p.Add(newTx("a", 0, 3, 10))
+	require.NoError(tt, err)
+	assert.Equal(tt, orig, replaced)
+
+	// Once committed, the client ID and request number are forgotten.
+	_, batch := p.CutBatch(1, 100)
+	p.Remove(batch[0].ID)
+	_, _, err = p.Add(newTx("a", 0, 1, 10))
+	assert.NoError(tt, err)
+}
@@ -0,0 +1,80 @@
+package simplemempool
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/common"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/parts/computeids"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/parts/formbatches"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/parts/garbagecollection"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/parts/gossip"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/parts/lookuptxs"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/parts/verifytxs"
+	"github.com/filecoin-project/mir/pkg/modules"
+)
+
+type ModuleConfig = common.ModuleConfig
+
+type ModuleParams = common.ModuleParams
+
+// DefaultModuleConfig returns a valid module config with default names for all modules.
+func DefaultModuleConfig() *ModuleConfig {
+	return common.DefaultModuleConfig()
+}
+
+// DefaultModuleParams returns valid module parameters with default values.
+func DefaultModuleParams() *ModuleParams {
+	return common.DefaultModuleParams()
+}
+
+// CheckParams checks whether the given module parameters satisfy all necessary constraints.
+func CheckParams(params *ModuleParams) error {
+	return common.CheckParams(params)
+}
+
+// NewModule creates a new instance of a simple mempool module implementation.
+// The mempool stores the requests it receives in NewRequests events as transactions
+// (each transaction being a serialized requestpb.Request)
+// and passes them to the availability layer in batches, in the order in which the requests were received
+// or, if params.PriorityOrdering is set, in the order of their priority.
+// Before storing a transaction, the mempool has it validated and prioritized by the validator module (see txvalidator)
+// and reports the rejection of invalid transactions to the clients module.
+// When the mempool is full (as limited by params.MaxTransactionsInPool and params.MaxPayloadInPool),
+// transactions with the lowest priority are evicted to make space for new ones with a higher priority.
+// A pending transaction can be replaced by one with the same client ID and request number and a higher priority,
+// but a transaction that has already been included in a batch cannot.
+// Other modules can have transactions validated by sending a VerifyTransactions event to the mempool.
+// Transaction IDs are the hashes of the transactions, and batch IDs the hashes of the IDs of their transactions,
+// all computed by the hasher module.
+// Committed transactions (announced by a TransactionsCommitted event) are removed from the mempool
+// and transactions included in batches are garbage-collected as instructed by the availability layer.
+// Transactions included in a batch that are not committed within params.RequeueTimeout
+// are included in further batches again, so that they are not lost if the batch is dropped by the consensus layer.
+// If params.GossipPeers is not empty, the mempool gossips pending transactions with the mempools of those nodes,
+// such that clients only need to submit each request to a single node.
+// NewModule returns an error if the module parameters are not valid.
+func NewModule(mc *ModuleConfig, params *ModuleParams) (modules.PassiveModule, error) {
+	if err := CheckParams(params); err != nil {
+		return nil, fmt.Errorf("invalid simple mempool parameters: %w", err)
+	}
+
+	m := dsl.NewModule(mc.Self)
+
+	commonState := common.NewState(params)
+
+	// The module does not need any initialization,
+	// but it must accept the Init event that is sent to all modules of a node.
+	dsl.UponInit(m, func() error {
+		return nil
+	})
+
+	formbatches.IncludeBatchCreation(m, mc, params, commonState)
+	lookuptxs.IncludeTransactionLookupByID(m, commonState)
+	computeids.IncludeComputationOfTransactionAndBatchIDs(m, mc)
+	verifytxs.IncludeVerificationOfTransactions(m, mc)
+	garbagecollection.IncludeGarbageCollection(m, commonState)
+	gossip.IncludeGossip(m, mc, params, commonState)
+
+	return m, nil
+}
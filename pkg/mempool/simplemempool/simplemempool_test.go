@@ -0,0 +1,436 @@
+package simplemempool
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	mirCrypto "github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/events"
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/mempool/simplemempool/internal/protobuf"
+	"github.com/filecoin-project/mir/pkg/mempool/txvalidator"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/mempoolpb/simplemempoolpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+const consumerModule = t.ModuleID("consumer")
+
+// testSystem is a system of simple mempool modules, with a hasher and a validator module per node.
+// Timer events are only fired when requested by the test, as are the periodic gossip ticks.
+// Messages are delivered to the destination nodes that are part of the system
+// and recorded for the destinations that are not (allowing the tests to play the role of other nodes).
+type testSystem struct {
+	tt         *testing.T
+	mc         *ModuleConfig
+	nodes      map[t.NodeID]modules.PassiveModule
+	hasher     modules.PassiveModule
+	validators map[t.NodeID]modules.PassiveModule
+
+	// Events waiting to be applied and timer events waiting to be fired.
+	queue  []nodeEvent
+	timers []nodeEvent
+
+	// Batches received by the consumer module and requests rejected at each node.
+	batches  map[t.NodeID][][][]byte
+	rejected map[t.NodeID][]*requestpb.RequestRejected
+
+	// Messages sent to nodes outside the system, indexed by their destination.
+	sent map[t.NodeID][]nodeMessage
+}
+
+type nodeEvent struct {
+	nodeID t.NodeID
+	event  *eventpb.Event
+}
+
+type nodeMessage struct {
+	from t.NodeID
+	msg  *simplemempoolpb.Message
+}
+
+// newTestSystem creates a new testSystem with the given nodes, all using the given module parameters and validator.
+// Each node gossips with all other nodes of the system, in addition to the gossip peers given in the parameters.
+func newTestSystem(
+	tt *testing.T,
+	nodeIDs []t.NodeID,
+	params *ModuleParams,
+	validator txvalidator.Validator,
+) *testSystem {
+	ts := &testSystem{
+		tt:         tt,
+		mc:         DefaultModuleConfig(),
+		nodes:      make(map[t.NodeID]modules.PassiveModule),
+		hasher:     mirCrypto.NewHasher(crypto.SHA256),
+		validators: make(map[t.NodeID]modules.PassiveModule),
+		batches:    make(map[t.NodeID][][][]byte),
+		rejected:   make(map[t.NodeID][]*requestpb.RequestRejected),
+		sent:       make(map[t.NodeID][]nodeMessage),
+	}
+
+	for _, nodeID := range nodeIDs {
+		nodeParams := *params
+		nodeParams.GossipPeers = nil
+		for _, peerID := range append(append([]t.NodeID{}, nodeIDs...), params.GossipPeers...) {
+			if peerID != nodeID {
+				nodeParams.GossipPeers = append(nodeParams.GossipPeers, peerID)
+			}
+		}
+
+		module, err := NewModule(ts.mc, &nodeParams)
+		require.NoError(tt, err)
+		ts.nodes[nodeID] = module
+		ts.validators[nodeID] = txvalidator.New(validator)
+		ts.queue = append(ts.queue, nodeEvent{nodeID, events.Init(ts.mc.Self)})
+	}
+	ts.run()
+
+	return ts
+}
+
+// submit submits a new request with the given client ID, request number and data to node nodeID.
+func (ts *testSystem) submit(nodeID t.NodeID, clientID t.ClientID, reqNo t.ReqNo, data string) *requestpb.Request {
+	req := &requestpb.Request{ClientId: clientID.Pb(), ReqNo: reqNo.Pb(), Data: []byte(data)}
+	ts.queue = append(ts.queue, nodeEvent{nodeID, events.NewClientRequests(ts.mc.Self, []*requestpb.Request{req})})
+	return req
+}
+
+// requestBatch has node nodeID request a batch from its mempool and returns the transactions in the batch.
+func (ts *testSystem) requestBatch(nodeID t.NodeID) [][]byte {
+	origin := &mppb.RequestBatchOrigin{Module: consumerModule.Pb()}
+	ts.queue = append(ts.queue, nodeEvent{nodeID, mpevents.RequestBatch(ts.mc.Self, origin)})
+	ts.run()
+	batches := ts.batches[nodeID]
+	require.NotEmpty(ts.tt, batches)
+	return batches[len(batches)-1]
+}
+
+// commit announces the given transactions as committed to the mempool of node nodeID.
+func (ts *testSystem) commit(nodeID t.NodeID, txs [][]byte) {
+	ts.queue = append(ts.queue, nodeEvent{nodeID, mpevents.TransactionsCommitted(ts.mc.Self, txs)})
+	ts.run()
+}
+
+// receive delivers a message sent by node from (which need not be part of the system) to node nodeID.
+func (ts *testSystem) receive(nodeID t.NodeID, from t.NodeID, msg *messagepb.Message) {
+	ts.queue = append(ts.queue, nodeEvent{nodeID, events.MessageReceived(ts.mc.Self, from, msg)})
+	ts.run()
+}
+
+// gossipTick starts a new gossip period at all nodes.
+func (ts *testSystem) gossipTick() {
+	for nodeID := range ts.nodes {
+		ts.queue = append(ts.queue, nodeEvent{nodeID, protobuf.GossipTick(ts.mc.Self)})
+	}
+	ts.run()
+}
+
+// takeSent returns and forgets the messages sent to node nodeID, which is not part of the system.
+func (ts *testSystem) takeSent(nodeID t.NodeID) []nodeMessage {
+	msgs := ts.sent[nodeID]
+	delete(ts.sent, nodeID)
+	return msgs
+}
+
+// run processes events until there are none left. Pending timer events are not fired.
+func (ts *testSystem) run() {
+	for len(ts.queue) > 0 {
+		next := ts.queue[0]
+		ts.queue = ts.queue[1:]
+
+		var module modules.PassiveModule
+		switch t.ModuleID(next.event.DestModule) {
+		case ts.mc.Self:
+			module = ts.nodes[next.nodeID]
+		case ts.mc.Hasher:
+			module = ts.hasher
+		case ts.mc.Validator:
+			module = ts.validators[next.nodeID]
+		case ts.mc.Timer:
+			switch e := next.event.Type.(type) {
+			case *eventpb.Event_TimerDelay:
+				for _, ev := range e.TimerDelay.Events {
+					ts.timers = append(ts.timers, nodeEvent{next.nodeID, ev})
+				}
+			case *eventpb.Event_TimerRepeat:
+				// Periodic events are not simulated. Gossip ticks are triggered by the tests.
+			default:
+				ts.tt.Fatalf("unexpected timer event: %T", e)
+			}
+			continue
+		case ts.mc.Net:
+			sendMessage := next.event.GetSendMessage()
+			require.NotNil(ts.tt, sendMessage)
+			for _, dest := range t.NodeIDSlice(sendMessage.Destinations) {
+				if _, ok := ts.nodes[dest]; ok {
+					msgReceived := events.MessageReceived(ts.mc.Self, next.nodeID, sendMessage.Msg)
+					ts.queue = append(ts.queue, nodeEvent{dest, msgReceived})
+				} else {
+					ts.sent[dest] = append(ts.sent[dest], nodeMessage{next.nodeID, sendMessage.Msg.GetSimpleMempool()})
+				}
+			}
+			continue
+		case ts.mc.Clients:
+			ts.rejected[next.nodeID] = append(ts.rejected[next.nodeID], next.event.GetRequestRejected())
+			continue
+		case consumerModule:
+			newBatch := next.event.GetMempool().GetNewBatch()
+			require.NotNil(ts.tt, newBatch)
+			ts.batches[next.nodeID] = append(ts.batches[next.nodeID], newBatch.Txs)
+			continue
+		default:
+			ts.tt.Fatalf("unexpected destination module: %v", next.event.DestModule)
+		}
+
+		evsOut, err := module.ApplyEvents(events.ListOf(next.event))
+		require.NoError(ts.tt, err)
+		for _, ev := range evsOut.Slice() {
+			ts.queue = append(ts.queue, nodeEvent{next.nodeID, ev})
+		}
+	}
+}
+
+// fireTimers fires all pending timer events and processes the resulting events.
+func (ts *testSystem) fireTimers() {
+	ts.queue = append(ts.queue, ts.timers...)
+	ts.timers = nil
+	ts.run()
+}
+
+func tx(tt *testing.T, req *requestpb.Request) []byte {
+	data, err := proto.Marshal(req)
+	require.NoError(tt, err)
+	return data
+}
+
+// txID computes the ID of a transaction the same way the mempool does (using the SHA256 hasher).
+func txID(tx []byte) t.TxID {
+	hash := sha256.Sum256(tx)
+	return t.TxID(hash[:])
+}
+
+// requestedTxIDs returns the IDs of the transactions requested in the given messages.
+func requestedTxIDs(msgs []nodeMessage) []t.TxID {
+	txIDs := make([]t.TxID, 0)
+	for _, msg := range msgs {
+		for _, id := range msg.msg.GetRequestTxs().GetTxIds() {
+			txIDs = append(txIDs, t.TxID(id))
+		}
+	}
+	return txIDs
+}
+
+func TestRequeueUncommittedBatch(tt *testing.T) {
+	ts := newTestSystem(tt, []t.NodeID{"0"}, DefaultModuleParams(), txvalidator.AcceptAll)
+
+	req0 := ts.submit("0", "c", 0, "a")
+	req1 := ts.submit("0", "c", 1, "b")
+	ts.run()
+	assert.Equal(tt, [][]byte{tx(tt, req0), tx(tt, req1)}, ts.requestBatch("0"))
+	assert.Empty(tt, ts.requestBatch("0"))
+
+	// Only the transactions of the batch that have not been committed in time are proposed again.
+	ts.commit("0", [][]byte{tx(tt, req0)})
+	ts.fireTimers()
+	assert.Equal(tt, [][]byte{tx(tt, req1)}, ts.requestBatch("0"))
+
+	// Once committed, they are not proposed again.
+	ts.commit("0", [][]byte{tx(tt, req1)})
+	ts.fireTimers()
+	assert.Empty(tt, ts.requestBatch("0"))
+}
+
+func TestRejectInvalidRequests(tt *testing.T) {
+	validator := txvalidator.ValidatorFunc(func(tx []byte) error {
+		req := &requestpb.Request{}
+		if err := proto.Unmarshal(tx, req); err != nil {
+			return err
+		}
+		if string(req.Data) == "spam" {
+			return fmt.Errorf("spam")
+		}
+		return nil
+	})
+	ts := newTestSystem(tt, []t.NodeID{"0"}, DefaultModuleParams(), validator)
+
+	valid := ts.submit("0", "c", 0, "a")
+	ts.submit("0", "c", 1, "spam")
+	ts.run()
+
+	// The rejection of the invalid request is reported to the clients module, with the reason given by the validator.
+	require.Len(tt, ts.rejected["0"], 1)
+	assert.Equal(tt, "c", ts.rejected["0"][0].ClientId)
+	assert.Equal(tt, uint64(1), ts.rejected["0"][0].ReqNo)
+	assert.Equal(tt, "spam", ts.rejected["0"][0].Reason)
+
+	// Only the valid request is included in a batch.
+	assert.Equal(tt, [][]byte{tx(tt, valid)}, ts.requestBatch("0"))
+}
+
+func TestGossip(tt *testing.T) {
+	ts := newTestSystem(tt, []t.NodeID{"0", "1", "2"}, DefaultModuleParams(), txvalidator.AcceptAll)
+
+	// A request submitted to a single node is proposed by all nodes once it has been gossiped.
+	req := ts.submit("0", "c", 0, "a")
+	ts.run()
+	ts.gossipTick()
+	for _, nodeID := range []t.NodeID{"0", "1", "2"} {
+		assert.Equal(tt, [][]byte{tx(tt, req)}, ts.requestBatch(nodeID))
+	}
+
+	// Transactions that are not pending any more by the time they would be announced are not gossiped.
+	req = ts.submit("1", "c", 1, "b")
+	ts.run()
+	assert.Equal(tt, [][]byte{tx(tt, req)}, ts.requestBatch("1"))
+	ts.gossipTick()
+	assert.Empty(tt, ts.requestBatch("0"))
+	assert.Empty(tt, ts.requestBatch("2"))
+}
+
+func TestGossipRetry(tt *testing.T) {
+	params := DefaultModuleParams()
+	params.GossipPeers = []t.NodeID{"peer"}
+	params.MaxGossipPayloadPerPeer = 1
+	ts := newTestSystem(tt, []t.NodeID{"0"}, params, txvalidator.AcceptAll)
+
+	txs := [][]byte{
+		tx(tt, &requestpb.Request{ClientId: "c", ReqNo: 0, Data: []byte("a")}),
+		tx(tt, &requestpb.Request{ClientId: "c", ReqNo: 1, Data: []byte("b")}),
+	}
+	ts.receive("0", "peer", protobuf.AnnounceTxIDsMessage(ts.mc.Self, []t.TxID{txID(txs[0]), txID(txs[1])}))
+	assert.Equal(tt, []t.TxID{txID(txs[0]), txID(txs[1])}, requestedTxIDs(ts.takeSent("peer")))
+
+	// Only the first provided transaction fits in the peer's quota.
+	ts.receive("0", "peer", protobuf.ProvideTxsMessage(ts.mc.Self, txs))
+	assert.Equal(tt, [][]byte{txs[0]}, ts.requestBatch("0"))
+
+	// The other one is requested again once the peer has had a full gossip period to provide it.
+	ts.gossipTick()
+	assert.Empty(tt, requestedTxIDs(ts.takeSent("peer")))
+	ts.gossipTick()
+	assert.Equal(tt, []t.TxID{txID(txs[1])}, requestedTxIDs(ts.takeSent("peer")))
+	ts.receive("0", "peer", protobuf.ProvideTxsMessage(ts.mc.Self, txs[1:]))
+	assert.Equal(tt, [][]byte{txs[1]}, ts.requestBatch("0"))
+
+	// Transactions that have not been requested are ignored.
+	unrequested := tx(tt, &requestpb.Request{ClientId: "c", ReqNo: 2, Data: []byte("c")})
+	ts.gossipTick()
+	ts.receive("0", "peer", protobuf.ProvideTxsMessage(ts.mc.Self, [][]byte{unrequested}))
+	assert.Empty(tt, ts.requestBatch("0"))
+}
+
+func TestGossipProvideQuota(tt *testing.T) {
+	params := DefaultModuleParams()
+	params.GossipPeers = []t.NodeID{"peer"}
+	params.MaxGossipPayloadPerPeer = 1
+	ts := newTestSystem(tt, []t.NodeID{"0"}, params, txvalidator.AcceptAll)
+
+	req0 := ts.submit("0", "c", 0, "a")
+	req1 := ts.submit("0", "c", 1, "b")
+	ts.run()
+	txIDs := []t.TxID{txID(tx(tt, req0)), txID(tx(tt, req1))}
+
+	// Only the first requested transaction fits in the peer's quota. Further requests are ignored.
+	ts.receive("0", "peer", protobuf.RequestTxsMessage(ts.mc.Self, txIDs))
+	ts.receive("0", "peer", protobuf.RequestTxsMessage(ts.mc.Self, txIDs[1:]))
+	sent := ts.takeSent("peer")
+	require.Len(tt, sent, 1)
+	assert.Equal(tt, [][]byte{tx(tt, req0)}, sent[0].msg.GetProvideTxs().GetTxs())
+
+	// The quota is replenished in the next gossip period.
+	ts.gossipTick()
+	ts.takeSent("peer")
+	ts.receive("0", "peer", protobuf.RequestTxsMessage(ts.mc.Self, txIDs[1:]))
+	sent = ts.takeSent("peer")
+	require.Len(tt, sent, 1)
+	assert.Equal(tt, [][]byte{tx(tt, req1)}, sent[0].msg.GetProvideTxs().GetTxs())
+}
+
+func TestGossipAnnouncementQuota(tt *testing.T) {
+	params := DefaultModuleParams()
+	params.GossipPeers = []t.NodeID{"peer"}
+	params.MaxAnnouncedTxsPerPeer = 3
+	ts := newTestSystem(tt, []t.NodeID{"0"}, params, txvalidator.AcceptAll)
+
+	// Announced transaction IDs exceeding the peer's quota are ignored.
+	ts.receive("0", "peer", protobuf.AnnounceTxIDsMessage(ts.mc.Self, []t.TxID{"a", "b"}))
+	ts.receive("0", "peer", protobuf.AnnounceTxIDsMessage(ts.mc.Self, []t.TxID{"c", "d"}))
+	assert.Equal(tt, []t.TxID{"a", "b", "c"}, requestedTxIDs(ts.takeSent("peer")))
+
+	// The quota is replenished in the next gossip period.
+	ts.gossipTick()
+	ts.takeSent("peer")
+	ts.receive("0", "peer", protobuf.AnnounceTxIDsMessage(ts.mc.Self, []t.TxID{"d"}))
+	assert.Equal(tt, []t.TxID{"d"}, requestedTxIDs(ts.takeSent("peer")))
+}
+
+func TestGossipMissingTxsLimit(tt *testing.T) {
+	params := DefaultModuleParams()
+	params.GossipPeers = []t.NodeID{"peer", "other"}
+	params.MaxMissingTxsPerPeer = 2
+	ts := newTestSystem(tt, []t.NodeID{"0"}, params, txvalidator.AcceptAll)
+
+	txIDs := []t.TxID{"a", "b", "c", "d"}
+
+	// Only as many announced transactions are requested from a peer as it may have missing.
+	ts.receive("0", "peer", protobuf.AnnounceTxIDsMessage(ts.mc.Self, txIDs))
+	assert.Equal(tt, txIDs[:2], requestedTxIDs(ts.takeSent("peer")))
+
+	// The limit applies to each peer separately.
+	ts.receive("0", "other", protobuf.AnnounceTxIDsMessage(ts.mc.Self, txIDs[2:]))
+	assert.Equal(tt, txIDs[2:], requestedTxIDs(ts.takeSent("other")))
+
+	// Once the node gives up on the missing transactions (after a bounded number of requests,
+	// each taking two gossip periods), the peer can announce new transactions again.
+	for i := 0; i < 25; i++ {
+		ts.gossipTick()
+	}
+	ts.takeSent("peer")
+	ts.receive("0", "peer", protobuf.AnnounceTxIDsMessage(ts.mc.Self, []t.TxID{"e", "f", "g"}))
+	assert.Equal(tt, []t.TxID{"e", "f"}, requestedTxIDs(ts.takeSent("peer")))
+}
+
+func TestCheckParams(tt *testing.T) {
+	valid := func() *ModuleParams {
+		params := DefaultModuleParams()
+		params.GossipPeers = []t.NodeID{"1", "2"}
+		return params
+	}
+	assert.NoError(tt, CheckParams(valid()))
+
+	// Gossip parameters are only checked if gossip is enabled.
+	noGossip := DefaultModuleParams()
+	noGossip.GossipPeriod = 0
+	assert.NoError(tt, CheckParams(noGossip))
+
+	invalid := map[string]func(params *ModuleParams){
+		"zero batch size":       func(params *ModuleParams) { params.MaxTransactionsInBatch = 0 },
+		"zero batch payload":    func(params *ModuleParams) { params.MaxPayloadInBatch = 0 },
+		"zero requeue timeout":  func(params *ModuleParams) { params.RequeueTimeout = 0 },
+		"negative pool size":    func(params *ModuleParams) { params.MaxTransactionsInPool = -1 },
+		"negative pool payload": func(params *ModuleParams) { params.MaxPayloadInPool = -1 },
+		"duplicate gossip peer": func(params *ModuleParams) { params.GossipPeers = []t.NodeID{"1", "1"} },
+		"zero gossip period":    func(params *ModuleParams) { params.GossipPeriod = 0 },
+		"zero gossip payload":   func(params *ModuleParams) { params.MaxGossipPayloadPerPeer = 0 },
+		"zero announced txs":    func(params *ModuleParams) { params.MaxAnnouncedTxsPerPeer = 0 },
+		"zero missing txs":      func(params *ModuleParams) { params.MaxMissingTxsPerPeer = 0 },
+	}
+	for desc, invalidate := range invalid {
+		params := valid()
+		invalidate(params)
+		assert.Error(tt, CheckParams(params), desc)
+
+		_, err := NewModule(DefaultModuleConfig(), params)
+		assert.Error(tt, err, desc)
+	}
+}
@@ -0,0 +1,109 @@
+// Package txvalidator provides a module that validates transactions on behalf of the mempool
+// using a validator supplied by the application.
+package txvalidator
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Validator decides whether a transaction is valid and thus can be ordered.
+// It is implemented by the application.
+type Validator interface {
+
+	// ValidateTransaction returns nil if tx is valid and an error describing the reason of its rejection otherwise.
+	// The decision must be deterministic, as different nodes validate the same transactions independently.
+	// ValidateTransaction may be called concurrently.
+	ValidateTransaction(tx []byte) error
+}
+
+// Prioritizer can optionally be implemented by a Validator to assign an application-defined priority
+// (e.g., a fee) to each valid transaction. Transactions with a higher priority are preferred by the mempool.
+// If the Validator does not implement Prioritizer, all transactions have priority 0.
+type Prioritizer interface {
+
+	// TransactionPriority returns the priority of the valid transaction tx.
+	// Like the validation, the priority must be deterministic.
+	TransactionPriority(tx []byte) uint64
+}
+
+// ValidatorFunc is an adapter allowing the use of an ordinary function as a Validator.
+type ValidatorFunc func(tx []byte) error
+
+// ValidateTransaction calls f(tx).
+func (f ValidatorFunc) ValidateTransaction(tx []byte) error {
+	return f(tx)
+}
+
+// AcceptAll is a Validator that considers all transactions valid.
+var AcceptAll = ValidatorFunc(func(tx []byte) error {
+	return nil
+})
+
+// Module is a module answering the mempool's VerifyTransactions events using a Validator.
+type Module struct {
+	validator Validator
+}
+
+// New returns a new Module validating transactions using validator.
+func New(validator Validator) *Module {
+	return &Module{validator: validator}
+}
+
+func (m *Module) ApplyEvents(eventsIn *events.EventList) (*events.EventList, error) {
+	return modules.ApplyEventsConcurrently(eventsIn, m.ApplyEvent)
+}
+
+func (m *Module) ApplyEvent(event *eventpb.Event) (*events.EventList, error) {
+	switch e := event.Type.(type) {
+	case *eventpb.Event_Init:
+		// no actions on init
+		return events.EmptyList(), nil
+	case *eventpb.Event_Mempool:
+		verifyEvent, ok := e.Mempool.Type.(*mppb.Event_VerifyTransactions)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type of mempool event: %T", e.Mempool.Type)
+		}
+		return m.verifyTransactions(verifyEvent.VerifyTransactions), nil
+	default:
+		return nil, fmt.Errorf("unexpected type of transaction validator event: %T", event.Type)
+	}
+}
+
+// verifyTransactions validates (and, if supported, prioritizes) each of the transactions
+// and produces a TransactionsVerified event.
+func (m *Module) verifyTransactions(verifyEvent *mppb.VerifyTransactions) *events.EventList {
+	prioritizer, _ := m.validator.(Prioritizer)
+
+	valid := make([]bool, len(verifyEvent.Txs))
+	errors := make([]string, len(verifyEvent.Txs))
+	priorities := make([]uint64, len(verifyEvent.Txs))
+	for i, tx := range verifyEvent.Txs {
+		if err := m.validator.ValidateTransaction(tx); err != nil {
+			errors[i] = err.Error()
+			continue
+		}
+
+		valid[i] = true
+		if prioritizer != nil {
+			priorities[i] = prioritizer.TransactionPriority(tx)
+		}
+	}
+
+	return events.ListOf(mpevents.TransactionsVerified(
+		t.ModuleID(verifyEvent.Origin.Module),
+		valid,
+		errors,
+		priorities,
+		verifyEvent.Origin,
+	))
+}
+
+// The ImplementsModule method only serves the purpose of indicating that this is a Module and must not be called.
+func (m *Module) ImplementsModule() {}
@@ -0,0 +1,57 @@
+package txvalidator
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mpevents "github.com/filecoin-project/mir/pkg/mempool/events"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	mppb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+)
+
+func TestVerifyTransactions(t *testing.T) {
+	m := New(ValidatorFunc(func(tx []byte) error {
+		if bytes.Equal(tx, []byte("spam")) {
+			return fmt.Errorf("spam")
+		}
+		return nil
+	}))
+
+	origin := &mppb.VerifyTransactionsOrigin{Module: "mempool"}
+	evsOut, err := m.ApplyEvent(mpevents.VerifyTransactions("validator",
+		[][]byte{[]byte("tx0"), []byte("spam"), []byte("tx2")}, origin))
+	require.NoError(t, err)
+	require.Equal(t, 1, evsOut.Len())
+
+	ev := evsOut.Iterator().Next()
+	assert.Equal(t, "mempool", ev.DestModule)
+	result := ev.Type.(*eventpb.Event_Mempool).Mempool.Type.(*mppb.Event_TransactionsVerified).TransactionsVerified
+	assert.Equal(t, []bool{true, false, true}, result.Valid)
+	assert.Equal(t, []string{"", "spam", ""}, result.Errors)
+	assert.Equal(t, []uint64{0, 0, 0}, result.Priorities)
+	assert.Equal(t, origin, result.Origin)
+}
+
+type lengthPrioritizer struct {
+	Validator
+}
+
+func (lengthPrioritizer) TransactionPriority(tx []byte) uint64 {
+	return uint64(len(tx))
+}
+
+func TestTransactionPriorities(t *testing.T) {
+	m := New(lengthPrioritizer{AcceptAll})
+
+	evsOut, err := m.ApplyEvent(mpevents.VerifyTransactions("validator",
+		[][]byte{[]byte("a"), []byte("abc")}, &mppb.VerifyTransactionsOrigin{Module: "mempool"}))
+	require.NoError(t, err)
+
+	ev := evsOut.Iterator().Next()
+	result := ev.Type.(*eventpb.Event_Mempool).Mempool.Type.(*mppb.Event_TransactionsVerified).TransactionsVerified
+	assert.Equal(t, []uint64{1, 3}, result.Priorities)
+}
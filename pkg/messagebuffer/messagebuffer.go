@@ -12,15 +12,20 @@ SPDX-License-Identifier: Apache-2.0
 // (e.g., in the ISS protocol, a message from a future epoch
 // received from another node that already transitioned to that epoch),
 // the message is stored in a buffer for later processing (e.g., when this node also transitions to that epoch).
-// The buffer has a maximal capacity, after which it starts evicting the oldest messages when storing new ones,
+// The buffer has a maximal capacity, after which it starts evicting messages when storing new ones,
 // such that the capacity constraint is never exceeded.
-// Effectively, the most recently received messages that together do not exceed the capacity are stored.
+// Each message has a rank (e.g., in the ISS protocol, its epoch number) determined by a user-provided Classifier.
+// When space is needed, messages with the highest rank (i.e., the ones that will be needed the latest) are evicted
+// first, and among those, the oldest ones. A new message is dropped if storing it would require evicting messages
+// of lower rank. Without a Classifier, all messages have the same rank and the oldest messages are evicted first.
+// All dropped messages are counted, per sending node and message type, in DropCounters.
 //
 // The buffer can be iterated over, selecting the messages that can be stored or safely ignored.
 package messagebuffer
 
 import (
 	"container/list"
+	"sync"
 
 	"google.golang.org/protobuf/proto"
 
@@ -53,12 +58,62 @@ const (
 	Invalid
 )
 
+// Classifier returns the rank of a message and the type of the message used for counting dropped messages.
+// Messages with a lower rank are preferentially kept in the buffer.
+type Classifier func(msg proto.Message) (rank uint64, msgType string)
+
+// DropCounters counts the messages dropped by message buffers, per sending node and message type.
+// The same DropCounters can be shared by multiple buffers.
+// DropCounters is safe for concurrent use, so the counts can be inspected (e.g. by an operator) at any time.
+type DropCounters struct {
+	mutex  sync.Mutex
+	counts map[t.NodeID]map[string]uint64
+}
+
+// NewDropCounters returns new DropCounters with all counts set to zero.
+func NewDropCounters() *DropCounters {
+	return &DropCounters{counts: make(map[t.NodeID]map[string]uint64)}
+}
+
+// Counts returns a copy of the current counts of dropped messages, indexed by sending node and message type.
+func (dc *DropCounters) Counts() map[t.NodeID]map[string]uint64 {
+	dc.mutex.Lock()
+	defer dc.mutex.Unlock()
+
+	counts := make(map[t.NodeID]map[string]uint64, len(dc.counts))
+	for nodeID, nodeCounts := range dc.counts {
+		counts[nodeID] = make(map[string]uint64, len(nodeCounts))
+		for msgType, count := range nodeCounts {
+			counts[nodeID][msgType] = count
+		}
+	}
+	return counts
+}
+
+// add increments the count of dropped messages of the given type sent by the given node.
+func (dc *DropCounters) add(nodeID t.NodeID, msgType string) {
+	dc.mutex.Lock()
+	defer dc.mutex.Unlock()
+
+	if _, ok := dc.counts[nodeID]; !ok {
+		dc.counts[nodeID] = make(map[string]uint64)
+	}
+	dc.counts[nodeID][msgType]++
+}
+
 // MessageBuffer represents a message buffer, buffering messages from a single node.
 type MessageBuffer struct {
 
 	// ID of the node sending the messages stored by this buffer.
 	nodeID t.NodeID
 
+	// Function determining the rank and type of the messages.
+	// If nil, all messages have rank 0 and their type is their protobuf message name.
+	classifier Classifier
+
+	// Counters of the messages dropped by this buffer.
+	dropped *DropCounters
+
 	// Maximal number of bytes of message data this MessageBuffer can store.
 	// Can be changed using the Resize method.
 	capacity int
@@ -66,21 +121,48 @@ type MessageBuffer struct {
 	// Number of bytes occupied by currently stored messages.
 	size int
 
-	// List of messages currently stored by this MessageBuffer.
+	// List of messages currently stored by this MessageBuffer (as *bufferedMessage values), oldest first.
 	messages *list.List
 
+	// The stored messages (more precisely, the elements of the messages list holding them), grouped by rank.
+	// Used for finding the messages to evict without inspecting all stored messages.
+	ranks *rankTree
+
 	// Logger for outputting debugging messages.
 	logger logging.Logger
 }
 
+// bufferedMessage represents a stored message, along with its metadata computed when storing it.
+type bufferedMessage struct {
+	msg     proto.Message
+	size    int
+	rank    uint64
+	msgType string
+
+	// The node of the rank tree holding the message and the element of the node's list referring to the message.
+	rankNode    *rankNode
+	rankElement *list.Element
+}
+
 // New returns a newly allocated and initialized MessageBuffer for the given nodeID and with the given initial capacity.
-func New(nodeID t.NodeID, capacity int, logger logging.Logger) *MessageBuffer {
+// The classifier determines the rank and type of the stored messages and can be nil (see Classifier).
+// Dropped messages are counted in dropped, which must not be nil.
+func New(
+	nodeID t.NodeID,
+	capacity int,
+	classifier Classifier,
+	dropped *DropCounters,
+	logger logging.Logger,
+) *MessageBuffer {
 	return &MessageBuffer{
-		nodeID:   nodeID,
-		logger:   logger,
-		capacity: capacity,
-		size:     0,
-		messages: list.New(),
+		nodeID:     nodeID,
+		classifier: classifier,
+		dropped:    dropped,
+		logger:     logger,
+		capacity:   capacity,
+		size:       0,
+		messages:   list.New(),
+		ranks:      newRankTree(),
 	}
 }
 
@@ -89,8 +171,15 @@ func New(nodeID t.NodeID, capacity int, logger logging.Logger) *MessageBuffer {
 // (using integer division, thus the resulting capacities might sum up to less than totalCapacity).
 // In the current implementation, only the payload of the stored message is counted towards the capacity,
 // disregarding the overhead of the buffer implementation itself.
+// All buffers use the same classifier and count dropped messages in the same DropCounters.
 // The returned buffers are stored in a map, indexed by node IDs.
-func NewBuffers(nodeIDs []t.NodeID, totalCapacity int, logger logging.Logger) map[t.NodeID]*MessageBuffer {
+func NewBuffers(
+	nodeIDs []t.NodeID,
+	totalCapacity int,
+	classifier Classifier,
+	dropped *DropCounters,
+	logger logging.Logger,
+) map[t.NodeID]*MessageBuffer {
 
 	// Allocate a new map for storing the buffers.
 	buffers := make(map[t.NodeID]*MessageBuffer)
@@ -101,6 +190,8 @@ func NewBuffers(nodeIDs []t.NodeID, totalCapacity int, logger logging.Logger) ma
 		// Create a new MessageBuffer.
 		buffers[nodeID] = New(nodeID,
 			totalCapacity/len(nodeIDs),
+			classifier,
+			dropped,
 			logging.Decorate(logger, "", "source", nodeID),
 		)
 	}
@@ -113,39 +204,51 @@ func NewBuffers(nodeIDs []t.NodeID, totalCapacity int, logger logging.Logger) ma
 // Returns true if the message has been successfully stored, false otherwise.
 // If msg is larger than the buffer capacity,
 // the message is not stored and the contents of the buffer is left untouched.
-// Otherwise, as many least recently added messages are removed from the buffer as is necessary for storing msg.
+// Otherwise, messages are removed from the buffer as necessary for storing msg, highest rank and oldest first.
+// If that would require removing messages with a lower rank than msg, msg is not stored instead.
 // Note that this implies that there is no guarantee that msg will remain in the buffer until it is explicitly consumed.
 // If store is invoked again with some other messages, msg can be pushed out of the buffer.
 func (mb *MessageBuffer) Store(msg proto.Message) bool {
 
-	// Calculate size of the message to store.
+	// Calculate size and rank of the message to store.
 	msgSize := proto.Size(msg)
+	rank, msgType := mb.classify(msg)
 
 	// If message does not fit in the buffer, even if all its contents were removed, return immediately.
 	if msgSize > mb.capacity {
 		mb.logger.Log(logging.LevelWarn, "Ignoring message larger than capacity.",
-			"source", mb.nodeID, "capacity", mb.capacity, "msgSize", msgSize)
+			"source", mb.nodeID, "type", msgType, "capacity", mb.capacity, "msgSize", msgSize)
+		mb.dropped.add(mb.nodeID, msgType)
+		return false
+	}
+
+	// If the message only fits in the buffer by removing messages that are more important, drop the message.
+	if mb.size+msgSize > mb.capacity && mb.size+msgSize-mb.ranks.sizeFrom(rank) > mb.capacity {
+		mb.logger.Log(logging.LevelWarn, "Dropped new message, buffer full of more urgent messages.",
+			"source", mb.nodeID, "type", msgType, "rank", rank)
+		mb.dropped.add(mb.nodeID, msgType)
 		return false
 	}
 
-	// Remove as many old messages as necessary to create enough space for the new message.
+	// Remove as many messages as necessary to create enough space for the new message.
 	for mb.size+msgSize > mb.capacity {
-		e := mb.messages.Front()
-		mb.remove(e)
-		mb.logger.Log(logging.LevelWarn, "Dropped old message, storing newer message.", "source", mb.nodeID)
+		mb.evict("Dropped message, storing more urgent message.")
 	}
 
 	// Add message to buffer and update current size.
-	mb.messages.PushBack(msg)
+	bm := &bufferedMessage{msg: msg, size: msgSize, rank: rank, msgType: msgType}
+	e := mb.messages.PushBack(bm)
+	bm.rankNode = mb.ranks.add(rank, msgSize)
+	bm.rankElement = bm.rankNode.elements.PushBack(e)
 	mb.size += msgSize
 	return true
 }
 
 // Resize changes the capacity of the MessageBuffer to newCapacity.
 // If newCapacity is smaller than the current capacity,
-// Resize removes as many least recently added messages
+// Resize removes as many messages (highest rank and oldest first)
 // as is necessary for the buffer size not to exceed the new capacity.
-// E.g., if the most recently added message is larger than newCapacity, the buffer will be empty after Resize returns.
+// E.g., if the last remaining message is larger than newCapacity, the buffer will be empty after Resize returns.
 func (mb *MessageBuffer) Resize(newCapacity int) {
 
 	// Update buffer capacity
@@ -154,19 +257,36 @@ func (mb *MessageBuffer) Resize(newCapacity int) {
 	// While the new capacity is exceeded
 	for mb.size > mb.capacity {
 
-		// Remove least recently added message.
-		e := mb.messages.Front()
-		mb.remove(e)
-		mb.logger.Log(logging.LevelWarn, "Dropped message when resizing buffer.", "source", mb.nodeID)
+		// Remove the least urgent message.
+		mb.evict("Dropped message when resizing buffer.")
+	}
+}
+
+// evict removes the oldest of the messages with the highest rank from the buffer and counts it as dropped.
+func (mb *MessageBuffer) evict(logMsg string) {
+
+	// Find the message to evict and remove it.
+	victim := mb.remove(mb.ranks.max().elements.Front().Value.(*list.Element))
+	mb.dropped.add(mb.nodeID, victim.msgType)
+	mb.logger.Log(logging.LevelWarn, logMsg, "source", mb.nodeID, "type", victim.msgType, "rank", victim.rank)
+}
+
+// classify returns the rank and type of a message, using the classifier of the buffer, if any.
+func (mb *MessageBuffer) classify(msg proto.Message) (uint64, string) {
+	if mb.classifier == nil {
+		return 0, string(msg.ProtoReflect().Descriptor().Name())
 	}
+	return mb.classifier(msg)
 }
 
 // remove removes the given element (holding one message) of the internal message list
-// and updates the current buffer size accordingly.
-func (mb *MessageBuffer) remove(e *list.Element) proto.Message {
-	msg := mb.messages.Remove(e).(proto.Message)
-	mb.size -= proto.Size(msg)
-	return msg
+// and updates the rank tree and the current buffer size accordingly.
+func (mb *MessageBuffer) remove(e *list.Element) *bufferedMessage {
+	bm := mb.messages.Remove(e).(*bufferedMessage)
+	bm.rankNode.elements.Remove(bm.rankElement)
+	mb.ranks.remove(bm.rank, bm.size)
+	mb.size -= bm.size
+	return bm
 }
 
 // Iterate iterates over all messages in the MessageBuffer and applies a and removes selected ones,
@@ -191,7 +311,7 @@ func (mb *MessageBuffer) Iterate(
 	for e != nil {
 
 		// Extract the message from and retain a pointer to the current list element
-		msg := e.Value.(proto.Message)
+		msg := e.Value.(*bufferedMessage).msg
 		currentElement := e
 		e = e.Next()
 
@@ -0,0 +1,121 @@
+package messagebuffer
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// testClassifier uses the value of a wrapped uint64 as the rank of the message.
+func testClassifier(msg proto.Message) (uint64, string) {
+	return msg.(*wrapperspb.UInt64Value).Value, "UInt64Value"
+}
+
+// storedRanks returns the ranks of all messages in the buffer, in the order of their insertion.
+func storedRanks(mb *MessageBuffer) []uint64 {
+	ranks := make([]uint64, 0)
+	for e := mb.messages.Front(); e != nil; e = e.Next() {
+		ranks = append(ranks, e.Value.(*bufferedMessage).msg.(*wrapperspb.UInt64Value).Value)
+	}
+	return ranks
+}
+
+func TestEpochAwareEviction(t *testing.T) {
+	msgSize := proto.Size(wrapperspb.UInt64(1))
+	dropped := NewDropCounters()
+	mb := New("1", 3*msgSize, testClassifier, dropped, logging.NilLogger)
+
+	assert.True(t, mb.Store(wrapperspb.UInt64(2)))
+	assert.True(t, mb.Store(wrapperspb.UInt64(1)))
+	assert.True(t, mb.Store(wrapperspb.UInt64(2)))
+
+	// A message with a lower rank replaces the oldest message with the highest rank.
+	assert.True(t, mb.Store(wrapperspb.UInt64(1)))
+	assert.Equal(t, []uint64{1, 2, 1}, storedRanks(mb))
+
+	// A message with a higher rank than all the stored ones is dropped.
+	assert.False(t, mb.Store(wrapperspb.UInt64(3)))
+	assert.Equal(t, []uint64{1, 2, 1}, storedRanks(mb))
+
+	// Resizing evicts the messages with the highest rank first.
+	mb.Resize(2 * msgSize)
+	assert.Equal(t, []uint64{1, 1}, storedRanks(mb))
+
+	assert.Equal(t, map[types.NodeID]map[string]uint64{"1": {"UInt64Value": 3}}, dropped.Counts())
+}
+
+// TestEvictionOrder compares the buffer with a straightforward model that inspects all stored messages,
+// for many messages with random ranks.
+func TestEvictionOrder(t *testing.T) {
+	msgSize := proto.Size(wrapperspb.UInt64(1))
+	capacity := 20 * msgSize
+	mb := New("1", capacity, testClassifier, NewDropCounters(), logging.NilLogger)
+	model := make([]uint64, 0)
+
+	// evictModel removes the oldest of the messages with the highest rank from the model.
+	evictModel := func() {
+		victim := 0
+		for i, rank := range model {
+			if rank > model[victim] {
+				victim = i
+			}
+		}
+		model = append(model[:victim], model[victim+1:]...)
+	}
+
+	r := rand.New(rand.NewSource(42)) //nolint:gosec
+	for i := 0; i < 2000; i++ {
+		rank := uint64(1 + r.Intn(30))
+
+		// A message is stored unless all the stored messages with at least its rank do not free enough space.
+		sizeFromRank := 0
+		for _, stored := range model {
+			if stored >= rank {
+				sizeFromRank += msgSize
+			}
+		}
+		size := len(model) * msgSize
+		fits := size+msgSize <= capacity || size+msgSize-sizeFromRank <= capacity
+		if fits {
+			for (len(model)+1)*msgSize > capacity {
+				evictModel()
+			}
+			model = append(model, rank)
+		}
+
+		assert.Equal(t, fits, mb.Store(wrapperspb.UInt64(rank)))
+		assert.Equal(t, model, storedRanks(mb))
+
+		// Occasionally shrink the buffer and grow it again.
+		if i%100 == 99 {
+			mb.Resize(capacity / 2)
+			for len(model)*msgSize > capacity/2 {
+				evictModel()
+			}
+			assert.Equal(t, model, storedRanks(mb))
+			mb.Resize(capacity)
+		}
+	}
+
+	// Consuming messages keeps the buffer consistent.
+	mb.Iterate(func(_ types.NodeID, msg proto.Message) Applicable {
+		if msg.(*wrapperspb.UInt64Value).Value%2 == 0 {
+			return Current
+		}
+		return Future
+	}, func(types.NodeID, proto.Message) {})
+	odd := make([]uint64, 0)
+	for _, rank := range model {
+		if rank%2 == 1 {
+			odd = append(odd, rank)
+		}
+	}
+	assert.Equal(t, odd, storedRanks(mb))
+	assert.Equal(t, len(odd)*msgSize, mb.ranks.sizeFrom(0))
+}
@@ -0,0 +1,187 @@
+package messagebuffer
+
+import (
+	"container/list"
+)
+
+// rankTree groups the messages stored in a MessageBuffer by their rank.
+// It is a treap (a binary search tree ordered by rank, balanced using random node priorities),
+// each node of which also holds the total size of the messages in its subtree.
+// This makes it possible to find the messages with the highest rank
+// and the total size of the messages with at least a given rank in logarithmic time.
+type rankTree struct {
+	root *rankNode
+
+	// State of the pseudo-random generator of node priorities.
+	// The priorities only affect the shape of the tree, never its contents.
+	prioritySeed uint64
+}
+
+// rankNode holds the stored messages of a single rank.
+type rankNode struct {
+	rank     uint64
+	priority uint64
+
+	// Elements of the MessageBuffer's message list holding the messages of this rank, oldest first.
+	elements *list.List
+
+	// Total size of the messages of this rank.
+	size int
+
+	// Total size of the messages in the subtree rooted at this node.
+	subtreeSize int
+
+	left  *rankNode
+	right *rankNode
+}
+
+func newRankTree() *rankTree {
+	return &rankTree{root: nil, prioritySeed: 0x9E3779B97F4A7C15}
+}
+
+// add accounts for a message of the given rank and size and returns the node of its rank,
+// where the caller is expected to store the message.
+func (rt *rankTree) add(rank uint64, size int) *rankNode {
+	var node *rankNode
+	rt.root, node = rt.insert(rt.root, rank, size)
+	return node
+}
+
+// remove accounts for the removal of a message of the given rank and size.
+// The caller is expected to have removed the message from the node of its rank beforehand.
+// If no messages of the rank remain, its node is removed from the tree.
+func (rt *rankTree) remove(rank uint64, size int) {
+	rt.root = rt.delete(rt.root, rank, size)
+}
+
+// max returns the node of the highest rank of all stored messages, or nil if there are none.
+func (rt *rankTree) max() *rankNode {
+	node := rt.root
+	for node != nil && node.right != nil {
+		node = node.right
+	}
+	return node
+}
+
+// sizeFrom returns the total size of the stored messages with at least the given rank.
+func (rt *rankTree) sizeFrom(rank uint64) int {
+	size := 0
+	for node := rt.root; node != nil; {
+		if node.rank >= rank {
+			size += node.size + node.right.getSubtreeSize()
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return size
+}
+
+// insert adds size to the node of the given rank in the subtree rooted at node, creating the node if necessary.
+// It returns the new root of the subtree and the node of the given rank.
+func (rt *rankTree) insert(node *rankNode, rank uint64, size int) (*rankNode, *rankNode) {
+	if node == nil {
+		newNode := &rankNode{
+			rank:        rank,
+			priority:    rt.nextPriority(),
+			elements:    list.New(),
+			size:        size,
+			subtreeSize: size,
+		}
+		return newNode, newNode
+	}
+
+	var target *rankNode
+	switch {
+	case rank < node.rank:
+		node.left, target = rt.insert(node.left, rank, size)
+		if node.left.priority > node.priority {
+			node = rotateRight(node)
+		}
+	case rank > node.rank:
+		node.right, target = rt.insert(node.right, rank, size)
+		if node.right.priority > node.priority {
+			node = rotateLeft(node)
+		}
+	default:
+		node.size += size
+		target = node
+	}
+	node.update()
+	return node, target
+}
+
+// delete subtracts size from the node of the given rank in the subtree rooted at node
+// and removes the node if it holds no more messages. It returns the new root of the subtree.
+func (rt *rankTree) delete(node *rankNode, rank uint64, size int) *rankNode {
+	switch {
+	case rank < node.rank:
+		node.left = rt.delete(node.left, rank, size)
+	case rank > node.rank:
+		node.right = rt.delete(node.right, rank, size)
+	default:
+		node.size -= size
+		if node.elements.Len() == 0 {
+			return merge(node.left, node.right)
+		}
+	}
+	node.update()
+	return node
+}
+
+// nextPriority returns a new pseudo-random node priority (using the xorshift64 generator).
+func (rt *rankTree) nextPriority() uint64 {
+	rt.prioritySeed ^= rt.prioritySeed << 13
+	rt.prioritySeed ^= rt.prioritySeed >> 7
+	rt.prioritySeed ^= rt.prioritySeed << 17
+	return rt.prioritySeed
+}
+
+// merge joins two subtrees, where all ranks in left are lower than all ranks in right, and returns the new root.
+func merge(left *rankNode, right *rankNode) *rankNode {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.priority > right.priority:
+		left.right = merge(left.right, right)
+		left.update()
+		return left
+	default:
+		right.left = merge(left, right.left)
+		right.update()
+		return right
+	}
+}
+
+func rotateRight(node *rankNode) *rankNode {
+	left := node.left
+	node.left = left.right
+	left.right = node
+	node.update()
+	left.update()
+	return left
+}
+
+func rotateLeft(node *rankNode) *rankNode {
+	right := node.right
+	node.right = right.left
+	right.left = node
+	node.update()
+	right.update()
+	return right
+}
+
+// update recomputes the subtree size of the node from its children.
+func (node *rankNode) update() {
+	node.subtreeSize = node.size + node.left.getSubtreeSize() + node.right.getSubtreeSize()
+}
+
+// getSubtreeSize returns the total size of the messages in the subtree rooted at node, which may be nil.
+func (node *rankNode) getSubtreeSize() int {
+	if node == nil {
+		return 0
+	}
+	return node.subtreeSize
+}
@@ -0,0 +1,839 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: abbapb/abbapb.proto
+
+package abbapb
+
+import (
+	_ "github.com/filecoin-project/mir/pkg/pb/mir"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Propose is used to input the initial value of this node to the agreement.
+type Propose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Propose) Reset() {
+	*x = Propose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Propose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Propose) ProtoMessage() {}
+
+func (x *Propose) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Propose.ProtoReflect.Descriptor instead.
+func (*Propose) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Propose) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// Decide is emitted when the agreement decides on a value.
+type Decide struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Decide) Reset() {
+	*x = Decide{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decide) ProtoMessage() {}
+
+func (x *Decide) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decide.ProtoReflect.Descriptor instead.
+func (*Decide) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Decide) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Event_Propose
+	//	*Event_Decide
+	Type isEvent_Type `protobuf_oneof:"type"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *Event) GetType() isEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Event) GetPropose() *Propose {
+	if x, ok := x.GetType().(*Event_Propose); ok {
+		return x.Propose
+	}
+	return nil
+}
+
+func (x *Event) GetDecide() *Decide {
+	if x, ok := x.GetType().(*Event_Decide); ok {
+		return x.Decide
+	}
+	return nil
+}
+
+type isEvent_Type interface {
+	isEvent_Type()
+}
+
+type Event_Propose struct {
+	Propose *Propose `protobuf:"bytes,1,opt,name=propose,proto3,oneof"`
+}
+
+type Event_Decide struct {
+	Decide *Decide `protobuf:"bytes,2,opt,name=decide,proto3,oneof"`
+}
+
+func (*Event_Propose) isEvent_Type() {}
+
+func (*Event_Decide) isEvent_Type() {}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Message_BvalMessage
+	//	*Message_AuxMessage
+	//	*Message_ConfMessage
+	//	*Message_CoinShareMessage
+	//	*Message_FinishMessage
+	Type isMessage_Type `protobuf_oneof:"type"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *Message) GetType() isMessage_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Message) GetBvalMessage() *BValMessage {
+	if x, ok := x.GetType().(*Message_BvalMessage); ok {
+		return x.BvalMessage
+	}
+	return nil
+}
+
+func (x *Message) GetAuxMessage() *AuxMessage {
+	if x, ok := x.GetType().(*Message_AuxMessage); ok {
+		return x.AuxMessage
+	}
+	return nil
+}
+
+func (x *Message) GetConfMessage() *ConfMessage {
+	if x, ok := x.GetType().(*Message_ConfMessage); ok {
+		return x.ConfMessage
+	}
+	return nil
+}
+
+func (x *Message) GetCoinShareMessage() *CoinShareMessage {
+	if x, ok := x.GetType().(*Message_CoinShareMessage); ok {
+		return x.CoinShareMessage
+	}
+	return nil
+}
+
+func (x *Message) GetFinishMessage() *FinishMessage {
+	if x, ok := x.GetType().(*Message_FinishMessage); ok {
+		return x.FinishMessage
+	}
+	return nil
+}
+
+type isMessage_Type interface {
+	isMessage_Type()
+}
+
+type Message_BvalMessage struct {
+	BvalMessage *BValMessage `protobuf:"bytes,1,opt,name=bval_message,json=bvalMessage,proto3,oneof"`
+}
+
+type Message_AuxMessage struct {
+	AuxMessage *AuxMessage `protobuf:"bytes,2,opt,name=aux_message,json=auxMessage,proto3,oneof"`
+}
+
+type Message_ConfMessage struct {
+	ConfMessage *ConfMessage `protobuf:"bytes,3,opt,name=conf_message,json=confMessage,proto3,oneof"`
+}
+
+type Message_CoinShareMessage struct {
+	CoinShareMessage *CoinShareMessage `protobuf:"bytes,4,opt,name=coin_share_message,json=coinShareMessage,proto3,oneof"`
+}
+
+type Message_FinishMessage struct {
+	FinishMessage *FinishMessage `protobuf:"bytes,5,opt,name=finish_message,json=finishMessage,proto3,oneof"`
+}
+
+func (*Message_BvalMessage) isMessage_Type() {}
+
+func (*Message_AuxMessage) isMessage_Type() {}
+
+func (*Message_ConfMessage) isMessage_Type() {}
+
+func (*Message_CoinShareMessage) isMessage_Type() {}
+
+func (*Message_FinishMessage) isMessage_Type() {}
+
+// BValMessage is used to broadcast a value that is an estimate of the sender in a round (binary-value broadcast).
+type BValMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BValMessage) Reset() {
+	*x = BValMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BValMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BValMessage) ProtoMessage() {}
+
+func (x *BValMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BValMessage.ProtoReflect.Descriptor instead.
+func (*BValMessage) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BValMessage) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BValMessage) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// AuxMessage carries the first value the sender obtained from the binary-value broadcast in a round.
+type AuxMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AuxMessage) Reset() {
+	*x = AuxMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuxMessage) ProtoMessage() {}
+
+func (x *AuxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuxMessage.ProtoReflect.Descriptor instead.
+func (*AuxMessage) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuxMessage) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *AuxMessage) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// ConfMessage carries the values the sender obtained from the binary-value broadcast in a round
+// before revealing its share of the common coin.
+type ConfMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round  uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Values []bool `protobuf:"varint,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *ConfMessage) Reset() {
+	*x = ConfMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfMessage) ProtoMessage() {}
+
+func (x *ConfMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfMessage.ProtoReflect.Descriptor instead.
+func (*ConfMessage) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConfMessage) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ConfMessage) GetValues() []bool {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// CoinShareMessage carries the sender's share of the common coin of a round.
+type CoinShareMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Share []byte `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *CoinShareMessage) Reset() {
+	*x = CoinShareMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoinShareMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinShareMessage) ProtoMessage() {}
+
+func (x *CoinShareMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinShareMessage.ProtoReflect.Descriptor instead.
+func (*CoinShareMessage) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CoinShareMessage) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *CoinShareMessage) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// FinishMessage announces the value the sender decided.
+type FinishMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FinishMessage) Reset() {
+	*x = FinishMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_abbapb_abbapb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishMessage) ProtoMessage() {}
+
+func (x *FinishMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_abbapb_abbapb_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishMessage.ProtoReflect.Descriptor instead.
+func (*FinishMessage) Descriptor() ([]byte, []int) {
+	return file_abbapb_abbapb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FinishMessage) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+var File_abbapb_abbapb_proto protoreflect.FileDescriptor
+
+var file_abbapb_abbapb_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2f, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x1a, 0x10, 0x6d,
+	0x69, 0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x1f, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x1e, 0x0a, 0x06, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x6c, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x62, 0x62,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x42, 0x0c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0xc6,
+	0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x76,
+	0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x56, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x61, 0x75, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x62, 0x62, 0x61,
+	0x70, 0x62, 0x2e, 0x41, 0x75, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x61, 0x75, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63,
+	0x6f, 0x69, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x3e, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x39, 0x0a, 0x0b, 0x42, 0x56, 0x61, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x38, 0x0a, 0x0a, 0x41, 0x75, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x43, 0x6f, 0x69,
+	0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x62, 0x62, 0x61, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_abbapb_abbapb_proto_rawDescOnce sync.Once
+	file_abbapb_abbapb_proto_rawDescData = file_abbapb_abbapb_proto_rawDesc
+)
+
+func file_abbapb_abbapb_proto_rawDescGZIP() []byte {
+	file_abbapb_abbapb_proto_rawDescOnce.Do(func() {
+		file_abbapb_abbapb_proto_rawDescData = protoimpl.X.CompressGZIP(file_abbapb_abbapb_proto_rawDescData)
+	})
+	return file_abbapb_abbapb_proto_rawDescData
+}
+
+var file_abbapb_abbapb_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_abbapb_abbapb_proto_goTypes = []interface{}{
+	(*Propose)(nil),          // 0: abbapb.Propose
+	(*Decide)(nil),           // 1: abbapb.Decide
+	(*Event)(nil),            // 2: abbapb.Event
+	(*Message)(nil),          // 3: abbapb.Message
+	(*BValMessage)(nil),      // 4: abbapb.BValMessage
+	(*AuxMessage)(nil),       // 5: abbapb.AuxMessage
+	(*ConfMessage)(nil),      // 6: abbapb.ConfMessage
+	(*CoinShareMessage)(nil), // 7: abbapb.CoinShareMessage
+	(*FinishMessage)(nil),    // 8: abbapb.FinishMessage
+}
+var file_abbapb_abbapb_proto_depIdxs = []int32{
+	0, // 0: abbapb.Event.propose:type_name -> abbapb.Propose
+	1, // 1: abbapb.Event.decide:type_name -> abbapb.Decide
+	4, // 2: abbapb.Message.bval_message:type_name -> abbapb.BValMessage
+	5, // 3: abbapb.Message.aux_message:type_name -> abbapb.AuxMessage
+	6, // 4: abbapb.Message.conf_message:type_name -> abbapb.ConfMessage
+	7, // 5: abbapb.Message.coin_share_message:type_name -> abbapb.CoinShareMessage
+	8, // 6: abbapb.Message.finish_message:type_name -> abbapb.FinishMessage
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_abbapb_abbapb_proto_init() }
+func file_abbapb_abbapb_proto_init() {
+	if File_abbapb_abbapb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_abbapb_abbapb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Propose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decide); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BValMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuxMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoinShareMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_abbapb_abbapb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_abbapb_abbapb_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*Event_Propose)(nil),
+		(*Event_Decide)(nil),
+	}
+	file_abbapb_abbapb_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*Message_BvalMessage)(nil),
+		(*Message_AuxMessage)(nil),
+		(*Message_ConfMessage)(nil),
+		(*Message_CoinShareMessage)(nil),
+		(*Message_FinishMessage)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_abbapb_abbapb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_abbapb_abbapb_proto_goTypes,
+		DependencyIndexes: file_abbapb_abbapb_proto_depIdxs,
+		MessageInfos:      file_abbapb_abbapb_proto_msgTypes,
+	}.Build()
+	File_abbapb_abbapb_proto = out.File
+	file_abbapb_abbapb_proto_rawDesc = nil
+	file_abbapb_abbapb_proto_goTypes = nil
+	file_abbapb_abbapb_proto_depIdxs = nil
+}
@@ -0,0 +1,16 @@
+package abbapb
+
+type Event_Type = isEvent_Type
+
+type Event_TypeWrapper[Ev any] interface {
+	Event_Type
+	Unwrap() *Ev
+}
+
+func (p *Event_Propose) Unwrap() *Propose {
+	return p.Propose
+}
+
+func (p *Event_Decide) Unwrap() *Decide {
+	return p.Decide
+}
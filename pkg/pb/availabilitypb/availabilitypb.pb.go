@@ -7,6 +7,7 @@
 package availabilitypb
 
 import (
+	avidpb "github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
 	mscpb "github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
 	contextstorepb "github.com/filecoin-project/mir/pkg/pb/contextstorepb"
 	dslpb "github.com/filecoin-project/mir/pkg/pb/dslpb"
@@ -36,6 +37,10 @@ type Event struct {
 	//	*Event_CertVerified
 	//	*Event_RequestTransactions
 	//	*Event_ProvideTransactions
+	//	*Event_SetRetentionIndex
+	//	*Event_ForgetBatchesBefore
+	//	*Event_Msc
+	//	*Event_Avid
 	Type isEvent_Type `protobuf_oneof:"Type"`
 }
 
@@ -120,6 +125,34 @@ func (x *Event) GetProvideTransactions() *ProvideTransactions {
 	return nil
 }
 
+func (x *Event) GetSetRetentionIndex() *SetRetentionIndex {
+	if x, ok := x.GetType().(*Event_SetRetentionIndex); ok {
+		return x.SetRetentionIndex
+	}
+	return nil
+}
+
+func (x *Event) GetForgetBatchesBefore() *ForgetBatchesBefore {
+	if x, ok := x.GetType().(*Event_ForgetBatchesBefore); ok {
+		return x.ForgetBatchesBefore
+	}
+	return nil
+}
+
+func (x *Event) GetMsc() *mscpb.Event {
+	if x, ok := x.GetType().(*Event_Msc); ok {
+		return x.Msc
+	}
+	return nil
+}
+
+func (x *Event) GetAvid() *avidpb.Event {
+	if x, ok := x.GetType().(*Event_Avid); ok {
+		return x.Avid
+	}
+	return nil
+}
+
 type isEvent_Type interface {
 	isEvent_Type()
 }
@@ -148,6 +181,24 @@ type Event_ProvideTransactions struct {
 	ProvideTransactions *ProvideTransactions `protobuf:"bytes,6,opt,name=provide_transactions,json=provideTransactions,proto3,oneof"`
 }
 
+type Event_SetRetentionIndex struct {
+	SetRetentionIndex *SetRetentionIndex `protobuf:"bytes,7,opt,name=set_retention_index,json=setRetentionIndex,proto3,oneof"`
+}
+
+type Event_ForgetBatchesBefore struct {
+	ForgetBatchesBefore *ForgetBatchesBefore `protobuf:"bytes,8,opt,name=forget_batches_before,json=forgetBatchesBefore,proto3,oneof"`
+}
+
+type Event_Msc struct {
+	// Internal events of the multisig collector.
+	Msc *mscpb.Event `protobuf:"bytes,9,opt,name=msc,proto3,oneof"`
+}
+
+type Event_Avid struct {
+	// Internal events of the AVID module.
+	Avid *avidpb.Event `protobuf:"bytes,10,opt,name=avid,proto3,oneof"`
+}
+
 func (*Event_RequestCert) isEvent_Type() {}
 
 func (*Event_NewCert) isEvent_Type() {}
@@ -160,6 +211,14 @@ func (*Event_RequestTransactions) isEvent_Type() {}
 
 func (*Event_ProvideTransactions) isEvent_Type() {}
 
+func (*Event_SetRetentionIndex) isEvent_Type() {}
+
+func (*Event_ForgetBatchesBefore) isEvent_Type() {}
+
+func (*Event_Msc) isEvent_Type() {}
+
+func (*Event_Avid) isEvent_Type() {}
+
 // RequestCert is used by the consensus layer to request an availability certificate for a batch of transactions
 // from the availability layer.
 type RequestCert struct {
@@ -499,6 +558,106 @@ func (x *ProvideTransactions) GetOrigin() *RequestTransactionsOrigin {
 	return nil
 }
 
+// SetRetentionIndex is used by the consensus layer to inform the availability layer about the retention index
+// (e.g., the current epoch number) to associate with all the batches the availability layer stores from now on.
+// Retention indices must be monotonically non-decreasing.
+type SetRetentionIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetentionIndex uint64 `protobuf:"varint,1,opt,name=retention_index,json=retentionIndex,proto3" json:"retention_index,omitempty"`
+}
+
+func (x *SetRetentionIndex) Reset() {
+	*x = SetRetentionIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionIndex) ProtoMessage() {}
+
+func (x *SetRetentionIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionIndex.ProtoReflect.Descriptor instead.
+func (*SetRetentionIndex) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetRetentionIndex) GetRetentionIndex() uint64 {
+	if x != nil {
+		return x.RetentionIndex
+	}
+	return 0
+}
+
+// ForgetBatchesBefore is used by the consensus layer to let the availability layer garbage-collect all the batches
+// associated with a retention index smaller than retention_index,
+// as the consensus layer will never request them (or verify their certificates) again.
+type ForgetBatchesBefore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetentionIndex uint64 `protobuf:"varint,1,opt,name=retention_index,json=retentionIndex,proto3" json:"retention_index,omitempty"`
+}
+
+func (x *ForgetBatchesBefore) Reset() {
+	*x = ForgetBatchesBefore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetBatchesBefore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetBatchesBefore) ProtoMessage() {}
+
+func (x *ForgetBatchesBefore) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetBatchesBefore.ProtoReflect.Descriptor instead.
+func (*ForgetBatchesBefore) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ForgetBatchesBefore) GetRetentionIndex() uint64 {
+	if x != nil {
+		return x.RetentionIndex
+	}
+	return 0
+}
+
 type RequestCertOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -514,7 +673,7 @@ type RequestCertOrigin struct {
 func (x *RequestCertOrigin) Reset() {
 	*x = RequestCertOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[7]
+		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -527,7 +686,7 @@ func (x *RequestCertOrigin) String() string {
 func (*RequestCertOrigin) ProtoMessage() {}
 
 func (x *RequestCertOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[7]
+	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +699,7 @@ func (x *RequestCertOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestCertOrigin.ProtoReflect.Descriptor instead.
 func (*RequestCertOrigin) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{7}
+	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RequestCertOrigin) GetModule() string {
@@ -602,7 +761,7 @@ type RequestTransactionsOrigin struct {
 func (x *RequestTransactionsOrigin) Reset() {
 	*x = RequestTransactionsOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[8]
+		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -615,7 +774,7 @@ func (x *RequestTransactionsOrigin) String() string {
 func (*RequestTransactionsOrigin) ProtoMessage() {}
 
 func (x *RequestTransactionsOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[8]
+	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +787,7 @@ func (x *RequestTransactionsOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestTransactionsOrigin.ProtoReflect.Descriptor instead.
 func (*RequestTransactionsOrigin) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{8}
+	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RequestTransactionsOrigin) GetModule() string {
@@ -690,7 +849,7 @@ type VerifyCertOrigin struct {
 func (x *VerifyCertOrigin) Reset() {
 	*x = VerifyCertOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[9]
+		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -703,7 +862,7 @@ func (x *VerifyCertOrigin) String() string {
 func (*VerifyCertOrigin) ProtoMessage() {}
 
 func (x *VerifyCertOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[9]
+	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +875,7 @@ func (x *VerifyCertOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyCertOrigin.ProtoReflect.Descriptor instead.
 func (*VerifyCertOrigin) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{9}
+	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *VerifyCertOrigin) GetModule() string {
@@ -770,13 +929,14 @@ type Cert struct {
 
 	// Types that are assignable to Type:
 	//	*Cert_Msc
+	//	*Cert_Avid
 	Type isCert_Type `protobuf_oneof:"Type"`
 }
 
 func (x *Cert) Reset() {
 	*x = Cert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[10]
+		mi := &file_availabilitypb_availabilitypb_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -789,7 +949,7 @@ func (x *Cert) String() string {
 func (*Cert) ProtoMessage() {}
 
 func (x *Cert) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[10]
+	mi := &file_availabilitypb_availabilitypb_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,7 +962,7 @@ func (x *Cert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cert.ProtoReflect.Descriptor instead.
 func (*Cert) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{10}
+	return file_availabilitypb_availabilitypb_proto_rawDescGZIP(), []int{12}
 }
 
 func (m *Cert) GetType() isCert_Type {
@@ -819,6 +979,13 @@ func (x *Cert) GetMsc() *mscpb.Cert {
 	return nil
 }
 
+func (x *Cert) GetAvid() *avidpb.Cert {
+	if x, ok := x.GetType().(*Cert_Avid); ok {
+		return x.Avid
+	}
+	return nil
+}
+
 type isCert_Type interface {
 	isCert_Type()
 }
@@ -827,8 +994,14 @@ type Cert_Msc struct {
 	Msc *mscpb.Cert `protobuf:"bytes,1,opt,name=msc,proto3,oneof"`
 }
 
+type Cert_Avid struct {
+	Avid *avidpb.Cert `protobuf:"bytes,2,opt,name=avid,proto3,oneof"`
+}
+
 func (*Cert_Msc) isCert_Type() {}
 
+func (*Cert_Avid) isCert_Type() {}
+
 var File_availabilitypb_availabilitypb_proto protoreflect.FileDescriptor
 
 var file_availabilitypb_availabilitypb_proto_rawDesc = []byte{
@@ -841,97 +1014,105 @@ var file_availabilitypb_availabilitypb_proto_rawDesc = []byte{
 	0x62, 0x2f, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x61,
 	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f, 0x6d, 0x73,
 	0x63, 0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x10, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xc5, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x48, 0x00,
-	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x34, 0x0a,
-	0x08, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
-	0x2e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x43,
-	0x65, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x65,
-	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x43, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65,
-	0x72, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x58, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x58, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0c, 0x0a, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x22, 0x6e, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x12, 0x28,
-	0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65,
-	0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x22, 0x70, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
-	0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x70, 0x0a, 0x0c, 0x43, 0x65, 0x72, 0x74, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65,
-	0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x12, 0x38, 0x0a,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x28, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x22, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f,
+	0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x05, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x40, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x12, 0x34, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43,
-	0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x76, 0x61, 0x69,
+	0x65, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x63,
+	0x65, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x58, 0x0a, 0x14, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x76, 0x61, 0x69,
 	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6a, 0x0a, 0x13,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0x9d, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0x94, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x4f,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00,
+	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x53, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48,
+	0x00, 0x52, 0x11, 0x73, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x59, 0x0a, 0x15, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x13, 0x66, 0x6f, 0x72, 0x67,
+	0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x2f, 0x0a, 0x03, 0x6d, 0x73, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73,
+	0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x73, 0x63,
+	0x12, 0x32, 0x0a, 0x04, 0x61, 0x76, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e,
+	0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04,
+	0x61, 0x76, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5,
+	0x18, 0x01, 0x22, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6e, 0x0a, 0x07,
+	0x4e, 0x65, 0x77, 0x43, 0x65, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72,
+	0x74, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x70, 0x0a, 0x0a,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04,
+	0x63, 0x65, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x70,
+	0x0a, 0x0c, 0x43, 0x65, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x12, 0x38, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65,
+	0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x22, 0x82, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6a, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x41,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x22, 0x3c, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x3e, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x95, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x4f,
 	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a,
 	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02,
@@ -940,15 +1121,38 @@ var file_availabilitypb_availabilitypb_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03,
 	0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70,
 	0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42,
-	0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12,
-	0x2e, 0x0a, 0x03, 0x6d, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73,
-	0x63, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x73, 0x63, 0x42,
-	0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03,
+	0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70,
+	0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42,
+	0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48,
+	0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x71,
+	0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x73, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x48,
+	0x00, 0x52, 0x03, 0x6d, 0x73, 0x63, 0x12, 0x31, 0x0a, 0x04, 0x61, 0x76, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x61, 0x76, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -963,7 +1167,7 @@ func file_availabilitypb_availabilitypb_proto_rawDescGZIP() []byte {
 	return file_availabilitypb_availabilitypb_proto_rawDescData
 }
 
-var file_availabilitypb_availabilitypb_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_availabilitypb_availabilitypb_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_availabilitypb_availabilitypb_proto_goTypes = []interface{}{
 	(*Event)(nil),                     // 0: availabilitypb.Event
 	(*RequestCert)(nil),               // 1: availabilitypb.RequestCert
@@ -972,13 +1176,18 @@ var file_availabilitypb_availabilitypb_proto_goTypes = []interface{}{
 	(*CertVerified)(nil),              // 4: availabilitypb.CertVerified
 	(*RequestTransactions)(nil),       // 5: availabilitypb.RequestTransactions
 	(*ProvideTransactions)(nil),       // 6: availabilitypb.ProvideTransactions
-	(*RequestCertOrigin)(nil),         // 7: availabilitypb.RequestCertOrigin
-	(*RequestTransactionsOrigin)(nil), // 8: availabilitypb.RequestTransactionsOrigin
-	(*VerifyCertOrigin)(nil),          // 9: availabilitypb.VerifyCertOrigin
-	(*Cert)(nil),                      // 10: availabilitypb.Cert
-	(*contextstorepb.Origin)(nil),     // 11: contextstorepb.Origin
-	(*dslpb.Origin)(nil),              // 12: dslpb.Origin
-	(*mscpb.Cert)(nil),                // 13: availabilitypb.mscpb.Cert
+	(*SetRetentionIndex)(nil),         // 7: availabilitypb.SetRetentionIndex
+	(*ForgetBatchesBefore)(nil),       // 8: availabilitypb.ForgetBatchesBefore
+	(*RequestCertOrigin)(nil),         // 9: availabilitypb.RequestCertOrigin
+	(*RequestTransactionsOrigin)(nil), // 10: availabilitypb.RequestTransactionsOrigin
+	(*VerifyCertOrigin)(nil),          // 11: availabilitypb.VerifyCertOrigin
+	(*Cert)(nil),                      // 12: availabilitypb.Cert
+	(*mscpb.Event)(nil),               // 13: availabilitypb.mscpb.Event
+	(*avidpb.Event)(nil),              // 14: availabilitypb.avidpb.Event
+	(*contextstorepb.Origin)(nil),     // 15: contextstorepb.Origin
+	(*dslpb.Origin)(nil),              // 16: dslpb.Origin
+	(*mscpb.Cert)(nil),                // 17: availabilitypb.mscpb.Cert
+	(*avidpb.Cert)(nil),               // 18: availabilitypb.avidpb.Cert
 }
 var file_availabilitypb_availabilitypb_proto_depIdxs = []int32{
 	1,  // 0: availabilitypb.Event.request_cert:type_name -> availabilitypb.RequestCert
@@ -987,27 +1196,32 @@ var file_availabilitypb_availabilitypb_proto_depIdxs = []int32{
 	4,  // 3: availabilitypb.Event.cert_verified:type_name -> availabilitypb.CertVerified
 	5,  // 4: availabilitypb.Event.request_transactions:type_name -> availabilitypb.RequestTransactions
 	6,  // 5: availabilitypb.Event.provide_transactions:type_name -> availabilitypb.ProvideTransactions
-	7,  // 6: availabilitypb.RequestCert.origin:type_name -> availabilitypb.RequestCertOrigin
-	10, // 7: availabilitypb.NewCert.cert:type_name -> availabilitypb.Cert
-	7,  // 8: availabilitypb.NewCert.origin:type_name -> availabilitypb.RequestCertOrigin
-	10, // 9: availabilitypb.VerifyCert.cert:type_name -> availabilitypb.Cert
-	9,  // 10: availabilitypb.VerifyCert.origin:type_name -> availabilitypb.VerifyCertOrigin
-	9,  // 11: availabilitypb.CertVerified.origin:type_name -> availabilitypb.VerifyCertOrigin
-	10, // 12: availabilitypb.RequestTransactions.cert:type_name -> availabilitypb.Cert
-	8,  // 13: availabilitypb.RequestTransactions.origin:type_name -> availabilitypb.RequestTransactionsOrigin
-	8,  // 14: availabilitypb.ProvideTransactions.origin:type_name -> availabilitypb.RequestTransactionsOrigin
-	11, // 15: availabilitypb.RequestCertOrigin.context_store:type_name -> contextstorepb.Origin
-	12, // 16: availabilitypb.RequestCertOrigin.dsl:type_name -> dslpb.Origin
-	11, // 17: availabilitypb.RequestTransactionsOrigin.context_store:type_name -> contextstorepb.Origin
-	12, // 18: availabilitypb.RequestTransactionsOrigin.dsl:type_name -> dslpb.Origin
-	11, // 19: availabilitypb.VerifyCertOrigin.context_store:type_name -> contextstorepb.Origin
-	12, // 20: availabilitypb.VerifyCertOrigin.dsl:type_name -> dslpb.Origin
-	13, // 21: availabilitypb.Cert.msc:type_name -> availabilitypb.mscpb.Cert
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	7,  // 6: availabilitypb.Event.set_retention_index:type_name -> availabilitypb.SetRetentionIndex
+	8,  // 7: availabilitypb.Event.forget_batches_before:type_name -> availabilitypb.ForgetBatchesBefore
+	13, // 8: availabilitypb.Event.msc:type_name -> availabilitypb.mscpb.Event
+	14, // 9: availabilitypb.Event.avid:type_name -> availabilitypb.avidpb.Event
+	9,  // 10: availabilitypb.RequestCert.origin:type_name -> availabilitypb.RequestCertOrigin
+	12, // 11: availabilitypb.NewCert.cert:type_name -> availabilitypb.Cert
+	9,  // 12: availabilitypb.NewCert.origin:type_name -> availabilitypb.RequestCertOrigin
+	12, // 13: availabilitypb.VerifyCert.cert:type_name -> availabilitypb.Cert
+	11, // 14: availabilitypb.VerifyCert.origin:type_name -> availabilitypb.VerifyCertOrigin
+	11, // 15: availabilitypb.CertVerified.origin:type_name -> availabilitypb.VerifyCertOrigin
+	12, // 16: availabilitypb.RequestTransactions.cert:type_name -> availabilitypb.Cert
+	10, // 17: availabilitypb.RequestTransactions.origin:type_name -> availabilitypb.RequestTransactionsOrigin
+	10, // 18: availabilitypb.ProvideTransactions.origin:type_name -> availabilitypb.RequestTransactionsOrigin
+	15, // 19: availabilitypb.RequestCertOrigin.context_store:type_name -> contextstorepb.Origin
+	16, // 20: availabilitypb.RequestCertOrigin.dsl:type_name -> dslpb.Origin
+	15, // 21: availabilitypb.RequestTransactionsOrigin.context_store:type_name -> contextstorepb.Origin
+	16, // 22: availabilitypb.RequestTransactionsOrigin.dsl:type_name -> dslpb.Origin
+	15, // 23: availabilitypb.VerifyCertOrigin.context_store:type_name -> contextstorepb.Origin
+	16, // 24: availabilitypb.VerifyCertOrigin.dsl:type_name -> dslpb.Origin
+	17, // 25: availabilitypb.Cert.msc:type_name -> availabilitypb.mscpb.Cert
+	18, // 26: availabilitypb.Cert.avid:type_name -> availabilitypb.avidpb.Cert
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_availabilitypb_availabilitypb_proto_init() }
@@ -1101,7 +1315,7 @@ func file_availabilitypb_availabilitypb_proto_init() {
 			}
 		}
 		file_availabilitypb_availabilitypb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestCertOrigin); i {
+			switch v := v.(*SetRetentionIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1113,7 +1327,7 @@ func file_availabilitypb_availabilitypb_proto_init() {
 			}
 		}
 		file_availabilitypb_availabilitypb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestTransactionsOrigin); i {
+			switch v := v.(*ForgetBatchesBefore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1125,7 +1339,7 @@ func file_availabilitypb_availabilitypb_proto_init() {
 			}
 		}
 		file_availabilitypb_availabilitypb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyCertOrigin); i {
+			switch v := v.(*RequestCertOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1137,6 +1351,30 @@ func file_availabilitypb_availabilitypb_proto_init() {
 			}
 		}
 		file_availabilitypb_availabilitypb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestTransactionsOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_availabilitypb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCertOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_availabilitypb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Cert); i {
 			case 0:
 				return &v.state
@@ -1156,21 +1394,26 @@ func file_availabilitypb_availabilitypb_proto_init() {
 		(*Event_CertVerified)(nil),
 		(*Event_RequestTransactions)(nil),
 		(*Event_ProvideTransactions)(nil),
+		(*Event_SetRetentionIndex)(nil),
+		(*Event_ForgetBatchesBefore)(nil),
+		(*Event_Msc)(nil),
+		(*Event_Avid)(nil),
 	}
-	file_availabilitypb_availabilitypb_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_availabilitypb_availabilitypb_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*RequestCertOrigin_ContextStore)(nil),
 		(*RequestCertOrigin_Dsl)(nil),
 	}
-	file_availabilitypb_availabilitypb_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_availabilitypb_availabilitypb_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*RequestTransactionsOrigin_ContextStore)(nil),
 		(*RequestTransactionsOrigin_Dsl)(nil),
 	}
-	file_availabilitypb_availabilitypb_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_availabilitypb_availabilitypb_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*VerifyCertOrigin_ContextStore)(nil),
 		(*VerifyCertOrigin_Dsl)(nil),
 	}
-	file_availabilitypb_availabilitypb_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_availabilitypb_availabilitypb_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*Cert_Msc)(nil),
+		(*Cert_Avid)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1178,7 +1421,7 @@ func file_availabilitypb_availabilitypb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_availabilitypb_availabilitypb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,5 +1,10 @@
 package availabilitypb
 
+import (
+	avidpb "github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
+	mscpb "github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+)
+
 type Event_Type = isEvent_Type
 
 type Event_TypeWrapper[Ev any] interface {
@@ -30,3 +35,19 @@ func (p *Event_RequestTransactions) Unwrap() *RequestTransactions {
 func (p *Event_ProvideTransactions) Unwrap() *ProvideTransactions {
 	return p.ProvideTransactions
 }
+
+func (p *Event_SetRetentionIndex) Unwrap() *SetRetentionIndex {
+	return p.SetRetentionIndex
+}
+
+func (p *Event_ForgetBatchesBefore) Unwrap() *ForgetBatchesBefore {
+	return p.ForgetBatchesBefore
+}
+
+func (p *Event_Msc) Unwrap() *mscpb.Event {
+	return p.Msc
+}
+
+func (p *Event_Avid) Unwrap() *avidpb.Event {
+	return p.Avid
+}
@@ -0,0 +1,798 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: availabilitypb/avidpb/avidpb.proto
+
+package avidpb
+
+import (
+	_ "github.com/filecoin-project/mir/pkg/pb/mir"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Event is an internal event of the AVID module.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Event_RequestTimeout
+	Type isEvent_Type `protobuf_oneof:"type"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Event) GetType() isEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Event) GetRequestTimeout() *RequestTimeout {
+	if x, ok := x.GetType().(*Event_RequestTimeout); ok {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+type isEvent_Type interface {
+	isEvent_Type()
+}
+
+type Event_RequestTimeout struct {
+	RequestTimeout *RequestTimeout `protobuf:"bytes,1,opt,name=request_timeout,json=requestTimeout,proto3,oneof"`
+}
+
+func (*Event_RequestTimeout) isEvent_Type() {}
+
+// RequestTimeout is triggered (via the timer module) when not enough chunks have been received in time
+// during batch reconstruction, so that the nodes that have not provided their chunk yet can be asked again.
+type RequestTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReqId uint64 `protobuf:"varint,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+}
+
+func (x *RequestTimeout) Reset() {
+	*x = RequestTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestTimeout) ProtoMessage() {}
+
+func (x *RequestTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestTimeout.ProtoReflect.Descriptor instead.
+func (*RequestTimeout) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestTimeout) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Message_Chunk
+	//	*Message_Sig
+	//	*Message_RequestChunk
+	//	*Message_ProvideChunk
+	Type isMessage_Type `protobuf_oneof:"type"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *Message) GetType() isMessage_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Message) GetChunk() *ChunkMessage {
+	if x, ok := x.GetType().(*Message_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *Message) GetSig() *SigMessage {
+	if x, ok := x.GetType().(*Message_Sig); ok {
+		return x.Sig
+	}
+	return nil
+}
+
+func (x *Message) GetRequestChunk() *RequestChunkMessage {
+	if x, ok := x.GetType().(*Message_RequestChunk); ok {
+		return x.RequestChunk
+	}
+	return nil
+}
+
+func (x *Message) GetProvideChunk() *ProvideChunkMessage {
+	if x, ok := x.GetType().(*Message_ProvideChunk); ok {
+		return x.ProvideChunk
+	}
+	return nil
+}
+
+type isMessage_Type interface {
+	isMessage_Type()
+}
+
+type Message_Chunk struct {
+	Chunk *ChunkMessage `protobuf:"bytes,1,opt,name=chunk,proto3,oneof"`
+}
+
+type Message_Sig struct {
+	Sig *SigMessage `protobuf:"bytes,2,opt,name=sig,proto3,oneof"`
+}
+
+type Message_RequestChunk struct {
+	RequestChunk *RequestChunkMessage `protobuf:"bytes,3,opt,name=request_chunk,json=requestChunk,proto3,oneof"`
+}
+
+type Message_ProvideChunk struct {
+	ProvideChunk *ProvideChunkMessage `protobuf:"bytes,4,opt,name=provide_chunk,json=provideChunk,proto3,oneof"`
+}
+
+func (*Message_Chunk) isMessage_Type() {}
+
+func (*Message_Sig) isMessage_Type() {}
+
+func (*Message_RequestChunk) isMessage_Type() {}
+
+func (*Message_ProvideChunk) isMessage_Type() {}
+
+// ChunkMessage is sent by the proposer of a batch to each node, carrying the chunk of the erasure-coded batch
+// the receiving node is responsible for, along with its inclusion proof in the Merkle tree over all chunks.
+type ChunkMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MerkleRoot []byte   `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Chunk      []byte   `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Proof      [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	ReqId      uint64   `protobuf:"varint,4,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+}
+
+func (x *ChunkMessage) Reset() {
+	*x = ChunkMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkMessage) ProtoMessage() {}
+
+func (x *ChunkMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkMessage.ProtoReflect.Descriptor instead.
+func (*ChunkMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChunkMessage) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *ChunkMessage) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ChunkMessage) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *ChunkMessage) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+type SigMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	ReqId     uint64 `protobuf:"varint,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+}
+
+func (x *SigMessage) Reset() {
+	*x = SigMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigMessage) ProtoMessage() {}
+
+func (x *SigMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigMessage.ProtoReflect.Descriptor instead.
+func (*SigMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SigMessage) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *SigMessage) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+type RequestChunkMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MerkleRoot []byte `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	ReqId      uint64 `protobuf:"varint,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+}
+
+func (x *RequestChunkMessage) Reset() {
+	*x = RequestChunkMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestChunkMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestChunkMessage) ProtoMessage() {}
+
+func (x *RequestChunkMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestChunkMessage.ProtoReflect.Descriptor instead.
+func (*RequestChunkMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestChunkMessage) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *RequestChunkMessage) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+type ProvideChunkMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Proof [][]byte `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+	ReqId uint64   `protobuf:"varint,3,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+}
+
+func (x *ProvideChunkMessage) Reset() {
+	*x = ProvideChunkMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvideChunkMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvideChunkMessage) ProtoMessage() {}
+
+func (x *ProvideChunkMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvideChunkMessage.ProtoReflect.Descriptor instead.
+func (*ProvideChunkMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProvideChunkMessage) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ProvideChunkMessage) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *ProvideChunkMessage) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+// Cert is an availability certificate committing to the Merkle root of the chunks of an erasure-coded batch.
+// The signers are encoded as a bitmap relative to the membership (the AllNodes module parameter)
+// and the signatures are ordered as the signers in the membership.
+type Cert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MerkleRoot   []byte   `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	SignerBitmap []byte   `protobuf:"bytes,2,opt,name=signer_bitmap,json=signerBitmap,proto3" json:"signer_bitmap,omitempty"`
+	Signatures   [][]byte `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *Cert) Reset() {
+	*x = Cert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cert) ProtoMessage() {}
+
+func (x *Cert) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_avidpb_avidpb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cert.ProtoReflect.Descriptor instead.
+func (*Cert) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Cert) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *Cert) GetSignerBitmap() []byte {
+	if x != nil {
+		return x.SignerBitmap
+	}
+	return nil
+}
+
+func (x *Cert) GetSignatures() [][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+var File_availabilitypb_avidpb_avidpb_proto protoreflect.FileDescriptor
+
+var file_availabilitypb_avidpb_avidpb_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
+	0x2f, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2e, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x1a, 0x10, 0x6d, 0x69, 0x72,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
+	0x2e, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0x27, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x22,
+	0xab, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x61, 0x76, 0x69, 0x64,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x35, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x69,
+	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12,
+	0x51, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x51, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x61, 0x76, 0x69, 0x64, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x72, 0x0a,
+	0x0c, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
+	0x71, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49,
+	0x64, 0x22, 0x41, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72,
+	0x65, 0x71, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65,
+	0x71, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x22, 0x6c, 0x0a,
+	0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x6b,
+	0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x42, 0x3e, 0x5a, 0x3c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f,
+	0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_availabilitypb_avidpb_avidpb_proto_rawDescOnce sync.Once
+	file_availabilitypb_avidpb_avidpb_proto_rawDescData = file_availabilitypb_avidpb_avidpb_proto_rawDesc
+)
+
+func file_availabilitypb_avidpb_avidpb_proto_rawDescGZIP() []byte {
+	file_availabilitypb_avidpb_avidpb_proto_rawDescOnce.Do(func() {
+		file_availabilitypb_avidpb_avidpb_proto_rawDescData = protoimpl.X.CompressGZIP(file_availabilitypb_avidpb_avidpb_proto_rawDescData)
+	})
+	return file_availabilitypb_avidpb_avidpb_proto_rawDescData
+}
+
+var file_availabilitypb_avidpb_avidpb_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_availabilitypb_avidpb_avidpb_proto_goTypes = []interface{}{
+	(*Event)(nil),               // 0: availabilitypb.avidpb.Event
+	(*RequestTimeout)(nil),      // 1: availabilitypb.avidpb.RequestTimeout
+	(*Message)(nil),             // 2: availabilitypb.avidpb.Message
+	(*ChunkMessage)(nil),        // 3: availabilitypb.avidpb.ChunkMessage
+	(*SigMessage)(nil),          // 4: availabilitypb.avidpb.SigMessage
+	(*RequestChunkMessage)(nil), // 5: availabilitypb.avidpb.RequestChunkMessage
+	(*ProvideChunkMessage)(nil), // 6: availabilitypb.avidpb.ProvideChunkMessage
+	(*Cert)(nil),                // 7: availabilitypb.avidpb.Cert
+}
+var file_availabilitypb_avidpb_avidpb_proto_depIdxs = []int32{
+	1, // 0: availabilitypb.avidpb.Event.request_timeout:type_name -> availabilitypb.avidpb.RequestTimeout
+	3, // 1: availabilitypb.avidpb.Message.chunk:type_name -> availabilitypb.avidpb.ChunkMessage
+	4, // 2: availabilitypb.avidpb.Message.sig:type_name -> availabilitypb.avidpb.SigMessage
+	5, // 3: availabilitypb.avidpb.Message.request_chunk:type_name -> availabilitypb.avidpb.RequestChunkMessage
+	6, // 4: availabilitypb.avidpb.Message.provide_chunk:type_name -> availabilitypb.avidpb.ProvideChunkMessage
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_availabilitypb_avidpb_avidpb_proto_init() }
+func file_availabilitypb_avidpb_avidpb_proto_init() {
+	if File_availabilitypb_avidpb_avidpb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestChunkMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvideChunkMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_avidpb_avidpb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_availabilitypb_avidpb_avidpb_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_RequestTimeout)(nil),
+	}
+	file_availabilitypb_avidpb_avidpb_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*Message_Chunk)(nil),
+		(*Message_Sig)(nil),
+		(*Message_RequestChunk)(nil),
+		(*Message_ProvideChunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_availabilitypb_avidpb_avidpb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_availabilitypb_avidpb_avidpb_proto_goTypes,
+		DependencyIndexes: file_availabilitypb_avidpb_avidpb_proto_depIdxs,
+		MessageInfos:      file_availabilitypb_avidpb_avidpb_proto_msgTypes,
+	}.Build()
+	File_availabilitypb_avidpb_avidpb_proto = out.File
+	file_availabilitypb_avidpb_avidpb_proto_rawDesc = nil
+	file_availabilitypb_avidpb_avidpb_proto_goTypes = nil
+	file_availabilitypb_avidpb_avidpb_proto_depIdxs = nil
+}
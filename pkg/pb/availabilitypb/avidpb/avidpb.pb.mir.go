@@ -0,0 +1,12 @@
+package avidpb
+
+type Event_Type = isEvent_Type
+
+type Event_TypeWrapper[Ev any] interface {
+	Event_Type
+	Unwrap() *Ev
+}
+
+func (p *Event_RequestTimeout) Unwrap() *RequestTimeout {
+	return p.RequestTimeout
+}
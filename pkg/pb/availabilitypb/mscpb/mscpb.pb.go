@@ -7,6 +7,7 @@
 package mscpb
 
 import (
+	_ "github.com/filecoin-project/mir/pkg/pb/mir"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,6 +21,130 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Event is an internal event of the multisig collector.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Event_RequestTimeout
+	Type isEvent_Type `protobuf_oneof:"type"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Event) GetType() isEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Event) GetRequestTimeout() *RequestTimeout {
+	if x, ok := x.GetType().(*Event_RequestTimeout); ok {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+type isEvent_Type interface {
+	isEvent_Type()
+}
+
+type Event_RequestTimeout struct {
+	RequestTimeout *RequestTimeout `protobuf:"bytes,1,opt,name=request_timeout,json=requestTimeout,proto3,oneof"`
+}
+
+func (*Event_RequestTimeout) isEvent_Type() {}
+
+// RequestTimeout is triggered (via the timer module) when the node asked during batch reconstruction
+// did not provide a valid response in time, so that the next node can be asked.
+type RequestTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReqId   uint64 `protobuf:"varint,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Attempt uint64 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (x *RequestTimeout) Reset() {
+	*x = RequestTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestTimeout) ProtoMessage() {}
+
+func (x *RequestTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestTimeout.ProtoReflect.Descriptor instead.
+func (*RequestTimeout) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestTimeout) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+func (x *RequestTimeout) GetAttempt() uint64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
 type Message struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -28,15 +153,17 @@ type Message struct {
 	// Types that are assignable to Type:
 	//	*Message_RequestSig
 	//	*Message_Sig
-	//	*Message_RequestBatch
-	//	*Message_ProvideBatch
+	//	*Message_RequestTxIds
+	//	*Message_ProvideTxIds
+	//	*Message_RequestMissingTxs
+	//	*Message_ProvideMissingTxs
 	Type isMessage_Type `protobuf_oneof:"type"`
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[0]
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -49,7 +176,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[0]
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,7 +189,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{0}
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{2}
 }
 
 func (m *Message) GetType() isMessage_Type {
@@ -86,16 +213,30 @@ func (x *Message) GetSig() *SigMessage {
 	return nil
 }
 
-func (x *Message) GetRequestBatch() *RequestBatchMessage {
-	if x, ok := x.GetType().(*Message_RequestBatch); ok {
-		return x.RequestBatch
+func (x *Message) GetRequestTxIds() *RequestTxIDsMessage {
+	if x, ok := x.GetType().(*Message_RequestTxIds); ok {
+		return x.RequestTxIds
+	}
+	return nil
+}
+
+func (x *Message) GetProvideTxIds() *ProvideTxIDsMessage {
+	if x, ok := x.GetType().(*Message_ProvideTxIds); ok {
+		return x.ProvideTxIds
+	}
+	return nil
+}
+
+func (x *Message) GetRequestMissingTxs() *RequestMissingTxsMessage {
+	if x, ok := x.GetType().(*Message_RequestMissingTxs); ok {
+		return x.RequestMissingTxs
 	}
 	return nil
 }
 
-func (x *Message) GetProvideBatch() *ProvideBatchMessage {
-	if x, ok := x.GetType().(*Message_ProvideBatch); ok {
-		return x.ProvideBatch
+func (x *Message) GetProvideMissingTxs() *ProvideMissingTxsMessage {
+	if x, ok := x.GetType().(*Message_ProvideMissingTxs); ok {
+		return x.ProvideMissingTxs
 	}
 	return nil
 }
@@ -112,21 +253,33 @@ type Message_Sig struct {
 	Sig *SigMessage `protobuf:"bytes,2,opt,name=sig,proto3,oneof"`
 }
 
-type Message_RequestBatch struct {
-	RequestBatch *RequestBatchMessage `protobuf:"bytes,3,opt,name=request_batch,json=requestBatch,proto3,oneof"`
+type Message_RequestTxIds struct {
+	RequestTxIds *RequestTxIDsMessage `protobuf:"bytes,3,opt,name=request_tx_ids,json=requestTxIds,proto3,oneof"`
 }
 
-type Message_ProvideBatch struct {
-	ProvideBatch *ProvideBatchMessage `protobuf:"bytes,4,opt,name=provide_batch,json=provideBatch,proto3,oneof"`
+type Message_ProvideTxIds struct {
+	ProvideTxIds *ProvideTxIDsMessage `protobuf:"bytes,4,opt,name=provide_tx_ids,json=provideTxIds,proto3,oneof"`
+}
+
+type Message_RequestMissingTxs struct {
+	RequestMissingTxs *RequestMissingTxsMessage `protobuf:"bytes,5,opt,name=request_missing_txs,json=requestMissingTxs,proto3,oneof"`
+}
+
+type Message_ProvideMissingTxs struct {
+	ProvideMissingTxs *ProvideMissingTxsMessage `protobuf:"bytes,6,opt,name=provide_missing_txs,json=provideMissingTxs,proto3,oneof"`
 }
 
 func (*Message_RequestSig) isMessage_Type() {}
 
 func (*Message_Sig) isMessage_Type() {}
 
-func (*Message_RequestBatch) isMessage_Type() {}
+func (*Message_RequestTxIds) isMessage_Type() {}
+
+func (*Message_ProvideTxIds) isMessage_Type() {}
 
-func (*Message_ProvideBatch) isMessage_Type() {}
+func (*Message_RequestMissingTxs) isMessage_Type() {}
+
+func (*Message_ProvideMissingTxs) isMessage_Type() {}
 
 type RequestSigMessage struct {
 	state         protoimpl.MessageState
@@ -140,7 +293,7 @@ type RequestSigMessage struct {
 func (x *RequestSigMessage) Reset() {
 	*x = RequestSigMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[1]
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -153,7 +306,7 @@ func (x *RequestSigMessage) String() string {
 func (*RequestSigMessage) ProtoMessage() {}
 
 func (x *RequestSigMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[1]
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +319,7 @@ func (x *RequestSigMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestSigMessage.ProtoReflect.Descriptor instead.
 func (*RequestSigMessage) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{1}
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RequestSigMessage) GetTxs() [][]byte {
@@ -195,7 +348,7 @@ type SigMessage struct {
 func (x *SigMessage) Reset() {
 	*x = SigMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[2]
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -208,7 +361,7 @@ func (x *SigMessage) String() string {
 func (*SigMessage) ProtoMessage() {}
 
 func (x *SigMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[2]
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +374,7 @@ func (x *SigMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigMessage.ProtoReflect.Descriptor instead.
 func (*SigMessage) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{2}
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SigMessage) GetSignature() []byte {
@@ -238,32 +391,33 @@ func (x *SigMessage) GetReqId() uint64 {
 	return 0
 }
 
-type RequestBatchMessage struct {
+// RequestTxIDsMessage is used to request the IDs of the transactions contained in a batch.
+type RequestTxIDsMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	BatchId []byte `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	ReqId   uint64 `protobuf:"varint,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
 }
 
-func (x *RequestBatchMessage) Reset() {
-	*x = RequestBatchMessage{}
+func (x *RequestTxIDsMessage) Reset() {
+	*x = RequestTxIDsMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[3]
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestBatchMessage) String() string {
+func (x *RequestTxIDsMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestBatchMessage) ProtoMessage() {}
+func (*RequestTxIDsMessage) ProtoMessage() {}
 
-func (x *RequestBatchMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[3]
+func (x *RequestTxIDsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,51 +428,51 @@ func (x *RequestBatchMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestBatchMessage.ProtoReflect.Descriptor instead.
-func (*RequestBatchMessage) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RequestTxIDsMessage.ProtoReflect.Descriptor instead.
+func (*RequestTxIDsMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RequestBatchMessage) GetBatchId() string {
+func (x *RequestTxIDsMessage) GetBatchId() []byte {
 	if x != nil {
 		return x.BatchId
 	}
-	return ""
+	return nil
 }
 
-func (x *RequestBatchMessage) GetReqId() uint64 {
+func (x *RequestTxIDsMessage) GetReqId() uint64 {
 	if x != nil {
 		return x.ReqId
 	}
 	return 0
 }
 
-type ProvideBatchMessage struct {
+type ProvideTxIDsMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Txs   [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	TxIds [][]byte `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
 	ReqId uint64   `protobuf:"varint,2,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
 }
 
-func (x *ProvideBatchMessage) Reset() {
-	*x = ProvideBatchMessage{}
+func (x *ProvideTxIDsMessage) Reset() {
+	*x = ProvideTxIDsMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[4]
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProvideBatchMessage) String() string {
+func (x *ProvideTxIDsMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProvideBatchMessage) ProtoMessage() {}
+func (*ProvideTxIDsMessage) ProtoMessage() {}
 
-func (x *ProvideBatchMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[4]
+func (x *ProvideTxIDsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,40 +483,157 @@ func (x *ProvideBatchMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProvideBatchMessage.ProtoReflect.Descriptor instead.
-func (*ProvideBatchMessage) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ProvideTxIDsMessage.ProtoReflect.Descriptor instead.
+func (*ProvideTxIDsMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ProvideBatchMessage) GetTxs() [][]byte {
+func (x *ProvideTxIDsMessage) GetTxIds() [][]byte {
 	if x != nil {
-		return x.Txs
+		return x.TxIds
+	}
+	return nil
+}
+
+func (x *ProvideTxIDsMessage) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+// RequestMissingTxsMessage is used to request the transactions of a batch that the requesting node does not have.
+type RequestMissingTxsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReqId uint64   `protobuf:"varint,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	TxIds [][]byte `protobuf:"bytes,2,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (x *RequestMissingTxsMessage) Reset() {
+	*x = RequestMissingTxsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestMissingTxsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMissingTxsMessage) ProtoMessage() {}
+
+func (x *RequestMissingTxsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMissingTxsMessage.ProtoReflect.Descriptor instead.
+func (*RequestMissingTxsMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestMissingTxsMessage) GetReqId() uint64 {
+	if x != nil {
+		return x.ReqId
+	}
+	return 0
+}
+
+func (x *RequestMissingTxsMessage) GetTxIds() [][]byte {
+	if x != nil {
+		return x.TxIds
 	}
 	return nil
 }
 
-func (x *ProvideBatchMessage) GetReqId() uint64 {
+type ProvideMissingTxsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReqId uint64   `protobuf:"varint,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Txs   [][]byte `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *ProvideMissingTxsMessage) Reset() {
+	*x = ProvideMissingTxsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvideMissingTxsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvideMissingTxsMessage) ProtoMessage() {}
+
+func (x *ProvideMissingTxsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvideMissingTxsMessage.ProtoReflect.Descriptor instead.
+func (*ProvideMissingTxsMessage) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProvideMissingTxsMessage) GetReqId() uint64 {
 	if x != nil {
 		return x.ReqId
 	}
 	return 0
 }
 
+func (x *ProvideMissingTxsMessage) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+// Cert is a compact availability certificate.
+// The signers are encoded as a bitmap relative to the membership (the AllNodes module parameter):
+// bit i (i.e., bit i%8 of byte i/8) is set if and only if the i-th node of the membership signed the batch.
+// The certificate contains either one signature per signer, ordered as the signers in the membership,
+// or (if the AggregateSignatures module parameter is set) a single threshold signature
+// combined from the signature shares of the signers.
 type Cert struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
-	// TODO: can be encoded as n bits
-	Signers    []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
-	Signatures [][]byte `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	BatchId             []byte   `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	SignerBitmap        []byte   `protobuf:"bytes,2,opt,name=signer_bitmap,json=signerBitmap,proto3" json:"signer_bitmap,omitempty"`
+	Signatures          [][]byte `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	AggregatedSignature []byte   `protobuf:"bytes,4,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
 }
 
 func (x *Cert) Reset() {
 	*x = Cert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[5]
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -375,7 +646,7 @@ func (x *Cert) String() string {
 func (*Cert) ProtoMessage() {}
 
 func (x *Cert) ProtoReflect() protoreflect.Message {
-	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[5]
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,19 +659,19 @@ func (x *Cert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cert.ProtoReflect.Descriptor instead.
 func (*Cert) Descriptor() ([]byte, []int) {
-	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{5}
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Cert) GetBatchId() string {
+func (x *Cert) GetBatchId() []byte {
 	if x != nil {
 		return x.BatchId
 	}
-	return ""
+	return nil
 }
 
-func (x *Cert) GetSigners() []string {
+func (x *Cert) GetSignerBitmap() []byte {
 	if x != nil {
-		return x.Signers
+		return x.SignerBitmap
 	}
 	return nil
 }
@@ -412,59 +683,184 @@ func (x *Cert) GetSignatures() [][]byte {
 	return nil
 }
 
+func (x *Cert) GetAggregatedSignature() []byte {
+	if x != nil {
+		return x.AggregatedSignature
+	}
+	return nil
+}
+
+// StoredBatch is a batch as persisted by the batch store of the multisig collector.
+type StoredBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId        []byte   `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	TxIds          [][]byte `protobuf:"bytes,2,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	Txs            [][]byte `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
+	RetentionIndex uint64   `protobuf:"varint,4,opt,name=retention_index,json=retentionIndex,proto3" json:"retention_index,omitempty"`
+}
+
+func (x *StoredBatch) Reset() {
+	*x = StoredBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoredBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredBatch) ProtoMessage() {}
+
+func (x *StoredBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_availabilitypb_mscpb_mscpb_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredBatch.ProtoReflect.Descriptor instead.
+func (*StoredBatch) Descriptor() ([]byte, []int) {
+	return file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StoredBatch) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *StoredBatch) GetTxIds() [][]byte {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+func (x *StoredBatch) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *StoredBatch) GetRetentionIndex() uint64 {
+	if x != nil {
+		return x.RetentionIndex
+	}
+	return 0
+}
+
 var File_availabilitypb_mscpb_mscpb_proto protoreflect.FileDescriptor
 
 var file_availabilitypb_mscpb_mscpb_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
 	0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x22, 0xb7, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67,
-	0x12, 0x34, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d,
-	0x73, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d,
-	0x73, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x50, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
-	0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x3c, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64,
-	0x22, 0x41, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x1a, 0x10, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73,
+	0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x42, 0x0c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5,
+	0x18, 0x01, 0x22, 0x41, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0xfd, 0x03, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a,
+	0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70,
+	0x62, 0x2e, 0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03,
+	0x73, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x78, 0x49, 0x64, 0x73, 0x12, 0x51, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e,
+	0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54, 0x78, 0x49,
+	0x44, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x54, 0x78, 0x49, 0x64, 0x73, 0x12, 0x60, 0x0a, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x12, 0x60, 0x0a, 0x13, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x78, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78,
+	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3c, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x15, 0x0a, 0x06,
 	0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65,
-	0x71, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x13,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x04,
-	0x43, 0x65, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e,
-	0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x71, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x78, 0x49, 0x44, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x22,
+	0x43, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54, 0x78, 0x49, 0x44, 0x73, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72,
+	0x65, 0x71, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x72, 0x65, 0x71, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x22, 0x43,
+	0x0a, 0x18, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
+	0x71, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03,
+	0x74, 0x78, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x7a, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74,
+	0x78, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x3d, 0x5a, 0x3b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f,
+	0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -479,25 +875,33 @@ func file_availabilitypb_mscpb_mscpb_proto_rawDescGZIP() []byte {
 	return file_availabilitypb_mscpb_mscpb_proto_rawDescData
 }
 
-var file_availabilitypb_mscpb_mscpb_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_availabilitypb_mscpb_mscpb_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_availabilitypb_mscpb_mscpb_proto_goTypes = []interface{}{
-	(*Message)(nil),             // 0: availabilitypb.mscpb.Message
-	(*RequestSigMessage)(nil),   // 1: availabilitypb.mscpb.RequestSigMessage
-	(*SigMessage)(nil),          // 2: availabilitypb.mscpb.SigMessage
-	(*RequestBatchMessage)(nil), // 3: availabilitypb.mscpb.RequestBatchMessage
-	(*ProvideBatchMessage)(nil), // 4: availabilitypb.mscpb.ProvideBatchMessage
-	(*Cert)(nil),                // 5: availabilitypb.mscpb.Cert
+	(*Event)(nil),                    // 0: availabilitypb.mscpb.Event
+	(*RequestTimeout)(nil),           // 1: availabilitypb.mscpb.RequestTimeout
+	(*Message)(nil),                  // 2: availabilitypb.mscpb.Message
+	(*RequestSigMessage)(nil),        // 3: availabilitypb.mscpb.RequestSigMessage
+	(*SigMessage)(nil),               // 4: availabilitypb.mscpb.SigMessage
+	(*RequestTxIDsMessage)(nil),      // 5: availabilitypb.mscpb.RequestTxIDsMessage
+	(*ProvideTxIDsMessage)(nil),      // 6: availabilitypb.mscpb.ProvideTxIDsMessage
+	(*RequestMissingTxsMessage)(nil), // 7: availabilitypb.mscpb.RequestMissingTxsMessage
+	(*ProvideMissingTxsMessage)(nil), // 8: availabilitypb.mscpb.ProvideMissingTxsMessage
+	(*Cert)(nil),                     // 9: availabilitypb.mscpb.Cert
+	(*StoredBatch)(nil),              // 10: availabilitypb.mscpb.StoredBatch
 }
 var file_availabilitypb_mscpb_mscpb_proto_depIdxs = []int32{
-	1, // 0: availabilitypb.mscpb.Message.request_sig:type_name -> availabilitypb.mscpb.RequestSigMessage
-	2, // 1: availabilitypb.mscpb.Message.sig:type_name -> availabilitypb.mscpb.SigMessage
-	3, // 2: availabilitypb.mscpb.Message.request_batch:type_name -> availabilitypb.mscpb.RequestBatchMessage
-	4, // 3: availabilitypb.mscpb.Message.provide_batch:type_name -> availabilitypb.mscpb.ProvideBatchMessage
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1, // 0: availabilitypb.mscpb.Event.request_timeout:type_name -> availabilitypb.mscpb.RequestTimeout
+	3, // 1: availabilitypb.mscpb.Message.request_sig:type_name -> availabilitypb.mscpb.RequestSigMessage
+	4, // 2: availabilitypb.mscpb.Message.sig:type_name -> availabilitypb.mscpb.SigMessage
+	5, // 3: availabilitypb.mscpb.Message.request_tx_ids:type_name -> availabilitypb.mscpb.RequestTxIDsMessage
+	6, // 4: availabilitypb.mscpb.Message.provide_tx_ids:type_name -> availabilitypb.mscpb.ProvideTxIDsMessage
+	7, // 5: availabilitypb.mscpb.Message.request_missing_txs:type_name -> availabilitypb.mscpb.RequestMissingTxsMessage
+	8, // 6: availabilitypb.mscpb.Message.provide_missing_txs:type_name -> availabilitypb.mscpb.ProvideMissingTxsMessage
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_availabilitypb_mscpb_mscpb_proto_init() }
@@ -507,7 +911,7 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_availabilitypb_mscpb_mscpb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -519,7 +923,7 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 			}
 		}
 		file_availabilitypb_mscpb_mscpb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestSigMessage); i {
+			switch v := v.(*RequestTimeout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -531,7 +935,7 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 			}
 		}
 		file_availabilitypb_mscpb_mscpb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigMessage); i {
+			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -543,7 +947,7 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 			}
 		}
 		file_availabilitypb_mscpb_mscpb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestBatchMessage); i {
+			switch v := v.(*RequestSigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -555,7 +959,7 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 			}
 		}
 		file_availabilitypb_mscpb_mscpb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProvideBatchMessage); i {
+			switch v := v.(*SigMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -567,6 +971,54 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 			}
 		}
 		file_availabilitypb_mscpb_mscpb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestTxIDsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_mscpb_mscpb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvideTxIDsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_mscpb_mscpb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestMissingTxsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_mscpb_mscpb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvideMissingTxsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_availabilitypb_mscpb_mscpb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Cert); i {
 			case 0:
 				return &v.state
@@ -578,12 +1030,29 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 				return nil
 			}
 		}
+		file_availabilitypb_mscpb_mscpb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoredBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_availabilitypb_mscpb_mscpb_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_RequestTimeout)(nil),
+	}
+	file_availabilitypb_mscpb_mscpb_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*Message_RequestSig)(nil),
 		(*Message_Sig)(nil),
-		(*Message_RequestBatch)(nil),
-		(*Message_ProvideBatch)(nil),
+		(*Message_RequestTxIds)(nil),
+		(*Message_ProvideTxIds)(nil),
+		(*Message_RequestMissingTxs)(nil),
+		(*Message_ProvideMissingTxs)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -591,7 +1060,7 @@ func file_availabilitypb_mscpb_mscpb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_availabilitypb_mscpb_mscpb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
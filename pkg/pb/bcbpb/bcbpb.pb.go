@@ -115,6 +115,62 @@ func (x *Deliver) GetData() []byte {
 	return nil
 }
 
+// InstanceDeliver is emitted by the BCB manager when an instance, identified by instance_id, delivers data.
+type InstanceDeliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId *InstanceID `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Data       []byte      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InstanceDeliver) Reset() {
+	*x = InstanceDeliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceDeliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceDeliver) ProtoMessage() {}
+
+func (x *InstanceDeliver) ProtoReflect() protoreflect.Message {
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceDeliver.ProtoReflect.Descriptor instead.
+func (*InstanceDeliver) Descriptor() ([]byte, []int) {
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InstanceDeliver) GetInstanceId() *InstanceID {
+	if x != nil {
+		return x.InstanceId
+	}
+	return nil
+}
+
+func (x *InstanceDeliver) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -123,13 +179,14 @@ type Event struct {
 	// Types that are assignable to Type:
 	//	*Event_Request
 	//	*Event_Deliver
+	//	*Event_InstanceDeliver
 	Type isEvent_Type `protobuf_oneof:"type"`
 }
 
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bcbpb_bcbpb_proto_msgTypes[2]
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -142,7 +199,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_bcbpb_bcbpb_proto_msgTypes[2]
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +212,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{2}
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{3}
 }
 
 func (m *Event) GetType() isEvent_Type {
@@ -179,6 +236,13 @@ func (x *Event) GetDeliver() *Deliver {
 	return nil
 }
 
+func (x *Event) GetInstanceDeliver() *InstanceDeliver {
+	if x, ok := x.GetType().(*Event_InstanceDeliver); ok {
+		return x.InstanceDeliver
+	}
+	return nil
+}
+
 type isEvent_Type interface {
 	isEvent_Type()
 }
@@ -191,10 +255,16 @@ type Event_Deliver struct {
 	Deliver *Deliver `protobuf:"bytes,2,opt,name=deliver,proto3,oneof"`
 }
 
+type Event_InstanceDeliver struct {
+	InstanceDeliver *InstanceDeliver `protobuf:"bytes,3,opt,name=instance_deliver,json=instanceDeliver,proto3,oneof"`
+}
+
 func (*Event_Request) isEvent_Type() {}
 
 func (*Event_Deliver) isEvent_Type() {}
 
+func (*Event_InstanceDeliver) isEvent_Type() {}
+
 type Message struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -204,13 +274,14 @@ type Message struct {
 	//	*Message_StartMessage
 	//	*Message_EchoMessage
 	//	*Message_FinalMessage
+	//	*Message_InstanceMessage
 	Type isMessage_Type `protobuf_oneof:"type"`
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bcbpb_bcbpb_proto_msgTypes[3]
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -223,7 +294,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_bcbpb_bcbpb_proto_msgTypes[3]
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +307,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{3}
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{4}
 }
 
 func (m *Message) GetType() isMessage_Type {
@@ -267,6 +338,13 @@ func (x *Message) GetFinalMessage() *FinalMessage {
 	return nil
 }
 
+func (x *Message) GetInstanceMessage() *InstanceMessage {
+	if x, ok := x.GetType().(*Message_InstanceMessage); ok {
+		return x.InstanceMessage
+	}
+	return nil
+}
+
 type isMessage_Type interface {
 	isMessage_Type()
 }
@@ -283,12 +361,18 @@ type Message_FinalMessage struct {
 	FinalMessage *FinalMessage `protobuf:"bytes,3,opt,name=final_message,json=finalMessage,proto3,oneof"`
 }
 
+type Message_InstanceMessage struct {
+	InstanceMessage *InstanceMessage `protobuf:"bytes,4,opt,name=instance_message,json=instanceMessage,proto3,oneof"`
+}
+
 func (*Message_StartMessage) isMessage_Type() {}
 
 func (*Message_EchoMessage) isMessage_Type() {}
 
 func (*Message_FinalMessage) isMessage_Type() {}
 
+func (*Message_InstanceMessage) isMessage_Type() {}
+
 type StartMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -300,7 +384,7 @@ type StartMessage struct {
 func (x *StartMessage) Reset() {
 	*x = StartMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bcbpb_bcbpb_proto_msgTypes[4]
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -313,7 +397,7 @@ func (x *StartMessage) String() string {
 func (*StartMessage) ProtoMessage() {}
 
 func (x *StartMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_bcbpb_bcbpb_proto_msgTypes[4]
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +410,7 @@ func (x *StartMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartMessage.ProtoReflect.Descriptor instead.
 func (*StartMessage) Descriptor() ([]byte, []int) {
-	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{4}
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *StartMessage) GetData() []byte {
@@ -347,7 +431,7 @@ type EchoMessage struct {
 func (x *EchoMessage) Reset() {
 	*x = EchoMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bcbpb_bcbpb_proto_msgTypes[5]
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -360,7 +444,7 @@ func (x *EchoMessage) String() string {
 func (*EchoMessage) ProtoMessage() {}
 
 func (x *EchoMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_bcbpb_bcbpb_proto_msgTypes[5]
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +457,7 @@ func (x *EchoMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EchoMessage.ProtoReflect.Descriptor instead.
 func (*EchoMessage) Descriptor() ([]byte, []int) {
-	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{5}
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EchoMessage) GetSignature() []byte {
@@ -396,7 +480,7 @@ type FinalMessage struct {
 func (x *FinalMessage) Reset() {
 	*x = FinalMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bcbpb_bcbpb_proto_msgTypes[6]
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -409,7 +493,7 @@ func (x *FinalMessage) String() string {
 func (*FinalMessage) ProtoMessage() {}
 
 func (x *FinalMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_bcbpb_bcbpb_proto_msgTypes[6]
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +506,7 @@ func (x *FinalMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalMessage.ProtoReflect.Descriptor instead.
 func (*FinalMessage) Descriptor() ([]byte, []int) {
-	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{6}
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FinalMessage) GetData() []byte {
@@ -446,6 +530,119 @@ func (x *FinalMessage) GetSignatures() [][]byte {
 	return nil
 }
 
+// InstanceMessage is used by the BCB manager to wrap a message of the instance identified by instance_id.
+type InstanceMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId *InstanceID `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Message    *Message    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *InstanceMessage) Reset() {
+	*x = InstanceMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMessage) ProtoMessage() {}
+
+func (x *InstanceMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMessage.ProtoReflect.Descriptor instead.
+func (*InstanceMessage) Descriptor() ([]byte, []int) {
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InstanceMessage) GetInstanceId() *InstanceID {
+	if x != nil {
+		return x.InstanceId
+	}
+	return nil
+}
+
+func (x *InstanceMessage) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// InstanceID identifies an instance of BCB hosted by the BCB manager
+// by the leader of the instance and a sequence number assigned by the leader.
+type InstanceID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leader string `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+	Sn     uint64 `protobuf:"varint,2,opt,name=sn,proto3" json:"sn,omitempty"`
+}
+
+func (x *InstanceID) Reset() {
+	*x = InstanceID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bcbpb_bcbpb_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceID) ProtoMessage() {}
+
+func (x *InstanceID) ProtoReflect() protoreflect.Message {
+	mi := &file_bcbpb_bcbpb_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceID.ProtoReflect.Descriptor instead.
+func (*InstanceID) Descriptor() ([]byte, []int) {
+	return file_bcbpb_bcbpb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstanceID) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *InstanceID) GetSn() uint64 {
+	if x != nil {
+		return x.Sn
+	}
+	return 0
+}
+
 var File_bcbpb_bcbpb_proto protoreflect.FileDescriptor
 
 var file_bcbpb_bcbpb_proto_rawDesc = []byte{
@@ -455,41 +652,66 @@ var file_bcbpb_bcbpb_proto_rawDesc = []byte{
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1d, 0x0a, 0x07, 0x44,
 	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x05, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2a, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62,
-	0x63, 0x62, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x37, 0x0a, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e,
-	0x45, 0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x65,
-	0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x66, 0x69,
-	0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x22,
-	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x2b, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x5c, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x42, 0x2e, 0x5a,
-	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65,
-	0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x63, 0x62, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x59, 0x0a, 0x0f, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb2, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62,
+	0x63, 0x62, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0x87, 0x02, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62,
+	0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b,
+	0x65, 0x63, 0x68, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5c, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x63,
+	0x62, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x63,
+	0x62, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69,
+	0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x63, 0x62, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -504,27 +726,35 @@ func file_bcbpb_bcbpb_proto_rawDescGZIP() []byte {
 	return file_bcbpb_bcbpb_proto_rawDescData
 }
 
-var file_bcbpb_bcbpb_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_bcbpb_bcbpb_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_bcbpb_bcbpb_proto_goTypes = []interface{}{
-	(*Request)(nil),      // 0: bcbpb.Request
-	(*Deliver)(nil),      // 1: bcbpb.Deliver
-	(*Event)(nil),        // 2: bcbpb.Event
-	(*Message)(nil),      // 3: bcbpb.Message
-	(*StartMessage)(nil), // 4: bcbpb.StartMessage
-	(*EchoMessage)(nil),  // 5: bcbpb.EchoMessage
-	(*FinalMessage)(nil), // 6: bcbpb.FinalMessage
+	(*Request)(nil),         // 0: bcbpb.Request
+	(*Deliver)(nil),         // 1: bcbpb.Deliver
+	(*InstanceDeliver)(nil), // 2: bcbpb.InstanceDeliver
+	(*Event)(nil),           // 3: bcbpb.Event
+	(*Message)(nil),         // 4: bcbpb.Message
+	(*StartMessage)(nil),    // 5: bcbpb.StartMessage
+	(*EchoMessage)(nil),     // 6: bcbpb.EchoMessage
+	(*FinalMessage)(nil),    // 7: bcbpb.FinalMessage
+	(*InstanceMessage)(nil), // 8: bcbpb.InstanceMessage
+	(*InstanceID)(nil),      // 9: bcbpb.InstanceID
 }
 var file_bcbpb_bcbpb_proto_depIdxs = []int32{
-	0, // 0: bcbpb.Event.request:type_name -> bcbpb.Request
-	1, // 1: bcbpb.Event.deliver:type_name -> bcbpb.Deliver
-	4, // 2: bcbpb.Message.start_message:type_name -> bcbpb.StartMessage
-	5, // 3: bcbpb.Message.echo_message:type_name -> bcbpb.EchoMessage
-	6, // 4: bcbpb.Message.final_message:type_name -> bcbpb.FinalMessage
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	9,  // 0: bcbpb.InstanceDeliver.instance_id:type_name -> bcbpb.InstanceID
+	0,  // 1: bcbpb.Event.request:type_name -> bcbpb.Request
+	1,  // 2: bcbpb.Event.deliver:type_name -> bcbpb.Deliver
+	2,  // 3: bcbpb.Event.instance_deliver:type_name -> bcbpb.InstanceDeliver
+	5,  // 4: bcbpb.Message.start_message:type_name -> bcbpb.StartMessage
+	6,  // 5: bcbpb.Message.echo_message:type_name -> bcbpb.EchoMessage
+	7,  // 6: bcbpb.Message.final_message:type_name -> bcbpb.FinalMessage
+	8,  // 7: bcbpb.Message.instance_message:type_name -> bcbpb.InstanceMessage
+	9,  // 8: bcbpb.InstanceMessage.instance_id:type_name -> bcbpb.InstanceID
+	4,  // 9: bcbpb.InstanceMessage.message:type_name -> bcbpb.Message
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_bcbpb_bcbpb_proto_init() }
@@ -558,7 +788,7 @@ func file_bcbpb_bcbpb_proto_init() {
 			}
 		}
 		file_bcbpb_bcbpb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+			switch v := v.(*InstanceDeliver); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -570,7 +800,7 @@ func file_bcbpb_bcbpb_proto_init() {
 			}
 		}
 		file_bcbpb_bcbpb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -582,7 +812,7 @@ func file_bcbpb_bcbpb_proto_init() {
 			}
 		}
 		file_bcbpb_bcbpb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartMessage); i {
+			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -594,7 +824,7 @@ func file_bcbpb_bcbpb_proto_init() {
 			}
 		}
 		file_bcbpb_bcbpb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoMessage); i {
+			switch v := v.(*StartMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -606,6 +836,18 @@ func file_bcbpb_bcbpb_proto_init() {
 			}
 		}
 		file_bcbpb_bcbpb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bcbpb_bcbpb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FinalMessage); i {
 			case 0:
 				return &v.state
@@ -617,15 +859,41 @@ func file_bcbpb_bcbpb_proto_init() {
 				return nil
 			}
 		}
+		file_bcbpb_bcbpb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bcbpb_bcbpb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_bcbpb_bcbpb_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_bcbpb_bcbpb_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*Event_Request)(nil),
 		(*Event_Deliver)(nil),
+		(*Event_InstanceDeliver)(nil),
 	}
-	file_bcbpb_bcbpb_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_bcbpb_bcbpb_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*Message_StartMessage)(nil),
 		(*Message_EchoMessage)(nil),
 		(*Message_FinalMessage)(nil),
+		(*Message_InstanceMessage)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -633,7 +901,7 @@ func file_bcbpb_bcbpb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bcbpb_bcbpb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
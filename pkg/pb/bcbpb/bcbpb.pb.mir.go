@@ -14,3 +14,7 @@ func (p *Event_Request) Unwrap() *Request {
 func (p *Event_Deliver) Unwrap() *Deliver {
 	return p.Deliver
 }
+
+func (p *Event_InstanceDeliver) Unwrap() *InstanceDeliver {
+	return p.InstanceDeliver
+}
@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: commitlogpb/commitlogpb.proto
+
+package commitlogpb
+
+import (
+	availabilitypb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	_ "github.com/filecoin-project/mir/pkg/pb/mir"
+	requestpb "github.com/filecoin-project/mir/pkg/pb/requestpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Event_Entry
+	//	*Event_StableCheckpoint
+	Type isEvent_Type `protobuf_oneof:"Type"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commitlogpb_commitlogpb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_commitlogpb_commitlogpb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_commitlogpb_commitlogpb_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Event) GetType() isEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Event) GetEntry() *Entry {
+	if x, ok := x.GetType().(*Event_Entry); ok {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *Event) GetStableCheckpoint() *StableCheckpoint {
+	if x, ok := x.GetType().(*Event_StableCheckpoint); ok {
+		return x.StableCheckpoint
+	}
+	return nil
+}
+
+type isEvent_Type interface {
+	isEvent_Type()
+}
+
+type Event_Entry struct {
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3,oneof"`
+}
+
+type Event_StableCheckpoint struct {
+	StableCheckpoint *StableCheckpoint `protobuf:"bytes,2,opt,name=stable_checkpoint,json=stableCheckpoint,proto3,oneof"`
+}
+
+func (*Event_Entry) isEvent_Type() {}
+
+func (*Event_StableCheckpoint) isEvent_Type() {}
+
+// StableCheckpoint is used by the ordering protocol to announce a new stable checkpoint to the commit log.
+// Entries ordered before the checkpoint are not needed by the protocol any more and the commit log may prune them.
+type StableCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Sn    uint64 `protobuf:"varint,2,opt,name=sn,proto3" json:"sn,omitempty"`
+}
+
+func (x *StableCheckpoint) Reset() {
+	*x = StableCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commitlogpb_commitlogpb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StableCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StableCheckpoint) ProtoMessage() {}
+
+func (x *StableCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_commitlogpb_commitlogpb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StableCheckpoint.ProtoReflect.Descriptor instead.
+func (*StableCheckpoint) Descriptor() ([]byte, []int) {
+	return file_commitlogpb_commitlogpb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StableCheckpoint) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *StableCheckpoint) GetSn() uint64 {
+	if x != nil {
+		return x.Sn
+	}
+	return 0
+}
+
+// Entry is an entry of the commit log. It is also used as an event by which the ordering protocol
+// announces a newly delivered entry to the commit log.
+type Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sn      uint64               `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	Epoch   uint64               `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Batch   *requestpb.Batch     `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
+	Digest  []byte               `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	Aborted bool                 `protobuf:"varint,5,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Suspect string               `protobuf:"bytes,6,opt,name=suspect,proto3" json:"suspect,omitempty"`
+	Cert    *availabilitypb.Cert `protobuf:"bytes,7,opt,name=cert,proto3" json:"cert,omitempty"`
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commitlogpb_commitlogpb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_commitlogpb_commitlogpb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_commitlogpb_commitlogpb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Entry) GetSn() uint64 {
+	if x != nil {
+		return x.Sn
+	}
+	return 0
+}
+
+func (x *Entry) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Entry) GetBatch() *requestpb.Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *Entry) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *Entry) GetAborted() bool {
+	if x != nil {
+		return x.Aborted
+	}
+	return false
+}
+
+func (x *Entry) GetSuspect() string {
+	if x != nil {
+		return x.Suspect
+	}
+	return ""
+}
+
+func (x *Entry) GetCert() *availabilitypb.Cert {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+var File_commitlogpb_commitlogpb_proto protoreflect.FileDescriptor
+
+var file_commitlogpb_commitlogpb_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x1a, 0x19, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x6d, 0x69,
+	0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f,
+	0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01,
+	0x22, 0x38, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x05, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x73, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x28,
+	0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_commitlogpb_commitlogpb_proto_rawDescOnce sync.Once
+	file_commitlogpb_commitlogpb_proto_rawDescData = file_commitlogpb_commitlogpb_proto_rawDesc
+)
+
+func file_commitlogpb_commitlogpb_proto_rawDescGZIP() []byte {
+	file_commitlogpb_commitlogpb_proto_rawDescOnce.Do(func() {
+		file_commitlogpb_commitlogpb_proto_rawDescData = protoimpl.X.CompressGZIP(file_commitlogpb_commitlogpb_proto_rawDescData)
+	})
+	return file_commitlogpb_commitlogpb_proto_rawDescData
+}
+
+var file_commitlogpb_commitlogpb_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_commitlogpb_commitlogpb_proto_goTypes = []interface{}{
+	(*Event)(nil),               // 0: commitlogpb.Event
+	(*StableCheckpoint)(nil),    // 1: commitlogpb.StableCheckpoint
+	(*Entry)(nil),               // 2: commitlogpb.Entry
+	(*requestpb.Batch)(nil),     // 3: requestpb.Batch
+	(*availabilitypb.Cert)(nil), // 4: availabilitypb.Cert
+}
+var file_commitlogpb_commitlogpb_proto_depIdxs = []int32{
+	2, // 0: commitlogpb.Event.entry:type_name -> commitlogpb.Entry
+	1, // 1: commitlogpb.Event.stable_checkpoint:type_name -> commitlogpb.StableCheckpoint
+	3, // 2: commitlogpb.Entry.batch:type_name -> requestpb.Batch
+	4, // 3: commitlogpb.Entry.cert:type_name -> availabilitypb.Cert
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_commitlogpb_commitlogpb_proto_init() }
+func file_commitlogpb_commitlogpb_proto_init() {
+	if File_commitlogpb_commitlogpb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_commitlogpb_commitlogpb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commitlogpb_commitlogpb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StableCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commitlogpb_commitlogpb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_commitlogpb_commitlogpb_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_Entry)(nil),
+		(*Event_StableCheckpoint)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_commitlogpb_commitlogpb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_commitlogpb_commitlogpb_proto_goTypes,
+		DependencyIndexes: file_commitlogpb_commitlogpb_proto_depIdxs,
+		MessageInfos:      file_commitlogpb_commitlogpb_proto_msgTypes,
+	}.Build()
+	File_commitlogpb_commitlogpb_proto = out.File
+	file_commitlogpb_commitlogpb_proto_rawDesc = nil
+	file_commitlogpb_commitlogpb_proto_goTypes = nil
+	file_commitlogpb_commitlogpb_proto_depIdxs = nil
+}
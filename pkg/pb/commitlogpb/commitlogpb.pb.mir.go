@@ -0,0 +1,16 @@
+package commitlogpb
+
+type Event_Type = isEvent_Type
+
+type Event_TypeWrapper[Ev any] interface {
+	Event_Type
+	Unwrap() *Ev
+}
+
+func (p *Event_Entry) Unwrap() *Entry {
+	return p.Entry
+}
+
+func (p *Event_StableCheckpoint) Unwrap() *StableCheckpoint {
+	return p.StableCheckpoint
+}
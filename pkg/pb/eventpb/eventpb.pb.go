@@ -12,8 +12,10 @@
 package eventpb
 
 import (
+	abbapb "github.com/filecoin-project/mir/pkg/pb/abbapb"
 	availabilitypb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	bcbpb "github.com/filecoin-project/mir/pkg/pb/bcbpb"
+	commitlogpb "github.com/filecoin-project/mir/pkg/pb/commitlogpb"
 	commonpb "github.com/filecoin-project/mir/pkg/pb/commonpb"
 	contextstorepb "github.com/filecoin-project/mir/pkg/pb/contextstorepb"
 	dslpb "github.com/filecoin-project/mir/pkg/pb/dslpb"
@@ -21,7 +23,9 @@ import (
 	mempoolpb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
 	messagepb "github.com/filecoin-project/mir/pkg/pb/messagepb"
 	_ "github.com/filecoin-project/mir/pkg/pb/mir"
+	rbcpb "github.com/filecoin-project/mir/pkg/pb/rbcpb"
 	requestpb "github.com/filecoin-project/mir/pkg/pb/requestpb"
+	threshcryptopb "github.com/filecoin-project/mir/pkg/pb/threshcryptopb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -75,6 +79,18 @@ type Event struct {
 	//	*Event_Mempool
 	//	*Event_Availability
 	//	*Event_NewConfig
+	//	*Event_RequestRejected
+	//	*Event_CommitLog
+	//	*Event_ComputeAuthenticator
+	//	*Event_AuthenticatorComputed
+	//	*Event_VerifyAuthenticators
+	//	*Event_AuthenticatorsVerified
+	//	*Event_Rbc
+	//	*Event_Abba
+	//	*Event_Threshcrypto
+	//	*Event_RegisterKey
+	//	*Event_RevokeKey
+	//	*Event_SetKeyEpoch
 	//	*Event_TestingString
 	//	*Event_TestingUint
 	Type isEvent_Type `protobuf_oneof:"type"`
@@ -337,6 +353,90 @@ func (x *Event) GetNewConfig() *NewConfig {
 	return nil
 }
 
+func (x *Event) GetRequestRejected() *requestpb.RequestRejected {
+	if x, ok := x.GetType().(*Event_RequestRejected); ok {
+		return x.RequestRejected
+	}
+	return nil
+}
+
+func (x *Event) GetCommitLog() *commitlogpb.Event {
+	if x, ok := x.GetType().(*Event_CommitLog); ok {
+		return x.CommitLog
+	}
+	return nil
+}
+
+func (x *Event) GetComputeAuthenticator() *ComputeAuthenticator {
+	if x, ok := x.GetType().(*Event_ComputeAuthenticator); ok {
+		return x.ComputeAuthenticator
+	}
+	return nil
+}
+
+func (x *Event) GetAuthenticatorComputed() *AuthenticatorComputed {
+	if x, ok := x.GetType().(*Event_AuthenticatorComputed); ok {
+		return x.AuthenticatorComputed
+	}
+	return nil
+}
+
+func (x *Event) GetVerifyAuthenticators() *VerifyAuthenticators {
+	if x, ok := x.GetType().(*Event_VerifyAuthenticators); ok {
+		return x.VerifyAuthenticators
+	}
+	return nil
+}
+
+func (x *Event) GetAuthenticatorsVerified() *AuthenticatorsVerified {
+	if x, ok := x.GetType().(*Event_AuthenticatorsVerified); ok {
+		return x.AuthenticatorsVerified
+	}
+	return nil
+}
+
+func (x *Event) GetRbc() *rbcpb.Event {
+	if x, ok := x.GetType().(*Event_Rbc); ok {
+		return x.Rbc
+	}
+	return nil
+}
+
+func (x *Event) GetAbba() *abbapb.Event {
+	if x, ok := x.GetType().(*Event_Abba); ok {
+		return x.Abba
+	}
+	return nil
+}
+
+func (x *Event) GetThreshcrypto() *threshcryptopb.Event {
+	if x, ok := x.GetType().(*Event_Threshcrypto); ok {
+		return x.Threshcrypto
+	}
+	return nil
+}
+
+func (x *Event) GetRegisterKey() *RegisterKey {
+	if x, ok := x.GetType().(*Event_RegisterKey); ok {
+		return x.RegisterKey
+	}
+	return nil
+}
+
+func (x *Event) GetRevokeKey() *RevokeKey {
+	if x, ok := x.GetType().(*Event_RevokeKey); ok {
+		return x.RevokeKey
+	}
+	return nil
+}
+
+func (x *Event) GetSetKeyEpoch() *SetKeyEpoch {
+	if x, ok := x.GetType().(*Event_SetKeyEpoch); ok {
+		return x.SetKeyEpoch
+	}
+	return nil
+}
+
 func (x *Event) GetTestingString() *wrapperspb.StringValue {
 	if x, ok := x.GetType().(*Event_TestingString); ok {
 		return x.TestingString
@@ -489,6 +589,54 @@ type Event_NewConfig struct {
 	NewConfig *NewConfig `protobuf:"bytes,31,opt,name=new_config,json=newConfig,proto3,oneof"`
 }
 
+type Event_RequestRejected struct {
+	RequestRejected *requestpb.RequestRejected `protobuf:"bytes,32,opt,name=request_rejected,json=requestRejected,proto3,oneof"`
+}
+
+type Event_CommitLog struct {
+	CommitLog *commitlogpb.Event `protobuf:"bytes,33,opt,name=commit_log,json=commitLog,proto3,oneof"`
+}
+
+type Event_ComputeAuthenticator struct {
+	ComputeAuthenticator *ComputeAuthenticator `protobuf:"bytes,34,opt,name=compute_authenticator,json=computeAuthenticator,proto3,oneof"`
+}
+
+type Event_AuthenticatorComputed struct {
+	AuthenticatorComputed *AuthenticatorComputed `protobuf:"bytes,35,opt,name=authenticator_computed,json=authenticatorComputed,proto3,oneof"`
+}
+
+type Event_VerifyAuthenticators struct {
+	VerifyAuthenticators *VerifyAuthenticators `protobuf:"bytes,36,opt,name=verify_authenticators,json=verifyAuthenticators,proto3,oneof"`
+}
+
+type Event_AuthenticatorsVerified struct {
+	AuthenticatorsVerified *AuthenticatorsVerified `protobuf:"bytes,37,opt,name=authenticators_verified,json=authenticatorsVerified,proto3,oneof"`
+}
+
+type Event_Rbc struct {
+	Rbc *rbcpb.Event `protobuf:"bytes,38,opt,name=rbc,proto3,oneof"`
+}
+
+type Event_Abba struct {
+	Abba *abbapb.Event `protobuf:"bytes,39,opt,name=abba,proto3,oneof"`
+}
+
+type Event_Threshcrypto struct {
+	Threshcrypto *threshcryptopb.Event `protobuf:"bytes,40,opt,name=threshcrypto,proto3,oneof"`
+}
+
+type Event_RegisterKey struct {
+	RegisterKey *RegisterKey `protobuf:"bytes,41,opt,name=register_key,json=registerKey,proto3,oneof"`
+}
+
+type Event_RevokeKey struct {
+	RevokeKey *RevokeKey `protobuf:"bytes,42,opt,name=revoke_key,json=revokeKey,proto3,oneof"`
+}
+
+type Event_SetKeyEpoch struct {
+	SetKeyEpoch *SetKeyEpoch `protobuf:"bytes,43,opt,name=set_key_epoch,json=setKeyEpoch,proto3,oneof"`
+}
+
 type Event_TestingString struct {
 	// for unit-tests
 	TestingString *wrapperspb.StringValue `protobuf:"bytes,301,opt,name=testingString,proto3,oneof"`
@@ -558,6 +706,30 @@ func (*Event_Availability) isEvent_Type() {}
 
 func (*Event_NewConfig) isEvent_Type() {}
 
+func (*Event_RequestRejected) isEvent_Type() {}
+
+func (*Event_CommitLog) isEvent_Type() {}
+
+func (*Event_ComputeAuthenticator) isEvent_Type() {}
+
+func (*Event_AuthenticatorComputed) isEvent_Type() {}
+
+func (*Event_VerifyAuthenticators) isEvent_Type() {}
+
+func (*Event_AuthenticatorsVerified) isEvent_Type() {}
+
+func (*Event_Rbc) isEvent_Type() {}
+
+func (*Event_Abba) isEvent_Type() {}
+
+func (*Event_Threshcrypto) isEvent_Type() {}
+
+func (*Event_RegisterKey) isEvent_Type() {}
+
+func (*Event_RevokeKey) isEvent_Type() {}
+
+func (*Event_SetKeyEpoch) isEvent_Type() {}
+
 func (*Event_TestingString) isEvent_Type() {}
 
 func (*Event_TestingUint) isEvent_Type() {}
@@ -1376,51 +1548,671 @@ func (m *SigVerOrigin) GetType() isSigVerOrigin_Type {
 	if m != nil {
 		return m.Type
 	}
-	return nil
+	return nil
+}
+
+func (x *SigVerOrigin) GetContextStore() *contextstorepb.Origin {
+	if x, ok := x.GetType().(*SigVerOrigin_ContextStore); ok {
+		return x.ContextStore
+	}
+	return nil
+}
+
+func (x *SigVerOrigin) GetIss() *isspb.ISSSigVerOrigin {
+	if x, ok := x.GetType().(*SigVerOrigin_Iss); ok {
+		return x.Iss
+	}
+	return nil
+}
+
+func (x *SigVerOrigin) GetDsl() *dslpb.Origin {
+	if x, ok := x.GetType().(*SigVerOrigin_Dsl); ok {
+		return x.Dsl
+	}
+	return nil
+}
+
+type isSigVerOrigin_Type interface {
+	isSigVerOrigin_Type()
+}
+
+type SigVerOrigin_ContextStore struct {
+	ContextStore *contextstorepb.Origin `protobuf:"bytes,2,opt,name=context_store,json=contextStore,proto3,oneof"`
+}
+
+type SigVerOrigin_Iss struct {
+	Iss *isspb.ISSSigVerOrigin `protobuf:"bytes,3,opt,name=iss,proto3,oneof"`
+}
+
+type SigVerOrigin_Dsl struct {
+	Dsl *dslpb.Origin `protobuf:"bytes,4,opt,name=dsl,proto3,oneof"`
+}
+
+func (*SigVerOrigin_ContextStore) isSigVerOrigin_Type() {}
+
+func (*SigVerOrigin_Iss) isSigVerOrigin_Type() {}
+
+func (*SigVerOrigin_Dsl) isSigVerOrigin_Type() {}
+
+type ComputeAuthenticator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    [][]byte    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NodeIds []string    `protobuf:"bytes,2,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Origin  *AuthOrigin `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *ComputeAuthenticator) Reset() {
+	*x = ComputeAuthenticator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeAuthenticator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeAuthenticator) ProtoMessage() {}
+
+func (x *ComputeAuthenticator) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeAuthenticator.ProtoReflect.Descriptor instead.
+func (*ComputeAuthenticator) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ComputeAuthenticator) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ComputeAuthenticator) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *ComputeAuthenticator) GetOrigin() *AuthOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+type AuthenticatorComputed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authenticator *commonpb.Authenticator `protobuf:"bytes,1,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+	Origin        *AuthOrigin             `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *AuthenticatorComputed) Reset() {
+	*x = AuthenticatorComputed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticatorComputed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticatorComputed) ProtoMessage() {}
+
+func (x *AuthenticatorComputed) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticatorComputed.ProtoReflect.Descriptor instead.
+func (*AuthenticatorComputed) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuthenticatorComputed) GetAuthenticator() *commonpb.Authenticator {
+	if x != nil {
+		return x.Authenticator
+	}
+	return nil
+}
+
+func (x *AuthenticatorComputed) GetOrigin() *AuthOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+type VerifyAuthenticators struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    []*SigVerData `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Macs    [][]byte      `protobuf:"bytes,2,rep,name=macs,proto3" json:"macs,omitempty"`
+	NodeIds []string      `protobuf:"bytes,3,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Origin  *AuthOrigin   `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *VerifyAuthenticators) Reset() {
+	*x = VerifyAuthenticators{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAuthenticators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuthenticators) ProtoMessage() {}
+
+func (x *VerifyAuthenticators) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuthenticators.ProtoReflect.Descriptor instead.
+func (*VerifyAuthenticators) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyAuthenticators) GetData() []*SigVerData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *VerifyAuthenticators) GetMacs() [][]byte {
+	if x != nil {
+		return x.Macs
+	}
+	return nil
+}
+
+func (x *VerifyAuthenticators) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *VerifyAuthenticators) GetOrigin() *AuthOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+type AuthenticatorsVerified struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin  *AuthOrigin `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	NodeIds []string    `protobuf:"bytes,2,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	Valid   []bool      `protobuf:"varint,3,rep,packed,name=valid,proto3" json:"valid,omitempty"`
+	Errors  []string    `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	AllOk   bool        `protobuf:"varint,5,opt,name=all_ok,json=allOk,proto3" json:"all_ok,omitempty"`
+}
+
+func (x *AuthenticatorsVerified) Reset() {
+	*x = AuthenticatorsVerified{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticatorsVerified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticatorsVerified) ProtoMessage() {}
+
+func (x *AuthenticatorsVerified) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticatorsVerified.ProtoReflect.Descriptor instead.
+func (*AuthenticatorsVerified) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuthenticatorsVerified) GetOrigin() *AuthOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *AuthenticatorsVerified) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+func (x *AuthenticatorsVerified) GetValid() []bool {
+	if x != nil {
+		return x.Valid
+	}
+	return nil
+}
+
+func (x *AuthenticatorsVerified) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *AuthenticatorsVerified) GetAllOk() bool {
+	if x != nil {
+		return x.AllOk
+	}
+	return false
+}
+
+type AuthOrigin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Types that are assignable to Type:
+	//	*AuthOrigin_ContextStore
+	//	*AuthOrigin_Iss
+	//	*AuthOrigin_Dsl
+	Type isAuthOrigin_Type `protobuf_oneof:"type"`
+}
+
+func (x *AuthOrigin) Reset() {
+	*x = AuthOrigin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthOrigin) ProtoMessage() {}
+
+func (x *AuthOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthOrigin.ProtoReflect.Descriptor instead.
+func (*AuthOrigin) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AuthOrigin) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (m *AuthOrigin) GetType() isAuthOrigin_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *AuthOrigin) GetContextStore() *contextstorepb.Origin {
+	if x, ok := x.GetType().(*AuthOrigin_ContextStore); ok {
+		return x.ContextStore
+	}
+	return nil
+}
+
+func (x *AuthOrigin) GetIss() *isspb.ISSAuthOrigin {
+	if x, ok := x.GetType().(*AuthOrigin_Iss); ok {
+		return x.Iss
+	}
+	return nil
+}
+
+func (x *AuthOrigin) GetDsl() *dslpb.Origin {
+	if x, ok := x.GetType().(*AuthOrigin_Dsl); ok {
+		return x.Dsl
+	}
+	return nil
+}
+
+type isAuthOrigin_Type interface {
+	isAuthOrigin_Type()
+}
+
+type AuthOrigin_ContextStore struct {
+	ContextStore *contextstorepb.Origin `protobuf:"bytes,2,opt,name=context_store,json=contextStore,proto3,oneof"`
+}
+
+type AuthOrigin_Iss struct {
+	Iss *isspb.ISSAuthOrigin `protobuf:"bytes,3,opt,name=iss,proto3,oneof"`
+}
+
+type AuthOrigin_Dsl struct {
+	Dsl *dslpb.Origin `protobuf:"bytes,4,opt,name=dsl,proto3,oneof"`
+}
+
+func (*AuthOrigin_ContextStore) isAuthOrigin_Type() {}
+
+func (*AuthOrigin_Iss) isAuthOrigin_Type() {}
+
+func (*AuthOrigin_Dsl) isAuthOrigin_Type() {}
+
+// RegisterKey associates a public key with a node or a client, starting from epoch from_epoch.
+// If the owner already has a key, the new key replaces it (i.e., the key is rotated) from that epoch on.
+type RegisterKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner     *KeyOwner `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PubKey    []byte    `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	FromEpoch uint64    `protobuf:"varint,3,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+}
+
+func (x *RegisterKey) Reset() {
+	*x = RegisterKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterKey) ProtoMessage() {}
+
+func (x *RegisterKey) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterKey.ProtoReflect.Descriptor instead.
+func (*RegisterKey) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RegisterKey) GetOwner() *KeyOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *RegisterKey) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *RegisterKey) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+// RevokeKey removes the public key of a node or a client, starting from epoch from_epoch.
+type RevokeKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner     *KeyOwner `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	FromEpoch uint64    `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+}
+
+func (x *RevokeKey) Reset() {
+	*x = RevokeKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeKey) ProtoMessage() {}
+
+func (x *RevokeKey) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeKey.ProtoReflect.Descriptor instead.
+func (*RevokeKey) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RevokeKey) GetOwner() *KeyOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *RevokeKey) GetFromEpoch() uint64 {
+	if x != nil {
+		return x.FromEpoch
+	}
+	return 0
+}
+
+// SetKeyEpoch informs the crypto module about the current epoch,
+// which determines the public keys used for verifying signatures.
+type SetKeyEpoch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *SetKeyEpoch) Reset() {
+	*x = SetKeyEpoch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetKeyEpoch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKeyEpoch) ProtoMessage() {}
+
+func (x *SetKeyEpoch) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKeyEpoch.ProtoReflect.Descriptor instead.
+func (*SetKeyEpoch) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetKeyEpoch) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type KeyOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*KeyOwner_NodeId
+	//	*KeyOwner_ClientId
+	Type isKeyOwner_Type `protobuf_oneof:"type"`
+}
+
+func (x *KeyOwner) Reset() {
+	*x = KeyOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eventpb_eventpb_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyOwner) ProtoMessage() {}
+
+func (x *KeyOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_eventpb_eventpb_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *SigVerOrigin) GetContextStore() *contextstorepb.Origin {
-	if x, ok := x.GetType().(*SigVerOrigin_ContextStore); ok {
-		return x.ContextStore
-	}
-	return nil
+// Deprecated: Use KeyOwner.ProtoReflect.Descriptor instead.
+func (*KeyOwner) Descriptor() ([]byte, []int) {
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SigVerOrigin) GetIss() *isspb.ISSSigVerOrigin {
-	if x, ok := x.GetType().(*SigVerOrigin_Iss); ok {
-		return x.Iss
+func (m *KeyOwner) GetType() isKeyOwner_Type {
+	if m != nil {
+		return m.Type
 	}
 	return nil
 }
 
-func (x *SigVerOrigin) GetDsl() *dslpb.Origin {
-	if x, ok := x.GetType().(*SigVerOrigin_Dsl); ok {
-		return x.Dsl
+func (x *KeyOwner) GetNodeId() string {
+	if x, ok := x.GetType().(*KeyOwner_NodeId); ok {
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-type isSigVerOrigin_Type interface {
-	isSigVerOrigin_Type()
+func (x *KeyOwner) GetClientId() string {
+	if x, ok := x.GetType().(*KeyOwner_ClientId); ok {
+		return x.ClientId
+	}
+	return ""
 }
 
-type SigVerOrigin_ContextStore struct {
-	ContextStore *contextstorepb.Origin `protobuf:"bytes,2,opt,name=context_store,json=contextStore,proto3,oneof"`
+type isKeyOwner_Type interface {
+	isKeyOwner_Type()
 }
 
-type SigVerOrigin_Iss struct {
-	Iss *isspb.ISSSigVerOrigin `protobuf:"bytes,3,opt,name=iss,proto3,oneof"`
+type KeyOwner_NodeId struct {
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,oneof"`
 }
 
-type SigVerOrigin_Dsl struct {
-	Dsl *dslpb.Origin `protobuf:"bytes,4,opt,name=dsl,proto3,oneof"`
+type KeyOwner_ClientId struct {
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof"`
 }
 
-func (*SigVerOrigin_ContextStore) isSigVerOrigin_Type() {}
-
-func (*SigVerOrigin_Iss) isSigVerOrigin_Type() {}
+func (*KeyOwner_NodeId) isKeyOwner_Type() {}
 
-func (*SigVerOrigin_Dsl) isSigVerOrigin_Type() {}
+func (*KeyOwner_ClientId) isKeyOwner_Type() {}
 
 type RequestReady struct {
 	state         protoimpl.MessageState
@@ -1433,7 +2225,7 @@ type RequestReady struct {
 func (x *RequestReady) Reset() {
 	*x = RequestReady{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[14]
+		mi := &file_eventpb_eventpb_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1446,7 +2238,7 @@ func (x *RequestReady) String() string {
 func (*RequestReady) ProtoMessage() {}
 
 func (x *RequestReady) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[14]
+	mi := &file_eventpb_eventpb_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,7 +2251,7 @@ func (x *RequestReady) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestReady.ProtoReflect.Descriptor instead.
 func (*RequestReady) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{14}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RequestReady) GetRequest() *requestpb.Request {
@@ -1481,7 +2273,7 @@ type SendMessage struct {
 func (x *SendMessage) Reset() {
 	*x = SendMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[15]
+		mi := &file_eventpb_eventpb_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1494,7 +2286,7 @@ func (x *SendMessage) String() string {
 func (*SendMessage) ProtoMessage() {}
 
 func (x *SendMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[15]
+	mi := &file_eventpb_eventpb_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,7 +2299,7 @@ func (x *SendMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendMessage.ProtoReflect.Descriptor instead.
 func (*SendMessage) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{15}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SendMessage) GetDestinations() []string {
@@ -1536,7 +2328,7 @@ type MessageReceived struct {
 func (x *MessageReceived) Reset() {
 	*x = MessageReceived{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[16]
+		mi := &file_eventpb_eventpb_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1549,7 +2341,7 @@ func (x *MessageReceived) String() string {
 func (*MessageReceived) ProtoMessage() {}
 
 func (x *MessageReceived) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[16]
+	mi := &file_eventpb_eventpb_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,7 +2354,7 @@ func (x *MessageReceived) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageReceived.ProtoReflect.Descriptor instead.
 func (*MessageReceived) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{16}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *MessageReceived) GetFrom() string {
@@ -1591,7 +2383,7 @@ type WALAppend struct {
 func (x *WALAppend) Reset() {
 	*x = WALAppend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[17]
+		mi := &file_eventpb_eventpb_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1604,7 +2396,7 @@ func (x *WALAppend) String() string {
 func (*WALAppend) ProtoMessage() {}
 
 func (x *WALAppend) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[17]
+	mi := &file_eventpb_eventpb_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1617,7 +2409,7 @@ func (x *WALAppend) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WALAppend.ProtoReflect.Descriptor instead.
 func (*WALAppend) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{17}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WALAppend) GetEvent() *Event {
@@ -1645,7 +2437,7 @@ type WALEntry struct {
 func (x *WALEntry) Reset() {
 	*x = WALEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[18]
+		mi := &file_eventpb_eventpb_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1658,7 +2450,7 @@ func (x *WALEntry) String() string {
 func (*WALEntry) ProtoMessage() {}
 
 func (x *WALEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[18]
+	mi := &file_eventpb_eventpb_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,7 +2463,7 @@ func (x *WALEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WALEntry.ProtoReflect.Descriptor instead.
 func (*WALEntry) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{18}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *WALEntry) GetEvent() *Event {
@@ -1692,7 +2484,7 @@ type WALTruncate struct {
 func (x *WALTruncate) Reset() {
 	*x = WALTruncate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[19]
+		mi := &file_eventpb_eventpb_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1705,7 +2497,7 @@ func (x *WALTruncate) String() string {
 func (*WALTruncate) ProtoMessage() {}
 
 func (x *WALTruncate) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[19]
+	mi := &file_eventpb_eventpb_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,7 +2510,7 @@ func (x *WALTruncate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WALTruncate.ProtoReflect.Descriptor instead.
 func (*WALTruncate) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{19}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *WALTruncate) GetRetentionIndex() uint64 {
@@ -1737,7 +2529,7 @@ type WALLoadAll struct {
 func (x *WALLoadAll) Reset() {
 	*x = WALLoadAll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[20]
+		mi := &file_eventpb_eventpb_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1750,7 +2542,7 @@ func (x *WALLoadAll) String() string {
 func (*WALLoadAll) ProtoMessage() {}
 
 func (x *WALLoadAll) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[20]
+	mi := &file_eventpb_eventpb_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,7 +2555,7 @@ func (x *WALLoadAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WALLoadAll.ProtoReflect.Descriptor instead.
 func (*WALLoadAll) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{20}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{29}
 }
 
 type Deliver struct {
@@ -1778,7 +2570,7 @@ type Deliver struct {
 func (x *Deliver) Reset() {
 	*x = Deliver{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[21]
+		mi := &file_eventpb_eventpb_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1791,7 +2583,7 @@ func (x *Deliver) String() string {
 func (*Deliver) ProtoMessage() {}
 
 func (x *Deliver) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[21]
+	mi := &file_eventpb_eventpb_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,7 +2596,7 @@ func (x *Deliver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Deliver.ProtoReflect.Descriptor instead.
 func (*Deliver) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{21}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Deliver) GetSn() uint64 {
@@ -1821,6 +2613,8 @@ func (x *Deliver) GetBatch() *requestpb.Batch {
 	return nil
 }
 
+// VerifyRequestSig requests the verification of the signature of a request
+// using the public key registered for the client that submitted the request.
 type VerifyRequestSig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1828,12 +2622,13 @@ type VerifyRequestSig struct {
 
 	Request   *requestpb.Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	Signature []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Origin    *SigVerOrigin      `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
 func (x *VerifyRequestSig) Reset() {
 	*x = VerifyRequestSig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[22]
+		mi := &file_eventpb_eventpb_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1846,7 +2641,7 @@ func (x *VerifyRequestSig) String() string {
 func (*VerifyRequestSig) ProtoMessage() {}
 
 func (x *VerifyRequestSig) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[22]
+	mi := &file_eventpb_eventpb_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,7 +2654,7 @@ func (x *VerifyRequestSig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyRequestSig.ProtoReflect.Descriptor instead.
 func (*VerifyRequestSig) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{22}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *VerifyRequestSig) GetRequest() *requestpb.Request {
@@ -1876,6 +2671,13 @@ func (x *VerifyRequestSig) GetSignature() []byte {
 	return nil
 }
 
+func (x *VerifyRequestSig) GetOrigin() *SigVerOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
 type RequestSigVerified struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1884,12 +2686,13 @@ type RequestSigVerified struct {
 	Request *requestpb.Request `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	Valid   bool               `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
 	Error   string             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Origin  *SigVerOrigin      `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
 func (x *RequestSigVerified) Reset() {
 	*x = RequestSigVerified{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[23]
+		mi := &file_eventpb_eventpb_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1902,7 +2705,7 @@ func (x *RequestSigVerified) String() string {
 func (*RequestSigVerified) ProtoMessage() {}
 
 func (x *RequestSigVerified) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[23]
+	mi := &file_eventpb_eventpb_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1915,7 +2718,7 @@ func (x *RequestSigVerified) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestSigVerified.ProtoReflect.Descriptor instead.
 func (*RequestSigVerified) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{23}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RequestSigVerified) GetRequest() *requestpb.Request {
@@ -1939,6 +2742,13 @@ func (x *RequestSigVerified) GetError() string {
 	return ""
 }
 
+func (x *RequestSigVerified) GetOrigin() *SigVerOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
 type StoreVerifiedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1952,7 +2762,7 @@ type StoreVerifiedRequest struct {
 func (x *StoreVerifiedRequest) Reset() {
 	*x = StoreVerifiedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[24]
+		mi := &file_eventpb_eventpb_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1965,7 +2775,7 @@ func (x *StoreVerifiedRequest) String() string {
 func (*StoreVerifiedRequest) ProtoMessage() {}
 
 func (x *StoreVerifiedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[24]
+	mi := &file_eventpb_eventpb_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +2788,7 @@ func (x *StoreVerifiedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreVerifiedRequest.ProtoReflect.Descriptor instead.
 func (*StoreVerifiedRequest) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{24}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *StoreVerifiedRequest) GetRequest() *requestpb.Request {
@@ -2014,7 +2824,7 @@ type AppSnapshotRequest struct {
 func (x *AppSnapshotRequest) Reset() {
 	*x = AppSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[25]
+		mi := &file_eventpb_eventpb_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2027,7 +2837,7 @@ func (x *AppSnapshotRequest) String() string {
 func (*AppSnapshotRequest) ProtoMessage() {}
 
 func (x *AppSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[25]
+	mi := &file_eventpb_eventpb_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,7 +2850,7 @@ func (x *AppSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*AppSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{25}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AppSnapshotRequest) GetModule() string {
@@ -2069,7 +2879,7 @@ type AppSnapshot struct {
 func (x *AppSnapshot) Reset() {
 	*x = AppSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[26]
+		mi := &file_eventpb_eventpb_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2082,7 +2892,7 @@ func (x *AppSnapshot) String() string {
 func (*AppSnapshot) ProtoMessage() {}
 
 func (x *AppSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[26]
+	mi := &file_eventpb_eventpb_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,7 +2905,7 @@ func (x *AppSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppSnapshot.ProtoReflect.Descriptor instead.
 func (*AppSnapshot) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{26}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AppSnapshot) GetEpoch() uint64 {
@@ -2123,7 +2933,7 @@ type AppRestoreState struct {
 func (x *AppRestoreState) Reset() {
 	*x = AppRestoreState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[27]
+		mi := &file_eventpb_eventpb_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2136,7 +2946,7 @@ func (x *AppRestoreState) String() string {
 func (*AppRestoreState) ProtoMessage() {}
 
 func (x *AppRestoreState) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[27]
+	mi := &file_eventpb_eventpb_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,7 +2959,7 @@ func (x *AppRestoreState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppRestoreState.ProtoReflect.Descriptor instead.
 func (*AppRestoreState) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{27}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AppRestoreState) GetData() []byte {
@@ -2171,7 +2981,7 @@ type TimerDelay struct {
 func (x *TimerDelay) Reset() {
 	*x = TimerDelay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[28]
+		mi := &file_eventpb_eventpb_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2184,7 +2994,7 @@ func (x *TimerDelay) String() string {
 func (*TimerDelay) ProtoMessage() {}
 
 func (x *TimerDelay) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[28]
+	mi := &file_eventpb_eventpb_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2197,7 +3007,7 @@ func (x *TimerDelay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerDelay.ProtoReflect.Descriptor instead.
 func (*TimerDelay) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{28}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TimerDelay) GetEvents() []*Event {
@@ -2227,7 +3037,7 @@ type TimerRepeat struct {
 func (x *TimerRepeat) Reset() {
 	*x = TimerRepeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[29]
+		mi := &file_eventpb_eventpb_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2240,7 +3050,7 @@ func (x *TimerRepeat) String() string {
 func (*TimerRepeat) ProtoMessage() {}
 
 func (x *TimerRepeat) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[29]
+	mi := &file_eventpb_eventpb_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2253,7 +3063,7 @@ func (x *TimerRepeat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerRepeat.ProtoReflect.Descriptor instead.
 func (*TimerRepeat) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{29}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *TimerRepeat) GetEvents() []*Event {
@@ -2288,7 +3098,7 @@ type TimerGarbageCollect struct {
 func (x *TimerGarbageCollect) Reset() {
 	*x = TimerGarbageCollect{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[30]
+		mi := &file_eventpb_eventpb_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2301,7 +3111,7 @@ func (x *TimerGarbageCollect) String() string {
 func (*TimerGarbageCollect) ProtoMessage() {}
 
 func (x *TimerGarbageCollect) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[30]
+	mi := &file_eventpb_eventpb_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2314,7 +3124,7 @@ func (x *TimerGarbageCollect) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerGarbageCollect.ProtoReflect.Descriptor instead.
 func (*TimerGarbageCollect) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{30}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *TimerGarbageCollect) GetRetentionIndex() uint64 {
@@ -2335,7 +3145,7 @@ type NewConfig struct {
 func (x *NewConfig) Reset() {
 	*x = NewConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eventpb_eventpb_proto_msgTypes[31]
+		mi := &file_eventpb_eventpb_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2348,7 +3158,7 @@ func (x *NewConfig) String() string {
 func (*NewConfig) ProtoMessage() {}
 
 func (x *NewConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_eventpb_eventpb_proto_msgTypes[31]
+	mi := &file_eventpb_eventpb_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,7 +3171,7 @@ func (x *NewConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewConfig.ProtoReflect.Descriptor instead.
 func (*NewConfig) Descriptor() ([]byte, []int) {
-	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{31}
+	return file_eventpb_eventpb_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *NewConfig) GetNodeIds() []string {
@@ -2385,315 +3195,444 @@ var file_eventpb_eventpb_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x11, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2f, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x64, 0x73,
-	0x6c, 0x70, 0x62, 0x2f, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x19, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xe5, 0x0f, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x69,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x23,
-	0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x74,
-	0x69, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x0a, 0x77, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x57, 0x41, 0x4c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x77,
-	0x61, 0x6c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x5f,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x41, 0x4c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00,
-	0x52, 0x08, 0x77, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x77, 0x61,
-	0x6c, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x41, 0x4c, 0x54, 0x72,
-	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x54, 0x72, 0x75,
-	0x6e, 0x63, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x12, 0x39, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b,
-	0x68, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x68,
-	0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
-	0x00, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
-	0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x61, 0x64, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x61, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x45, 0x0a, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x69, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x53, 0x53, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x69, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67,
-	0x48, 0x00, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x53, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x73, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48,
-	0x00, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x55, 0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14,
-	0x61, 0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x61, 0x70, 0x70, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
-	0x0c, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x70,
-	0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x70, 0x70,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x5f,
-	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x70,
-	0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
-	0x0f, 0x61, 0x70, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x36, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18,
-	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65,
-	0x72, 0x5f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65,
-	0x70, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x12, 0x52, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x72,
-	0x62, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x1b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x48, 0x00, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x62, 0x63, 0x62, 0x18, 0x1c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x03, 0x62, 0x63, 0x62, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
-	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x6d,
-	0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07,
-	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09,
-	0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0d, 0x74, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0xad, 0x02, 0x20, 0x01, 0x28,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x72, 0x62, 0x63, 0x70, 0x62, 0x2f, 0x72, 0x62, 0x63,
+	0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62,
+	0x2f, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x70, 0x62, 0x2f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x10, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x64, 0x73, 0x6c, 0x70, 0x62,
+	0x2f, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6d, 0x65,
+	0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70,
+	0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x15, 0x0a, 0x05,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12,
+	0x33, 0x0a, 0x0a, 0x77, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x41,
+	0x4c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x77, 0x61, 0x6c, 0x41, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x57, 0x41, 0x4c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x77, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x5f, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x41, 0x4c, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x39, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x48, 0x00, 0x52,
+	0x0b, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0c,
+	0x68, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x48, 0x00, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x39, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x69,
+	0x67, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x73, 0x69, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x69, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x73, 0x69, 0x67, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x10, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x39, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b,
+	0x73, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x03, 0x69, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x53, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x03, 0x69, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x48, 0x00, 0x52, 0x10,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67,
+	0x12, 0x4f, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x12, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x55, 0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x70, 0x70,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x12,
+	0x52, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x47, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x13,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x62, 0x63, 0x62, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x03, 0x62, 0x63, 0x62, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x33, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4e,
+	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x47, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x33,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x12, 0x54, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x16, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x15, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x12, 0x54, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x48, 0x00, 0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x5a, 0x0a, 0x17, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x16, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x03, 0x72, 0x62, 0x63, 0x18, 0x26, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x62, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x03, 0x72, 0x62, 0x63, 0x12, 0x23, 0x0a, 0x04, 0x61, 0x62, 0x62, 0x61, 0x18, 0x27,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x61, 0x62, 0x62, 0x61, 0x12, 0x3b, 0x0a, 0x0c, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x39, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x45, 0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0xad, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0b, 0x74,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x69, 0x6e, 0x74, 0x18, 0xae, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48,
-	0x00, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x41, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x69, 0x6e, 0x74, 0x18,
-	0xae, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55,
-	0x69, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x64, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x5f,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
-	0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0x06, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x22,
-	0x06, 0x0a, 0x04, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x3d, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x62, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x48,
-	0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48,
+	0x00, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x69, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5,
+	0x18, 0x01, 0x22, 0x06, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x22, 0x06, 0x0a, 0x04, 0x54, 0x69,
+	0x63, 0x6b, 0x22, 0x3d, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x22, 0x62, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x53, 0x0a, 0x0a, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2b, 0x0a,
 	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x53, 0x0a, 0x0a, 0x48, 0x61,
-	0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65,
-	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73,
-	0x74, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73,
-	0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22,
-	0xe8, 0x01, 0x0a, 0x0a, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x69, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x53, 0x53, 0x48, 0x61,
-	0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x69, 0x73, 0x73, 0x12,
-	0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64,
-	0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64,
-	0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4e, 0x0a, 0x0b, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x57, 0x0a, 0x0a, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x03, 0x69, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49,
-	0x53, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03,
-	0x69, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48,
-	0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x20,
-	0x0a, 0x0a, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0xa3, 0x01, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x53,
-	0x69, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x56,
-	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x06,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x53,
-	0x69, 0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x6b, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x4f, 0x6b, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x53,
-	0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x69, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x53, 0x53, 0x53, 0x69, 0x67, 0x56, 0x65,
-	0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x69, 0x73, 0x73, 0x12, 0x21,
+	0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x0a, 0x48,
+	0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x03, 0x69, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x53, 0x53, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x69, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4e, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x57, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xb8,
+	0x01, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x03, 0x69, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x53, 0x53, 0x53, 0x69, 0x67,
+	0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x69, 0x73, 0x73, 0x12, 0x21,
 	0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73,
 	0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73,
-	0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x20, 0x0a, 0x0a, 0x53, 0x69, 0x67,
+	0x56, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x0e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x12, 0x27,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x73, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x15,
+	0x0a, 0x06, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x6c, 0x6c, 0x4f, 0x6b, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a,
+	0x03, 0x69, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x73,
+	0x70, 0x62, 0x2e, 0x49, 0x53, 0x53, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x69, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x72, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x9b,
+	0x01, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04,
+	0x6d, 0x61, 0x63, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xa5, 0x01, 0x0a,
+	0x16, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x6c, 0x6c, 0x4f, 0x6b, 0x22, 0xb8, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x03, 0x69, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e,
+	0x49, 0x53, 0x53, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52,
+	0x03, 0x69, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x6e, 0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x27,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x22,
+	0x53, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0x23, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x4c, 0x0a, 0x08, 0x4b, 0x65, 0x79,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x42,
+	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x4b,
+	0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x70, 0x62, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x5a, 0x0a, 0x09, 0x57,
+	0x41, 0x4c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x30, 0x0a, 0x08, 0x57, 0x41, 0x4c, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x0b, 0x57, 0x41, 0x4c,
+	0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x0c, 0x0a, 0x0a, 0x57, 0x41, 0x4c, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x22,
+	0x41, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69,
+	0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x22, 0x9d, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69,
+	0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x22, 0x4b, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x70, 0x62,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x5a, 0x0a,
-	0x09, 0x57, 0x41, 0x4c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x30, 0x0a, 0x08, 0x57, 0x41, 0x4c,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x0b, 0x57,
-	0x41, 0x4c, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
-	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x22, 0x0c, 0x0a, 0x0a, 0x57, 0x41, 0x4c, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x6c,
-	0x6c, 0x22, 0x41, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x26, 0x0a, 0x05,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x22, 0x5e, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x6e, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
-	0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69,
+	0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x22, 0x7e, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x7e, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24,
-	0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x37, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x25, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4a, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x22, 0x74, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61,
-	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65,
-	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3e, 0x0a, 0x13, 0x54, 0x69,
-	0x6d, 0x65, 0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65,
-	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x26, 0x0a, 0x09, 0x4e, 0x65,
-	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x73, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x42, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x37, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x25, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4a, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x22, 0x74, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3e, 0x0a, 0x13, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x26, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73,
+	0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2708,62 +3647,78 @@ func file_eventpb_eventpb_proto_rawDescGZIP() []byte {
 	return file_eventpb_eventpb_proto_rawDescData
 }
 
-var file_eventpb_eventpb_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_eventpb_eventpb_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_eventpb_eventpb_proto_goTypes = []interface{}{
-	(*Event)(nil),                  // 0: eventpb.Event
-	(*Init)(nil),                   // 1: eventpb.Init
-	(*Tick)(nil),                   // 2: eventpb.Tick
-	(*NewRequests)(nil),            // 3: eventpb.NewRequests
-	(*HashRequest)(nil),            // 4: eventpb.HashRequest
-	(*HashResult)(nil),             // 5: eventpb.HashResult
-	(*HashOrigin)(nil),             // 6: eventpb.HashOrigin
-	(*SignRequest)(nil),            // 7: eventpb.SignRequest
-	(*SignResult)(nil),             // 8: eventpb.SignResult
-	(*SignOrigin)(nil),             // 9: eventpb.SignOrigin
-	(*SigVerData)(nil),             // 10: eventpb.SigVerData
-	(*VerifyNodeSigs)(nil),         // 11: eventpb.VerifyNodeSigs
-	(*NodeSigsVerified)(nil),       // 12: eventpb.NodeSigsVerified
-	(*SigVerOrigin)(nil),           // 13: eventpb.SigVerOrigin
-	(*RequestReady)(nil),           // 14: eventpb.RequestReady
-	(*SendMessage)(nil),            // 15: eventpb.SendMessage
-	(*MessageReceived)(nil),        // 16: eventpb.MessageReceived
-	(*WALAppend)(nil),              // 17: eventpb.WALAppend
-	(*WALEntry)(nil),               // 18: eventpb.WALEntry
-	(*WALTruncate)(nil),            // 19: eventpb.WALTruncate
-	(*WALLoadAll)(nil),             // 20: eventpb.WALLoadAll
-	(*Deliver)(nil),                // 21: eventpb.Deliver
-	(*VerifyRequestSig)(nil),       // 22: eventpb.VerifyRequestSig
-	(*RequestSigVerified)(nil),     // 23: eventpb.RequestSigVerified
-	(*StoreVerifiedRequest)(nil),   // 24: eventpb.StoreVerifiedRequest
-	(*AppSnapshotRequest)(nil),     // 25: eventpb.AppSnapshotRequest
-	(*AppSnapshot)(nil),            // 26: eventpb.AppSnapshot
-	(*AppRestoreState)(nil),        // 27: eventpb.AppRestoreState
-	(*TimerDelay)(nil),             // 28: eventpb.TimerDelay
-	(*TimerRepeat)(nil),            // 29: eventpb.TimerRepeat
-	(*TimerGarbageCollect)(nil),    // 30: eventpb.TimerGarbageCollect
-	(*NewConfig)(nil),              // 31: eventpb.NewConfig
-	(*isspb.ISSEvent)(nil),         // 32: isspb.ISSEvent
-	(*bcbpb.Event)(nil),            // 33: bcbpb.Event
-	(*mempoolpb.Event)(nil),        // 34: mempoolpb.Event
-	(*availabilitypb.Event)(nil),   // 35: availabilitypb.Event
-	(*wrapperspb.StringValue)(nil), // 36: google.protobuf.StringValue
-	(*wrapperspb.UInt64Value)(nil), // 37: google.protobuf.UInt64Value
-	(*requestpb.Request)(nil),      // 38: requestpb.Request
-	(*commonpb.HashData)(nil),      // 39: commonpb.HashData
-	(*contextstorepb.Origin)(nil),  // 40: contextstorepb.Origin
-	(*isspb.ISSHashOrigin)(nil),    // 41: isspb.ISSHashOrigin
-	(*dslpb.Origin)(nil),           // 42: dslpb.Origin
-	(*isspb.ISSSignOrigin)(nil),    // 43: isspb.ISSSignOrigin
-	(*isspb.ISSSigVerOrigin)(nil),  // 44: isspb.ISSSigVerOrigin
-	(*messagepb.Message)(nil),      // 45: messagepb.Message
-	(*requestpb.Batch)(nil),        // 46: requestpb.Batch
+	(*Event)(nil),                     // 0: eventpb.Event
+	(*Init)(nil),                      // 1: eventpb.Init
+	(*Tick)(nil),                      // 2: eventpb.Tick
+	(*NewRequests)(nil),               // 3: eventpb.NewRequests
+	(*HashRequest)(nil),               // 4: eventpb.HashRequest
+	(*HashResult)(nil),                // 5: eventpb.HashResult
+	(*HashOrigin)(nil),                // 6: eventpb.HashOrigin
+	(*SignRequest)(nil),               // 7: eventpb.SignRequest
+	(*SignResult)(nil),                // 8: eventpb.SignResult
+	(*SignOrigin)(nil),                // 9: eventpb.SignOrigin
+	(*SigVerData)(nil),                // 10: eventpb.SigVerData
+	(*VerifyNodeSigs)(nil),            // 11: eventpb.VerifyNodeSigs
+	(*NodeSigsVerified)(nil),          // 12: eventpb.NodeSigsVerified
+	(*SigVerOrigin)(nil),              // 13: eventpb.SigVerOrigin
+	(*ComputeAuthenticator)(nil),      // 14: eventpb.ComputeAuthenticator
+	(*AuthenticatorComputed)(nil),     // 15: eventpb.AuthenticatorComputed
+	(*VerifyAuthenticators)(nil),      // 16: eventpb.VerifyAuthenticators
+	(*AuthenticatorsVerified)(nil),    // 17: eventpb.AuthenticatorsVerified
+	(*AuthOrigin)(nil),                // 18: eventpb.AuthOrigin
+	(*RegisterKey)(nil),               // 19: eventpb.RegisterKey
+	(*RevokeKey)(nil),                 // 20: eventpb.RevokeKey
+	(*SetKeyEpoch)(nil),               // 21: eventpb.SetKeyEpoch
+	(*KeyOwner)(nil),                  // 22: eventpb.KeyOwner
+	(*RequestReady)(nil),              // 23: eventpb.RequestReady
+	(*SendMessage)(nil),               // 24: eventpb.SendMessage
+	(*MessageReceived)(nil),           // 25: eventpb.MessageReceived
+	(*WALAppend)(nil),                 // 26: eventpb.WALAppend
+	(*WALEntry)(nil),                  // 27: eventpb.WALEntry
+	(*WALTruncate)(nil),               // 28: eventpb.WALTruncate
+	(*WALLoadAll)(nil),                // 29: eventpb.WALLoadAll
+	(*Deliver)(nil),                   // 30: eventpb.Deliver
+	(*VerifyRequestSig)(nil),          // 31: eventpb.VerifyRequestSig
+	(*RequestSigVerified)(nil),        // 32: eventpb.RequestSigVerified
+	(*StoreVerifiedRequest)(nil),      // 33: eventpb.StoreVerifiedRequest
+	(*AppSnapshotRequest)(nil),        // 34: eventpb.AppSnapshotRequest
+	(*AppSnapshot)(nil),               // 35: eventpb.AppSnapshot
+	(*AppRestoreState)(nil),           // 36: eventpb.AppRestoreState
+	(*TimerDelay)(nil),                // 37: eventpb.TimerDelay
+	(*TimerRepeat)(nil),               // 38: eventpb.TimerRepeat
+	(*TimerGarbageCollect)(nil),       // 39: eventpb.TimerGarbageCollect
+	(*NewConfig)(nil),                 // 40: eventpb.NewConfig
+	(*isspb.ISSEvent)(nil),            // 41: isspb.ISSEvent
+	(*bcbpb.Event)(nil),               // 42: bcbpb.Event
+	(*mempoolpb.Event)(nil),           // 43: mempoolpb.Event
+	(*availabilitypb.Event)(nil),      // 44: availabilitypb.Event
+	(*requestpb.RequestRejected)(nil), // 45: requestpb.RequestRejected
+	(*commitlogpb.Event)(nil),         // 46: commitlogpb.Event
+	(*rbcpb.Event)(nil),               // 47: rbcpb.Event
+	(*abbapb.Event)(nil),              // 48: abbapb.Event
+	(*threshcryptopb.Event)(nil),      // 49: threshcryptopb.Event
+	(*wrapperspb.StringValue)(nil),    // 50: google.protobuf.StringValue
+	(*wrapperspb.UInt64Value)(nil),    // 51: google.protobuf.UInt64Value
+	(*requestpb.Request)(nil),         // 52: requestpb.Request
+	(*commonpb.HashData)(nil),         // 53: commonpb.HashData
+	(*contextstorepb.Origin)(nil),     // 54: contextstorepb.Origin
+	(*isspb.ISSHashOrigin)(nil),       // 55: isspb.ISSHashOrigin
+	(*dslpb.Origin)(nil),              // 56: dslpb.Origin
+	(*isspb.ISSSignOrigin)(nil),       // 57: isspb.ISSSignOrigin
+	(*isspb.ISSSigVerOrigin)(nil),     // 58: isspb.ISSSigVerOrigin
+	(*commonpb.Authenticator)(nil),    // 59: commonpb.Authenticator
+	(*isspb.ISSAuthOrigin)(nil),       // 60: isspb.ISSAuthOrigin
+	(*messagepb.Message)(nil),         // 61: messagepb.Message
+	(*requestpb.Batch)(nil),           // 62: requestpb.Batch
 }
 var file_eventpb_eventpb_proto_depIdxs = []int32{
 	1,  // 0: eventpb.Event.init:type_name -> eventpb.Init
 	2,  // 1: eventpb.Event.tick:type_name -> eventpb.Tick
-	17, // 2: eventpb.Event.wal_append:type_name -> eventpb.WALAppend
-	18, // 3: eventpb.Event.wal_entry:type_name -> eventpb.WALEntry
-	19, // 4: eventpb.Event.wal_truncate:type_name -> eventpb.WALTruncate
+	26, // 2: eventpb.Event.wal_append:type_name -> eventpb.WALAppend
+	27, // 3: eventpb.Event.wal_entry:type_name -> eventpb.WALEntry
+	28, // 4: eventpb.Event.wal_truncate:type_name -> eventpb.WALTruncate
 	3,  // 5: eventpb.Event.new_requests:type_name -> eventpb.NewRequests
 	4,  // 6: eventpb.Event.hash_request:type_name -> eventpb.HashRequest
 	5,  // 7: eventpb.Event.hash_result:type_name -> eventpb.HashResult
@@ -2771,62 +3726,87 @@ var file_eventpb_eventpb_proto_depIdxs = []int32{
 	8,  // 9: eventpb.Event.sign_result:type_name -> eventpb.SignResult
 	11, // 10: eventpb.Event.verify_node_sigs:type_name -> eventpb.VerifyNodeSigs
 	12, // 11: eventpb.Event.node_sigs_verified:type_name -> eventpb.NodeSigsVerified
-	14, // 12: eventpb.Event.request_ready:type_name -> eventpb.RequestReady
-	15, // 13: eventpb.Event.send_message:type_name -> eventpb.SendMessage
-	16, // 14: eventpb.Event.message_received:type_name -> eventpb.MessageReceived
-	21, // 15: eventpb.Event.deliver:type_name -> eventpb.Deliver
-	32, // 16: eventpb.Event.iss:type_name -> isspb.ISSEvent
-	22, // 17: eventpb.Event.verify_request_sig:type_name -> eventpb.VerifyRequestSig
-	23, // 18: eventpb.Event.request_sig_verified:type_name -> eventpb.RequestSigVerified
-	24, // 19: eventpb.Event.store_verified_request:type_name -> eventpb.StoreVerifiedRequest
-	25, // 20: eventpb.Event.app_snapshot_request:type_name -> eventpb.AppSnapshotRequest
-	26, // 21: eventpb.Event.app_snapshot:type_name -> eventpb.AppSnapshot
-	27, // 22: eventpb.Event.app_restore_state:type_name -> eventpb.AppRestoreState
-	28, // 23: eventpb.Event.timer_delay:type_name -> eventpb.TimerDelay
-	29, // 24: eventpb.Event.timer_repeat:type_name -> eventpb.TimerRepeat
-	30, // 25: eventpb.Event.timer_garbage_collect:type_name -> eventpb.TimerGarbageCollect
-	33, // 26: eventpb.Event.bcb:type_name -> bcbpb.Event
-	34, // 27: eventpb.Event.mempool:type_name -> mempoolpb.Event
-	35, // 28: eventpb.Event.availability:type_name -> availabilitypb.Event
-	31, // 29: eventpb.Event.new_config:type_name -> eventpb.NewConfig
-	36, // 30: eventpb.Event.testingString:type_name -> google.protobuf.StringValue
-	37, // 31: eventpb.Event.testingUint:type_name -> google.protobuf.UInt64Value
-	0,  // 32: eventpb.Event.next:type_name -> eventpb.Event
-	38, // 33: eventpb.NewRequests.requests:type_name -> requestpb.Request
-	39, // 34: eventpb.HashRequest.data:type_name -> commonpb.HashData
-	6,  // 35: eventpb.HashRequest.origin:type_name -> eventpb.HashOrigin
-	6,  // 36: eventpb.HashResult.origin:type_name -> eventpb.HashOrigin
-	40, // 37: eventpb.HashOrigin.context_store:type_name -> contextstorepb.Origin
-	38, // 38: eventpb.HashOrigin.request:type_name -> requestpb.Request
-	41, // 39: eventpb.HashOrigin.iss:type_name -> isspb.ISSHashOrigin
-	42, // 40: eventpb.HashOrigin.dsl:type_name -> dslpb.Origin
-	9,  // 41: eventpb.SignRequest.origin:type_name -> eventpb.SignOrigin
-	9,  // 42: eventpb.SignResult.origin:type_name -> eventpb.SignOrigin
-	40, // 43: eventpb.SignOrigin.context_store:type_name -> contextstorepb.Origin
-	43, // 44: eventpb.SignOrigin.iss:type_name -> isspb.ISSSignOrigin
-	42, // 45: eventpb.SignOrigin.dsl:type_name -> dslpb.Origin
-	10, // 46: eventpb.VerifyNodeSigs.data:type_name -> eventpb.SigVerData
-	13, // 47: eventpb.VerifyNodeSigs.origin:type_name -> eventpb.SigVerOrigin
-	13, // 48: eventpb.NodeSigsVerified.origin:type_name -> eventpb.SigVerOrigin
-	40, // 49: eventpb.SigVerOrigin.context_store:type_name -> contextstorepb.Origin
-	44, // 50: eventpb.SigVerOrigin.iss:type_name -> isspb.ISSSigVerOrigin
-	42, // 51: eventpb.SigVerOrigin.dsl:type_name -> dslpb.Origin
-	38, // 52: eventpb.RequestReady.request:type_name -> requestpb.Request
-	45, // 53: eventpb.SendMessage.msg:type_name -> messagepb.Message
-	45, // 54: eventpb.MessageReceived.msg:type_name -> messagepb.Message
-	0,  // 55: eventpb.WALAppend.event:type_name -> eventpb.Event
-	0,  // 56: eventpb.WALEntry.event:type_name -> eventpb.Event
-	46, // 57: eventpb.Deliver.batch:type_name -> requestpb.Batch
-	38, // 58: eventpb.VerifyRequestSig.request:type_name -> requestpb.Request
-	38, // 59: eventpb.RequestSigVerified.request:type_name -> requestpb.Request
-	38, // 60: eventpb.StoreVerifiedRequest.request:type_name -> requestpb.Request
-	0,  // 61: eventpb.TimerDelay.events:type_name -> eventpb.Event
-	0,  // 62: eventpb.TimerRepeat.events:type_name -> eventpb.Event
-	63, // [63:63] is the sub-list for method output_type
-	63, // [63:63] is the sub-list for method input_type
-	63, // [63:63] is the sub-list for extension type_name
-	63, // [63:63] is the sub-list for extension extendee
-	0,  // [0:63] is the sub-list for field type_name
+	23, // 12: eventpb.Event.request_ready:type_name -> eventpb.RequestReady
+	24, // 13: eventpb.Event.send_message:type_name -> eventpb.SendMessage
+	25, // 14: eventpb.Event.message_received:type_name -> eventpb.MessageReceived
+	30, // 15: eventpb.Event.deliver:type_name -> eventpb.Deliver
+	41, // 16: eventpb.Event.iss:type_name -> isspb.ISSEvent
+	31, // 17: eventpb.Event.verify_request_sig:type_name -> eventpb.VerifyRequestSig
+	32, // 18: eventpb.Event.request_sig_verified:type_name -> eventpb.RequestSigVerified
+	33, // 19: eventpb.Event.store_verified_request:type_name -> eventpb.StoreVerifiedRequest
+	34, // 20: eventpb.Event.app_snapshot_request:type_name -> eventpb.AppSnapshotRequest
+	35, // 21: eventpb.Event.app_snapshot:type_name -> eventpb.AppSnapshot
+	36, // 22: eventpb.Event.app_restore_state:type_name -> eventpb.AppRestoreState
+	37, // 23: eventpb.Event.timer_delay:type_name -> eventpb.TimerDelay
+	38, // 24: eventpb.Event.timer_repeat:type_name -> eventpb.TimerRepeat
+	39, // 25: eventpb.Event.timer_garbage_collect:type_name -> eventpb.TimerGarbageCollect
+	42, // 26: eventpb.Event.bcb:type_name -> bcbpb.Event
+	43, // 27: eventpb.Event.mempool:type_name -> mempoolpb.Event
+	44, // 28: eventpb.Event.availability:type_name -> availabilitypb.Event
+	40, // 29: eventpb.Event.new_config:type_name -> eventpb.NewConfig
+	45, // 30: eventpb.Event.request_rejected:type_name -> requestpb.RequestRejected
+	46, // 31: eventpb.Event.commit_log:type_name -> commitlogpb.Event
+	14, // 32: eventpb.Event.compute_authenticator:type_name -> eventpb.ComputeAuthenticator
+	15, // 33: eventpb.Event.authenticator_computed:type_name -> eventpb.AuthenticatorComputed
+	16, // 34: eventpb.Event.verify_authenticators:type_name -> eventpb.VerifyAuthenticators
+	17, // 35: eventpb.Event.authenticators_verified:type_name -> eventpb.AuthenticatorsVerified
+	47, // 36: eventpb.Event.rbc:type_name -> rbcpb.Event
+	48, // 37: eventpb.Event.abba:type_name -> abbapb.Event
+	49, // 38: eventpb.Event.threshcrypto:type_name -> threshcryptopb.Event
+	19, // 39: eventpb.Event.register_key:type_name -> eventpb.RegisterKey
+	20, // 40: eventpb.Event.revoke_key:type_name -> eventpb.RevokeKey
+	21, // 41: eventpb.Event.set_key_epoch:type_name -> eventpb.SetKeyEpoch
+	50, // 42: eventpb.Event.testingString:type_name -> google.protobuf.StringValue
+	51, // 43: eventpb.Event.testingUint:type_name -> google.protobuf.UInt64Value
+	0,  // 44: eventpb.Event.next:type_name -> eventpb.Event
+	52, // 45: eventpb.NewRequests.requests:type_name -> requestpb.Request
+	53, // 46: eventpb.HashRequest.data:type_name -> commonpb.HashData
+	6,  // 47: eventpb.HashRequest.origin:type_name -> eventpb.HashOrigin
+	6,  // 48: eventpb.HashResult.origin:type_name -> eventpb.HashOrigin
+	54, // 49: eventpb.HashOrigin.context_store:type_name -> contextstorepb.Origin
+	52, // 50: eventpb.HashOrigin.request:type_name -> requestpb.Request
+	55, // 51: eventpb.HashOrigin.iss:type_name -> isspb.ISSHashOrigin
+	56, // 52: eventpb.HashOrigin.dsl:type_name -> dslpb.Origin
+	9,  // 53: eventpb.SignRequest.origin:type_name -> eventpb.SignOrigin
+	9,  // 54: eventpb.SignResult.origin:type_name -> eventpb.SignOrigin
+	54, // 55: eventpb.SignOrigin.context_store:type_name -> contextstorepb.Origin
+	57, // 56: eventpb.SignOrigin.iss:type_name -> isspb.ISSSignOrigin
+	56, // 57: eventpb.SignOrigin.dsl:type_name -> dslpb.Origin
+	10, // 58: eventpb.VerifyNodeSigs.data:type_name -> eventpb.SigVerData
+	13, // 59: eventpb.VerifyNodeSigs.origin:type_name -> eventpb.SigVerOrigin
+	13, // 60: eventpb.NodeSigsVerified.origin:type_name -> eventpb.SigVerOrigin
+	54, // 61: eventpb.SigVerOrigin.context_store:type_name -> contextstorepb.Origin
+	58, // 62: eventpb.SigVerOrigin.iss:type_name -> isspb.ISSSigVerOrigin
+	56, // 63: eventpb.SigVerOrigin.dsl:type_name -> dslpb.Origin
+	18, // 64: eventpb.ComputeAuthenticator.origin:type_name -> eventpb.AuthOrigin
+	59, // 65: eventpb.AuthenticatorComputed.authenticator:type_name -> commonpb.Authenticator
+	18, // 66: eventpb.AuthenticatorComputed.origin:type_name -> eventpb.AuthOrigin
+	10, // 67: eventpb.VerifyAuthenticators.data:type_name -> eventpb.SigVerData
+	18, // 68: eventpb.VerifyAuthenticators.origin:type_name -> eventpb.AuthOrigin
+	18, // 69: eventpb.AuthenticatorsVerified.origin:type_name -> eventpb.AuthOrigin
+	54, // 70: eventpb.AuthOrigin.context_store:type_name -> contextstorepb.Origin
+	60, // 71: eventpb.AuthOrigin.iss:type_name -> isspb.ISSAuthOrigin
+	56, // 72: eventpb.AuthOrigin.dsl:type_name -> dslpb.Origin
+	22, // 73: eventpb.RegisterKey.owner:type_name -> eventpb.KeyOwner
+	22, // 74: eventpb.RevokeKey.owner:type_name -> eventpb.KeyOwner
+	52, // 75: eventpb.RequestReady.request:type_name -> requestpb.Request
+	61, // 76: eventpb.SendMessage.msg:type_name -> messagepb.Message
+	61, // 77: eventpb.MessageReceived.msg:type_name -> messagepb.Message
+	0,  // 78: eventpb.WALAppend.event:type_name -> eventpb.Event
+	0,  // 79: eventpb.WALEntry.event:type_name -> eventpb.Event
+	62, // 80: eventpb.Deliver.batch:type_name -> requestpb.Batch
+	52, // 81: eventpb.VerifyRequestSig.request:type_name -> requestpb.Request
+	13, // 82: eventpb.VerifyRequestSig.origin:type_name -> eventpb.SigVerOrigin
+	52, // 83: eventpb.RequestSigVerified.request:type_name -> requestpb.Request
+	13, // 84: eventpb.RequestSigVerified.origin:type_name -> eventpb.SigVerOrigin
+	52, // 85: eventpb.StoreVerifiedRequest.request:type_name -> requestpb.Request
+	0,  // 86: eventpb.TimerDelay.events:type_name -> eventpb.Event
+	0,  // 87: eventpb.TimerRepeat.events:type_name -> eventpb.Event
+	88, // [88:88] is the sub-list for method output_type
+	88, // [88:88] is the sub-list for method input_type
+	88, // [88:88] is the sub-list for extension type_name
+	88, // [88:88] is the sub-list for extension extendee
+	0,  // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_eventpb_eventpb_proto_init() }
@@ -3004,7 +3984,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestReady); i {
+			switch v := v.(*ComputeAuthenticator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3016,7 +3996,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessage); i {
+			switch v := v.(*AuthenticatorComputed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3028,7 +4008,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageReceived); i {
+			switch v := v.(*VerifyAuthenticators); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3040,7 +4020,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WALAppend); i {
+			switch v := v.(*AuthenticatorsVerified); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3052,7 +4032,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WALEntry); i {
+			switch v := v.(*AuthOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3064,7 +4044,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WALTruncate); i {
+			switch v := v.(*RegisterKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3076,7 +4056,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WALLoadAll); i {
+			switch v := v.(*RevokeKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3088,7 +4068,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deliver); i {
+			switch v := v.(*SetKeyEpoch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3100,7 +4080,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyRequestSig); i {
+			switch v := v.(*KeyOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3112,7 +4092,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestSigVerified); i {
+			switch v := v.(*RequestReady); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3124,7 +4104,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreVerifiedRequest); i {
+			switch v := v.(*SendMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3136,7 +4116,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppSnapshotRequest); i {
+			switch v := v.(*MessageReceived); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3148,7 +4128,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppSnapshot); i {
+			switch v := v.(*WALAppend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3160,7 +4140,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppRestoreState); i {
+			switch v := v.(*WALEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3172,7 +4152,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerDelay); i {
+			switch v := v.(*WALTruncate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3184,7 +4164,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerRepeat); i {
+			switch v := v.(*WALLoadAll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3196,7 +4176,7 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerGarbageCollect); i {
+			switch v := v.(*Deliver); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3208,6 +4188,114 @@ func file_eventpb_eventpb_proto_init() {
 			}
 		}
 		file_eventpb_eventpb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRequestSig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSigVerified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreVerifiedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppRestoreState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerDelay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerRepeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerGarbageCollect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eventpb_eventpb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NewConfig); i {
 			case 0:
 				return &v.state
@@ -3251,6 +4339,18 @@ func file_eventpb_eventpb_proto_init() {
 		(*Event_Mempool)(nil),
 		(*Event_Availability)(nil),
 		(*Event_NewConfig)(nil),
+		(*Event_RequestRejected)(nil),
+		(*Event_CommitLog)(nil),
+		(*Event_ComputeAuthenticator)(nil),
+		(*Event_AuthenticatorComputed)(nil),
+		(*Event_VerifyAuthenticators)(nil),
+		(*Event_AuthenticatorsVerified)(nil),
+		(*Event_Rbc)(nil),
+		(*Event_Abba)(nil),
+		(*Event_Threshcrypto)(nil),
+		(*Event_RegisterKey)(nil),
+		(*Event_RevokeKey)(nil),
+		(*Event_SetKeyEpoch)(nil),
 		(*Event_TestingString)(nil),
 		(*Event_TestingUint)(nil),
 	}
@@ -3270,13 +4370,22 @@ func file_eventpb_eventpb_proto_init() {
 		(*SigVerOrigin_Iss)(nil),
 		(*SigVerOrigin_Dsl)(nil),
 	}
+	file_eventpb_eventpb_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*AuthOrigin_ContextStore)(nil),
+		(*AuthOrigin_Iss)(nil),
+		(*AuthOrigin_Dsl)(nil),
+	}
+	file_eventpb_eventpb_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*KeyOwner_NodeId)(nil),
+		(*KeyOwner_ClientId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eventpb_eventpb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,10 +1,15 @@
 package eventpb
 
 import (
+	abbapb "github.com/filecoin-project/mir/pkg/pb/abbapb"
 	availabilitypb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	bcbpb "github.com/filecoin-project/mir/pkg/pb/bcbpb"
+	commitlogpb "github.com/filecoin-project/mir/pkg/pb/commitlogpb"
 	isspb "github.com/filecoin-project/mir/pkg/pb/isspb"
 	mempoolpb "github.com/filecoin-project/mir/pkg/pb/mempoolpb"
+	rbcpb "github.com/filecoin-project/mir/pkg/pb/rbcpb"
+	requestpb "github.com/filecoin-project/mir/pkg/pb/requestpb"
+	threshcryptopb "github.com/filecoin-project/mir/pkg/pb/threshcryptopb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -135,6 +140,54 @@ func (p *Event_NewConfig) Unwrap() *NewConfig {
 	return p.NewConfig
 }
 
+func (p *Event_RequestRejected) Unwrap() *requestpb.RequestRejected {
+	return p.RequestRejected
+}
+
+func (p *Event_CommitLog) Unwrap() *commitlogpb.Event {
+	return p.CommitLog
+}
+
+func (p *Event_ComputeAuthenticator) Unwrap() *ComputeAuthenticator {
+	return p.ComputeAuthenticator
+}
+
+func (p *Event_AuthenticatorComputed) Unwrap() *AuthenticatorComputed {
+	return p.AuthenticatorComputed
+}
+
+func (p *Event_VerifyAuthenticators) Unwrap() *VerifyAuthenticators {
+	return p.VerifyAuthenticators
+}
+
+func (p *Event_AuthenticatorsVerified) Unwrap() *AuthenticatorsVerified {
+	return p.AuthenticatorsVerified
+}
+
+func (p *Event_Rbc) Unwrap() *rbcpb.Event {
+	return p.Rbc
+}
+
+func (p *Event_Abba) Unwrap() *abbapb.Event {
+	return p.Abba
+}
+
+func (p *Event_Threshcrypto) Unwrap() *threshcryptopb.Event {
+	return p.Threshcrypto
+}
+
+func (p *Event_RegisterKey) Unwrap() *RegisterKey {
+	return p.RegisterKey
+}
+
+func (p *Event_RevokeKey) Unwrap() *RevokeKey {
+	return p.RevokeKey
+}
+
+func (p *Event_SetKeyEpoch) Unwrap() *SetKeyEpoch {
+	return p.SetKeyEpoch
+}
+
 func (p *Event_TestingString) Unwrap() *wrapperspb.StringValue {
 	return p.TestingString
 }
@@ -12,6 +12,7 @@
 package isspb
 
 import (
+	availabilitypb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	commonpb "github.com/filecoin-project/mir/pkg/pb/commonpb"
 	isspbftpb "github.com/filecoin-project/mir/pkg/pb/isspbftpb"
 	requestpb "github.com/filecoin-project/mir/pkg/pb/requestpb"
@@ -188,9 +189,10 @@ type SBMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch    uint64             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Instance uint64             `protobuf:"varint,2,opt,name=instance,proto3" json:"instance,omitempty"`
-	Msg      *SBInstanceMessage `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Epoch         uint64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Instance      uint64                  `protobuf:"varint,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Msg           *SBInstanceMessage      `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Authenticator *commonpb.Authenticator `protobuf:"bytes,4,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
 }
 
 func (x *SBMessage) Reset() {
@@ -246,15 +248,23 @@ func (x *SBMessage) GetMsg() *SBInstanceMessage {
 	return nil
 }
 
+func (x *SBMessage) GetAuthenticator() *commonpb.Authenticator {
+	if x != nil {
+		return x.Authenticator
+	}
+	return nil
+}
+
 type Checkpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch           uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Sn              uint64 `protobuf:"varint,2,opt,name=sn,proto3" json:"sn,omitempty"`
-	AppSnapshotHash []byte `protobuf:"bytes,3,opt,name=appSnapshotHash,proto3" json:"appSnapshotHash,omitempty"`
-	Signature       []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Epoch           uint64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Sn              uint64                  `protobuf:"varint,2,opt,name=sn,proto3" json:"sn,omitempty"`
+	AppSnapshotHash []byte                  `protobuf:"bytes,3,opt,name=appSnapshotHash,proto3" json:"appSnapshotHash,omitempty"`
+	Signature       []byte                  `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Authenticator   *commonpb.Authenticator `protobuf:"bytes,5,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
 }
 
 func (x *Checkpoint) Reset() {
@@ -317,6 +327,13 @@ func (x *Checkpoint) GetSignature() []byte {
 	return nil
 }
 
+func (x *Checkpoint) GetAuthenticator() *commonpb.Authenticator {
+	if x != nil {
+		return x.Authenticator
+	}
+	return nil
+}
+
 type SBInstanceMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -641,6 +658,7 @@ type ISSHashOrigin struct {
 	//	*ISSHashOrigin_LogEntrySn
 	//	*ISSHashOrigin_AppSnapshotEpoch
 	//	*ISSHashOrigin_Requests
+	//	*ISSHashOrigin_StableCheckpoint
 	Type isISSHashOrigin_Type `protobuf_oneof:"type"`
 }
 
@@ -711,6 +729,13 @@ func (x *ISSHashOrigin) GetRequests() *RequestHashOrigin {
 	return nil
 }
 
+func (x *ISSHashOrigin) GetStableCheckpoint() *StableCheckpoint {
+	if x, ok := x.GetType().(*ISSHashOrigin_StableCheckpoint); ok {
+		return x.StableCheckpoint
+	}
+	return nil
+}
+
 type isISSHashOrigin_Type interface {
 	isISSHashOrigin_Type()
 }
@@ -731,6 +756,10 @@ type ISSHashOrigin_Requests struct {
 	Requests *RequestHashOrigin `protobuf:"bytes,4,opt,name=requests,proto3,oneof"`
 }
 
+type ISSHashOrigin_StableCheckpoint struct {
+	StableCheckpoint *StableCheckpoint `protobuf:"bytes,5,opt,name=stable_checkpoint,json=stableCheckpoint,proto3,oneof"`
+}
+
 func (*ISSHashOrigin_Sb) isISSHashOrigin_Type() {}
 
 func (*ISSHashOrigin_LogEntrySn) isISSHashOrigin_Type() {}
@@ -739,6 +768,8 @@ func (*ISSHashOrigin_AppSnapshotEpoch) isISSHashOrigin_Type() {}
 
 func (*ISSHashOrigin_Requests) isISSHashOrigin_Type() {}
 
+func (*ISSHashOrigin_StableCheckpoint) isISSHashOrigin_Type() {}
+
 type RequestHashOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -875,6 +906,7 @@ type ISSSigVerOrigin struct {
 	//	*ISSSigVerOrigin_Sb
 	//	*ISSSigVerOrigin_CheckpointEpoch
 	//	*ISSSigVerOrigin_StableCheckpoint
+	//	*ISSSigVerOrigin_CheckpointCert
 	Type isISSSigVerOrigin_Type `protobuf_oneof:"type"`
 }
 
@@ -938,6 +970,13 @@ func (x *ISSSigVerOrigin) GetStableCheckpoint() *StableCheckpoint {
 	return nil
 }
 
+func (x *ISSSigVerOrigin) GetCheckpointCert() uint64 {
+	if x, ok := x.GetType().(*ISSSigVerOrigin_CheckpointCert); ok {
+		return x.CheckpointCert
+	}
+	return 0
+}
+
 type isISSSigVerOrigin_Type interface {
 	isISSSigVerOrigin_Type()
 }
@@ -954,25 +993,33 @@ type ISSSigVerOrigin_StableCheckpoint struct {
 	StableCheckpoint *StableCheckpoint `protobuf:"bytes,3,opt,name=stable_checkpoint,json=stableCheckpoint,proto3,oneof"`
 }
 
+type ISSSigVerOrigin_CheckpointCert struct {
+	CheckpointCert uint64 `protobuf:"varint,4,opt,name=checkpoint_cert,json=checkpointCert,proto3,oneof"`
+}
+
 func (*ISSSigVerOrigin_Sb) isISSSigVerOrigin_Type() {}
 
 func (*ISSSigVerOrigin_CheckpointEpoch) isISSSigVerOrigin_Type() {}
 
 func (*ISSSigVerOrigin_StableCheckpoint) isISSSigVerOrigin_Type() {}
 
-type PersistCheckpoint struct {
+func (*ISSSigVerOrigin_CheckpointCert) isISSSigVerOrigin_Type() {}
+
+type ISSAuthOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sn              uint64 `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
-	AppSnapshot     []byte `protobuf:"bytes,2,opt,name=app_snapshot,json=appSnapshot,proto3" json:"app_snapshot,omitempty"`
-	AppSnapshotHash []byte `protobuf:"bytes,3,opt,name=app_snapshot_hash,json=appSnapshotHash,proto3" json:"app_snapshot_hash,omitempty"`
-	Signature       []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Types that are assignable to Type:
+	//	*ISSAuthOrigin_SbSend
+	//	*ISSAuthOrigin_SbReceive
+	//	*ISSAuthOrigin_CheckpointSend
+	//	*ISSAuthOrigin_CheckpointReceive
+	Type isISSAuthOrigin_Type `protobuf_oneof:"type"`
 }
 
-func (x *PersistCheckpoint) Reset() {
-	*x = PersistCheckpoint{}
+func (x *ISSAuthOrigin) Reset() {
+	*x = ISSAuthOrigin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_isspb_isspb_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,13 +1027,13 @@ func (x *PersistCheckpoint) Reset() {
 	}
 }
 
-func (x *PersistCheckpoint) String() string {
+func (x *ISSAuthOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PersistCheckpoint) ProtoMessage() {}
+func (*ISSAuthOrigin) ProtoMessage() {}
 
-func (x *PersistCheckpoint) ProtoReflect() protoreflect.Message {
+func (x *ISSAuthOrigin) ProtoReflect() protoreflect.Message {
 	mi := &file_isspb_isspb_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,52 +1045,86 @@ func (x *PersistCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PersistCheckpoint.ProtoReflect.Descriptor instead.
-func (*PersistCheckpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use ISSAuthOrigin.ProtoReflect.Descriptor instead.
+func (*ISSAuthOrigin) Descriptor() ([]byte, []int) {
 	return file_isspb_isspb_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PersistCheckpoint) GetSn() uint64 {
-	if x != nil {
-		return x.Sn
+func (m *ISSAuthOrigin) GetType() isISSAuthOrigin_Type {
+	if m != nil {
+		return m.Type
 	}
-	return 0
+	return nil
 }
 
-func (x *PersistCheckpoint) GetAppSnapshot() []byte {
-	if x != nil {
-		return x.AppSnapshot
+func (x *ISSAuthOrigin) GetSbSend() *SBAuthSendOrigin {
+	if x, ok := x.GetType().(*ISSAuthOrigin_SbSend); ok {
+		return x.SbSend
 	}
 	return nil
 }
 
-func (x *PersistCheckpoint) GetAppSnapshotHash() []byte {
-	if x != nil {
-		return x.AppSnapshotHash
+func (x *ISSAuthOrigin) GetSbReceive() *SBAuthReceiveOrigin {
+	if x, ok := x.GetType().(*ISSAuthOrigin_SbReceive); ok {
+		return x.SbReceive
 	}
 	return nil
 }
 
-func (x *PersistCheckpoint) GetSignature() []byte {
-	if x != nil {
-		return x.Signature
+func (x *ISSAuthOrigin) GetCheckpointSend() uint64 {
+	if x, ok := x.GetType().(*ISSAuthOrigin_CheckpointSend); ok {
+		return x.CheckpointSend
+	}
+	return 0
+}
+
+func (x *ISSAuthOrigin) GetCheckpointReceive() *CheckpointAuthReceiveOrigin {
+	if x, ok := x.GetType().(*ISSAuthOrigin_CheckpointReceive); ok {
+		return x.CheckpointReceive
 	}
 	return nil
 }
 
-type StableCheckpoint struct {
+type isISSAuthOrigin_Type interface {
+	isISSAuthOrigin_Type()
+}
+
+type ISSAuthOrigin_SbSend struct {
+	SbSend *SBAuthSendOrigin `protobuf:"bytes,1,opt,name=sb_send,json=sbSend,proto3,oneof"`
+}
+
+type ISSAuthOrigin_SbReceive struct {
+	SbReceive *SBAuthReceiveOrigin `protobuf:"bytes,2,opt,name=sb_receive,json=sbReceive,proto3,oneof"`
+}
+
+type ISSAuthOrigin_CheckpointSend struct {
+	CheckpointSend uint64 `protobuf:"varint,3,opt,name=checkpoint_send,json=checkpointSend,proto3,oneof"`
+}
+
+type ISSAuthOrigin_CheckpointReceive struct {
+	CheckpointReceive *CheckpointAuthReceiveOrigin `protobuf:"bytes,4,opt,name=checkpoint_receive,json=checkpointReceive,proto3,oneof"`
+}
+
+func (*ISSAuthOrigin_SbSend) isISSAuthOrigin_Type() {}
+
+func (*ISSAuthOrigin_SbReceive) isISSAuthOrigin_Type() {}
+
+func (*ISSAuthOrigin_CheckpointSend) isISSAuthOrigin_Type() {}
+
+func (*ISSAuthOrigin_CheckpointReceive) isISSAuthOrigin_Type() {}
+
+// SBAuthSendOrigin holds an SB message waiting for its authenticator to be computed before being sent.
+type SBAuthSendOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch       uint64            `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Sn          uint64            `protobuf:"varint,2,opt,name=sn,proto3" json:"sn,omitempty"`
-	AppSnapshot []byte            `protobuf:"bytes,3,opt,name=app_snapshot,json=appSnapshot,proto3" json:"app_snapshot,omitempty"`
-	Cert        map[string][]byte `protobuf:"bytes,4,rep,name=cert,proto3" json:"cert,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Msg          *SBMessage `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Destinations []string   `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
 }
 
-func (x *StableCheckpoint) Reset() {
-	*x = StableCheckpoint{}
+func (x *SBAuthSendOrigin) Reset() {
+	*x = SBAuthSendOrigin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_isspb_isspb_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,13 +1132,13 @@ func (x *StableCheckpoint) Reset() {
 	}
 }
 
-func (x *StableCheckpoint) String() string {
+func (x *SBAuthSendOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StableCheckpoint) ProtoMessage() {}
+func (*SBAuthSendOrigin) ProtoMessage() {}
 
-func (x *StableCheckpoint) ProtoReflect() protoreflect.Message {
+func (x *SBAuthSendOrigin) ProtoReflect() protoreflect.Message {
 	mi := &file_isspb_isspb_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,52 +1150,37 @@ func (x *StableCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StableCheckpoint.ProtoReflect.Descriptor instead.
-func (*StableCheckpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use SBAuthSendOrigin.ProtoReflect.Descriptor instead.
+func (*SBAuthSendOrigin) Descriptor() ([]byte, []int) {
 	return file_isspb_isspb_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StableCheckpoint) GetEpoch() uint64 {
-	if x != nil {
-		return x.Epoch
-	}
-	return 0
-}
-
-func (x *StableCheckpoint) GetSn() uint64 {
-	if x != nil {
-		return x.Sn
-	}
-	return 0
-}
-
-func (x *StableCheckpoint) GetAppSnapshot() []byte {
+func (x *SBAuthSendOrigin) GetMsg() *SBMessage {
 	if x != nil {
-		return x.AppSnapshot
+		return x.Msg
 	}
 	return nil
 }
 
-func (x *StableCheckpoint) GetCert() map[string][]byte {
+func (x *SBAuthSendOrigin) GetDestinations() []string {
 	if x != nil {
-		return x.Cert
+		return x.Destinations
 	}
 	return nil
 }
 
-// PersistStableCheckpoint needs to be a separate Event from StableCheckpoint, since both are ISSEvents,
-// but, the protocol must differentiate between them. While the former will be applied on recovery from the WAL,
-// the latter serves as a notification to the ISS protocol when a stable checkpoint has been persisted.
-type PersistStableCheckpoint struct {
+// SBAuthReceiveOrigin holds a received SB message waiting for its authenticator to be verified before being applied.
+type SBAuthReceiveOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StableCheckpoint *StableCheckpoint `protobuf:"bytes,1,opt,name=stable_checkpoint,json=stableCheckpoint,proto3" json:"stable_checkpoint,omitempty"`
+	Msg  *SBMessage `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	From string     `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
 }
 
-func (x *PersistStableCheckpoint) Reset() {
-	*x = PersistStableCheckpoint{}
+func (x *SBAuthReceiveOrigin) Reset() {
+	*x = SBAuthReceiveOrigin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_isspb_isspb_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1122,13 +1188,13 @@ func (x *PersistStableCheckpoint) Reset() {
 	}
 }
 
-func (x *PersistStableCheckpoint) String() string {
+func (x *SBAuthReceiveOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PersistStableCheckpoint) ProtoMessage() {}
+func (*SBAuthReceiveOrigin) ProtoMessage() {}
 
-func (x *PersistStableCheckpoint) ProtoReflect() protoreflect.Message {
+func (x *SBAuthReceiveOrigin) ProtoReflect() protoreflect.Message {
 	mi := &file_isspb_isspb_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1140,26 +1206,37 @@ func (x *PersistStableCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PersistStableCheckpoint.ProtoReflect.Descriptor instead.
-func (*PersistStableCheckpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use SBAuthReceiveOrigin.ProtoReflect.Descriptor instead.
+func (*SBAuthReceiveOrigin) Descriptor() ([]byte, []int) {
 	return file_isspb_isspb_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PersistStableCheckpoint) GetStableCheckpoint() *StableCheckpoint {
+func (x *SBAuthReceiveOrigin) GetMsg() *SBMessage {
 	if x != nil {
-		return x.StableCheckpoint
+		return x.Msg
 	}
 	return nil
 }
 
-type PushCheckpoint struct {
+func (x *SBAuthReceiveOrigin) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+// CheckpointAuthReceiveOrigin identifies a received Checkpoint message waiting for its authenticator to be verified.
+type CheckpointAuthReceiveOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	From  string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
 }
 
-func (x *PushCheckpoint) Reset() {
-	*x = PushCheckpoint{}
+func (x *CheckpointAuthReceiveOrigin) Reset() {
+	*x = CheckpointAuthReceiveOrigin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_isspb_isspb_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,13 +1244,13 @@ func (x *PushCheckpoint) Reset() {
 	}
 }
 
-func (x *PushCheckpoint) String() string {
+func (x *CheckpointAuthReceiveOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushCheckpoint) ProtoMessage() {}
+func (*CheckpointAuthReceiveOrigin) ProtoMessage() {}
 
-func (x *PushCheckpoint) ProtoReflect() protoreflect.Message {
+func (x *CheckpointAuthReceiveOrigin) ProtoReflect() protoreflect.Message {
 	mi := &file_isspb_isspb_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,23 +1262,39 @@ func (x *PushCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushCheckpoint.ProtoReflect.Descriptor instead.
-func (*PushCheckpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckpointAuthReceiveOrigin.ProtoReflect.Descriptor instead.
+func (*CheckpointAuthReceiveOrigin) Descriptor() ([]byte, []int) {
 	return file_isspb_isspb_proto_rawDescGZIP(), []int{13}
 }
 
-type SBEvent struct {
+func (x *CheckpointAuthReceiveOrigin) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *CheckpointAuthReceiveOrigin) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+type PersistCheckpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch    uint64           `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Instance uint64           `protobuf:"varint,2,opt,name=instance,proto3" json:"instance,omitempty"`
-	Event    *SBInstanceEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Sn              uint64          `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	AppSnapshot     []byte          `protobuf:"bytes,2,opt,name=app_snapshot,json=appSnapshot,proto3" json:"app_snapshot,omitempty"`
+	AppSnapshotHash []byte          `protobuf:"bytes,3,opt,name=app_snapshot_hash,json=appSnapshotHash,proto3" json:"app_snapshot_hash,omitempty"`
+	Signature       []byte          `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	ClientProgress  *ClientProgress `protobuf:"bytes,5,opt,name=client_progress,json=clientProgress,proto3" json:"client_progress,omitempty"`
 }
 
-func (x *SBEvent) Reset() {
-	*x = SBEvent{}
+func (x *PersistCheckpoint) Reset() {
+	*x = PersistCheckpoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_isspb_isspb_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,13 +1302,13 @@ func (x *SBEvent) Reset() {
 	}
 }
 
-func (x *SBEvent) String() string {
+func (x *PersistCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SBEvent) ProtoMessage() {}
+func (*PersistCheckpoint) ProtoMessage() {}
 
-func (x *SBEvent) ProtoReflect() protoreflect.Message {
+func (x *PersistCheckpoint) ProtoReflect() protoreflect.Message {
 	mi := &file_isspb_isspb_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,63 +1320,60 @@ func (x *SBEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SBEvent.ProtoReflect.Descriptor instead.
-func (*SBEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use PersistCheckpoint.ProtoReflect.Descriptor instead.
+func (*PersistCheckpoint) Descriptor() ([]byte, []int) {
 	return file_isspb_isspb_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SBEvent) GetEpoch() uint64 {
+func (x *PersistCheckpoint) GetSn() uint64 {
 	if x != nil {
-		return x.Epoch
+		return x.Sn
 	}
 	return 0
 }
 
-func (x *SBEvent) GetInstance() uint64 {
+func (x *PersistCheckpoint) GetAppSnapshot() []byte {
 	if x != nil {
-		return x.Instance
+		return x.AppSnapshot
 	}
-	return 0
+	return nil
 }
 
-func (x *SBEvent) GetEvent() *SBInstanceEvent {
+func (x *PersistCheckpoint) GetAppSnapshotHash() []byte {
 	if x != nil {
-		return x.Event
+		return x.AppSnapshotHash
 	}
 	return nil
 }
 
-type SBInstanceEvent struct {
+func (x *PersistCheckpoint) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *PersistCheckpoint) GetClientProgress() *ClientProgress {
+	if x != nil {
+		return x.ClientProgress
+	}
+	return nil
+}
+
+type StableCheckpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Type:
-	//	*SBInstanceEvent_Init
-	//	*SBInstanceEvent_Deliver
-	//	*SBInstanceEvent_MessageReceived
-	//	*SBInstanceEvent_PendingRequests
-	//	*SBInstanceEvent_Tick
-	//	*SBInstanceEvent_CutBatch
-	//	*SBInstanceEvent_BatchReady
-	//	*SBInstanceEvent_HashRequest
-	//	*SBInstanceEvent_HashResult
-	//	*SBInstanceEvent_SignResult
-	//	*SBInstanceEvent_NodeSigsVerified
-	//	*SBInstanceEvent_ResurrectBatch
-	//	*SBInstanceEvent_PbftPersistPreprepare
-	//	*SBInstanceEvent_PbftPersistPrepare
-	//	*SBInstanceEvent_PbftPersistCommit
-	//	*SBInstanceEvent_PbftPersistSignedViewChange
-	//	*SBInstanceEvent_PbftPersistNewView
-	//	*SBInstanceEvent_PbftProposeTimeout
-	//	*SBInstanceEvent_PbftViewChangeBatchTimeout
-	//	*SBInstanceEvent_PbftViewChangeSegTimeout
-	Type isSBInstanceEvent_Type `protobuf_oneof:"type"`
+	Epoch          uint64            `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Sn             uint64            `protobuf:"varint,2,opt,name=sn,proto3" json:"sn,omitempty"`
+	AppSnapshot    []byte            `protobuf:"bytes,3,opt,name=app_snapshot,json=appSnapshot,proto3" json:"app_snapshot,omitempty"`
+	Cert           map[string][]byte `protobuf:"bytes,4,rep,name=cert,proto3" json:"cert,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ClientProgress *ClientProgress   `protobuf:"bytes,5,opt,name=client_progress,json=clientProgress,proto3" json:"client_progress,omitempty"`
 }
 
-func (x *SBInstanceEvent) Reset() {
-	*x = SBInstanceEvent{}
+func (x *StableCheckpoint) Reset() {
+	*x = StableCheckpoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_isspb_isspb_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1291,13 +1381,13 @@ func (x *SBInstanceEvent) Reset() {
 	}
 }
 
-func (x *SBInstanceEvent) String() string {
+func (x *StableCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SBInstanceEvent) ProtoMessage() {}
+func (*StableCheckpoint) ProtoMessage() {}
 
-func (x *SBInstanceEvent) ProtoReflect() protoreflect.Message {
+func (x *StableCheckpoint) ProtoReflect() protoreflect.Message {
 	mi := &file_isspb_isspb_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,50 +1399,415 @@ func (x *SBInstanceEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SBInstanceEvent.ProtoReflect.Descriptor instead.
-func (*SBInstanceEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use StableCheckpoint.ProtoReflect.Descriptor instead.
+func (*StableCheckpoint) Descriptor() ([]byte, []int) {
 	return file_isspb_isspb_proto_rawDescGZIP(), []int{15}
 }
 
-func (m *SBInstanceEvent) GetType() isSBInstanceEvent_Type {
-	if m != nil {
-		return m.Type
+func (x *StableCheckpoint) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *SBInstanceEvent) GetInit() *SBInit {
-	if x, ok := x.GetType().(*SBInstanceEvent_Init); ok {
-		return x.Init
+func (x *StableCheckpoint) GetSn() uint64 {
+	if x != nil {
+		return x.Sn
 	}
-	return nil
+	return 0
 }
 
-func (x *SBInstanceEvent) GetDeliver() *SBDeliver {
-	if x, ok := x.GetType().(*SBInstanceEvent_Deliver); ok {
-		return x.Deliver
+func (x *StableCheckpoint) GetAppSnapshot() []byte {
+	if x != nil {
+		return x.AppSnapshot
 	}
 	return nil
 }
 
-func (x *SBInstanceEvent) GetMessageReceived() *SBMessageReceived {
-	if x, ok := x.GetType().(*SBInstanceEvent_MessageReceived); ok {
-		return x.MessageReceived
+func (x *StableCheckpoint) GetCert() map[string][]byte {
+	if x != nil {
+		return x.Cert
 	}
 	return nil
 }
 
-func (x *SBInstanceEvent) GetPendingRequests() *SBPendingRequests {
-	if x, ok := x.GetType().(*SBInstanceEvent_PendingRequests); ok {
-		return x.PendingRequests
+func (x *StableCheckpoint) GetClientProgress() *ClientProgress {
+	if x != nil {
+		return x.ClientProgress
 	}
 	return nil
 }
 
-func (x *SBInstanceEvent) GetTick() *SBTick {
-	if x, ok := x.GetType().(*SBInstanceEvent_Tick); ok {
-		return x.Tick
-	}
+// ClientProgress represents the requests delivered to the application at a checkpoint,
+// so that no request is delivered again after restoring the checkpoint.
+// The clients are sorted by their IDs.
+type ClientProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*DeliveredReqs `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *ClientProgress) Reset() {
+	*x = ClientProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientProgress) ProtoMessage() {}
+
+func (x *ClientProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientProgress.ProtoReflect.Descriptor instead.
+func (*ClientProgress) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClientProgress) GetClients() []*DeliveredReqs {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+// DeliveredReqs represents the delivered requests of a single client:
+// all requests with request numbers below low_wm and those in delivered, which are sorted.
+type DeliveredReqs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId  string   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	LowWm     uint64   `protobuf:"varint,2,opt,name=low_wm,json=lowWm,proto3" json:"low_wm,omitempty"`
+	Delivered []uint64 `protobuf:"varint,3,rep,packed,name=delivered,proto3" json:"delivered,omitempty"`
+}
+
+func (x *DeliveredReqs) Reset() {
+	*x = DeliveredReqs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveredReqs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveredReqs) ProtoMessage() {}
+
+func (x *DeliveredReqs) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveredReqs.ProtoReflect.Descriptor instead.
+func (*DeliveredReqs) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeliveredReqs) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *DeliveredReqs) GetLowWm() uint64 {
+	if x != nil {
+		return x.LowWm
+	}
+	return 0
+}
+
+func (x *DeliveredReqs) GetDelivered() []uint64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return nil
+}
+
+// PersistStableCheckpoint needs to be a separate Event from StableCheckpoint, since both are ISSEvents,
+// but, the protocol must differentiate between them. While the former will be applied on recovery from the WAL,
+// the latter serves as a notification to the ISS protocol when a stable checkpoint has been persisted.
+type PersistStableCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StableCheckpoint *StableCheckpoint `protobuf:"bytes,1,opt,name=stable_checkpoint,json=stableCheckpoint,proto3" json:"stable_checkpoint,omitempty"`
+}
+
+func (x *PersistStableCheckpoint) Reset() {
+	*x = PersistStableCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistStableCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistStableCheckpoint) ProtoMessage() {}
+
+func (x *PersistStableCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistStableCheckpoint.ProtoReflect.Descriptor instead.
+func (*PersistStableCheckpoint) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PersistStableCheckpoint) GetStableCheckpoint() *StableCheckpoint {
+	if x != nil {
+		return x.StableCheckpoint
+	}
+	return nil
+}
+
+type PushCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushCheckpoint) Reset() {
+	*x = PushCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushCheckpoint) ProtoMessage() {}
+
+func (x *PushCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushCheckpoint.ProtoReflect.Descriptor instead.
+func (*PushCheckpoint) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{19}
+}
+
+type SBEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch    uint64           `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Instance uint64           `protobuf:"varint,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Event    *SBInstanceEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *SBEvent) Reset() {
+	*x = SBEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SBEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBEvent) ProtoMessage() {}
+
+func (x *SBEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBEvent.ProtoReflect.Descriptor instead.
+func (*SBEvent) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SBEvent) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SBEvent) GetInstance() uint64 {
+	if x != nil {
+		return x.Instance
+	}
+	return 0
+}
+
+func (x *SBEvent) GetEvent() *SBInstanceEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type SBInstanceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*SBInstanceEvent_Init
+	//	*SBInstanceEvent_Deliver
+	//	*SBInstanceEvent_MessageReceived
+	//	*SBInstanceEvent_PendingRequests
+	//	*SBInstanceEvent_Tick
+	//	*SBInstanceEvent_CutBatch
+	//	*SBInstanceEvent_BatchReady
+	//	*SBInstanceEvent_HashRequest
+	//	*SBInstanceEvent_HashResult
+	//	*SBInstanceEvent_SignResult
+	//	*SBInstanceEvent_NodeSigsVerified
+	//	*SBInstanceEvent_ResurrectBatch
+	//	*SBInstanceEvent_VerifyCert
+	//	*SBInstanceEvent_CertVerified
+	//	*SBInstanceEvent_PbftPersistPreprepare
+	//	*SBInstanceEvent_PbftPersistPrepare
+	//	*SBInstanceEvent_PbftPersistCommit
+	//	*SBInstanceEvent_PbftPersistSignedViewChange
+	//	*SBInstanceEvent_PbftPersistNewView
+	//	*SBInstanceEvent_PbftProposeTimeout
+	//	*SBInstanceEvent_PbftViewChangeBatchTimeout
+	//	*SBInstanceEvent_PbftViewChangeSegTimeout
+	//	*SBInstanceEvent_PbftFetchTimeout
+	Type isSBInstanceEvent_Type `protobuf_oneof:"type"`
+}
+
+func (x *SBInstanceEvent) Reset() {
+	*x = SBInstanceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SBInstanceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBInstanceEvent) ProtoMessage() {}
+
+func (x *SBInstanceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBInstanceEvent.ProtoReflect.Descriptor instead.
+func (*SBInstanceEvent) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *SBInstanceEvent) GetType() isSBInstanceEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *SBInstanceEvent) GetInit() *SBInit {
+	if x, ok := x.GetType().(*SBInstanceEvent_Init); ok {
+		return x.Init
+	}
+	return nil
+}
+
+func (x *SBInstanceEvent) GetDeliver() *SBDeliver {
+	if x, ok := x.GetType().(*SBInstanceEvent_Deliver); ok {
+		return x.Deliver
+	}
+	return nil
+}
+
+func (x *SBInstanceEvent) GetMessageReceived() *SBMessageReceived {
+	if x, ok := x.GetType().(*SBInstanceEvent_MessageReceived); ok {
+		return x.MessageReceived
+	}
+	return nil
+}
+
+func (x *SBInstanceEvent) GetPendingRequests() *SBPendingRequests {
+	if x, ok := x.GetType().(*SBInstanceEvent_PendingRequests); ok {
+		return x.PendingRequests
+	}
+	return nil
+}
+
+func (x *SBInstanceEvent) GetTick() *SBTick {
+	if x, ok := x.GetType().(*SBInstanceEvent_Tick); ok {
+		return x.Tick
+	}
 	return nil
 }
 
@@ -1405,6 +1860,20 @@ func (x *SBInstanceEvent) GetResurrectBatch() *requestpb.Batch {
 	return nil
 }
 
+func (x *SBInstanceEvent) GetVerifyCert() *SBVerifyCert {
+	if x, ok := x.GetType().(*SBInstanceEvent_VerifyCert); ok {
+		return x.VerifyCert
+	}
+	return nil
+}
+
+func (x *SBInstanceEvent) GetCertVerified() *SBCertVerified {
+	if x, ok := x.GetType().(*SBInstanceEvent_CertVerified); ok {
+		return x.CertVerified
+	}
+	return nil
+}
+
 func (x *SBInstanceEvent) GetPbftPersistPreprepare() *isspbftpb.Preprepare {
 	if x, ok := x.GetType().(*SBInstanceEvent_PbftPersistPreprepare); ok {
 		return x.PbftPersistPreprepare
@@ -1461,6 +1930,13 @@ func (x *SBInstanceEvent) GetPbftViewChangeSegTimeout() uint64 {
 	return 0
 }
 
+func (x *SBInstanceEvent) GetPbftFetchTimeout() *isspbftpb.FetchTimeout {
+	if x, ok := x.GetType().(*SBInstanceEvent_PbftFetchTimeout); ok {
+		return x.PbftFetchTimeout
+	}
+	return nil
+}
+
 type isSBInstanceEvent_Type interface {
 	isSBInstanceEvent_Type()
 }
@@ -1513,6 +1989,14 @@ type SBInstanceEvent_ResurrectBatch struct {
 	ResurrectBatch *requestpb.Batch `protobuf:"bytes,14,opt,name=resurrect_batch,json=resurrectBatch,proto3,oneof"`
 }
 
+type SBInstanceEvent_VerifyCert struct {
+	VerifyCert *SBVerifyCert `protobuf:"bytes,15,opt,name=verify_cert,json=verifyCert,proto3,oneof"`
+}
+
+type SBInstanceEvent_CertVerified struct {
+	CertVerified *SBCertVerified `protobuf:"bytes,16,opt,name=cert_verified,json=certVerified,proto3,oneof"`
+}
+
 type SBInstanceEvent_PbftPersistPreprepare struct {
 	PbftPersistPreprepare *isspbftpb.Preprepare `protobuf:"bytes,100,opt,name=pbft_persist_preprepare,json=pbftPersistPreprepare,proto3,oneof"`
 }
@@ -1545,6 +2029,10 @@ type SBInstanceEvent_PbftViewChangeSegTimeout struct {
 	PbftViewChangeSegTimeout uint64 `protobuf:"varint,107,opt,name=pbft_view_change_seg_timeout,json=pbftViewChangeSegTimeout,proto3,oneof"`
 }
 
+type SBInstanceEvent_PbftFetchTimeout struct {
+	PbftFetchTimeout *isspbftpb.FetchTimeout `protobuf:"bytes,108,opt,name=pbft_fetch_timeout,json=pbftFetchTimeout,proto3,oneof"`
+}
+
 func (*SBInstanceEvent_Init) isSBInstanceEvent_Type() {}
 
 func (*SBInstanceEvent_Deliver) isSBInstanceEvent_Type() {}
@@ -1569,6 +2057,10 @@ func (*SBInstanceEvent_NodeSigsVerified) isSBInstanceEvent_Type() {}
 
 func (*SBInstanceEvent_ResurrectBatch) isSBInstanceEvent_Type() {}
 
+func (*SBInstanceEvent_VerifyCert) isSBInstanceEvent_Type() {}
+
+func (*SBInstanceEvent_CertVerified) isSBInstanceEvent_Type() {}
+
 func (*SBInstanceEvent_PbftPersistPreprepare) isSBInstanceEvent_Type() {}
 
 func (*SBInstanceEvent_PbftPersistPrepare) isSBInstanceEvent_Type() {}
@@ -1585,6 +2077,8 @@ func (*SBInstanceEvent_PbftViewChangeBatchTimeout) isSBInstanceEvent_Type() {}
 
 func (*SBInstanceEvent_PbftViewChangeSegTimeout) isSBInstanceEvent_Type() {}
 
+func (*SBInstanceEvent_PbftFetchTimeout) isSBInstanceEvent_Type() {}
+
 type SBInit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1594,7 +2088,7 @@ type SBInit struct {
 func (x *SBInit) Reset() {
 	*x = SBInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[16]
+		mi := &file_isspb_isspb_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1607,7 +2101,7 @@ func (x *SBInit) String() string {
 func (*SBInit) ProtoMessage() {}
 
 func (x *SBInit) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[16]
+	mi := &file_isspb_isspb_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +2114,7 @@ func (x *SBInit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBInit.ProtoReflect.Descriptor instead.
 func (*SBInit) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{16}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{22}
 }
 
 type SBCutBatch struct {
@@ -1634,7 +2128,7 @@ type SBCutBatch struct {
 func (x *SBCutBatch) Reset() {
 	*x = SBCutBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[17]
+		mi := &file_isspb_isspb_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1647,7 +2141,7 @@ func (x *SBCutBatch) String() string {
 func (*SBCutBatch) ProtoMessage() {}
 
 func (x *SBCutBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[17]
+	mi := &file_isspb_isspb_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +2154,7 @@ func (x *SBCutBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBCutBatch.ProtoReflect.Descriptor instead.
 func (*SBCutBatch) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{17}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SBCutBatch) GetMaxSize() uint64 {
@@ -1675,14 +2169,15 @@ type SBBatchReady struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Batch               *requestpb.Batch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
-	PendingRequestsLeft uint64           `protobuf:"varint,2,opt,name=pending_requests_left,json=pendingRequestsLeft,proto3" json:"pending_requests_left,omitempty"`
+	Batch               *requestpb.Batch     `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	PendingRequestsLeft uint64               `protobuf:"varint,2,opt,name=pending_requests_left,json=pendingRequestsLeft,proto3" json:"pending_requests_left,omitempty"`
+	Cert                *availabilitypb.Cert `protobuf:"bytes,3,opt,name=cert,proto3" json:"cert,omitempty"`
 }
 
 func (x *SBBatchReady) Reset() {
 	*x = SBBatchReady{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[18]
+		mi := &file_isspb_isspb_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1695,7 +2190,7 @@ func (x *SBBatchReady) String() string {
 func (*SBBatchReady) ProtoMessage() {}
 
 func (x *SBBatchReady) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[18]
+	mi := &file_isspb_isspb_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,7 +2203,7 @@ func (x *SBBatchReady) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBBatchReady.ProtoReflect.Descriptor instead.
 func (*SBBatchReady) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{18}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SBBatchReady) GetBatch() *requestpb.Batch {
@@ -1725,20 +2220,28 @@ func (x *SBBatchReady) GetPendingRequestsLeft() uint64 {
 	return 0
 }
 
+func (x *SBBatchReady) GetCert() *availabilitypb.Cert {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
 type SBDeliver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sn      uint64           `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
-	Batch   *requestpb.Batch `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
-	Aborted bool             `protobuf:"varint,3,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Sn      uint64               `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	Batch   *requestpb.Batch     `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	Aborted bool                 `protobuf:"varint,3,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Cert    *availabilitypb.Cert `protobuf:"bytes,4,opt,name=cert,proto3" json:"cert,omitempty"`
 }
 
 func (x *SBDeliver) Reset() {
 	*x = SBDeliver{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[19]
+		mi := &file_isspb_isspb_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1751,7 +2254,7 @@ func (x *SBDeliver) String() string {
 func (*SBDeliver) ProtoMessage() {}
 
 func (x *SBDeliver) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[19]
+	mi := &file_isspb_isspb_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1764,7 +2267,7 @@ func (x *SBDeliver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBDeliver.ProtoReflect.Descriptor instead.
 func (*SBDeliver) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{19}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SBDeliver) GetSn() uint64 {
@@ -1788,6 +2291,13 @@ func (x *SBDeliver) GetAborted() bool {
 	return false
 }
 
+func (x *SBDeliver) GetCert() *availabilitypb.Cert {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
 type SBMessageReceived struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1800,7 +2310,7 @@ type SBMessageReceived struct {
 func (x *SBMessageReceived) Reset() {
 	*x = SBMessageReceived{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[20]
+		mi := &file_isspb_isspb_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1813,7 +2323,7 @@ func (x *SBMessageReceived) String() string {
 func (*SBMessageReceived) ProtoMessage() {}
 
 func (x *SBMessageReceived) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[20]
+	mi := &file_isspb_isspb_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,7 +2336,7 @@ func (x *SBMessageReceived) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBMessageReceived.ProtoReflect.Descriptor instead.
 func (*SBMessageReceived) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{20}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SBMessageReceived) GetFrom() string {
@@ -1854,7 +2364,7 @@ type SBPendingRequests struct {
 func (x *SBPendingRequests) Reset() {
 	*x = SBPendingRequests{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[21]
+		mi := &file_isspb_isspb_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1867,7 +2377,7 @@ func (x *SBPendingRequests) String() string {
 func (*SBPendingRequests) ProtoMessage() {}
 
 func (x *SBPendingRequests) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[21]
+	mi := &file_isspb_isspb_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,7 +2390,7 @@ func (x *SBPendingRequests) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBPendingRequests.ProtoReflect.Descriptor instead.
 func (*SBPendingRequests) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{21}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SBPendingRequests) GetNumRequests() uint64 {
@@ -1899,7 +2409,7 @@ type SBTick struct {
 func (x *SBTick) Reset() {
 	*x = SBTick{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[22]
+		mi := &file_isspb_isspb_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1912,7 +2422,7 @@ func (x *SBTick) String() string {
 func (*SBTick) ProtoMessage() {}
 
 func (x *SBTick) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[22]
+	mi := &file_isspb_isspb_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +2435,7 @@ func (x *SBTick) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBTick.ProtoReflect.Descriptor instead.
 func (*SBTick) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{22}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{28}
 }
 
 type SBHashRequest struct {
@@ -1940,7 +2450,7 @@ type SBHashRequest struct {
 func (x *SBHashRequest) Reset() {
 	*x = SBHashRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[23]
+		mi := &file_isspb_isspb_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1953,7 +2463,7 @@ func (x *SBHashRequest) String() string {
 func (*SBHashRequest) ProtoMessage() {}
 
 func (x *SBHashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[23]
+	mi := &file_isspb_isspb_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,7 +2476,7 @@ func (x *SBHashRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBHashRequest.ProtoReflect.Descriptor instead.
 func (*SBHashRequest) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{23}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SBHashRequest) GetData() []*commonpb.HashData {
@@ -1995,7 +2505,7 @@ type SBHashResult struct {
 func (x *SBHashResult) Reset() {
 	*x = SBHashResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[24]
+		mi := &file_isspb_isspb_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2008,7 +2518,7 @@ func (x *SBHashResult) String() string {
 func (*SBHashResult) ProtoMessage() {}
 
 func (x *SBHashResult) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[24]
+	mi := &file_isspb_isspb_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,7 +2531,7 @@ func (x *SBHashResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBHashResult.ProtoReflect.Descriptor instead.
 func (*SBHashResult) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{24}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SBHashResult) GetDigests() [][]byte {
@@ -2051,7 +2561,7 @@ type SBHashOrigin struct {
 func (x *SBHashOrigin) Reset() {
 	*x = SBHashOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[25]
+		mi := &file_isspb_isspb_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2064,7 +2574,7 @@ func (x *SBHashOrigin) String() string {
 func (*SBHashOrigin) ProtoMessage() {}
 
 func (x *SBHashOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[25]
+	mi := &file_isspb_isspb_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,7 +2587,7 @@ func (x *SBHashOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBHashOrigin.ProtoReflect.Descriptor instead.
 func (*SBHashOrigin) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{25}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SBHashOrigin) GetEpoch() uint64 {
@@ -2118,7 +2628,7 @@ type SBInstanceHashOrigin struct {
 func (x *SBInstanceHashOrigin) Reset() {
 	*x = SBInstanceHashOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[26]
+		mi := &file_isspb_isspb_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2131,7 +2641,7 @@ func (x *SBInstanceHashOrigin) String() string {
 func (*SBInstanceHashOrigin) ProtoMessage() {}
 
 func (x *SBInstanceHashOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[26]
+	mi := &file_isspb_isspb_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2144,7 +2654,7 @@ func (x *SBInstanceHashOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBInstanceHashOrigin.ProtoReflect.Descriptor instead.
 func (*SBInstanceHashOrigin) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{26}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{32}
 }
 
 func (m *SBInstanceHashOrigin) GetType() isSBInstanceHashOrigin_Type {
@@ -2201,54 +2711,294 @@ type SBInstanceHashOrigin_PbftMissingPreprepare struct {
 	PbftMissingPreprepare *isspbftpb.Preprepare `protobuf:"bytes,2,opt,name=pbft_missing_preprepare,json=pbftMissingPreprepare,proto3,oneof"`
 }
 
-type SBInstanceHashOrigin_PbftNewView struct {
-	PbftNewView *isspbftpb.NewView `protobuf:"bytes,3,opt,name=pbft_new_view,json=pbftNewView,proto3,oneof"`
+type SBInstanceHashOrigin_PbftNewView struct {
+	PbftNewView *isspbftpb.NewView `protobuf:"bytes,3,opt,name=pbft_new_view,json=pbftNewView,proto3,oneof"`
+}
+
+type SBInstanceHashOrigin_PbftEmptyPreprepares struct {
+	PbftEmptyPreprepares uint64 `protobuf:"varint,4,opt,name=pbft_empty_preprepares,json=pbftEmptyPreprepares,proto3,oneof"`
+}
+
+type SBInstanceHashOrigin_PbftCatchUpResponse struct {
+	PbftCatchUpResponse *isspbftpb.Preprepare `protobuf:"bytes,5,opt,name=pbft_catch_up_response,json=pbftCatchUpResponse,proto3,oneof"`
+}
+
+func (*SBInstanceHashOrigin_PbftPreprepare) isSBInstanceHashOrigin_Type() {}
+
+func (*SBInstanceHashOrigin_PbftMissingPreprepare) isSBInstanceHashOrigin_Type() {}
+
+func (*SBInstanceHashOrigin_PbftNewView) isSBInstanceHashOrigin_Type() {}
+
+func (*SBInstanceHashOrigin_PbftEmptyPreprepares) isSBInstanceHashOrigin_Type() {}
+
+func (*SBInstanceHashOrigin_PbftCatchUpResponse) isSBInstanceHashOrigin_Type() {}
+
+type SBSignResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature []byte                `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Origin    *SBInstanceSignOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *SBSignResult) Reset() {
+	*x = SBSignResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SBSignResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBSignResult) ProtoMessage() {}
+
+func (x *SBSignResult) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBSignResult.ProtoReflect.Descriptor instead.
+func (*SBSignResult) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SBSignResult) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *SBSignResult) GetOrigin() *SBInstanceSignOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+type SBSignOrigin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch    uint64                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Instance uint64                `protobuf:"varint,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Origin   *SBInstanceSignOrigin `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *SBSignOrigin) Reset() {
+	*x = SBSignOrigin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SBSignOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBSignOrigin) ProtoMessage() {}
+
+func (x *SBSignOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBSignOrigin.ProtoReflect.Descriptor instead.
+func (*SBSignOrigin) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SBSignOrigin) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SBSignOrigin) GetInstance() uint64 {
+	if x != nil {
+		return x.Instance
+	}
+	return 0
+}
+
+func (x *SBSignOrigin) GetOrigin() *SBInstanceSignOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+type SBInstanceSignOrigin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*SBInstanceSignOrigin_PbftViewChange
+	Type isSBInstanceSignOrigin_Type `protobuf_oneof:"type"`
+}
+
+func (x *SBInstanceSignOrigin) Reset() {
+	*x = SBInstanceSignOrigin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SBInstanceSignOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBInstanceSignOrigin) ProtoMessage() {}
+
+func (x *SBInstanceSignOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBInstanceSignOrigin.ProtoReflect.Descriptor instead.
+func (*SBInstanceSignOrigin) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{35}
+}
+
+func (m *SBInstanceSignOrigin) GetType() isSBInstanceSignOrigin_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *SBInstanceSignOrigin) GetPbftViewChange() *isspbftpb.ViewChange {
+	if x, ok := x.GetType().(*SBInstanceSignOrigin_PbftViewChange); ok {
+		return x.PbftViewChange
+	}
+	return nil
+}
+
+type isSBInstanceSignOrigin_Type interface {
+	isSBInstanceSignOrigin_Type()
+}
+
+type SBInstanceSignOrigin_PbftViewChange struct {
+	PbftViewChange *isspbftpb.ViewChange `protobuf:"bytes,1,opt,name=pbft_view_change,json=pbftViewChange,proto3,oneof"`
+}
+
+func (*SBInstanceSignOrigin_PbftViewChange) isSBInstanceSignOrigin_Type() {}
+
+type SBVerifyCert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cert   *availabilitypb.Cert  `protobuf:"bytes,1,opt,name=cert,proto3" json:"cert,omitempty"`
+	Origin *SBInstanceCertOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *SBVerifyCert) Reset() {
+	*x = SBVerifyCert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspb_isspb_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SBVerifyCert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SBVerifyCert) ProtoMessage() {}
+
+func (x *SBVerifyCert) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SBVerifyCert.ProtoReflect.Descriptor instead.
+func (*SBVerifyCert) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{36}
 }
 
-type SBInstanceHashOrigin_PbftEmptyPreprepares struct {
-	PbftEmptyPreprepares uint64 `protobuf:"varint,4,opt,name=pbft_empty_preprepares,json=pbftEmptyPreprepares,proto3,oneof"`
+func (x *SBVerifyCert) GetCert() *availabilitypb.Cert {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
 }
 
-type SBInstanceHashOrigin_PbftCatchUpResponse struct {
-	PbftCatchUpResponse *isspbftpb.Preprepare `protobuf:"bytes,5,opt,name=pbft_catch_up_response,json=pbftCatchUpResponse,proto3,oneof"`
+func (x *SBVerifyCert) GetOrigin() *SBInstanceCertOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
 }
 
-func (*SBInstanceHashOrigin_PbftPreprepare) isSBInstanceHashOrigin_Type() {}
-
-func (*SBInstanceHashOrigin_PbftMissingPreprepare) isSBInstanceHashOrigin_Type() {}
-
-func (*SBInstanceHashOrigin_PbftNewView) isSBInstanceHashOrigin_Type() {}
-
-func (*SBInstanceHashOrigin_PbftEmptyPreprepares) isSBInstanceHashOrigin_Type() {}
-
-func (*SBInstanceHashOrigin_PbftCatchUpResponse) isSBInstanceHashOrigin_Type() {}
-
-type SBSignResult struct {
+type SBCertVerified struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature []byte                `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Origin    *SBInstanceSignOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Valid  bool                  `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Err    string                `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	Origin *SBInstanceCertOrigin `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
-func (x *SBSignResult) Reset() {
-	*x = SBSignResult{}
+func (x *SBCertVerified) Reset() {
+	*x = SBCertVerified{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[27]
+		mi := &file_isspb_isspb_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SBSignResult) String() string {
+func (x *SBCertVerified) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SBSignResult) ProtoMessage() {}
+func (*SBCertVerified) ProtoMessage() {}
 
-func (x *SBSignResult) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[27]
+func (x *SBCertVerified) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2259,52 +3009,59 @@ func (x *SBSignResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SBSignResult.ProtoReflect.Descriptor instead.
-func (*SBSignResult) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SBCertVerified.ProtoReflect.Descriptor instead.
+func (*SBCertVerified) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SBSignResult) GetSignature() []byte {
+func (x *SBCertVerified) GetValid() bool {
 	if x != nil {
-		return x.Signature
+		return x.Valid
 	}
-	return nil
+	return false
 }
 
-func (x *SBSignResult) GetOrigin() *SBInstanceSignOrigin {
+func (x *SBCertVerified) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+func (x *SBCertVerified) GetOrigin() *SBInstanceCertOrigin {
 	if x != nil {
 		return x.Origin
 	}
 	return nil
 }
 
-type SBSignOrigin struct {
+type SBCertOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Epoch    uint64                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Instance uint64                `protobuf:"varint,2,opt,name=instance,proto3" json:"instance,omitempty"`
-	Origin   *SBInstanceSignOrigin `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Origin   *SBInstanceCertOrigin `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
-func (x *SBSignOrigin) Reset() {
-	*x = SBSignOrigin{}
+func (x *SBCertOrigin) Reset() {
+	*x = SBCertOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[28]
+		mi := &file_isspb_isspb_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SBSignOrigin) String() string {
+func (x *SBCertOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SBSignOrigin) ProtoMessage() {}
+func (*SBCertOrigin) ProtoMessage() {}
 
-func (x *SBSignOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[28]
+func (x *SBCertOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2315,59 +3072,59 @@ func (x *SBSignOrigin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SBSignOrigin.ProtoReflect.Descriptor instead.
-func (*SBSignOrigin) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SBCertOrigin.ProtoReflect.Descriptor instead.
+func (*SBCertOrigin) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SBSignOrigin) GetEpoch() uint64 {
+func (x *SBCertOrigin) GetEpoch() uint64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *SBSignOrigin) GetInstance() uint64 {
+func (x *SBCertOrigin) GetInstance() uint64 {
 	if x != nil {
 		return x.Instance
 	}
 	return 0
 }
 
-func (x *SBSignOrigin) GetOrigin() *SBInstanceSignOrigin {
+func (x *SBCertOrigin) GetOrigin() *SBInstanceCertOrigin {
 	if x != nil {
 		return x.Origin
 	}
 	return nil
 }
 
-type SBInstanceSignOrigin struct {
+type SBInstanceCertOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Type:
-	//	*SBInstanceSignOrigin_PbftViewChange
-	Type isSBInstanceSignOrigin_Type `protobuf_oneof:"type"`
+	//	*SBInstanceCertOrigin_PbftPreprepare
+	Type isSBInstanceCertOrigin_Type `protobuf_oneof:"type"`
 }
 
-func (x *SBInstanceSignOrigin) Reset() {
-	*x = SBInstanceSignOrigin{}
+func (x *SBInstanceCertOrigin) Reset() {
+	*x = SBInstanceCertOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[29]
+		mi := &file_isspb_isspb_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SBInstanceSignOrigin) String() string {
+func (x *SBInstanceCertOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SBInstanceSignOrigin) ProtoMessage() {}
+func (*SBInstanceCertOrigin) ProtoMessage() {}
 
-func (x *SBInstanceSignOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[29]
+func (x *SBInstanceCertOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_isspb_isspb_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2378,34 +3135,34 @@ func (x *SBInstanceSignOrigin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SBInstanceSignOrigin.ProtoReflect.Descriptor instead.
-func (*SBInstanceSignOrigin) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SBInstanceCertOrigin.ProtoReflect.Descriptor instead.
+func (*SBInstanceCertOrigin) Descriptor() ([]byte, []int) {
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{39}
 }
 
-func (m *SBInstanceSignOrigin) GetType() isSBInstanceSignOrigin_Type {
+func (m *SBInstanceCertOrigin) GetType() isSBInstanceCertOrigin_Type {
 	if m != nil {
 		return m.Type
 	}
 	return nil
 }
 
-func (x *SBInstanceSignOrigin) GetPbftViewChange() *isspbftpb.ViewChange {
-	if x, ok := x.GetType().(*SBInstanceSignOrigin_PbftViewChange); ok {
-		return x.PbftViewChange
+func (x *SBInstanceCertOrigin) GetPbftPreprepare() *isspbftpb.Preprepare {
+	if x, ok := x.GetType().(*SBInstanceCertOrigin_PbftPreprepare); ok {
+		return x.PbftPreprepare
 	}
 	return nil
 }
 
-type isSBInstanceSignOrigin_Type interface {
-	isSBInstanceSignOrigin_Type()
+type isSBInstanceCertOrigin_Type interface {
+	isSBInstanceCertOrigin_Type()
 }
 
-type SBInstanceSignOrigin_PbftViewChange struct {
-	PbftViewChange *isspbftpb.ViewChange `protobuf:"bytes,1,opt,name=pbft_view_change,json=pbftViewChange,proto3,oneof"`
+type SBInstanceCertOrigin_PbftPreprepare struct {
+	PbftPreprepare *isspbftpb.Preprepare `protobuf:"bytes,1,opt,name=pbft_preprepare,json=pbftPreprepare,proto3,oneof"`
 }
 
-func (*SBInstanceSignOrigin_PbftViewChange) isSBInstanceSignOrigin_Type() {}
+func (*SBInstanceCertOrigin_PbftPreprepare) isSBInstanceCertOrigin_Type() {}
 
 type SBNodeSigsVerified struct {
 	state         protoimpl.MessageState
@@ -2422,7 +3179,7 @@ type SBNodeSigsVerified struct {
 func (x *SBNodeSigsVerified) Reset() {
 	*x = SBNodeSigsVerified{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[30]
+		mi := &file_isspb_isspb_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2435,7 +3192,7 @@ func (x *SBNodeSigsVerified) String() string {
 func (*SBNodeSigsVerified) ProtoMessage() {}
 
 func (x *SBNodeSigsVerified) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[30]
+	mi := &file_isspb_isspb_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,7 +3205,7 @@ func (x *SBNodeSigsVerified) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBNodeSigsVerified.ProtoReflect.Descriptor instead.
 func (*SBNodeSigsVerified) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{30}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SBNodeSigsVerified) GetNodeIds() []string {
@@ -2499,7 +3256,7 @@ type SBSigVerOrigin struct {
 func (x *SBSigVerOrigin) Reset() {
 	*x = SBSigVerOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[31]
+		mi := &file_isspb_isspb_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2512,7 +3269,7 @@ func (x *SBSigVerOrigin) String() string {
 func (*SBSigVerOrigin) ProtoMessage() {}
 
 func (x *SBSigVerOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[31]
+	mi := &file_isspb_isspb_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,7 +3282,7 @@ func (x *SBSigVerOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBSigVerOrigin.ProtoReflect.Descriptor instead.
 func (*SBSigVerOrigin) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{31}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SBSigVerOrigin) GetEpoch() uint64 {
@@ -2563,7 +3320,7 @@ type SBInstanceSigVerOrigin struct {
 func (x *SBInstanceSigVerOrigin) Reset() {
 	*x = SBInstanceSigVerOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspb_isspb_proto_msgTypes[32]
+		mi := &file_isspb_isspb_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2576,7 +3333,7 @@ func (x *SBInstanceSigVerOrigin) String() string {
 func (*SBInstanceSigVerOrigin) ProtoMessage() {}
 
 func (x *SBInstanceSigVerOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_isspb_isspb_proto_msgTypes[32]
+	mi := &file_isspb_isspb_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,7 +3346,7 @@ func (x *SBInstanceSigVerOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SBInstanceSigVerOrigin.ProtoReflect.Descriptor instead.
 func (*SBInstanceSigVerOrigin) Descriptor() ([]byte, []int) {
-	return file_isspb_isspb_proto_rawDescGZIP(), []int{32}
+	return file_isspb_isspb_proto_rawDescGZIP(), []int{42}
 }
 
 func (m *SBInstanceSigVerOrigin) GetType() isSBInstanceSigVerOrigin_Type {
@@ -2638,385 +3395,498 @@ var file_isspb_isspb_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2f, 0x69,
 	0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x02, 0x0a, 0x0a, 0x49, 0x53,
-	0x53, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x02, 0x73, 0x62, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x02, 0x73, 0x62, 0x12, 0x33, 0x0a, 0x0a,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69,
-	0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x72, 0x65, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x44, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x09, 0x53, 0x42, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x22, 0x7a, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x73, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61,
-	0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xd3, 0x05, 0x0a,
-	0x11, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x73, 0x73,
-	0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00,
-	0x52, 0x0b, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x34, 0x0a,
-	0x0b, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x62, 0x66, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x54, 0x0a, 0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04,
+	0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83,
+	0x02, 0x0a, 0x0a, 0x49, 0x53, 0x53, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a,
+	0x02, 0x73, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x73, 0x73, 0x70,
+	0x62, 0x2e, 0x53, 0x42, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x02, 0x73,
+	0x62, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4c,
+	0x0a, 0x13, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73,
+	0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x42, 0x06, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x44, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x09, 0x53,
+	0x42, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e,
+	0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x70,
+	0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0xd3, 0x05, 0x0a, 0x11, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x62, 0x66, 0x74, 0x5f,
+	0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x62, 0x66, 0x74, 0x50,
+	0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x62, 0x66,
+	0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66,
+	0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x62,
+	0x66, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x54, 0x0a, 0x17, 0x70, 0x62, 0x66, 0x74,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70,
+	0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x14, 0x70, 0x62, 0x66, 0x74, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x56,
+	0x0a, 0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x15, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66,
+	0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00,
+	0x52, 0x15, 0x70, 0x62, 0x66, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x62, 0x66, 0x74, 0x5f,
+	0x6e, 0x65, 0x77, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69,
+	0x65, 0x77, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x62, 0x66, 0x74, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65,
+	0x77, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62,
+	0x2e, 0x44, 0x6f, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x08, 0x70, 0x62, 0x66, 0x74, 0x44, 0x6f, 0x6e,
+	0x65, 0x12, 0x4e, 0x0a, 0x15, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x75, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x70,
+	0x62, 0x66, 0x74, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4c, 0x0a, 0x16, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x75, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x13, 0x70, 0x62, 0x66, 0x74,
+	0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x08, 0x49, 0x53, 0x53, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x70, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x46, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x73,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x73,
+	0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x17, 0x70, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x02, 0x73, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x02, 0x73, 0x62, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x75, 0x73, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x92, 0x02, 0x0a, 0x0d, 0x49, 0x53, 0x53, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x02, 0x73, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x73, 0x62, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f,
+	0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x6e, 0x12, 0x2e,
+	0x0a, 0x12, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x10, 0x61, 0x70,
+	0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x36,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x06,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x0d, 0x49,
+	0x53, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x02,
+	0x73, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
+	0x2e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52,
+	0x02, 0x73, 0x62, 0x12, 0x2b, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x49, 0x53, 0x53,
+	0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x02,
+	0x73, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
+	0x2e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48,
+	0x00, 0x52, 0x02, 0x73, 0x62, 0x12, 0x2b, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x43, 0x65, 0x72, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x88, 0x02,
+	0x0a, 0x0d, 0x49, 0x53, 0x53, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x32, 0x0a, 0x07, 0x73, 0x62, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x65, 0x6e, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x73, 0x62, 0x53,
+	0x65, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x62, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e,
+	0x53, 0x42, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x73, 0x62, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x12, 0x29, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73,
+	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x53, 0x0a, 0x12, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x53, 0x42, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x73, 0x73, 0x70,
+	0x62, 0x2e, 0x53, 0x42, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x13, 0x53, 0x42, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
+	0x2e, 0x53, 0x42, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x22, 0x47, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0xd0, 0x01, 0x0a,
+	0x11, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x73, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0f, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x3e, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x8b, 0x02, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x35, 0x0a,
+	0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73,
+	0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x63, 0x65, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x43, 0x65, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a,
+	0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2e, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x61, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a,
+	0x06, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c,
+	0x6f, 0x77, 0x57, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x65, 0x64, 0x22, 0x5f, 0x0a, 0x17, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x44, 0x0a,
+	0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x07, 0x53, 0x42, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0xde, 0x0b, 0x0a, 0x0f, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x69,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x73, 0x73,
+	0x70, 0x62, 0x2e, 0x53, 0x42, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x45,
+	0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
+	0x2e, 0x53, 0x42, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x54, 0x69,
+	0x63, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x75,
+	0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x43, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0b,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x73, 0x73,
+	0x70, 0x62, 0x2e, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x68, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69,
+	0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x49, 0x0a, 0x12, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x73, 0x5f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73,
+	0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x69,
+	0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x75, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69,
+	0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72,
+	0x74, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x3c, 0x0a, 0x0d, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53,
+	0x42, 0x43, 0x65, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0c, 0x63, 0x65, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x4f, 0x0a,
+	0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x15, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x46,
+	0x0a, 0x14, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69,
+	0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x48, 0x00, 0x52, 0x12, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x13, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x11, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x63, 0x0a, 0x1f, 0x70,
+	0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x67,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62,
 	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x14, 0x70, 0x62, 0x66, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56,
-	0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x17, 0x70, 0x62, 0x66,
-	0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x73, 0x73,
-	0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x70, 0x62, 0x66, 0x74,
-	0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4f, 0x0a, 0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x15, 0x70, 0x62, 0x66,
-	0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x73, 0x73, 0x70,
-	0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52,
-	0x0b, 0x70, 0x62, 0x66, 0x74, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x2e, 0x0a, 0x09,
-	0x70, 0x62, 0x66, 0x74, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x6e, 0x65,
-	0x48, 0x00, 0x52, 0x08, 0x70, 0x62, 0x66, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x4e, 0x0a, 0x15,
-	0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x70, 0x62, 0x66, 0x74, 0x43, 0x61,
-	0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x16,
-	0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
-	0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x13, 0x70, 0x62, 0x66, 0x74, 0x43, 0x61, 0x74, 0x63, 0x68,
-	0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x08, 0x49, 0x53, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x49, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x19, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x65,
-	0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x17, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x20, 0x0a, 0x02, 0x73, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69,
-	0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x02,
-	0x73, 0x62, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x75, 0x73, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xca, 0x01, 0x0a,
-	0x0d, 0x49, 0x53, 0x53, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x25,
-	0x0a, 0x02, 0x73, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73,
-	0x70, 0x62, 0x2e, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48,
-	0x00, 0x52, 0x02, 0x73, 0x62, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74,
-	0x72, 0x79, 0x5f, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x6c,
-	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x10, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x2e,
-	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x6b,
-	0x0a, 0x0d, 0x49, 0x53, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
-	0x25, 0x0a, 0x02, 0x73, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x48, 0x00, 0x52, 0x02, 0x73, 0x62, 0x12, 0x2b, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x0f,
-	0x49, 0x53, 0x53, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
-	0x27, 0x0a, 0x02, 0x73, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x73, 0x62, 0x12, 0x2b, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x06, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
-	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x73,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2a,
-	0x0a, 0x11, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x73, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x43, 0x65,
-	0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x1a, 0x37, 0x0a,
-	0x09, 0x43, 0x65, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f, 0x0a, 0x17, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x44, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69,
-	0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x07, 0x53, 0x42, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x22, 0x9f, 0x0a, 0x0a, 0x0f, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x6e, 0x69, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53,
-	0x42, 0x49, 0x6e, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x69, 0x74, 0x12, 0x2c, 0x0a,
-	0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48,
-	0x00, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
-	0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x69, 0x63,
-	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e,
-	0x53, 0x42, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x30,
-	0x0a, 0x09, 0x63, 0x75, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x43, 0x75, 0x74, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x36, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x68,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
-	0x2e, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52,
-	0x0a, 0x68, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x73,
-	0x69, 0x67, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x73,
-	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69,
-	0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x6f,
-	0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3b,
-	0x0a, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73,
-	0x75, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x17, 0x70,
-	0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x70,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
-	0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x15, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x14,
-	0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x73, 0x73,
-	0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00,
-	0x52, 0x12, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x13, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x73, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x11, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73,
-	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x63, 0x0a, 0x1f, 0x70, 0x62, 0x66,
-	0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x67, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x1b, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x47,
-	0x0a, 0x15, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x6e,
-	0x65, 0x77, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65,
-	0x77, 0x48, 0x00, 0x52, 0x12, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
-	0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x32, 0x0a, 0x14, 0x70, 0x62, 0x66, 0x74, 0x5f,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x69, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x12, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x5f, 0x0a, 0x1e, 0x70,
-	0x62, 0x66, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x6a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e,
-	0x56, 0x43, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x00,
-	0x52, 0x1a, 0x70, 0x62, 0x66, 0x74, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x40, 0x0a, 0x1c,
-	0x70, 0x62, 0x66, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x73, 0x65, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x6b, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x00, 0x52, 0x18, 0x70, 0x62, 0x66, 0x74, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x06,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x08, 0x0a, 0x06, 0x53, 0x42, 0x49, 0x6e, 0x69, 0x74,
-	0x22, 0x27, 0x0a, 0x0a, 0x53, 0x42, 0x43, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19,
-	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6a, 0x0a, 0x0c, 0x53, 0x42, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x65, 0x48, 0x00, 0x52, 0x1b, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x47, 0x0a, 0x15, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x56,
+	0x69, 0x65, 0x77, 0x48, 0x00, 0x52, 0x12, 0x70, 0x62, 0x66, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x32, 0x0a, 0x14, 0x70, 0x62, 0x66,
+	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x69, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x12, 0x70, 0x62, 0x66, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x5f, 0x0a,
+	0x1e, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70,
+	0x62, 0x2e, 0x56, 0x43, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x48, 0x00, 0x52, 0x1a, 0x70, 0x62, 0x66, 0x74, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x40,
+	0x0a, 0x1c, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x73, 0x65, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x6b,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x18, 0x70, 0x62, 0x66, 0x74, 0x56, 0x69, 0x65, 0x77,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x47, 0x0a, 0x12, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69,
+	0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x10, 0x70, 0x62, 0x66, 0x74, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x08, 0x0a, 0x06, 0x53, 0x42, 0x49, 0x6e, 0x69, 0x74, 0x22, 0x27, 0x0a, 0x0a, 0x53,
+	0x42, 0x43, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x53, 0x42, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x32, 0x0a,
+	0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x4c, 0x65, 0x66,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x09,
+	0x53, 0x42, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x5d, 0x0a, 0x09, 0x53, 0x42, 0x44, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x73, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f,
-	0x72, 0x74, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x11, 0x53, 0x42, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73, 0x73,
-	0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x35, 0x0a, 0x11, 0x53, 0x42, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x22, 0x08, 0x0a, 0x06, 0x53, 0x42, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x64, 0x0a, 0x0d, 0x53, 0x42,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x48, 0x61,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x63,
+	0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52,
+	0x04, 0x63, 0x65, 0x72, 0x74, 0x22, 0x53, 0x0a, 0x11, 0x53, 0x42, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x73,
+	0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x35, 0x0a, 0x11, 0x53, 0x42,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x22, 0x08, 0x0a, 0x06, 0x53, 0x42, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x64, 0x0a, 0x0d, 0x53,
+	0x42, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x48,
+	0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x22, 0x5d, 0x0a, 0x0c, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73,
+	0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x61,
 	0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x22, 0x5d, 0x0a, 0x0c, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73,
-	0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22,
-	0x75, 0x0a, 0x0c, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x22, 0x75, 0x0a, 0x0c, 0x53, 0x42, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x14, 0x53, 0x42, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x40, 0x0a, 0x0f, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70,
+	0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x48, 0x00, 0x52, 0x0e, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x12, 0x4f, 0x0a, 0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x15, 0x70, 0x62,
+	0x66, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6e, 0x65, 0x77, 0x5f,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x73, 0x73,
+	0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x48, 0x00,
+	0x52, 0x0b, 0x70, 0x62, 0x66, 0x74, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x36, 0x0a,
+	0x16, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x14, 0x70, 0x62, 0x66, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x16, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x13,
+	0x70, 0x62, 0x66, 0x74, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x61, 0x0a, 0x0c, 0x53,
+	0x42, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70,
+	0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x75,
+	0x0a, 0x0c, 0x53, 0x42, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x61, 0x0a, 0x14, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x41, 0x0a,
+	0x10, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66,
+	0x74, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x0e, 0x70, 0x62, 0x66, 0x74, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6d, 0x0a, 0x0c, 0x53, 0x42, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x65, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65,
+	0x72, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x42, 0x43, 0x65, 0x72,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72,
+	0x72, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x75, 0x0a, 0x0c, 0x53, 0x42, 0x43, 0x65, 0x72, 0x74,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
+	0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x65, 0x72, 0x74, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x60, 0x0a,
+	0x14, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x65, 0x72, 0x74, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x72,
+	0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x65,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0xab, 0x01, 0x0a, 0x12, 0x53, 0x42, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x4f, 0x6b, 0x22, 0x79, 0x0a,
+	0x0e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
 	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
 	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x14, 0x53, 0x42, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
-	0x40, 0x0a, 0x0f, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
-	0x66, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48,
-	0x00, 0x52, 0x0e, 0x70, 0x62, 0x66, 0x74, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x12, 0x4f, 0x0a, 0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x15, 0x70, 0x62, 0x66,
-	0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x76,
-	0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x73, 0x73, 0x70,
-	0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52,
-	0x0b, 0x70, 0x62, 0x66, 0x74, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x12, 0x36, 0x0a, 0x16,
-	0x70, 0x62, 0x66, 0x74, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x14,
-	0x70, 0x62, 0x66, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x16, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x13, 0x70,
-	0x62, 0x66, 0x74, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x61, 0x0a, 0x0c, 0x53, 0x42,
-	0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
-	0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x75, 0x0a,
-	0x0c, 0x53, 0x42, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x33, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x22, 0x61, 0x0a, 0x14, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x10,
-	0x70, 0x62, 0x66, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74,
-	0x70, 0x62, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x0e, 0x70, 0x62, 0x66, 0x74, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
-	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x53, 0x42, 0x4e, 0x6f,
-	0x64, 0x65, 0x53, 0x69, 0x67, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e,
-	0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72,
-	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x15,
-	0x0a, 0x06, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x61, 0x6c, 0x6c, 0x4f, 0x6b, 0x22, 0x79, 0x0a, 0x0e, 0x53, 0x42, 0x53, 0x69, 0x67, 0x56, 0x65,
-	0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x73, 0x70,
-	0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x56,
-	0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x22, 0xb0, 0x01, 0x0a, 0x16, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53,
-	0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x54, 0x0a, 0x17, 0x70,
-	0x62, 0x66, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69,
-	0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56,
-	0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x14, 0x70, 0x62, 0x66,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x69,
-	0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
-	0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52, 0x0b,
-	0x70, 0x62, 0x66, 0x74, 0x4e, 0x65, 0x77, 0x56, 0x69, 0x65, 0x77, 0x42, 0x06, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x69, 0x73,
-	0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x42, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x16, 0x53, 0x42, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x54, 0x0a, 0x17, 0x70, 0x62, 0x66, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x14, 0x70, 0x62, 0x66, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56,
+	0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x70, 0x62, 0x66,
+	0x74, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77,
+	0x56, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x62, 0x66, 0x74, 0x4e, 0x65, 0x77, 0x56,
+	0x69, 0x65, 0x77, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f,
+	0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x69, 0x73, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3031,7 +3901,7 @@ func file_isspb_isspb_proto_rawDescGZIP() []byte {
 	return file_isspb_isspb_proto_rawDescData
 }
 
-var file_isspb_isspb_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_isspb_isspb_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_isspb_isspb_proto_goTypes = []interface{}{
 	(*ISSMessage)(nil),                  // 0: isspb.ISSMessage
 	(*RetransmitRequests)(nil),          // 1: isspb.RetransmitRequests
@@ -3043,116 +3913,150 @@ var file_isspb_isspb_proto_goTypes = []interface{}{
 	(*RequestHashOrigin)(nil),           // 7: isspb.RequestHashOrigin
 	(*ISSSignOrigin)(nil),               // 8: isspb.ISSSignOrigin
 	(*ISSSigVerOrigin)(nil),             // 9: isspb.ISSSigVerOrigin
-	(*PersistCheckpoint)(nil),           // 10: isspb.PersistCheckpoint
-	(*StableCheckpoint)(nil),            // 11: isspb.StableCheckpoint
-	(*PersistStableCheckpoint)(nil),     // 12: isspb.PersistStableCheckpoint
-	(*PushCheckpoint)(nil),              // 13: isspb.PushCheckpoint
-	(*SBEvent)(nil),                     // 14: isspb.SBEvent
-	(*SBInstanceEvent)(nil),             // 15: isspb.SBInstanceEvent
-	(*SBInit)(nil),                      // 16: isspb.SBInit
-	(*SBCutBatch)(nil),                  // 17: isspb.SBCutBatch
-	(*SBBatchReady)(nil),                // 18: isspb.SBBatchReady
-	(*SBDeliver)(nil),                   // 19: isspb.SBDeliver
-	(*SBMessageReceived)(nil),           // 20: isspb.SBMessageReceived
-	(*SBPendingRequests)(nil),           // 21: isspb.SBPendingRequests
-	(*SBTick)(nil),                      // 22: isspb.SBTick
-	(*SBHashRequest)(nil),               // 23: isspb.SBHashRequest
-	(*SBHashResult)(nil),                // 24: isspb.SBHashResult
-	(*SBHashOrigin)(nil),                // 25: isspb.SBHashOrigin
-	(*SBInstanceHashOrigin)(nil),        // 26: isspb.SBInstanceHashOrigin
-	(*SBSignResult)(nil),                // 27: isspb.SBSignResult
-	(*SBSignOrigin)(nil),                // 28: isspb.SBSignOrigin
-	(*SBInstanceSignOrigin)(nil),        // 29: isspb.SBInstanceSignOrigin
-	(*SBNodeSigsVerified)(nil),          // 30: isspb.SBNodeSigsVerified
-	(*SBSigVerOrigin)(nil),              // 31: isspb.SBSigVerOrigin
-	(*SBInstanceSigVerOrigin)(nil),      // 32: isspb.SBInstanceSigVerOrigin
-	nil,                                 // 33: isspb.StableCheckpoint.CertEntry
-	(*requestpb.Request)(nil),           // 34: requestpb.Request
-	(*isspbftpb.Preprepare)(nil),        // 35: isspbftpb.Preprepare
-	(*isspbftpb.Prepare)(nil),           // 36: isspbftpb.Prepare
-	(*isspbftpb.Commit)(nil),            // 37: isspbftpb.Commit
-	(*isspbftpb.SignedViewChange)(nil),  // 38: isspbftpb.SignedViewChange
-	(*isspbftpb.PreprepareRequest)(nil), // 39: isspbftpb.PreprepareRequest
-	(*isspbftpb.NewView)(nil),           // 40: isspbftpb.NewView
-	(*isspbftpb.Done)(nil),              // 41: isspbftpb.Done
-	(*isspbftpb.CatchUpRequest)(nil),    // 42: isspbftpb.CatchUpRequest
-	(*requestpb.Batch)(nil),             // 43: requestpb.Batch
-	(*isspbftpb.VCBatchTimeout)(nil),    // 44: isspbftpb.VCBatchTimeout
-	(*commonpb.HashData)(nil),           // 45: commonpb.HashData
-	(*isspbftpb.ViewChange)(nil),        // 46: isspbftpb.ViewChange
+	(*ISSAuthOrigin)(nil),               // 10: isspb.ISSAuthOrigin
+	(*SBAuthSendOrigin)(nil),            // 11: isspb.SBAuthSendOrigin
+	(*SBAuthReceiveOrigin)(nil),         // 12: isspb.SBAuthReceiveOrigin
+	(*CheckpointAuthReceiveOrigin)(nil), // 13: isspb.CheckpointAuthReceiveOrigin
+	(*PersistCheckpoint)(nil),           // 14: isspb.PersistCheckpoint
+	(*StableCheckpoint)(nil),            // 15: isspb.StableCheckpoint
+	(*ClientProgress)(nil),              // 16: isspb.ClientProgress
+	(*DeliveredReqs)(nil),               // 17: isspb.DeliveredReqs
+	(*PersistStableCheckpoint)(nil),     // 18: isspb.PersistStableCheckpoint
+	(*PushCheckpoint)(nil),              // 19: isspb.PushCheckpoint
+	(*SBEvent)(nil),                     // 20: isspb.SBEvent
+	(*SBInstanceEvent)(nil),             // 21: isspb.SBInstanceEvent
+	(*SBInit)(nil),                      // 22: isspb.SBInit
+	(*SBCutBatch)(nil),                  // 23: isspb.SBCutBatch
+	(*SBBatchReady)(nil),                // 24: isspb.SBBatchReady
+	(*SBDeliver)(nil),                   // 25: isspb.SBDeliver
+	(*SBMessageReceived)(nil),           // 26: isspb.SBMessageReceived
+	(*SBPendingRequests)(nil),           // 27: isspb.SBPendingRequests
+	(*SBTick)(nil),                      // 28: isspb.SBTick
+	(*SBHashRequest)(nil),               // 29: isspb.SBHashRequest
+	(*SBHashResult)(nil),                // 30: isspb.SBHashResult
+	(*SBHashOrigin)(nil),                // 31: isspb.SBHashOrigin
+	(*SBInstanceHashOrigin)(nil),        // 32: isspb.SBInstanceHashOrigin
+	(*SBSignResult)(nil),                // 33: isspb.SBSignResult
+	(*SBSignOrigin)(nil),                // 34: isspb.SBSignOrigin
+	(*SBInstanceSignOrigin)(nil),        // 35: isspb.SBInstanceSignOrigin
+	(*SBVerifyCert)(nil),                // 36: isspb.SBVerifyCert
+	(*SBCertVerified)(nil),              // 37: isspb.SBCertVerified
+	(*SBCertOrigin)(nil),                // 38: isspb.SBCertOrigin
+	(*SBInstanceCertOrigin)(nil),        // 39: isspb.SBInstanceCertOrigin
+	(*SBNodeSigsVerified)(nil),          // 40: isspb.SBNodeSigsVerified
+	(*SBSigVerOrigin)(nil),              // 41: isspb.SBSigVerOrigin
+	(*SBInstanceSigVerOrigin)(nil),      // 42: isspb.SBInstanceSigVerOrigin
+	nil,                                 // 43: isspb.StableCheckpoint.CertEntry
+	(*requestpb.Request)(nil),           // 44: requestpb.Request
+	(*commonpb.Authenticator)(nil),      // 45: commonpb.Authenticator
+	(*isspbftpb.Preprepare)(nil),        // 46: isspbftpb.Preprepare
+	(*isspbftpb.Prepare)(nil),           // 47: isspbftpb.Prepare
+	(*isspbftpb.Commit)(nil),            // 48: isspbftpb.Commit
+	(*isspbftpb.SignedViewChange)(nil),  // 49: isspbftpb.SignedViewChange
+	(*isspbftpb.PreprepareRequest)(nil), // 50: isspbftpb.PreprepareRequest
+	(*isspbftpb.NewView)(nil),           // 51: isspbftpb.NewView
+	(*isspbftpb.Done)(nil),              // 52: isspbftpb.Done
+	(*isspbftpb.CatchUpRequest)(nil),    // 53: isspbftpb.CatchUpRequest
+	(*requestpb.Batch)(nil),             // 54: requestpb.Batch
+	(*isspbftpb.VCBatchTimeout)(nil),    // 55: isspbftpb.VCBatchTimeout
+	(*isspbftpb.FetchTimeout)(nil),      // 56: isspbftpb.FetchTimeout
+	(*availabilitypb.Cert)(nil),         // 57: availabilitypb.Cert
+	(*commonpb.HashData)(nil),           // 58: commonpb.HashData
+	(*isspbftpb.ViewChange)(nil),        // 59: isspbftpb.ViewChange
 }
 var file_isspb_isspb_proto_depIdxs = []int32{
 	2,  // 0: isspb.ISSMessage.sb:type_name -> isspb.SBMessage
 	3,  // 1: isspb.ISSMessage.checkpoint:type_name -> isspb.Checkpoint
-	11, // 2: isspb.ISSMessage.stable_checkpoint:type_name -> isspb.StableCheckpoint
+	15, // 2: isspb.ISSMessage.stable_checkpoint:type_name -> isspb.StableCheckpoint
 	1,  // 3: isspb.ISSMessage.retransmit_requests:type_name -> isspb.RetransmitRequests
-	34, // 4: isspb.RetransmitRequests.requests:type_name -> requestpb.Request
+	44, // 4: isspb.RetransmitRequests.requests:type_name -> requestpb.Request
 	4,  // 5: isspb.SBMessage.msg:type_name -> isspb.SBInstanceMessage
-	35, // 6: isspb.SBInstanceMessage.pbft_preprepare:type_name -> isspbftpb.Preprepare
-	36, // 7: isspb.SBInstanceMessage.pbft_prepare:type_name -> isspbftpb.Prepare
-	37, // 8: isspb.SBInstanceMessage.pbft_commit:type_name -> isspbftpb.Commit
-	38, // 9: isspb.SBInstanceMessage.pbft_signed_view_change:type_name -> isspbftpb.SignedViewChange
-	39, // 10: isspb.SBInstanceMessage.pbft_preprepare_request:type_name -> isspbftpb.PreprepareRequest
-	35, // 11: isspb.SBInstanceMessage.pbft_missing_preprepare:type_name -> isspbftpb.Preprepare
-	40, // 12: isspb.SBInstanceMessage.pbft_new_view:type_name -> isspbftpb.NewView
-	41, // 13: isspb.SBInstanceMessage.pbft_done:type_name -> isspbftpb.Done
-	42, // 14: isspb.SBInstanceMessage.pbft_catch_up_request:type_name -> isspbftpb.CatchUpRequest
-	35, // 15: isspb.SBInstanceMessage.pbft_catch_up_response:type_name -> isspbftpb.Preprepare
-	10, // 16: isspb.ISSEvent.persist_checkpoint:type_name -> isspb.PersistCheckpoint
-	11, // 17: isspb.ISSEvent.stable_checkpoint:type_name -> isspb.StableCheckpoint
-	12, // 18: isspb.ISSEvent.persist_stable_checkpoint:type_name -> isspb.PersistStableCheckpoint
-	14, // 19: isspb.ISSEvent.sb:type_name -> isspb.SBEvent
-	13, // 20: isspb.ISSEvent.push_checkpoint:type_name -> isspb.PushCheckpoint
-	25, // 21: isspb.ISSHashOrigin.sb:type_name -> isspb.SBHashOrigin
-	7,  // 22: isspb.ISSHashOrigin.requests:type_name -> isspb.RequestHashOrigin
-	34, // 23: isspb.RequestHashOrigin.requests:type_name -> requestpb.Request
-	28, // 24: isspb.ISSSignOrigin.sb:type_name -> isspb.SBSignOrigin
-	31, // 25: isspb.ISSSigVerOrigin.sb:type_name -> isspb.SBSigVerOrigin
-	11, // 26: isspb.ISSSigVerOrigin.stable_checkpoint:type_name -> isspb.StableCheckpoint
-	33, // 27: isspb.StableCheckpoint.cert:type_name -> isspb.StableCheckpoint.CertEntry
-	11, // 28: isspb.PersistStableCheckpoint.stable_checkpoint:type_name -> isspb.StableCheckpoint
-	15, // 29: isspb.SBEvent.event:type_name -> isspb.SBInstanceEvent
-	16, // 30: isspb.SBInstanceEvent.init:type_name -> isspb.SBInit
-	19, // 31: isspb.SBInstanceEvent.deliver:type_name -> isspb.SBDeliver
-	20, // 32: isspb.SBInstanceEvent.message_received:type_name -> isspb.SBMessageReceived
-	21, // 33: isspb.SBInstanceEvent.pending_requests:type_name -> isspb.SBPendingRequests
-	22, // 34: isspb.SBInstanceEvent.tick:type_name -> isspb.SBTick
-	17, // 35: isspb.SBInstanceEvent.cut_batch:type_name -> isspb.SBCutBatch
-	18, // 36: isspb.SBInstanceEvent.batch_ready:type_name -> isspb.SBBatchReady
-	23, // 37: isspb.SBInstanceEvent.hash_request:type_name -> isspb.SBHashRequest
-	24, // 38: isspb.SBInstanceEvent.hash_result:type_name -> isspb.SBHashResult
-	27, // 39: isspb.SBInstanceEvent.sign_result:type_name -> isspb.SBSignResult
-	30, // 40: isspb.SBInstanceEvent.node_sigs_verified:type_name -> isspb.SBNodeSigsVerified
-	43, // 41: isspb.SBInstanceEvent.resurrect_batch:type_name -> requestpb.Batch
-	35, // 42: isspb.SBInstanceEvent.pbft_persist_preprepare:type_name -> isspbftpb.Preprepare
-	36, // 43: isspb.SBInstanceEvent.pbft_persist_prepare:type_name -> isspbftpb.Prepare
-	37, // 44: isspb.SBInstanceEvent.pbft_persist_commit:type_name -> isspbftpb.Commit
-	38, // 45: isspb.SBInstanceEvent.pbft_persist_signed_view_change:type_name -> isspbftpb.SignedViewChange
-	40, // 46: isspb.SBInstanceEvent.pbft_persist_new_view:type_name -> isspbftpb.NewView
-	44, // 47: isspb.SBInstanceEvent.pbft_view_change_batch_timeout:type_name -> isspbftpb.VCBatchTimeout
-	43, // 48: isspb.SBBatchReady.batch:type_name -> requestpb.Batch
-	43, // 49: isspb.SBDeliver.batch:type_name -> requestpb.Batch
-	4,  // 50: isspb.SBMessageReceived.msg:type_name -> isspb.SBInstanceMessage
-	45, // 51: isspb.SBHashRequest.data:type_name -> commonpb.HashData
-	25, // 52: isspb.SBHashRequest.origin:type_name -> isspb.SBHashOrigin
-	26, // 53: isspb.SBHashResult.origin:type_name -> isspb.SBInstanceHashOrigin
-	26, // 54: isspb.SBHashOrigin.origin:type_name -> isspb.SBInstanceHashOrigin
-	35, // 55: isspb.SBInstanceHashOrigin.pbft_preprepare:type_name -> isspbftpb.Preprepare
-	35, // 56: isspb.SBInstanceHashOrigin.pbft_missing_preprepare:type_name -> isspbftpb.Preprepare
-	40, // 57: isspb.SBInstanceHashOrigin.pbft_new_view:type_name -> isspbftpb.NewView
-	35, // 58: isspb.SBInstanceHashOrigin.pbft_catch_up_response:type_name -> isspbftpb.Preprepare
-	29, // 59: isspb.SBSignResult.origin:type_name -> isspb.SBInstanceSignOrigin
-	29, // 60: isspb.SBSignOrigin.origin:type_name -> isspb.SBInstanceSignOrigin
-	46, // 61: isspb.SBInstanceSignOrigin.pbft_view_change:type_name -> isspbftpb.ViewChange
-	32, // 62: isspb.SBNodeSigsVerified.origin:type_name -> isspb.SBInstanceSigVerOrigin
-	32, // 63: isspb.SBSigVerOrigin.origin:type_name -> isspb.SBInstanceSigVerOrigin
-	38, // 64: isspb.SBInstanceSigVerOrigin.pbft_signed_view_change:type_name -> isspbftpb.SignedViewChange
-	40, // 65: isspb.SBInstanceSigVerOrigin.pbft_new_view:type_name -> isspbftpb.NewView
-	66, // [66:66] is the sub-list for method output_type
-	66, // [66:66] is the sub-list for method input_type
-	66, // [66:66] is the sub-list for extension type_name
-	66, // [66:66] is the sub-list for extension extendee
-	0,  // [0:66] is the sub-list for field type_name
+	45, // 6: isspb.SBMessage.authenticator:type_name -> commonpb.Authenticator
+	45, // 7: isspb.Checkpoint.authenticator:type_name -> commonpb.Authenticator
+	46, // 8: isspb.SBInstanceMessage.pbft_preprepare:type_name -> isspbftpb.Preprepare
+	47, // 9: isspb.SBInstanceMessage.pbft_prepare:type_name -> isspbftpb.Prepare
+	48, // 10: isspb.SBInstanceMessage.pbft_commit:type_name -> isspbftpb.Commit
+	49, // 11: isspb.SBInstanceMessage.pbft_signed_view_change:type_name -> isspbftpb.SignedViewChange
+	50, // 12: isspb.SBInstanceMessage.pbft_preprepare_request:type_name -> isspbftpb.PreprepareRequest
+	46, // 13: isspb.SBInstanceMessage.pbft_missing_preprepare:type_name -> isspbftpb.Preprepare
+	51, // 14: isspb.SBInstanceMessage.pbft_new_view:type_name -> isspbftpb.NewView
+	52, // 15: isspb.SBInstanceMessage.pbft_done:type_name -> isspbftpb.Done
+	53, // 16: isspb.SBInstanceMessage.pbft_catch_up_request:type_name -> isspbftpb.CatchUpRequest
+	46, // 17: isspb.SBInstanceMessage.pbft_catch_up_response:type_name -> isspbftpb.Preprepare
+	14, // 18: isspb.ISSEvent.persist_checkpoint:type_name -> isspb.PersistCheckpoint
+	15, // 19: isspb.ISSEvent.stable_checkpoint:type_name -> isspb.StableCheckpoint
+	18, // 20: isspb.ISSEvent.persist_stable_checkpoint:type_name -> isspb.PersistStableCheckpoint
+	20, // 21: isspb.ISSEvent.sb:type_name -> isspb.SBEvent
+	19, // 22: isspb.ISSEvent.push_checkpoint:type_name -> isspb.PushCheckpoint
+	31, // 23: isspb.ISSHashOrigin.sb:type_name -> isspb.SBHashOrigin
+	7,  // 24: isspb.ISSHashOrigin.requests:type_name -> isspb.RequestHashOrigin
+	15, // 25: isspb.ISSHashOrigin.stable_checkpoint:type_name -> isspb.StableCheckpoint
+	44, // 26: isspb.RequestHashOrigin.requests:type_name -> requestpb.Request
+	34, // 27: isspb.ISSSignOrigin.sb:type_name -> isspb.SBSignOrigin
+	41, // 28: isspb.ISSSigVerOrigin.sb:type_name -> isspb.SBSigVerOrigin
+	15, // 29: isspb.ISSSigVerOrigin.stable_checkpoint:type_name -> isspb.StableCheckpoint
+	11, // 30: isspb.ISSAuthOrigin.sb_send:type_name -> isspb.SBAuthSendOrigin
+	12, // 31: isspb.ISSAuthOrigin.sb_receive:type_name -> isspb.SBAuthReceiveOrigin
+	13, // 32: isspb.ISSAuthOrigin.checkpoint_receive:type_name -> isspb.CheckpointAuthReceiveOrigin
+	2,  // 33: isspb.SBAuthSendOrigin.msg:type_name -> isspb.SBMessage
+	2,  // 34: isspb.SBAuthReceiveOrigin.msg:type_name -> isspb.SBMessage
+	16, // 35: isspb.PersistCheckpoint.client_progress:type_name -> isspb.ClientProgress
+	43, // 36: isspb.StableCheckpoint.cert:type_name -> isspb.StableCheckpoint.CertEntry
+	16, // 37: isspb.StableCheckpoint.client_progress:type_name -> isspb.ClientProgress
+	17, // 38: isspb.ClientProgress.clients:type_name -> isspb.DeliveredReqs
+	15, // 39: isspb.PersistStableCheckpoint.stable_checkpoint:type_name -> isspb.StableCheckpoint
+	21, // 40: isspb.SBEvent.event:type_name -> isspb.SBInstanceEvent
+	22, // 41: isspb.SBInstanceEvent.init:type_name -> isspb.SBInit
+	25, // 42: isspb.SBInstanceEvent.deliver:type_name -> isspb.SBDeliver
+	26, // 43: isspb.SBInstanceEvent.message_received:type_name -> isspb.SBMessageReceived
+	27, // 44: isspb.SBInstanceEvent.pending_requests:type_name -> isspb.SBPendingRequests
+	28, // 45: isspb.SBInstanceEvent.tick:type_name -> isspb.SBTick
+	23, // 46: isspb.SBInstanceEvent.cut_batch:type_name -> isspb.SBCutBatch
+	24, // 47: isspb.SBInstanceEvent.batch_ready:type_name -> isspb.SBBatchReady
+	29, // 48: isspb.SBInstanceEvent.hash_request:type_name -> isspb.SBHashRequest
+	30, // 49: isspb.SBInstanceEvent.hash_result:type_name -> isspb.SBHashResult
+	33, // 50: isspb.SBInstanceEvent.sign_result:type_name -> isspb.SBSignResult
+	40, // 51: isspb.SBInstanceEvent.node_sigs_verified:type_name -> isspb.SBNodeSigsVerified
+	54, // 52: isspb.SBInstanceEvent.resurrect_batch:type_name -> requestpb.Batch
+	36, // 53: isspb.SBInstanceEvent.verify_cert:type_name -> isspb.SBVerifyCert
+	37, // 54: isspb.SBInstanceEvent.cert_verified:type_name -> isspb.SBCertVerified
+	46, // 55: isspb.SBInstanceEvent.pbft_persist_preprepare:type_name -> isspbftpb.Preprepare
+	47, // 56: isspb.SBInstanceEvent.pbft_persist_prepare:type_name -> isspbftpb.Prepare
+	48, // 57: isspb.SBInstanceEvent.pbft_persist_commit:type_name -> isspbftpb.Commit
+	49, // 58: isspb.SBInstanceEvent.pbft_persist_signed_view_change:type_name -> isspbftpb.SignedViewChange
+	51, // 59: isspb.SBInstanceEvent.pbft_persist_new_view:type_name -> isspbftpb.NewView
+	55, // 60: isspb.SBInstanceEvent.pbft_view_change_batch_timeout:type_name -> isspbftpb.VCBatchTimeout
+	56, // 61: isspb.SBInstanceEvent.pbft_fetch_timeout:type_name -> isspbftpb.FetchTimeout
+	54, // 62: isspb.SBBatchReady.batch:type_name -> requestpb.Batch
+	57, // 63: isspb.SBBatchReady.cert:type_name -> availabilitypb.Cert
+	54, // 64: isspb.SBDeliver.batch:type_name -> requestpb.Batch
+	57, // 65: isspb.SBDeliver.cert:type_name -> availabilitypb.Cert
+	4,  // 66: isspb.SBMessageReceived.msg:type_name -> isspb.SBInstanceMessage
+	58, // 67: isspb.SBHashRequest.data:type_name -> commonpb.HashData
+	31, // 68: isspb.SBHashRequest.origin:type_name -> isspb.SBHashOrigin
+	32, // 69: isspb.SBHashResult.origin:type_name -> isspb.SBInstanceHashOrigin
+	32, // 70: isspb.SBHashOrigin.origin:type_name -> isspb.SBInstanceHashOrigin
+	46, // 71: isspb.SBInstanceHashOrigin.pbft_preprepare:type_name -> isspbftpb.Preprepare
+	46, // 72: isspb.SBInstanceHashOrigin.pbft_missing_preprepare:type_name -> isspbftpb.Preprepare
+	51, // 73: isspb.SBInstanceHashOrigin.pbft_new_view:type_name -> isspbftpb.NewView
+	46, // 74: isspb.SBInstanceHashOrigin.pbft_catch_up_response:type_name -> isspbftpb.Preprepare
+	35, // 75: isspb.SBSignResult.origin:type_name -> isspb.SBInstanceSignOrigin
+	35, // 76: isspb.SBSignOrigin.origin:type_name -> isspb.SBInstanceSignOrigin
+	59, // 77: isspb.SBInstanceSignOrigin.pbft_view_change:type_name -> isspbftpb.ViewChange
+	57, // 78: isspb.SBVerifyCert.cert:type_name -> availabilitypb.Cert
+	39, // 79: isspb.SBVerifyCert.origin:type_name -> isspb.SBInstanceCertOrigin
+	39, // 80: isspb.SBCertVerified.origin:type_name -> isspb.SBInstanceCertOrigin
+	39, // 81: isspb.SBCertOrigin.origin:type_name -> isspb.SBInstanceCertOrigin
+	46, // 82: isspb.SBInstanceCertOrigin.pbft_preprepare:type_name -> isspbftpb.Preprepare
+	42, // 83: isspb.SBNodeSigsVerified.origin:type_name -> isspb.SBInstanceSigVerOrigin
+	42, // 84: isspb.SBSigVerOrigin.origin:type_name -> isspb.SBInstanceSigVerOrigin
+	49, // 85: isspb.SBInstanceSigVerOrigin.pbft_signed_view_change:type_name -> isspbftpb.SignedViewChange
+	51, // 86: isspb.SBInstanceSigVerOrigin.pbft_new_view:type_name -> isspbftpb.NewView
+	87, // [87:87] is the sub-list for method output_type
+	87, // [87:87] is the sub-list for method input_type
+	87, // [87:87] is the sub-list for extension type_name
+	87, // [87:87] is the sub-list for extension extendee
+	0,  // [0:87] is the sub-list for field type_name
 }
 
 func init() { file_isspb_isspb_proto_init() }
@@ -3282,7 +4186,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PersistCheckpoint); i {
+			switch v := v.(*ISSAuthOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3294,7 +4198,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StableCheckpoint); i {
+			switch v := v.(*SBAuthSendOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3306,7 +4210,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PersistStableCheckpoint); i {
+			switch v := v.(*SBAuthReceiveOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3318,7 +4222,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushCheckpoint); i {
+			switch v := v.(*CheckpointAuthReceiveOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3330,7 +4234,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBEvent); i {
+			switch v := v.(*PersistCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3342,7 +4246,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBInstanceEvent); i {
+			switch v := v.(*StableCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3354,7 +4258,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBInit); i {
+			switch v := v.(*ClientProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3366,7 +4270,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBCutBatch); i {
+			switch v := v.(*DeliveredReqs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3378,7 +4282,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBBatchReady); i {
+			switch v := v.(*PersistStableCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3390,7 +4294,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBDeliver); i {
+			switch v := v.(*PushCheckpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3402,7 +4306,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBMessageReceived); i {
+			switch v := v.(*SBEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3414,7 +4318,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBPendingRequests); i {
+			switch v := v.(*SBInstanceEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3426,7 +4330,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBTick); i {
+			switch v := v.(*SBInit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3438,7 +4342,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBHashRequest); i {
+			switch v := v.(*SBCutBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3450,7 +4354,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBHashResult); i {
+			switch v := v.(*SBBatchReady); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3462,7 +4366,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBHashOrigin); i {
+			switch v := v.(*SBDeliver); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3474,7 +4378,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBInstanceHashOrigin); i {
+			switch v := v.(*SBMessageReceived); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3486,7 +4390,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBSignResult); i {
+			switch v := v.(*SBPendingRequests); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3498,7 +4402,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBSignOrigin); i {
+			switch v := v.(*SBTick); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3510,7 +4414,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBInstanceSignOrigin); i {
+			switch v := v.(*SBHashRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3522,7 +4426,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBNodeSigsVerified); i {
+			switch v := v.(*SBHashResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3534,7 +4438,7 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SBSigVerOrigin); i {
+			switch v := v.(*SBHashOrigin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3546,6 +4450,126 @@ func file_isspb_isspb_proto_init() {
 			}
 		}
 		file_isspb_isspb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBInstanceHashOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBSignResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBSignOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBInstanceSignOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBVerifyCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBCertVerified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBCertOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBInstanceCertOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBNodeSigsVerified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SBSigVerOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspb_isspb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SBInstanceSigVerOrigin); i {
 			case 0:
 				return &v.state
@@ -3588,6 +4612,7 @@ func file_isspb_isspb_proto_init() {
 		(*ISSHashOrigin_LogEntrySn)(nil),
 		(*ISSHashOrigin_AppSnapshotEpoch)(nil),
 		(*ISSHashOrigin_Requests)(nil),
+		(*ISSHashOrigin_StableCheckpoint)(nil),
 	}
 	file_isspb_isspb_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*ISSSignOrigin_Sb)(nil),
@@ -3597,8 +4622,15 @@ func file_isspb_isspb_proto_init() {
 		(*ISSSigVerOrigin_Sb)(nil),
 		(*ISSSigVerOrigin_CheckpointEpoch)(nil),
 		(*ISSSigVerOrigin_StableCheckpoint)(nil),
+		(*ISSSigVerOrigin_CheckpointCert)(nil),
 	}
-	file_isspb_isspb_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_isspb_isspb_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*ISSAuthOrigin_SbSend)(nil),
+		(*ISSAuthOrigin_SbReceive)(nil),
+		(*ISSAuthOrigin_CheckpointSend)(nil),
+		(*ISSAuthOrigin_CheckpointReceive)(nil),
+	}
+	file_isspb_isspb_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*SBInstanceEvent_Init)(nil),
 		(*SBInstanceEvent_Deliver)(nil),
 		(*SBInstanceEvent_MessageReceived)(nil),
@@ -3611,6 +4643,8 @@ func file_isspb_isspb_proto_init() {
 		(*SBInstanceEvent_SignResult)(nil),
 		(*SBInstanceEvent_NodeSigsVerified)(nil),
 		(*SBInstanceEvent_ResurrectBatch)(nil),
+		(*SBInstanceEvent_VerifyCert)(nil),
+		(*SBInstanceEvent_CertVerified)(nil),
 		(*SBInstanceEvent_PbftPersistPreprepare)(nil),
 		(*SBInstanceEvent_PbftPersistPrepare)(nil),
 		(*SBInstanceEvent_PbftPersistCommit)(nil),
@@ -3619,18 +4653,22 @@ func file_isspb_isspb_proto_init() {
 		(*SBInstanceEvent_PbftProposeTimeout)(nil),
 		(*SBInstanceEvent_PbftViewChangeBatchTimeout)(nil),
 		(*SBInstanceEvent_PbftViewChangeSegTimeout)(nil),
+		(*SBInstanceEvent_PbftFetchTimeout)(nil),
 	}
-	file_isspb_isspb_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_isspb_isspb_proto_msgTypes[32].OneofWrappers = []interface{}{
 		(*SBInstanceHashOrigin_PbftPreprepare)(nil),
 		(*SBInstanceHashOrigin_PbftMissingPreprepare)(nil),
 		(*SBInstanceHashOrigin_PbftNewView)(nil),
 		(*SBInstanceHashOrigin_PbftEmptyPreprepares)(nil),
 		(*SBInstanceHashOrigin_PbftCatchUpResponse)(nil),
 	}
-	file_isspb_isspb_proto_msgTypes[29].OneofWrappers = []interface{}{
+	file_isspb_isspb_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*SBInstanceSignOrigin_PbftViewChange)(nil),
 	}
-	file_isspb_isspb_proto_msgTypes[32].OneofWrappers = []interface{}{
+	file_isspb_isspb_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*SBInstanceCertOrigin_PbftPreprepare)(nil),
+	}
+	file_isspb_isspb_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*SBInstanceSigVerOrigin_PbftSignedViewChange)(nil),
 		(*SBInstanceSigVerOrigin_PbftNewView)(nil),
 	}
@@ -3640,7 +4678,7 @@ func file_isspb_isspb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_isspb_isspb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
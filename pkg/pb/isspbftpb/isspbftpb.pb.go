@@ -12,6 +12,7 @@
 package isspbftpb
 
 import (
+	availabilitypb "github.com/filecoin-project/mir/pkg/pb/availabilitypb"
 	requestpb "github.com/filecoin-project/mir/pkg/pb/requestpb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -31,10 +32,11 @@ type Preprepare struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sn      uint64           `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
-	View    uint64           `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
-	Batch   *requestpb.Batch `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
-	Aborted bool             `protobuf:"varint,4,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Sn      uint64               `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	View    uint64               `protobuf:"varint,2,opt,name=view,proto3" json:"view,omitempty"`
+	Batch   *requestpb.Batch     `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
+	Aborted bool                 `protobuf:"varint,4,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Cert    *availabilitypb.Cert `protobuf:"bytes,5,opt,name=cert,proto3" json:"cert,omitempty"`
 }
 
 func (x *Preprepare) Reset() {
@@ -97,6 +99,13 @@ func (x *Preprepare) GetAborted() bool {
 	return false
 }
 
+func (x *Preprepare) GetCert() *availabilitypb.Cert {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
 type Prepare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -813,6 +822,77 @@ func (x *VCBatchTimeout) GetNumCommitted() uint64 {
 	return 0
 }
 
+type FetchTimeout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sn      uint64 `protobuf:"varint,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	Digest  []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	CatchUp bool   `protobuf:"varint,3,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"`
+	Attempt uint64 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (x *FetchTimeout) Reset() {
+	*x = FetchTimeout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_isspbftpb_isspbftpb_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchTimeout) ProtoMessage() {}
+
+func (x *FetchTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_isspbftpb_isspbftpb_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchTimeout.ProtoReflect.Descriptor instead.
+func (*FetchTimeout) Descriptor() ([]byte, []int) {
+	return file_isspbftpb_isspbftpb_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FetchTimeout) GetSn() uint64 {
+	if x != nil {
+		return x.Sn
+	}
+	return 0
+}
+
+func (x *FetchTimeout) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *FetchTimeout) GetCatchUp() bool {
+	if x != nil {
+		return x.CatchUp
+	}
+	return false
+}
+
+func (x *FetchTimeout) GetAttempt() uint64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
 type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -822,7 +902,7 @@ type Status struct {
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_isspbftpb_isspbftpb_proto_msgTypes[13]
+		mi := &file_isspbftpb_isspbftpb_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -835,7 +915,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_isspbftpb_isspbftpb_proto_msgTypes[13]
+	mi := &file_isspbftpb_isspbftpb_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +928,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_isspbftpb_isspbftpb_proto_rawDescGZIP(), []int{13}
+	return file_isspbftpb_isspbftpb_proto_rawDescGZIP(), []int{14}
 }
 
 var File_isspbftpb_isspbftpb_proto protoreflect.FileDescriptor
@@ -858,14 +938,19 @@ var file_isspbftpb_isspbftpb_proto_rawDesc = []byte{
 	0x62, 0x66, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x69, 0x73, 0x73,
 	0x70, 0x62, 0x66, 0x74, 0x70, 0x62, 0x1a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70,
 	0x62, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x72, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x6f, 0x1a, 0x23, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70,
+	0x62, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x63,
+	0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52,
+	0x04, 0x63, 0x65, 0x72, 0x74, 0x22, 0x45, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
 	0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x6e,
 	0x12, 0x12, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
 	0x76, 0x69, 0x65, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03,
@@ -931,11 +1016,18 @@ var file_isspbftpb_isspbftpb_proto_rawDesc = []byte{
 	0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x76, 0x69, 0x65,
 	0x77, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0x08, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
-	0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69,
-	0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d,
-	0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66,
-	0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0x6b, 0x0a, 0x0c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x73, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x22, 0x08, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x32, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63,
+	0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x69, 0x73, 0x73, 0x70, 0x62, 0x66, 0x74, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -950,36 +1042,39 @@ func file_isspbftpb_isspbftpb_proto_rawDescGZIP() []byte {
 	return file_isspbftpb_isspbftpb_proto_rawDescData
 }
 
-var file_isspbftpb_isspbftpb_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_isspbftpb_isspbftpb_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_isspbftpb_isspbftpb_proto_goTypes = []interface{}{
-	(*Preprepare)(nil),        // 0: isspbftpb.Preprepare
-	(*Prepare)(nil),           // 1: isspbftpb.Prepare
-	(*Commit)(nil),            // 2: isspbftpb.Commit
-	(*Done)(nil),              // 3: isspbftpb.Done
-	(*CatchUpRequest)(nil),    // 4: isspbftpb.CatchUpRequest
-	(*ViewChange)(nil),        // 5: isspbftpb.ViewChange
-	(*SignedViewChange)(nil),  // 6: isspbftpb.SignedViewChange
-	(*NewView)(nil),           // 7: isspbftpb.NewView
-	(*PSetEntry)(nil),         // 8: isspbftpb.PSetEntry
-	(*QSetEntry)(nil),         // 9: isspbftpb.QSetEntry
-	(*PreprepareRequest)(nil), // 10: isspbftpb.PreprepareRequest
-	(*ReqWaitReference)(nil),  // 11: isspbftpb.ReqWaitReference
-	(*VCBatchTimeout)(nil),    // 12: isspbftpb.VCBatchTimeout
-	(*Status)(nil),            // 13: isspbftpb.Status
-	(*requestpb.Batch)(nil),   // 14: requestpb.Batch
+	(*Preprepare)(nil),          // 0: isspbftpb.Preprepare
+	(*Prepare)(nil),             // 1: isspbftpb.Prepare
+	(*Commit)(nil),              // 2: isspbftpb.Commit
+	(*Done)(nil),                // 3: isspbftpb.Done
+	(*CatchUpRequest)(nil),      // 4: isspbftpb.CatchUpRequest
+	(*ViewChange)(nil),          // 5: isspbftpb.ViewChange
+	(*SignedViewChange)(nil),    // 6: isspbftpb.SignedViewChange
+	(*NewView)(nil),             // 7: isspbftpb.NewView
+	(*PSetEntry)(nil),           // 8: isspbftpb.PSetEntry
+	(*QSetEntry)(nil),           // 9: isspbftpb.QSetEntry
+	(*PreprepareRequest)(nil),   // 10: isspbftpb.PreprepareRequest
+	(*ReqWaitReference)(nil),    // 11: isspbftpb.ReqWaitReference
+	(*VCBatchTimeout)(nil),      // 12: isspbftpb.VCBatchTimeout
+	(*FetchTimeout)(nil),        // 13: isspbftpb.FetchTimeout
+	(*Status)(nil),              // 14: isspbftpb.Status
+	(*requestpb.Batch)(nil),     // 15: requestpb.Batch
+	(*availabilitypb.Cert)(nil), // 16: availabilitypb.Cert
 }
 var file_isspbftpb_isspbftpb_proto_depIdxs = []int32{
-	14, // 0: isspbftpb.Preprepare.batch:type_name -> requestpb.Batch
-	8,  // 1: isspbftpb.ViewChange.p_set:type_name -> isspbftpb.PSetEntry
-	9,  // 2: isspbftpb.ViewChange.q_set:type_name -> isspbftpb.QSetEntry
-	5,  // 3: isspbftpb.SignedViewChange.view_change:type_name -> isspbftpb.ViewChange
-	6,  // 4: isspbftpb.NewView.signed_view_changes:type_name -> isspbftpb.SignedViewChange
-	0,  // 5: isspbftpb.NewView.preprepares:type_name -> isspbftpb.Preprepare
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	15, // 0: isspbftpb.Preprepare.batch:type_name -> requestpb.Batch
+	16, // 1: isspbftpb.Preprepare.cert:type_name -> availabilitypb.Cert
+	8,  // 2: isspbftpb.ViewChange.p_set:type_name -> isspbftpb.PSetEntry
+	9,  // 3: isspbftpb.ViewChange.q_set:type_name -> isspbftpb.QSetEntry
+	5,  // 4: isspbftpb.SignedViewChange.view_change:type_name -> isspbftpb.ViewChange
+	6,  // 5: isspbftpb.NewView.signed_view_changes:type_name -> isspbftpb.SignedViewChange
+	0,  // 6: isspbftpb.NewView.preprepares:type_name -> isspbftpb.Preprepare
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_isspbftpb_isspbftpb_proto_init() }
@@ -1145,6 +1240,18 @@ func file_isspbftpb_isspbftpb_proto_init() {
 			}
 		}
 		file_isspbftpb_isspbftpb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_isspbftpb_isspbftpb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -1163,7 +1270,7 @@ func file_isspbftpb_isspbftpb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_isspbftpb_isspbftpb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
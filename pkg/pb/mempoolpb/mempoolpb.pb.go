@@ -9,6 +9,7 @@ package mempoolpb
 import (
 	contextstorepb "github.com/filecoin-project/mir/pkg/pb/contextstorepb"
 	dslpb "github.com/filecoin-project/mir/pkg/pb/dslpb"
+	simplemempoolpb "github.com/filecoin-project/mir/pkg/pb/mempoolpb/simplemempoolpb"
 	_ "github.com/filecoin-project/mir/pkg/pb/mir"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -37,6 +38,12 @@ type Event struct {
 	//	*Event_TransactionIdsResponse
 	//	*Event_RequestBatchId
 	//	*Event_BatchIdResponse
+	//	*Event_SetRetentionIndex
+	//	*Event_ForgetTransactionsBefore
+	//	*Event_TransactionsCommitted
+	//	*Event_VerifyTransactions
+	//	*Event_TransactionsVerified
+	//	*Event_SimpleMempool
 	Type isEvent_Type `protobuf_oneof:"Type"`
 }
 
@@ -135,6 +142,48 @@ func (x *Event) GetBatchIdResponse() *BatchIDResponse {
 	return nil
 }
 
+func (x *Event) GetSetRetentionIndex() *SetRetentionIndex {
+	if x, ok := x.GetType().(*Event_SetRetentionIndex); ok {
+		return x.SetRetentionIndex
+	}
+	return nil
+}
+
+func (x *Event) GetForgetTransactionsBefore() *ForgetTransactionsBefore {
+	if x, ok := x.GetType().(*Event_ForgetTransactionsBefore); ok {
+		return x.ForgetTransactionsBefore
+	}
+	return nil
+}
+
+func (x *Event) GetTransactionsCommitted() *TransactionsCommitted {
+	if x, ok := x.GetType().(*Event_TransactionsCommitted); ok {
+		return x.TransactionsCommitted
+	}
+	return nil
+}
+
+func (x *Event) GetVerifyTransactions() *VerifyTransactions {
+	if x, ok := x.GetType().(*Event_VerifyTransactions); ok {
+		return x.VerifyTransactions
+	}
+	return nil
+}
+
+func (x *Event) GetTransactionsVerified() *TransactionsVerified {
+	if x, ok := x.GetType().(*Event_TransactionsVerified); ok {
+		return x.TransactionsVerified
+	}
+	return nil
+}
+
+func (x *Event) GetSimpleMempool() *simplemempoolpb.Event {
+	if x, ok := x.GetType().(*Event_SimpleMempool); ok {
+		return x.SimpleMempool
+	}
+	return nil
+}
+
 type isEvent_Type interface {
 	isEvent_Type()
 }
@@ -171,6 +220,31 @@ type Event_BatchIdResponse struct {
 	BatchIdResponse *BatchIDResponse `protobuf:"bytes,8,opt,name=batch_id_response,json=batchIdResponse,proto3,oneof"`
 }
 
+type Event_SetRetentionIndex struct {
+	SetRetentionIndex *SetRetentionIndex `protobuf:"bytes,9,opt,name=set_retention_index,json=setRetentionIndex,proto3,oneof"`
+}
+
+type Event_ForgetTransactionsBefore struct {
+	ForgetTransactionsBefore *ForgetTransactionsBefore `protobuf:"bytes,10,opt,name=forget_transactions_before,json=forgetTransactionsBefore,proto3,oneof"`
+}
+
+type Event_TransactionsCommitted struct {
+	TransactionsCommitted *TransactionsCommitted `protobuf:"bytes,11,opt,name=transactions_committed,json=transactionsCommitted,proto3,oneof"`
+}
+
+type Event_VerifyTransactions struct {
+	VerifyTransactions *VerifyTransactions `protobuf:"bytes,12,opt,name=verify_transactions,json=verifyTransactions,proto3,oneof"`
+}
+
+type Event_TransactionsVerified struct {
+	TransactionsVerified *TransactionsVerified `protobuf:"bytes,13,opt,name=transactions_verified,json=transactionsVerified,proto3,oneof"`
+}
+
+type Event_SimpleMempool struct {
+	// Internal events of the simple mempool.
+	SimpleMempool *simplemempoolpb.Event `protobuf:"bytes,14,opt,name=simple_mempool,json=simpleMempool,proto3,oneof"`
+}
+
 func (*Event_RequestBatch) isEvent_Type() {}
 
 func (*Event_NewBatch) isEvent_Type() {}
@@ -187,6 +261,18 @@ func (*Event_RequestBatchId) isEvent_Type() {}
 
 func (*Event_BatchIdResponse) isEvent_Type() {}
 
+func (*Event_SetRetentionIndex) isEvent_Type() {}
+
+func (*Event_ForgetTransactionsBefore) isEvent_Type() {}
+
+func (*Event_TransactionsCommitted) isEvent_Type() {}
+
+func (*Event_VerifyTransactions) isEvent_Type() {}
+
+func (*Event_TransactionsVerified) isEvent_Type() {}
+
+func (*Event_SimpleMempool) isEvent_Type() {}
+
 // RequestBatch is used by the availability layer to request a new batch of transactions from the mempool.
 type RequestBatch struct {
 	state         protoimpl.MessageState
@@ -241,7 +327,7 @@ type NewBatch struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxIds  []string            `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	TxIds  [][]byte            `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
 	Txs    [][]byte            `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
 	Origin *RequestBatchOrigin `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
 }
@@ -278,7 +364,7 @@ func (*NewBatch) Descriptor() ([]byte, []int) {
 	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *NewBatch) GetTxIds() []string {
+func (x *NewBatch) GetTxIds() [][]byte {
 	if x != nil {
 		return x.TxIds
 	}
@@ -306,7 +392,7 @@ type RequestTransactions struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxIds  []string                   `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	TxIds  [][]byte                   `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
 	Origin *RequestTransactionsOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
@@ -342,7 +428,7 @@ func (*RequestTransactions) Descriptor() ([]byte, []int) {
 	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RequestTransactions) GetTxIds() []string {
+func (x *RequestTransactions) GetTxIds() [][]byte {
 	if x != nil {
 		return x.TxIds
 	}
@@ -483,7 +569,7 @@ type TransactionIDsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxIds  []string                     `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	TxIds  [][]byte                     `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
 	Origin *RequestTransactionIDsOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
@@ -519,7 +605,7 @@ func (*TransactionIDsResponse) Descriptor() ([]byte, []int) {
 	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TransactionIDsResponse) GetTxIds() []string {
+func (x *TransactionIDsResponse) GetTxIds() [][]byte {
 	if x != nil {
 		return x.TxIds
 	}
@@ -540,7 +626,7 @@ type RequestBatchID struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxIds  []string              `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	TxIds  [][]byte              `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
 	Origin *RequestBatchIDOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
@@ -576,7 +662,7 @@ func (*RequestBatchID) Descriptor() ([]byte, []int) {
 	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RequestBatchID) GetTxIds() []string {
+func (x *RequestBatchID) GetTxIds() [][]byte {
 	if x != nil {
 		return x.TxIds
 	}
@@ -596,7 +682,7 @@ type BatchIDResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BatchId string                `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	BatchId []byte                `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	Origin  *RequestBatchIDOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
@@ -632,11 +718,11 @@ func (*BatchIDResponse) Descriptor() ([]byte, []int) {
 	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BatchIDResponse) GetBatchId() string {
+func (x *BatchIDResponse) GetBatchId() []byte {
 	if x != nil {
 		return x.BatchId
 	}
-	return ""
+	return nil
 }
 
 func (x *BatchIDResponse) GetOrigin() *RequestBatchIDOrigin {
@@ -646,6 +732,286 @@ func (x *BatchIDResponse) GetOrigin() *RequestBatchIDOrigin {
 	return nil
 }
 
+// SetRetentionIndex is used by the availability layer to inform the mempool about the retention index
+// to associate with all the transactions the mempool includes in batches from now on.
+// Retention indices must be monotonically non-decreasing.
+type SetRetentionIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetentionIndex uint64 `protobuf:"varint,1,opt,name=retention_index,json=retentionIndex,proto3" json:"retention_index,omitempty"`
+}
+
+func (x *SetRetentionIndex) Reset() {
+	*x = SetRetentionIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionIndex) ProtoMessage() {}
+
+func (x *SetRetentionIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionIndex.ProtoReflect.Descriptor instead.
+func (*SetRetentionIndex) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetRetentionIndex) GetRetentionIndex() uint64 {
+	if x != nil {
+		return x.RetentionIndex
+	}
+	return 0
+}
+
+// ForgetTransactionsBefore is used by the availability layer to let the mempool garbage-collect all the transactions
+// included in batches associated with a retention index smaller than retention_index,
+// as the availability layer will never request them again.
+type ForgetTransactionsBefore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetentionIndex uint64 `protobuf:"varint,1,opt,name=retention_index,json=retentionIndex,proto3" json:"retention_index,omitempty"`
+}
+
+func (x *ForgetTransactionsBefore) Reset() {
+	*x = ForgetTransactionsBefore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetTransactionsBefore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetTransactionsBefore) ProtoMessage() {}
+
+func (x *ForgetTransactionsBefore) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetTransactionsBefore.ProtoReflect.Descriptor instead.
+func (*ForgetTransactionsBefore) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ForgetTransactionsBefore) GetRetentionIndex() uint64 {
+	if x != nil {
+		return x.RetentionIndex
+	}
+	return 0
+}
+
+// TransactionsCommitted is used by the consensus layer to inform the mempool that the given transactions
+// have been committed, such that the mempool does not include them in any further batch.
+type TransactionsCommitted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *TransactionsCommitted) Reset() {
+	*x = TransactionsCommitted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionsCommitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionsCommitted) ProtoMessage() {}
+
+func (x *TransactionsCommitted) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionsCommitted.ProtoReflect.Descriptor instead.
+func (*TransactionsCommitted) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransactionsCommitted) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+// VerifyTransactions is used to have transactions validated by the application, before they enter the mempool
+// and before a node vouches for a batch of transactions received from another node.
+type VerifyTransactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs    [][]byte                  `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Origin *VerifyTransactionsOrigin `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+}
+
+func (x *VerifyTransactions) Reset() {
+	*x = VerifyTransactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyTransactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTransactions) ProtoMessage() {}
+
+func (x *VerifyTransactions) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTransactions.ProtoReflect.Descriptor instead.
+func (*VerifyTransactions) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyTransactions) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *VerifyTransactions) GetOrigin() *VerifyTransactionsOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+// TransactionsVerified is a response to a VerifyTransactions event.
+// For each transaction, valid indicates whether the transaction is valid and, if not, errors contains the reason.
+// Priorities contains the application-defined priority (e.g., a fee) of each transaction.
+type TransactionsVerified struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid      []bool                    `protobuf:"varint,1,rep,packed,name=valid,proto3" json:"valid,omitempty"`
+	Errors     []string                  `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Origin     *VerifyTransactionsOrigin `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Priorities []uint64                  `protobuf:"varint,4,rep,packed,name=priorities,proto3" json:"priorities,omitempty"`
+}
+
+func (x *TransactionsVerified) Reset() {
+	*x = TransactionsVerified{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionsVerified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionsVerified) ProtoMessage() {}
+
+func (x *TransactionsVerified) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionsVerified.ProtoReflect.Descriptor instead.
+func (*TransactionsVerified) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransactionsVerified) GetValid() []bool {
+	if x != nil {
+		return x.Valid
+	}
+	return nil
+}
+
+func (x *TransactionsVerified) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TransactionsVerified) GetOrigin() *VerifyTransactionsOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *TransactionsVerified) GetPriorities() []uint64 {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
 type RequestBatchOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -661,7 +1027,7 @@ type RequestBatchOrigin struct {
 func (x *RequestBatchOrigin) Reset() {
 	*x = RequestBatchOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[9]
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -674,7 +1040,7 @@ func (x *RequestBatchOrigin) String() string {
 func (*RequestBatchOrigin) ProtoMessage() {}
 
 func (x *RequestBatchOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[9]
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +1053,7 @@ func (x *RequestBatchOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestBatchOrigin.ProtoReflect.Descriptor instead.
 func (*RequestBatchOrigin) Descriptor() ([]byte, []int) {
-	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{9}
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RequestBatchOrigin) GetModule() string {
@@ -749,7 +1115,7 @@ type RequestTransactionsOrigin struct {
 func (x *RequestTransactionsOrigin) Reset() {
 	*x = RequestTransactionsOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[10]
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +1128,7 @@ func (x *RequestTransactionsOrigin) String() string {
 func (*RequestTransactionsOrigin) ProtoMessage() {}
 
 func (x *RequestTransactionsOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[10]
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1141,7 @@ func (x *RequestTransactionsOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestTransactionsOrigin.ProtoReflect.Descriptor instead.
 func (*RequestTransactionsOrigin) Descriptor() ([]byte, []int) {
-	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{10}
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RequestTransactionsOrigin) GetModule() string {
@@ -837,7 +1203,7 @@ type RequestTransactionIDsOrigin struct {
 func (x *RequestTransactionIDsOrigin) Reset() {
 	*x = RequestTransactionIDsOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[11]
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -850,7 +1216,7 @@ func (x *RequestTransactionIDsOrigin) String() string {
 func (*RequestTransactionIDsOrigin) ProtoMessage() {}
 
 func (x *RequestTransactionIDsOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[11]
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,7 +1229,7 @@ func (x *RequestTransactionIDsOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestTransactionIDsOrigin.ProtoReflect.Descriptor instead.
 func (*RequestTransactionIDsOrigin) Descriptor() ([]byte, []int) {
-	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{11}
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RequestTransactionIDsOrigin) GetModule() string {
@@ -925,7 +1291,7 @@ type RequestBatchIDOrigin struct {
 func (x *RequestBatchIDOrigin) Reset() {
 	*x = RequestBatchIDOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[12]
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -938,7 +1304,7 @@ func (x *RequestBatchIDOrigin) String() string {
 func (*RequestBatchIDOrigin) ProtoMessage() {}
 
 func (x *RequestBatchIDOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[12]
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +1317,7 @@ func (x *RequestBatchIDOrigin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestBatchIDOrigin.ProtoReflect.Descriptor instead.
 func (*RequestBatchIDOrigin) Descriptor() ([]byte, []int) {
-	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{12}
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RequestBatchIDOrigin) GetModule() string {
@@ -998,6 +1364,94 @@ func (*RequestBatchIDOrigin_ContextStore) isRequestBatchIDOrigin_Type() {}
 
 func (*RequestBatchIDOrigin_Dsl) isRequestBatchIDOrigin_Type() {}
 
+type VerifyTransactionsOrigin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Types that are assignable to Type:
+	//	*VerifyTransactionsOrigin_ContextStore
+	//	*VerifyTransactionsOrigin_Dsl
+	Type isVerifyTransactionsOrigin_Type `protobuf_oneof:"Type"`
+}
+
+func (x *VerifyTransactionsOrigin) Reset() {
+	*x = VerifyTransactionsOrigin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_mempoolpb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyTransactionsOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTransactionsOrigin) ProtoMessage() {}
+
+func (x *VerifyTransactionsOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_mempoolpb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTransactionsOrigin.ProtoReflect.Descriptor instead.
+func (*VerifyTransactionsOrigin) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_mempoolpb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VerifyTransactionsOrigin) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (m *VerifyTransactionsOrigin) GetType() isVerifyTransactionsOrigin_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *VerifyTransactionsOrigin) GetContextStore() *contextstorepb.Origin {
+	if x, ok := x.GetType().(*VerifyTransactionsOrigin_ContextStore); ok {
+		return x.ContextStore
+	}
+	return nil
+}
+
+func (x *VerifyTransactionsOrigin) GetDsl() *dslpb.Origin {
+	if x, ok := x.GetType().(*VerifyTransactionsOrigin_Dsl); ok {
+		return x.Dsl
+	}
+	return nil
+}
+
+type isVerifyTransactionsOrigin_Type interface {
+	isVerifyTransactionsOrigin_Type()
+}
+
+type VerifyTransactionsOrigin_ContextStore struct {
+	ContextStore *contextstorepb.Origin `protobuf:"bytes,2,opt,name=context_store,json=contextStore,proto3,oneof"`
+}
+
+type VerifyTransactionsOrigin_Dsl struct {
+	Dsl *dslpb.Origin `protobuf:"bytes,3,opt,name=dsl,proto3,oneof"`
+}
+
+func (*VerifyTransactionsOrigin_ContextStore) isVerifyTransactionsOrigin_Type() {}
+
+func (*VerifyTransactionsOrigin_Dsl) isVerifyTransactionsOrigin_Type() {}
+
 var File_mempoolpb_mempoolpb_proto protoreflect.FileDescriptor
 
 var file_mempoolpb_mempoolpb_proto_rawDesc = []byte{
@@ -1006,132 +1460,175 @@ var file_mempoolpb_mempoolpb_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x1a, 0x23, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73,
 	0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74,
 	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x64, 0x73, 0x6c,
-	0x70, 0x62, 0x2f, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10,
-	0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x82, 0x05, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x09, 0x6e, 0x65,
-	0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x53,
-	0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x70, 0x62, 0x2f, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x10, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x87, 0x09, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x09, 0x6e,
+	0x65, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x53, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
+	0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x17,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x48,
+	0x00, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x48, 0x00, 0x52, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x48,
+	0x0a, 0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x11, 0x73, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x63, 0x0a, 0x1a, 0x66, 0x6f, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x48, 0x00, 0x52, 0x18, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x59, 0x0a,
+	0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70,
+	0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x15, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x48, 0x00, 0x52, 0x14, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x49, 0x0a, 0x0e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x42, 0x0c, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0x45, 0x0a, 0x0c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65,
+	0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x22, 0x6a, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05,
+	0x74, 0x78, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6a,
+	0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d,
 	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x13,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x54,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x14, 0x54,
 	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x17, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d,
-	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x48, 0x00,
-	0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
-	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x48, 0x00, 0x52, 0x0e, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x48, 0x0a,
-	0x11, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x04, 0x80, 0xb5, 0x18, 0x01, 0x22, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6a, 0x0a, 0x08,
-	0x4e, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78,
-	0x73, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6a, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x07,
-	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12,
+	0x3c, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x69, 0x0a,
+	0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x6f, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
 	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x69, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74,
-	0x78, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x22, 0x6f, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06,
-	0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78,
-	0x49, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x22, 0x60, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x49, 0x44, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x06,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
-	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x65, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x96, 0x01, 0x0a,
-	0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e,
-	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e,
-	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x4f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03,
-	0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70,
-	0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42,
-	0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x60, 0x0a, 0x0e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x12, 0x15, 0x0a, 0x06, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x49,
+	0x64, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x65, 0x0a, 0x0f, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x44, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x22, 0x3c, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x43, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x29, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73,
+	0x22, 0x63, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62,
+	0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
 	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
 	0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
@@ -1140,10 +1637,40 @@ var file_mempoolpb_mempoolpb_proto_rawDesc = []byte{
 	0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69,
 	0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x6d,
-	0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x44, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x48, 0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x9c, 0x01, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x64, 0x73, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x64, 0x73, 0x6c, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48,
+	0x00, 0x52, 0x03, 0x64, 0x73, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x42, 0x32,
+	0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c,
+	0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69,
+	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1158,7 +1685,7 @@ func file_mempoolpb_mempoolpb_proto_rawDescGZIP() []byte {
 	return file_mempoolpb_mempoolpb_proto_rawDescData
 }
 
-var file_mempoolpb_mempoolpb_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_mempoolpb_mempoolpb_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_mempoolpb_mempoolpb_proto_goTypes = []interface{}{
 	(*Event)(nil),                       // 0: mempoolpb.Event
 	(*RequestBatch)(nil),                // 1: mempoolpb.RequestBatch
@@ -1169,12 +1696,19 @@ var file_mempoolpb_mempoolpb_proto_goTypes = []interface{}{
 	(*TransactionIDsResponse)(nil),      // 6: mempoolpb.TransactionIDsResponse
 	(*RequestBatchID)(nil),              // 7: mempoolpb.RequestBatchID
 	(*BatchIDResponse)(nil),             // 8: mempoolpb.BatchIDResponse
-	(*RequestBatchOrigin)(nil),          // 9: mempoolpb.RequestBatchOrigin
-	(*RequestTransactionsOrigin)(nil),   // 10: mempoolpb.RequestTransactionsOrigin
-	(*RequestTransactionIDsOrigin)(nil), // 11: mempoolpb.RequestTransactionIDsOrigin
-	(*RequestBatchIDOrigin)(nil),        // 12: mempoolpb.RequestBatchIDOrigin
-	(*contextstorepb.Origin)(nil),       // 13: contextstorepb.Origin
-	(*dslpb.Origin)(nil),                // 14: dslpb.Origin
+	(*SetRetentionIndex)(nil),           // 9: mempoolpb.SetRetentionIndex
+	(*ForgetTransactionsBefore)(nil),    // 10: mempoolpb.ForgetTransactionsBefore
+	(*TransactionsCommitted)(nil),       // 11: mempoolpb.TransactionsCommitted
+	(*VerifyTransactions)(nil),          // 12: mempoolpb.VerifyTransactions
+	(*TransactionsVerified)(nil),        // 13: mempoolpb.TransactionsVerified
+	(*RequestBatchOrigin)(nil),          // 14: mempoolpb.RequestBatchOrigin
+	(*RequestTransactionsOrigin)(nil),   // 15: mempoolpb.RequestTransactionsOrigin
+	(*RequestTransactionIDsOrigin)(nil), // 16: mempoolpb.RequestTransactionIDsOrigin
+	(*RequestBatchIDOrigin)(nil),        // 17: mempoolpb.RequestBatchIDOrigin
+	(*VerifyTransactionsOrigin)(nil),    // 18: mempoolpb.VerifyTransactionsOrigin
+	(*simplemempoolpb.Event)(nil),       // 19: mempoolpb.simplemempoolpb.Event
+	(*contextstorepb.Origin)(nil),       // 20: contextstorepb.Origin
+	(*dslpb.Origin)(nil),                // 21: dslpb.Origin
 }
 var file_mempoolpb_mempoolpb_proto_depIdxs = []int32{
 	1,  // 0: mempoolpb.Event.request_batch:type_name -> mempoolpb.RequestBatch
@@ -1185,27 +1719,37 @@ var file_mempoolpb_mempoolpb_proto_depIdxs = []int32{
 	6,  // 5: mempoolpb.Event.transaction_ids_response:type_name -> mempoolpb.TransactionIDsResponse
 	7,  // 6: mempoolpb.Event.request_batch_id:type_name -> mempoolpb.RequestBatchID
 	8,  // 7: mempoolpb.Event.batch_id_response:type_name -> mempoolpb.BatchIDResponse
-	9,  // 8: mempoolpb.RequestBatch.origin:type_name -> mempoolpb.RequestBatchOrigin
-	9,  // 9: mempoolpb.NewBatch.origin:type_name -> mempoolpb.RequestBatchOrigin
-	10, // 10: mempoolpb.RequestTransactions.origin:type_name -> mempoolpb.RequestTransactionsOrigin
-	10, // 11: mempoolpb.TransactionsResponse.origin:type_name -> mempoolpb.RequestTransactionsOrigin
-	11, // 12: mempoolpb.RequestTransactionIDs.origin:type_name -> mempoolpb.RequestTransactionIDsOrigin
-	11, // 13: mempoolpb.TransactionIDsResponse.origin:type_name -> mempoolpb.RequestTransactionIDsOrigin
-	12, // 14: mempoolpb.RequestBatchID.origin:type_name -> mempoolpb.RequestBatchIDOrigin
-	12, // 15: mempoolpb.BatchIDResponse.origin:type_name -> mempoolpb.RequestBatchIDOrigin
-	13, // 16: mempoolpb.RequestBatchOrigin.context_store:type_name -> contextstorepb.Origin
-	14, // 17: mempoolpb.RequestBatchOrigin.dsl:type_name -> dslpb.Origin
-	13, // 18: mempoolpb.RequestTransactionsOrigin.context_store:type_name -> contextstorepb.Origin
-	14, // 19: mempoolpb.RequestTransactionsOrigin.dsl:type_name -> dslpb.Origin
-	13, // 20: mempoolpb.RequestTransactionIDsOrigin.context_store:type_name -> contextstorepb.Origin
-	14, // 21: mempoolpb.RequestTransactionIDsOrigin.dsl:type_name -> dslpb.Origin
-	13, // 22: mempoolpb.RequestBatchIDOrigin.context_store:type_name -> contextstorepb.Origin
-	14, // 23: mempoolpb.RequestBatchIDOrigin.dsl:type_name -> dslpb.Origin
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	9,  // 8: mempoolpb.Event.set_retention_index:type_name -> mempoolpb.SetRetentionIndex
+	10, // 9: mempoolpb.Event.forget_transactions_before:type_name -> mempoolpb.ForgetTransactionsBefore
+	11, // 10: mempoolpb.Event.transactions_committed:type_name -> mempoolpb.TransactionsCommitted
+	12, // 11: mempoolpb.Event.verify_transactions:type_name -> mempoolpb.VerifyTransactions
+	13, // 12: mempoolpb.Event.transactions_verified:type_name -> mempoolpb.TransactionsVerified
+	19, // 13: mempoolpb.Event.simple_mempool:type_name -> mempoolpb.simplemempoolpb.Event
+	14, // 14: mempoolpb.RequestBatch.origin:type_name -> mempoolpb.RequestBatchOrigin
+	14, // 15: mempoolpb.NewBatch.origin:type_name -> mempoolpb.RequestBatchOrigin
+	15, // 16: mempoolpb.RequestTransactions.origin:type_name -> mempoolpb.RequestTransactionsOrigin
+	15, // 17: mempoolpb.TransactionsResponse.origin:type_name -> mempoolpb.RequestTransactionsOrigin
+	16, // 18: mempoolpb.RequestTransactionIDs.origin:type_name -> mempoolpb.RequestTransactionIDsOrigin
+	16, // 19: mempoolpb.TransactionIDsResponse.origin:type_name -> mempoolpb.RequestTransactionIDsOrigin
+	17, // 20: mempoolpb.RequestBatchID.origin:type_name -> mempoolpb.RequestBatchIDOrigin
+	17, // 21: mempoolpb.BatchIDResponse.origin:type_name -> mempoolpb.RequestBatchIDOrigin
+	18, // 22: mempoolpb.VerifyTransactions.origin:type_name -> mempoolpb.VerifyTransactionsOrigin
+	18, // 23: mempoolpb.TransactionsVerified.origin:type_name -> mempoolpb.VerifyTransactionsOrigin
+	20, // 24: mempoolpb.RequestBatchOrigin.context_store:type_name -> contextstorepb.Origin
+	21, // 25: mempoolpb.RequestBatchOrigin.dsl:type_name -> dslpb.Origin
+	20, // 26: mempoolpb.RequestTransactionsOrigin.context_store:type_name -> contextstorepb.Origin
+	21, // 27: mempoolpb.RequestTransactionsOrigin.dsl:type_name -> dslpb.Origin
+	20, // 28: mempoolpb.RequestTransactionIDsOrigin.context_store:type_name -> contextstorepb.Origin
+	21, // 29: mempoolpb.RequestTransactionIDsOrigin.dsl:type_name -> dslpb.Origin
+	20, // 30: mempoolpb.RequestBatchIDOrigin.context_store:type_name -> contextstorepb.Origin
+	21, // 31: mempoolpb.RequestBatchIDOrigin.dsl:type_name -> dslpb.Origin
+	20, // 32: mempoolpb.VerifyTransactionsOrigin.context_store:type_name -> contextstorepb.Origin
+	21, // 33: mempoolpb.VerifyTransactionsOrigin.dsl:type_name -> dslpb.Origin
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_mempoolpb_mempoolpb_proto_init() }
@@ -1323,7 +1867,7 @@ func file_mempoolpb_mempoolpb_proto_init() {
 			}
 		}
 		file_mempoolpb_mempoolpb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestBatchOrigin); i {
+			switch v := v.(*SetRetentionIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,7 +1879,7 @@ func file_mempoolpb_mempoolpb_proto_init() {
 			}
 		}
 		file_mempoolpb_mempoolpb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestTransactionsOrigin); i {
+			switch v := v.(*ForgetTransactionsBefore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1347,7 +1891,7 @@ func file_mempoolpb_mempoolpb_proto_init() {
 			}
 		}
 		file_mempoolpb_mempoolpb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestTransactionIDsOrigin); i {
+			switch v := v.(*TransactionsCommitted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1359,6 +1903,66 @@ func file_mempoolpb_mempoolpb_proto_init() {
 			}
 		}
 		file_mempoolpb_mempoolpb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_mempoolpb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsVerified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_mempoolpb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestBatchOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_mempoolpb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestTransactionsOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_mempoolpb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestTransactionIDsOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_mempoolpb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestBatchIDOrigin); i {
 			case 0:
 				return &v.state
@@ -1370,6 +1974,18 @@ func file_mempoolpb_mempoolpb_proto_init() {
 				return nil
 			}
 		}
+		file_mempoolpb_mempoolpb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTransactionsOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_mempoolpb_mempoolpb_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Event_RequestBatch)(nil),
@@ -1380,30 +1996,40 @@ func file_mempoolpb_mempoolpb_proto_init() {
 		(*Event_TransactionIdsResponse)(nil),
 		(*Event_RequestBatchId)(nil),
 		(*Event_BatchIdResponse)(nil),
-	}
-	file_mempoolpb_mempoolpb_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*Event_SetRetentionIndex)(nil),
+		(*Event_ForgetTransactionsBefore)(nil),
+		(*Event_TransactionsCommitted)(nil),
+		(*Event_VerifyTransactions)(nil),
+		(*Event_TransactionsVerified)(nil),
+		(*Event_SimpleMempool)(nil),
+	}
+	file_mempoolpb_mempoolpb_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*RequestBatchOrigin_ContextStore)(nil),
 		(*RequestBatchOrigin_Dsl)(nil),
 	}
-	file_mempoolpb_mempoolpb_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_mempoolpb_mempoolpb_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*RequestTransactionsOrigin_ContextStore)(nil),
 		(*RequestTransactionsOrigin_Dsl)(nil),
 	}
-	file_mempoolpb_mempoolpb_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_mempoolpb_mempoolpb_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*RequestTransactionIDsOrigin_ContextStore)(nil),
 		(*RequestTransactionIDsOrigin_Dsl)(nil),
 	}
-	file_mempoolpb_mempoolpb_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_mempoolpb_mempoolpb_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*RequestBatchIDOrigin_ContextStore)(nil),
 		(*RequestBatchIDOrigin_Dsl)(nil),
 	}
+	file_mempoolpb_mempoolpb_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*VerifyTransactionsOrigin_ContextStore)(nil),
+		(*VerifyTransactionsOrigin_Dsl)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mempoolpb_mempoolpb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,5 +1,9 @@
 package mempoolpb
 
+import (
+	simplemempoolpb "github.com/filecoin-project/mir/pkg/pb/mempoolpb/simplemempoolpb"
+)
+
 type Event_Type = isEvent_Type
 
 type Event_TypeWrapper[Ev any] interface {
@@ -38,3 +42,27 @@ func (p *Event_RequestBatchId) Unwrap() *RequestBatchID {
 func (p *Event_BatchIdResponse) Unwrap() *BatchIDResponse {
 	return p.BatchIdResponse
 }
+
+func (p *Event_SetRetentionIndex) Unwrap() *SetRetentionIndex {
+	return p.SetRetentionIndex
+}
+
+func (p *Event_ForgetTransactionsBefore) Unwrap() *ForgetTransactionsBefore {
+	return p.ForgetTransactionsBefore
+}
+
+func (p *Event_TransactionsCommitted) Unwrap() *TransactionsCommitted {
+	return p.TransactionsCommitted
+}
+
+func (p *Event_VerifyTransactions) Unwrap() *VerifyTransactions {
+	return p.VerifyTransactions
+}
+
+func (p *Event_TransactionsVerified) Unwrap() *TransactionsVerified {
+	return p.TransactionsVerified
+}
+
+func (p *Event_SimpleMempool) Unwrap() *simplemempoolpb.Event {
+	return p.SimpleMempool
+}
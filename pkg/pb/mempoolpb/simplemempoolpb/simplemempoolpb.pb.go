@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: mempoolpb/simplemempoolpb/simplemempoolpb.proto
+
+package simplemempoolpb
+
+import (
+	_ "github.com/filecoin-project/mir/pkg/pb/mir"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Event is an internal event of the simple mempool.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Event_GossipTick
+	//	*Event_RequeueBatch
+	Type isEvent_Type `protobuf_oneof:"type"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Event) GetType() isEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Event) GetGossipTick() *GossipTick {
+	if x, ok := x.GetType().(*Event_GossipTick); ok {
+		return x.GossipTick
+	}
+	return nil
+}
+
+func (x *Event) GetRequeueBatch() *RequeueBatch {
+	if x, ok := x.GetType().(*Event_RequeueBatch); ok {
+		return x.RequeueBatch
+	}
+	return nil
+}
+
+type isEvent_Type interface {
+	isEvent_Type()
+}
+
+type Event_GossipTick struct {
+	GossipTick *GossipTick `protobuf:"bytes,1,opt,name=gossip_tick,json=gossipTick,proto3,oneof"`
+}
+
+type Event_RequeueBatch struct {
+	RequeueBatch *RequeueBatch `protobuf:"bytes,2,opt,name=requeue_batch,json=requeueBatch,proto3,oneof"`
+}
+
+func (*Event_GossipTick) isEvent_Type() {}
+
+func (*Event_RequeueBatch) isEvent_Type() {}
+
+// GossipTick is periodically triggered (via the timer module) to announce new transactions to the other nodes,
+// to retry requests for missing transactions, and to replenish the quota of data provided to each node.
+type GossipTick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GossipTick) Reset() {
+	*x = GossipTick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipTick) ProtoMessage() {}
+
+func (x *GossipTick) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipTick.ProtoReflect.Descriptor instead.
+func (*GossipTick) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP(), []int{1}
+}
+
+// RequeueBatch is triggered (via the timer module) some time after a batch has been created,
+// to make the transactions of the batch that have not been committed available for inclusion in further batches.
+type RequeueBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchNr uint64 `protobuf:"varint,1,opt,name=batch_nr,json=batchNr,proto3" json:"batch_nr,omitempty"`
+}
+
+func (x *RequeueBatch) Reset() {
+	*x = RequeueBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequeueBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueBatch) ProtoMessage() {}
+
+func (x *RequeueBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueBatch.ProtoReflect.Descriptor instead.
+func (*RequeueBatch) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequeueBatch) GetBatchNr() uint64 {
+	if x != nil {
+		return x.BatchNr
+	}
+	return 0
+}
+
+// Message is a message exchanged between simple mempools when gossiping transactions.
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*Message_AnnounceTxIds
+	//	*Message_RequestTxs
+	//	*Message_ProvideTxs
+	Type isMessage_Type `protobuf_oneof:"type"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *Message) GetType() isMessage_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Message) GetAnnounceTxIds() *AnnounceTxIDsMessage {
+	if x, ok := x.GetType().(*Message_AnnounceTxIds); ok {
+		return x.AnnounceTxIds
+	}
+	return nil
+}
+
+func (x *Message) GetRequestTxs() *RequestTxsMessage {
+	if x, ok := x.GetType().(*Message_RequestTxs); ok {
+		return x.RequestTxs
+	}
+	return nil
+}
+
+func (x *Message) GetProvideTxs() *ProvideTxsMessage {
+	if x, ok := x.GetType().(*Message_ProvideTxs); ok {
+		return x.ProvideTxs
+	}
+	return nil
+}
+
+type isMessage_Type interface {
+	isMessage_Type()
+}
+
+type Message_AnnounceTxIds struct {
+	AnnounceTxIds *AnnounceTxIDsMessage `protobuf:"bytes,1,opt,name=announce_tx_ids,json=announceTxIds,proto3,oneof"`
+}
+
+type Message_RequestTxs struct {
+	RequestTxs *RequestTxsMessage `protobuf:"bytes,2,opt,name=request_txs,json=requestTxs,proto3,oneof"`
+}
+
+type Message_ProvideTxs struct {
+	ProvideTxs *ProvideTxsMessage `protobuf:"bytes,3,opt,name=provide_txs,json=provideTxs,proto3,oneof"`
+}
+
+func (*Message_AnnounceTxIds) isMessage_Type() {}
+
+func (*Message_RequestTxs) isMessage_Type() {}
+
+func (*Message_ProvideTxs) isMessage_Type() {}
+
+// AnnounceTxIDsMessage announces the IDs of transactions recently added to the sender's mempool.
+type AnnounceTxIDsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxIds [][]byte `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (x *AnnounceTxIDsMessage) Reset() {
+	*x = AnnounceTxIDsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceTxIDsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceTxIDsMessage) ProtoMessage() {}
+
+func (x *AnnounceTxIDsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceTxIDsMessage.ProtoReflect.Descriptor instead.
+func (*AnnounceTxIDsMessage) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AnnounceTxIDsMessage) GetTxIds() [][]byte {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+// RequestTxsMessage is used to request the transactions, previously announced by the receiver,
+// that the sender does not have.
+type RequestTxsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxIds [][]byte `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (x *RequestTxsMessage) Reset() {
+	*x = RequestTxsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestTxsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestTxsMessage) ProtoMessage() {}
+
+func (x *RequestTxsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestTxsMessage.ProtoReflect.Descriptor instead.
+func (*RequestTxsMessage) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestTxsMessage) GetTxIds() [][]byte {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+type ProvideTxsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *ProvideTxsMessage) Reset() {
+	*x = ProvideTxsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvideTxsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvideTxsMessage) ProtoMessage() {}
+
+func (x *ProvideTxsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvideTxsMessage.ProtoReflect.Descriptor instead.
+func (*ProvideTxsMessage) Descriptor() ([]byte, []int) {
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProvideTxsMessage) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+var File_mempoolpb_simplemempoolpb_simplemempoolpb_proto protoreflect.FileDescriptor
+
+var file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x19, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x1a, 0x10, 0x6d, 0x69,
+	0x72, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf,
+	0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x67, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x69,
+	0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x42, 0x0c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x04, 0x80, 0xb5, 0x18, 0x01,
+	0x22, 0x0c, 0x0a, 0x0a, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x29,
+	0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x72, 0x22, 0x8e, 0x02, 0x0a, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x54, 0x78, 0x49, 0x44, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x54, 0x78, 0x49, 0x64, 0x73,
+	0x12, 0x4f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70,
+	0x62, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x78, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x78,
+	0x73, 0x12, 0x4f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x78, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x70, 0x62, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54, 0x78, 0x73, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x54,
+	0x78, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2d, 0x0a, 0x14, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x54, 0x78, 0x49, 0x44, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x49, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x11, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x78, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05,
+	0x74, 0x78, 0x49, 0x64, 0x73, 0x22, 0x25, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x54, 0x78, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x42, 0x42, 0x5a, 0x40,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63,
+	0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62,
+	0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescOnce sync.Once
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescData = file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDesc
+)
+
+func file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescGZIP() []byte {
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescOnce.Do(func() {
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescData = protoimpl.X.CompressGZIP(file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescData)
+	})
+	return file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDescData
+}
+
+var file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_goTypes = []interface{}{
+	(*Event)(nil),                // 0: mempoolpb.simplemempoolpb.Event
+	(*GossipTick)(nil),           // 1: mempoolpb.simplemempoolpb.GossipTick
+	(*RequeueBatch)(nil),         // 2: mempoolpb.simplemempoolpb.RequeueBatch
+	(*Message)(nil),              // 3: mempoolpb.simplemempoolpb.Message
+	(*AnnounceTxIDsMessage)(nil), // 4: mempoolpb.simplemempoolpb.AnnounceTxIDsMessage
+	(*RequestTxsMessage)(nil),    // 5: mempoolpb.simplemempoolpb.RequestTxsMessage
+	(*ProvideTxsMessage)(nil),    // 6: mempoolpb.simplemempoolpb.ProvideTxsMessage
+}
+var file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_depIdxs = []int32{
+	1, // 0: mempoolpb.simplemempoolpb.Event.gossip_tick:type_name -> mempoolpb.simplemempoolpb.GossipTick
+	2, // 1: mempoolpb.simplemempoolpb.Event.requeue_batch:type_name -> mempoolpb.simplemempoolpb.RequeueBatch
+	4, // 2: mempoolpb.simplemempoolpb.Message.announce_tx_ids:type_name -> mempoolpb.simplemempoolpb.AnnounceTxIDsMessage
+	5, // 3: mempoolpb.simplemempoolpb.Message.request_txs:type_name -> mempoolpb.simplemempoolpb.RequestTxsMessage
+	6, // 4: mempoolpb.simplemempoolpb.Message.provide_txs:type_name -> mempoolpb.simplemempoolpb.ProvideTxsMessage
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_init() }
+func file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_init() {
+	if File_mempoolpb_simplemempoolpb_simplemempoolpb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipTick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequeueBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceTxIDsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestTxsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvideTxsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_GossipTick)(nil),
+		(*Event_RequeueBatch)(nil),
+	}
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*Message_AnnounceTxIds)(nil),
+		(*Message_RequestTxs)(nil),
+		(*Message_ProvideTxs)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_goTypes,
+		DependencyIndexes: file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_depIdxs,
+		MessageInfos:      file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_msgTypes,
+	}.Build()
+	File_mempoolpb_simplemempoolpb_simplemempoolpb_proto = out.File
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_rawDesc = nil
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_goTypes = nil
+	file_mempoolpb_simplemempoolpb_simplemempoolpb_proto_depIdxs = nil
+}
@@ -0,0 +1,16 @@
+package simplemempoolpb
+
+type Event_Type = isEvent_Type
+
+type Event_TypeWrapper[Ev any] interface {
+	Event_Type
+	Unwrap() *Ev
+}
+
+func (p *Event_GossipTick) Unwrap() *GossipTick {
+	return p.GossipTick
+}
+
+func (p *Event_RequeueBatch) Unwrap() *RequeueBatch {
+	return p.RequeueBatch
+}
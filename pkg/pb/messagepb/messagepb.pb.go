@@ -12,9 +12,13 @@
 package messagepb
 
 import (
+	abbapb "github.com/filecoin-project/mir/pkg/pb/abbapb"
+	avidpb "github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
 	mscpb "github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
 	bcbpb "github.com/filecoin-project/mir/pkg/pb/bcbpb"
 	isspb "github.com/filecoin-project/mir/pkg/pb/isspb"
+	simplemempoolpb "github.com/filecoin-project/mir/pkg/pb/mempoolpb/simplemempoolpb"
+	rbcpb "github.com/filecoin-project/mir/pkg/pb/rbcpb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -38,6 +42,10 @@ type Message struct {
 	//	*Message_Iss
 	//	*Message_Bcb
 	//	*Message_MultisigCollector
+	//	*Message_Avid
+	//	*Message_SimpleMempool
+	//	*Message_Rbc
+	//	*Message_Abba
 	Type isMessage_Type `protobuf_oneof:"type"`
 }
 
@@ -108,6 +116,34 @@ func (x *Message) GetMultisigCollector() *mscpb.Message {
 	return nil
 }
 
+func (x *Message) GetAvid() *avidpb.Message {
+	if x, ok := x.GetType().(*Message_Avid); ok {
+		return x.Avid
+	}
+	return nil
+}
+
+func (x *Message) GetSimpleMempool() *simplemempoolpb.Message {
+	if x, ok := x.GetType().(*Message_SimpleMempool); ok {
+		return x.SimpleMempool
+	}
+	return nil
+}
+
+func (x *Message) GetRbc() *rbcpb.Message {
+	if x, ok := x.GetType().(*Message_Rbc); ok {
+		return x.Rbc
+	}
+	return nil
+}
+
+func (x *Message) GetAbba() *abbapb.Message {
+	if x, ok := x.GetType().(*Message_Abba); ok {
+		return x.Abba
+	}
+	return nil
+}
+
 type isMessage_Type interface {
 	isMessage_Type()
 }
@@ -124,12 +160,36 @@ type Message_MultisigCollector struct {
 	MultisigCollector *mscpb.Message `protobuf:"bytes,4,opt,name=multisig_collector,json=multisigCollector,proto3,oneof"`
 }
 
+type Message_Avid struct {
+	Avid *avidpb.Message `protobuf:"bytes,5,opt,name=avid,proto3,oneof"`
+}
+
+type Message_SimpleMempool struct {
+	SimpleMempool *simplemempoolpb.Message `protobuf:"bytes,6,opt,name=simple_mempool,json=simpleMempool,proto3,oneof"`
+}
+
+type Message_Rbc struct {
+	Rbc *rbcpb.Message `protobuf:"bytes,7,opt,name=rbc,proto3,oneof"`
+}
+
+type Message_Abba struct {
+	Abba *abbapb.Message `protobuf:"bytes,8,opt,name=abba,proto3,oneof"`
+}
+
 func (*Message_Iss) isMessage_Type() {}
 
 func (*Message_Bcb) isMessage_Type() {}
 
 func (*Message_MultisigCollector) isMessage_Type() {}
 
+func (*Message_Avid) isMessage_Type() {}
+
+func (*Message_SimpleMempool) isMessage_Type() {}
+
+func (*Message_Rbc) isMessage_Type() {}
+
+func (*Message_Abba) isMessage_Type() {}
+
 var File_messagepb_messagepb_proto protoreflect.FileDescriptor
 
 var file_messagepb_messagepb_proto_rawDesc = []byte{
@@ -137,26 +197,47 @@ var file_messagepb_messagepb_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x70, 0x62, 0x1a, 0x11, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2f, 0x69, 0x73,
 	0x73, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x62, 0x63, 0x62, 0x70, 0x62,
-	0x2f, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63,
-	0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd,
-	0x01, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x64, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x69,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62,
-	0x2e, 0x49, 0x53, 0x53, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03, 0x69,
-	0x73, 0x73, 0x12, 0x22, 0x0a, 0x03, 0x62, 0x63, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x03, 0x62, 0x63, 0x62, 0x12, 0x4e, 0x0a, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x70, 0x62, 0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x32,
-	0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c,
-	0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69,
-	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x62, 0x63, 0x62, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x72, 0x62,
+	0x63, 0x70, 0x62, 0x2f, 0x72, 0x62, 0x63, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x13, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2f, 0x61, 0x62, 0x62, 0x61, 0x70, 0x62, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2f, 0x6d, 0x73, 0x63, 0x70, 0x62,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x2f, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2f, 0x61, 0x76,
+	0x69, 0x64, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x03, 0x0a, 0x07,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x69, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x73, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x53,
+	0x53, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03, 0x69, 0x73, 0x73, 0x12,
+	0x22, 0x0a, 0x03, 0x62, 0x63, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62,
+	0x63, 0x62, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03,
+	0x62, 0x63, 0x62, 0x12, 0x4e, 0x0a, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62,
+	0x2e, 0x6d, 0x73, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x11, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x61, 0x76, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x70, 0x62, 0x2e, 0x61, 0x76, 0x69, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x04, 0x61, 0x76, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x0e, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d,
+	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x62, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x62, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x03, 0x72, 0x62, 0x63, 0x12, 0x25, 0x0a, 0x04, 0x61, 0x62,
+	0x62, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x62, 0x62, 0x61, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x04, 0x61, 0x62, 0x62,
+	0x61, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e,
+	0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -173,20 +254,28 @@ func file_messagepb_messagepb_proto_rawDescGZIP() []byte {
 
 var file_messagepb_messagepb_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_messagepb_messagepb_proto_goTypes = []interface{}{
-	(*Message)(nil),          // 0: messagepb.Message
-	(*isspb.ISSMessage)(nil), // 1: isspb.ISSMessage
-	(*bcbpb.Message)(nil),    // 2: bcbpb.Message
-	(*mscpb.Message)(nil),    // 3: availabilitypb.mscpb.Message
+	(*Message)(nil),                 // 0: messagepb.Message
+	(*isspb.ISSMessage)(nil),        // 1: isspb.ISSMessage
+	(*bcbpb.Message)(nil),           // 2: bcbpb.Message
+	(*mscpb.Message)(nil),           // 3: availabilitypb.mscpb.Message
+	(*avidpb.Message)(nil),          // 4: availabilitypb.avidpb.Message
+	(*simplemempoolpb.Message)(nil), // 5: mempoolpb.simplemempoolpb.Message
+	(*rbcpb.Message)(nil),           // 6: rbcpb.Message
+	(*abbapb.Message)(nil),          // 7: abbapb.Message
 }
 var file_messagepb_messagepb_proto_depIdxs = []int32{
 	1, // 0: messagepb.Message.iss:type_name -> isspb.ISSMessage
 	2, // 1: messagepb.Message.bcb:type_name -> bcbpb.Message
 	3, // 2: messagepb.Message.multisig_collector:type_name -> availabilitypb.mscpb.Message
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: messagepb.Message.avid:type_name -> availabilitypb.avidpb.Message
+	5, // 4: messagepb.Message.simple_mempool:type_name -> mempoolpb.simplemempoolpb.Message
+	6, // 5: messagepb.Message.rbc:type_name -> rbcpb.Message
+	7, // 6: messagepb.Message.abba:type_name -> abbapb.Message
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_messagepb_messagepb_proto_init() }
@@ -212,6 +301,10 @@ func file_messagepb_messagepb_proto_init() {
 		(*Message_Iss)(nil),
 		(*Message_Bcb)(nil),
 		(*Message_MultisigCollector)(nil),
+		(*Message_Avid)(nil),
+		(*Message_SimpleMempool)(nil),
+		(*Message_Rbc)(nil),
+		(*Message_Abba)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
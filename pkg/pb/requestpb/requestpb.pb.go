@@ -190,6 +190,71 @@ func (x *Batch) GetRequests() []*HashedRequest {
 	return nil
 }
 
+// RequestRejected informs a client that a request it submitted has been rejected by a node
+// (e.g., because the request exceeded the client's quota) and will not be ordered by that node.
+type RequestRejected struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ReqNo    uint64 `protobuf:"varint,2,opt,name=req_no,json=reqNo,proto3" json:"req_no,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RequestRejected) Reset() {
+	*x = RequestRejected{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_requestpb_requestpb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestRejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRejected) ProtoMessage() {}
+
+func (x *RequestRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_requestpb_requestpb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRejected.ProtoReflect.Descriptor instead.
+func (*RequestRejected) Descriptor() ([]byte, []int) {
+	return file_requestpb_requestpb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RequestRejected) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RequestRejected) GetReqNo() uint64 {
+	if x != nil {
+		return x.ReqNo
+	}
+	return 0
+}
+
+func (x *RequestRejected) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_requestpb_requestpb_proto protoreflect.FileDescriptor
 
 var file_requestpb_requestpb_proto_rawDesc = []byte{
@@ -209,11 +274,17 @@ var file_requestpb_requestpb_proto_rawDesc = []byte{
 	0x68, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e,
 	0x48, 0x61, 0x73, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x62, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x71, 0x5f, 0x6e,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x71, 0x4e, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
+	0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -228,11 +299,12 @@ func file_requestpb_requestpb_proto_rawDescGZIP() []byte {
 	return file_requestpb_requestpb_proto_rawDescData
 }
 
-var file_requestpb_requestpb_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_requestpb_requestpb_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_requestpb_requestpb_proto_goTypes = []interface{}{
-	(*Request)(nil),       // 0: requestpb.Request
-	(*HashedRequest)(nil), // 1: requestpb.HashedRequest
-	(*Batch)(nil),         // 2: requestpb.Batch
+	(*Request)(nil),         // 0: requestpb.Request
+	(*HashedRequest)(nil),   // 1: requestpb.HashedRequest
+	(*Batch)(nil),           // 2: requestpb.Batch
+	(*RequestRejected)(nil), // 3: requestpb.RequestRejected
 }
 var file_requestpb_requestpb_proto_depIdxs = []int32{
 	0, // 0: requestpb.HashedRequest.req:type_name -> requestpb.Request
@@ -286,6 +358,18 @@ func file_requestpb_requestpb_proto_init() {
 				return nil
 			}
 		}
+		file_requestpb_requestpb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestRejected); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -293,7 +377,7 @@ func file_requestpb_requestpb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_requestpb_requestpb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
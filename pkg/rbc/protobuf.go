@@ -0,0 +1,40 @@
+package rbc
+
+import (
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	"github.com/filecoin-project/mir/pkg/pb/rbcpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+func Message(moduleID t.ModuleID, msg *rbcpb.Message) *messagepb.Message {
+	return &messagepb.Message{
+		DestModule: moduleID.Pb(),
+		Type: &messagepb.Message_Rbc{
+			Rbc: msg,
+		},
+	}
+}
+
+func SendMessage(moduleID t.ModuleID, data []byte) *messagepb.Message {
+	return Message(moduleID, &rbcpb.Message{
+		Type: &rbcpb.Message_SendMessage{
+			SendMessage: &rbcpb.SendMessage{Data: data},
+		},
+	})
+}
+
+func EchoMessage(moduleID t.ModuleID, data []byte) *messagepb.Message {
+	return Message(moduleID, &rbcpb.Message{
+		Type: &rbcpb.Message_EchoMessage{
+			EchoMessage: &rbcpb.EchoMessage{Data: data},
+		},
+	})
+}
+
+func ReadyMessage(moduleID t.ModuleID, data []byte) *messagepb.Message {
+	return Message(moduleID, &rbcpb.Message{
+		Type: &rbcpb.Message_ReadyMessage{
+			ReadyMessage: &rbcpb.ReadyMessage{Data: data},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package rbcdsl
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	"github.com/filecoin-project/mir/pkg/pb/rbcpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Module-specific dsl functions for emitting events.
+
+func Request(m dsl.Module, dest t.ModuleID, data []byte) {
+	dsl.EmitEvent(m, &eventpb.Event{
+		DestModule: dest.Pb(),
+
+		Type: &eventpb.Event_Rbc{
+			Rbc: &rbcpb.Event{
+				Type: &rbcpb.Event_Request{
+					Request: &rbcpb.Request{
+						Data: data,
+					},
+				},
+			},
+		},
+	})
+}
+
+func Deliver(m dsl.Module, dest t.ModuleID, data []byte) {
+	dsl.EmitEvent(m, &eventpb.Event{
+		DestModule: dest.Pb(),
+
+		Type: &eventpb.Event_Rbc{
+			Rbc: &rbcpb.Event{
+				Type: &rbcpb.Event_Deliver{
+					Deliver: &rbcpb.Deliver{
+						Data: data,
+					},
+				},
+			},
+		},
+	})
+}
+
+// Module-specific dsl functions for processing events.
+
+func UponEvent[EvWrapper rbcpb.Event_TypeWrapper[Ev], Ev any](m dsl.Module, handler func(ev *Ev) error) {
+	dsl.UponEvent[*eventpb.Event_Rbc](m, func(ev *rbcpb.Event) error {
+		evWrapper, ok := ev.Type.(EvWrapper)
+		if !ok {
+			return nil
+		}
+		return handler(evWrapper.Unwrap())
+	})
+}
+
+func UponRequest(m dsl.Module, handler func(data []byte) error) {
+	UponEvent[*rbcpb.Event_Request](m, func(ev *rbcpb.Request) error {
+		return handler(ev.Data)
+	})
+}
+
+func UponDeliver(m dsl.Module, handler func(data []byte) error) {
+	UponEvent[*rbcpb.Event_Deliver](m, func(ev *rbcpb.Deliver) error {
+		return handler(ev.Data)
+	})
+}
+
+func UponRBCMessageReceived(m dsl.Module, handler func(from t.NodeID, msg *rbcpb.Message) error) {
+	dsl.UponMessageReceived(m, func(from t.NodeID, msg *messagepb.Message) error {
+		rbcMsgWrapper, ok := msg.Type.(*messagepb.Message_Rbc)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, rbcMsgWrapper.Rbc)
+	})
+}
+
+func UponSendMessageReceived(m dsl.Module, handler func(from t.NodeID, data []byte) error) {
+	UponRBCMessageReceived(m, func(from t.NodeID, msg *rbcpb.Message) error {
+		sendMsgWrapper, ok := msg.Type.(*rbcpb.Message_SendMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, sendMsgWrapper.SendMessage.Data)
+	})
+}
+
+func UponEchoMessageReceived(m dsl.Module, handler func(from t.NodeID, data []byte) error) {
+	UponRBCMessageReceived(m, func(from t.NodeID, msg *rbcpb.Message) error {
+		echoMsgWrapper, ok := msg.Type.(*rbcpb.Message_EchoMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, echoMsgWrapper.EchoMessage.Data)
+	})
+}
+
+func UponReadyMessageReceived(m dsl.Module, handler func(from t.NodeID, data []byte) error) {
+	UponRBCMessageReceived(m, func(from t.NodeID, msg *rbcpb.Message) error {
+		readyMsgWrapper, ok := msg.Type.(*rbcpb.Message_ReadyMessage)
+		if !ok {
+			return nil
+		}
+
+		return handler(from, readyMsgWrapper.ReadyMessage.Data)
+	})
+}
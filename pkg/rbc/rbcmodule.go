@@ -0,0 +1,149 @@
+package rbc
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/rbc/rbcdsl"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+type ModuleConfig struct {
+	Self     t.ModuleID // id of this module
+	Consumer t.ModuleID // id of the module to send the "Deliver" event to
+	Net      t.ModuleID
+}
+
+func DefaultModuleConfig(consumer t.ModuleID) *ModuleConfig {
+	return &ModuleConfig{
+		Self:     "rbc",
+		Consumer: consumer,
+		Net:      "net",
+	}
+}
+
+type ModuleParams struct {
+	AllNodes []t.NodeID // the list of participating nodes
+	Leader   t.NodeID   // the id of the leader of the instance
+}
+
+func (params *ModuleParams) GetN() int {
+	return len(params.AllNodes)
+}
+
+func (params *ModuleParams) GetF() int {
+	return (params.GetN() - 1) / 3
+}
+
+type rbcModuleState struct {
+	sentEcho  bool
+	sentReady bool
+	delivered bool
+
+	// the nodes from which an ECHO (resp. READY) message has been received, only the first one of which counts
+	receivedEcho  map[t.NodeID]bool
+	receivedReady map[t.NodeID]bool
+
+	// the number of ECHO (resp. READY) messages received for each value, indexed by the value
+	echoCounts  map[string]int
+	readyCounts map[string]int
+}
+
+// NewModule returns a passive module for the Authenticated Double-Echo Broadcast from the textbook "Introduction to
+// reliable and secure distributed programming", also known as Bracha's reliable broadcast.
+// In addition to the guarantees of the Signed Echo Broadcast (see package bcb), it guarantees totality:
+// if some correct node delivers a value, all correct nodes eventually deliver it.
+// It relies on authenticated links instead of signatures.
+// The pseudocode can also be found in https://dcl.epfl.ch/site/_media/education/sdc_byzconsensus.pdf (Algorithm 6
+// (Authenticated Double-Echo Broadcast [Bra87]))
+func NewModule(mc *ModuleConfig, params *ModuleParams, nodeID t.NodeID) modules.PassiveModule {
+	m := dsl.NewModule(mc.Self)
+
+	state := rbcModuleState{
+		sentEcho:  false,
+		sentReady: false,
+		delivered: false,
+
+		receivedEcho:  make(map[t.NodeID]bool),
+		receivedReady: make(map[t.NodeID]bool),
+		echoCounts:    make(map[string]int),
+		readyCounts:   make(map[string]int),
+	}
+
+	isMember := make(map[t.NodeID]struct{}, len(params.AllNodes))
+	for _, n := range params.AllNodes {
+		isMember[n] = struct{}{}
+	}
+
+	// sendReady sends a READY message for data to all nodes, unless it has already sent one.
+	sendReady := func(data []byte) {
+		if !state.sentReady {
+			state.sentReady = true
+			dsl.SendMessage(m, mc.Net, ReadyMessage(mc.Self, data), params.AllNodes)
+		}
+	}
+
+	dsl.UponInit(m, func() error {
+		// no initialization required
+		return nil
+	})
+
+	// upon event <rbc, Broadcast | m> do    // only process s
+	rbcdsl.UponRequest(m, func(data []byte) error {
+		if nodeID != params.Leader {
+			return fmt.Errorf("only the leader node can receive requests")
+		}
+		dsl.SendMessage(m, mc.Net, SendMessage(mc.Self, data), params.AllNodes)
+		return nil
+	})
+
+	// upon event <al, Deliver | p, [SEND, m]> such that p = s and sentecho = FALSE do
+	rbcdsl.UponSendMessageReceived(m, func(from t.NodeID, data []byte) error {
+		if from == params.Leader && !state.sentEcho {
+			state.sentEcho = true
+			dsl.SendMessage(m, mc.Net, EchoMessage(mc.Self, data), params.AllNodes)
+		}
+		return nil
+	})
+
+	// upon event <al, Deliver | p, [ECHO, m]> do
+	rbcdsl.UponEchoMessageReceived(m, func(from t.NodeID, data []byte) error {
+		// if echos[p] = ⊥ then
+		if _, ok := isMember[from]; !ok || state.receivedEcho[from] {
+			return nil
+		}
+		state.receivedEcho[from] = true
+		state.echoCounts[string(data)]++
+
+		// upon exists m != ⊥ such that #({p ∈ Π | echos[p] = m}) > (N+f)/2 and sentready = FALSE do
+		if state.echoCounts[string(data)] > (params.GetN()+params.GetF())/2 {
+			sendReady(data)
+		}
+		return nil
+	})
+
+	// upon event <al, Deliver | p, [READY, m]> do
+	rbcdsl.UponReadyMessageReceived(m, func(from t.NodeID, data []byte) error {
+		// if readys[p] = ⊥ then
+		if _, ok := isMember[from]; !ok || state.receivedReady[from] {
+			return nil
+		}
+		state.receivedReady[from] = true
+		state.readyCounts[string(data)]++
+
+		// upon exists m != ⊥ such that #({p ∈ Π | readys[p] = m}) > f and sentready = FALSE do
+		if state.readyCounts[string(data)] > params.GetF() {
+			sendReady(data)
+		}
+
+		// upon exists m != ⊥ such that #({p ∈ Π | readys[p] = m}) > 2f and delivered = FALSE do
+		if state.readyCounts[string(data)] > 2*params.GetF() && !state.delivered {
+			state.delivered = true
+			rbcdsl.Deliver(m, mc.Consumer, data)
+		}
+		return nil
+	})
+
+	return m
+}
@@ -0,0 +1,110 @@
+package rbc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/messagepb"
+	"github.com/filecoin-project/mir/pkg/pb/rbcpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+type nodeEvent struct {
+	nodeID t.NodeID
+	event  *eventpb.Event
+}
+
+// runBroadcast has the leader (the first node) broadcast data among n nodes
+// and returns the values delivered by each node.
+// Messages for which drop returns true are not delivered.
+func runBroadcast(
+	tt *testing.T,
+	n int,
+	data []byte,
+	drop func(from, to t.NodeID, msg *messagepb.Message) bool,
+) map[t.NodeID][][]byte {
+	nodeIDs := make([]t.NodeID, n)
+	for i := range nodeIDs {
+		nodeIDs[i] = t.NewNodeIDFromInt(i)
+	}
+
+	mc := DefaultModuleConfig("app")
+	params := &ModuleParams{AllNodes: nodeIDs, Leader: nodeIDs[0]}
+	nodes := make(map[t.NodeID]modules.PassiveModule)
+	for _, nodeID := range nodeIDs {
+		nodes[nodeID] = NewModule(mc, params, nodeID)
+	}
+
+	delivered := make(map[t.NodeID][][]byte)
+	queue := []nodeEvent{{nodeIDs[0], &eventpb.Event{
+		DestModule: mc.Self.Pb(),
+		Type:       &eventpb.Event_Rbc{Rbc: &rbcpb.Event{Type: &rbcpb.Event_Request{Request: &rbcpb.Request{Data: data}}}},
+	}}}
+
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		switch t.ModuleID(next.event.DestModule) {
+		case mc.Self:
+			evsOut, err := nodes[next.nodeID].ApplyEvents(events.ListOf(next.event))
+			require.NoError(tt, err)
+			iter := evsOut.Iterator()
+			for ev := iter.Next(); ev != nil; ev = iter.Next() {
+				queue = append(queue, nodeEvent{next.nodeID, ev})
+			}
+		case mc.Net:
+			sendEvent := next.event.Type.(*eventpb.Event_SendMessage).SendMessage
+			for _, dest := range t.NodeIDSlice(sendEvent.Destinations) {
+				if !drop(next.nodeID, dest, sendEvent.Msg) {
+					queue = append(queue, nodeEvent{dest, events.MessageReceived(mc.Self, next.nodeID, sendEvent.Msg)})
+				}
+			}
+		case mc.Consumer:
+			deliver := next.event.Type.(*eventpb.Event_Rbc).Rbc.Type.(*rbcpb.Event_Deliver).Deliver
+			delivered[next.nodeID] = append(delivered[next.nodeID], deliver.Data)
+		default:
+			tt.Fatalf("unexpected destination module: %v", next.event.DestModule)
+		}
+	}
+
+	return delivered
+}
+
+func TestBroadcast(tt *testing.T) {
+	delivered := runBroadcast(tt, 4, []byte("data"), func(_, _ t.NodeID, _ *messagepb.Message) bool {
+		return false
+	})
+
+	require.Len(tt, delivered, 4)
+	for _, values := range delivered {
+		assert.Equal(tt, [][]byte{[]byte("data")}, values)
+	}
+}
+
+func TestTotality(tt *testing.T) {
+	// The last node only receives READY messages, but still delivers.
+	lastNode := t.NewNodeIDFromInt(3)
+	delivered := runBroadcast(tt, 4, []byte("data"), func(_, to t.NodeID, msg *messagepb.Message) bool {
+		_, isReady := msg.Type.(*messagepb.Message_Rbc).Rbc.Type.(*rbcpb.Message_ReadyMessage)
+		return to == lastNode && !isReady
+	})
+
+	require.Len(tt, delivered, 4)
+	assert.Equal(tt, [][]byte{[]byte("data")}, delivered[lastNode])
+}
+
+func TestNoDeliveryWithoutQuorum(tt *testing.T) {
+	// The leader's SEND message only reaches one other node, so no node can collect enough ECHO messages.
+	delivered := runBroadcast(tt, 4, []byte("data"), func(from, to t.NodeID, msg *messagepb.Message) bool {
+		_, isSend := msg.Type.(*messagepb.Message_Rbc).Rbc.Type.(*rbcpb.Message_SendMessage)
+		return isSend && to != from && to != t.NewNodeIDFromInt(1)
+	})
+
+	assert.Empty(tt, delivered)
+}
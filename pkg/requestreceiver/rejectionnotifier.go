@@ -0,0 +1,112 @@
+package requestreceiver
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/logging"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	"github.com/filecoin-project/mir/pkg/pb/requestpb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// RejectionNotifier is a passive module that reports requests rejected by the protocol
+// (through RequestRejected events) back to the clients that submitted them.
+// A RejectionNotifier is meant to be passed both to the Node (as a module, the ISS protocol expects it as "clients")
+// and to the RequestReceiver, through the connections of which the rejections are reported.
+// The rejection of a request is reported to all connections over which its client has submitted requests.
+// If the client is not connected, the rejection is dropped.
+type RejectionNotifier struct {
+
+	// Client connections, indexed by the client ID.
+	// The lock synchronizes the access by the Node (applying events) and the RequestReceiver (managing connections).
+	lock    sync.Mutex
+	clients map[t.ClientID]map[*clientStream]struct{}
+
+	// Logger for outputting debugging messages.
+	logger logging.Logger
+}
+
+// NewRejectionNotifier returns a new RejectionNotifier with no clients connected.
+func NewRejectionNotifier(logger logging.Logger) *RejectionNotifier {
+	// If no logger was given, only write errors to the console.
+	if logger == nil {
+		logger = logging.ConsoleErrorLogger
+	}
+
+	return &RejectionNotifier{
+		clients: make(map[t.ClientID]map[*clientStream]struct{}),
+		logger:  logger,
+	}
+}
+
+// ApplyEvents reports all the rejections in the given events to the corresponding clients.
+func (rn *RejectionNotifier) ApplyEvents(eventsIn *events.EventList) (*events.EventList, error) {
+	iter := eventsIn.Iterator()
+	for event := iter.Next(); event != nil; event = iter.Next() {
+		switch e := event.Type.(type) {
+		case *eventpb.Event_Init:
+			// no actions on init
+		case *eventpb.Event_RequestRejected:
+			rn.notify(e.RequestRejected)
+		default:
+			return nil, fmt.Errorf("unexpected type of RejectionNotifier event: %T", event.Type)
+		}
+	}
+
+	return events.EmptyList(), nil
+}
+
+// The ImplementsModule method only serves the purpose of indicating that this is a Module and must not be called.
+func (rn *RejectionNotifier) ImplementsModule() {}
+
+// notify sends the rejection to all connections of the client that submitted the rejected request.
+func (rn *RejectionNotifier) notify(rejection *requestpb.RequestRejected) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+
+	for stream := range rn.clients[t.ClientID(rejection.ClientId)] {
+		if err := stream.Send(rejection); err != nil {
+			rn.logger.Log(logging.LevelWarn, "Failed to report rejected request.",
+				"clId", rejection.ClientId, "reqNo", rejection.ReqNo, "err", err)
+		}
+	}
+}
+
+// register associates a client connection with a client ID,
+// so that rejections of the client's requests are reported over this connection.
+func (rn *RejectionNotifier) register(clientID t.ClientID, stream *clientStream) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+
+	if _, ok := rn.clients[clientID]; !ok {
+		rn.clients[clientID] = make(map[*clientStream]struct{})
+	}
+	rn.clients[clientID][stream] = struct{}{}
+}
+
+// unregister removes the association created by register.
+func (rn *RejectionNotifier) unregister(clientID t.ClientID, stream *clientStream) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+
+	delete(rn.clients[clientID], stream)
+	if len(rn.clients[clientID]) == 0 {
+		delete(rn.clients, clientID)
+	}
+}
+
+// clientStream wraps the server side of a client connection,
+// making it possible to safely report rejections from multiple goroutines.
+type clientStream struct {
+	lock sync.Mutex
+	srv  RequestReceiver_ListenServer
+}
+
+// Send reports a rejected request to the client.
+func (cs *clientStream) Send(rejection *requestpb.RequestRejected) error {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.srv.Send(rejection)
+}
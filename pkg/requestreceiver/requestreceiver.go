@@ -11,11 +11,13 @@ import (
 	"net"
 	"strconv"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
 	"github.com/filecoin-project/mir"
+	"github.com/filecoin-project/mir/pkg/clientquota"
 	"github.com/filecoin-project/mir/pkg/events"
 	"github.com/filecoin-project/mir/pkg/logging"
 	"github.com/filecoin-project/mir/pkg/pb/requestpb"
@@ -31,6 +33,16 @@ type RequestReceiver struct {
 	// The ID of the module to which to submit the received requests.
 	moduleID t.ModuleID
 
+	// Per-client limits on the received requests.
+	// Requests exceeding these limits are not submitted to the Node and their rejection is reported to the client.
+	quota clientquota.Params
+
+	// Limits the rate at which each client submits requests, according to quota.
+	rateLimiter *clientquota.RateLimiter
+
+	// Used to report to the clients the requests rejected later by the protocol. May be nil.
+	notifier *RejectionNotifier
+
 	// The gRPC server used by this networking module.
 	grpcServer *grpc.Server
 
@@ -49,22 +61,40 @@ type RequestReceiver struct {
 // The returned RequestReceiver is not yet running (able to receive requests).
 // This needs to be done explicitly by calling the Start() method.
 // For the requests to be processed by passed Node, the Node must also be running.
-func NewRequestReceiver(node *mir.Node, moduleID t.ModuleID, logger logging.Logger) *RequestReceiver {
+// quota specifies the per-client limits enforced on the received requests. If nil, no limits are enforced.
+// notifier, if not nil, must be the RejectionNotifier used by the Node to report requests rejected by the protocol.
+func NewRequestReceiver(
+	node *mir.Node,
+	moduleID t.ModuleID,
+	quota *clientquota.Params,
+	notifier *RejectionNotifier,
+	logger logging.Logger,
+) *RequestReceiver {
 	// If no logger was given, only write errors to the console.
 	if logger == nil {
 		logger = logging.ConsoleErrorLogger
 	}
 
+	// If no quota was given, do not limit the clients.
+	if quota == nil {
+		quota = &clientquota.Params{}
+	}
+
 	return &RequestReceiver{
-		node:     node,
-		moduleID: moduleID,
-		logger:   logger,
+		node:        node,
+		moduleID:    moduleID,
+		quota:       *quota,
+		rateLimiter: clientquota.NewRateLimiter(quota.Rate, quota.Burst),
+		notifier:    notifier,
+		logger:      logger,
 	}
 }
 
-// Listen implements the gRPC Listen service (multi-request-single-response).
+// Listen implements the gRPC Listen service (bidirectional stream).
 // It receives messages from the gRPC client running on the Mir client
 // and submits them to the Node associated with this RequestReceiver.
+// Requests violating the client's quota are not submitted.
+// Instead, their rejection, as well as any later rejection by the protocol, is reported back to the client.
 // This function is called by the gRPC system on every new connection
 // from a Mir client's gRPC client.
 func (rr *RequestReceiver) Listen(srv RequestReceiver_ListenServer) error {
@@ -77,6 +107,20 @@ func (rr *RequestReceiver) Listen(srv RequestReceiver_ListenServer) error {
 		return fmt.Errorf("failed to get grpc peer info from context")
 	}
 
+	// Wrap the server stream, so that rejections can be reported to the client also by the RejectionNotifier.
+	// Each connection belongs to a single client, determined by the first request received over it.
+	// Only that client is registered with the RejectionNotifier, so that a connection cannot receive
+	// the rejections of other clients' requests.
+	// Unregister the connection from the RejectionNotifier when it is closed.
+	stream := &clientStream{srv: srv}
+	var streamClientID t.ClientID
+	registered := false
+	defer func() {
+		if registered && rr.notifier != nil {
+			rr.notifier.unregister(streamClientID, stream)
+		}
+	}()
+
 	// Declare loop variables outside, since err is checked also after the loop finishes.
 	var err error
 	var req *requestpb.Request
@@ -86,6 +130,38 @@ func (rr *RequestReceiver) Listen(srv RequestReceiver_ListenServer) error {
 
 		rr.logger.Log(logging.LevelInfo, "Received request", "clId", req.ClientId, "reqNo", req.ReqNo)
 
+		// Associate the connection with the client of its first request
+		// and make sure later rejections of the client's requests by the protocol are reported over this connection.
+		if !registered {
+			streamClientID = t.ClientID(req.ClientId)
+			if rr.notifier != nil {
+				rr.notifier.register(streamClientID, stream)
+			}
+			registered = true
+		}
+
+		// Check the request against the client's quota and report the rejection to the client if it is violated.
+		// Requests of other clients than the one the connection belongs to are rejected as well.
+		var qErr error
+		if t.ClientID(req.ClientId) != streamClientID {
+			qErr = fmt.Errorf("connection belongs to client %v", streamClientID)
+		} else {
+			qErr = rr.checkQuota(req)
+		}
+		if qErr != nil {
+			rr.logger.Log(logging.LevelWarn, "Rejecting request.",
+				"clId", req.ClientId, "reqNo", req.ReqNo, "reason", qErr)
+			if sndErr := stream.Send(&requestpb.RequestRejected{
+				ClientId: req.ClientId,
+				ReqNo:    req.ReqNo,
+				Reason:   qErr.Error(),
+			}); sndErr != nil {
+				rr.logger.Log(logging.LevelWarn, "Failed to report rejected request.",
+					"clId", req.ClientId, "reqNo", req.ReqNo, "err", sndErr)
+			}
+			continue
+		}
+
 		// Submit the request to the Node.
 		if srErr := rr.node.InjectEvents(srv.Context(), events.ListOf(events.NewClientRequests(
 			rr.moduleID,
@@ -105,8 +181,18 @@ func (rr *RequestReceiver) Listen(srv RequestReceiver_ListenServer) error {
 		rr.logger.Log(logging.LevelWarn, fmt.Sprintf("Connection terminated: %s (%v)", p.Addr.String(), err))
 	}
 
-	// Send gRPC response message and close connection.
-	return srv.SendAndClose(&ByeBye{})
+	// Close connection.
+	return nil
+}
+
+// checkQuota returns a non-nil error if the request violates the quota of its client.
+// Only the limits on request size and rate are checked here.
+// The number of outstanding requests is only known (and thus checked) by the protocol.
+func (rr *RequestReceiver) checkQuota(req *requestpb.Request) error {
+	if err := clientquota.CheckSize(rr.quota.MaxRequestBytes, req); err != nil {
+		return err
+	}
+	return rr.rateLimiter.Allow(t.ClientID(req.ClientId), time.Now())
 }
 
 // Start starts the RequestReceiver by initializing and starting the internal gRPC server,
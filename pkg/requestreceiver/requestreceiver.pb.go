@@ -16,7 +16,6 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
-	sync "sync"
 )
 
 const (
@@ -26,44 +25,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type ByeBye struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *ByeBye) Reset() {
-	*x = ByeBye{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_requestreceiver_requestreceiver_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ByeBye) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ByeBye) ProtoMessage() {}
-
-func (x *ByeBye) ProtoReflect() protoreflect.Message {
-	mi := &file_requestreceiver_requestreceiver_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ByeBye.ProtoReflect.Descriptor instead.
-func (*ByeBye) Descriptor() ([]byte, []int) {
-	return file_requestreceiver_requestreceiver_proto_rawDescGZIP(), []int{0}
-}
-
 var File_requestreceiver_requestreceiver_proto protoreflect.FileDescriptor
 
 var file_requestreceiver_requestreceiver_proto_rawDesc = []byte{
@@ -72,38 +33,25 @@ var file_requestreceiver_requestreceiver_proto_rawDesc = []byte{
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x1a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x08, 0x0a, 0x06, 0x42, 0x79, 0x65, 0x42, 0x79, 0x65, 0x32, 0x4a, 0x0a,
-	0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x12, 0x37, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x12, 0x2e, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x2e, 0x42, 0x79, 0x65, 0x42, 0x79, 0x65, 0x28, 0x01, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e,
-	0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x32, 0x4f, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x12, 0x12, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x28, 0x01, 0x30, 0x01, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x2d, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2f, 0x6d, 0x69, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
-var (
-	file_requestreceiver_requestreceiver_proto_rawDescOnce sync.Once
-	file_requestreceiver_requestreceiver_proto_rawDescData = file_requestreceiver_requestreceiver_proto_rawDesc
-)
-
-func file_requestreceiver_requestreceiver_proto_rawDescGZIP() []byte {
-	file_requestreceiver_requestreceiver_proto_rawDescOnce.Do(func() {
-		file_requestreceiver_requestreceiver_proto_rawDescData = protoimpl.X.CompressGZIP(file_requestreceiver_requestreceiver_proto_rawDescData)
-	})
-	return file_requestreceiver_requestreceiver_proto_rawDescData
-}
-
-var file_requestreceiver_requestreceiver_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_requestreceiver_requestreceiver_proto_goTypes = []interface{}{
-	(*ByeBye)(nil),            // 0: requestreceiver.ByeBye
-	(*requestpb.Request)(nil), // 1: requestpb.Request
+	(*requestpb.Request)(nil),         // 0: requestpb.Request
+	(*requestpb.RequestRejected)(nil), // 1: requestpb.RequestRejected
 }
 var file_requestreceiver_requestreceiver_proto_depIdxs = []int32{
-	1, // 0: requestreceiver.RequestReceiver.Listen:input_type -> requestpb.Request
-	0, // 1: requestreceiver.RequestReceiver.Listen:output_type -> requestreceiver.ByeBye
+	0, // 0: requestreceiver.RequestReceiver.Listen:input_type -> requestpb.Request
+	1, // 1: requestreceiver.RequestReceiver.Listen:output_type -> requestpb.RequestRejected
 	1, // [1:2] is the sub-list for method output_type
 	0, // [0:1] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
@@ -116,33 +64,18 @@ func file_requestreceiver_requestreceiver_proto_init() {
 	if File_requestreceiver_requestreceiver_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_requestreceiver_requestreceiver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ByeBye); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_requestreceiver_requestreceiver_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_requestreceiver_requestreceiver_proto_goTypes,
 		DependencyIndexes: file_requestreceiver_requestreceiver_proto_depIdxs,
-		MessageInfos:      file_requestreceiver_requestreceiver_proto_msgTypes,
 	}.Build()
 	File_requestreceiver_requestreceiver_proto = out.File
 	file_requestreceiver_requestreceiver_proto_rawDesc = nil
@@ -45,7 +45,7 @@ func (c *requestReceiverClient) Listen(ctx context.Context, opts ...grpc.CallOpt
 
 type RequestReceiver_ListenClient interface {
 	Send(*requestpb.Request) error
-	CloseAndRecv() (*ByeBye, error)
+	Recv() (*requestpb.RequestRejected, error)
 	grpc.ClientStream
 }
 
@@ -57,11 +57,8 @@ func (x *requestReceiverListenClient) Send(m *requestpb.Request) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *requestReceiverListenClient) CloseAndRecv() (*ByeBye, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(ByeBye)
+func (x *requestReceiverListenClient) Recv() (*requestpb.RequestRejected, error) {
+	m := new(requestpb.RequestRejected)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -101,7 +98,7 @@ func _RequestReceiver_Listen_Handler(srv interface{}, stream grpc.ServerStream)
 }
 
 type RequestReceiver_ListenServer interface {
-	SendAndClose(*ByeBye) error
+	Send(*requestpb.RequestRejected) error
 	Recv() (*requestpb.Request, error)
 	grpc.ServerStream
 }
@@ -110,7 +107,7 @@ type requestReceiverListenServer struct {
 	grpc.ServerStream
 }
 
-func (x *requestReceiverListenServer) SendAndClose(m *ByeBye) error {
+func (x *requestReceiverListenServer) Send(m *requestpb.RequestRejected) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -133,6 +130,7 @@ var RequestReceiver_ServiceDesc = grpc.ServiceDesc{
 		{
 			StreamName:    "Listen",
 			Handler:       _RequestReceiver_Listen_Handler,
+			ServerStreams: true,
 			ClientStreams: true,
 		},
 	},
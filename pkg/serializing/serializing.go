@@ -8,7 +8,10 @@ package serializing
 
 import (
 	"encoding/binary"
+	"fmt"
 
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/isspb"
 	"github.com/filecoin-project/mir/pkg/pb/requestpb"
 	t "github.com/filecoin-project/mir/pkg/types"
 )
@@ -31,10 +34,10 @@ func RequestForHash(req *requestpb.Request) [][]byte {
 func BatchForHash(batch *requestpb.Batch) [][]byte {
 
 	// Allocate output slice.
-	data := make([][]byte, len(batch.Requests))
+	data := make([][]byte, len(batch.GetRequests()))
 
 	// Collect all request digests in the batch.
-	for i, reqRef := range batch.Requests {
+	for i, reqRef := range batch.GetRequests() {
 		data[i] = reqRef.Digest
 	}
 
@@ -42,6 +45,67 @@ func BatchForHash(batch *requestpb.Batch) [][]byte {
 	return data
 }
 
+// CertForHash returns the data of an availability certificate to be hashed.
+// Like BatchForHash, it is used when the certificate is proposed instead of the batch itself.
+// Each field of the certificate is prefixed by its length (and the list of signatures by the number of signatures),
+// such that the data of two different certificates never has the same concatenation.
+// Since certificates are received from other nodes, CertForHash returns an error if the certificate is malformed
+// (i.e., of unknown type or missing its content) instead of assuming it is well-formed.
+func CertForHash(cert *availabilitypb.Cert) ([][]byte, error) {
+	switch c := cert.GetType().(type) {
+	case *availabilitypb.Cert_Msc:
+		if c.Msc == nil {
+			return nil, fmt.Errorf("empty multisig collector certificate")
+		}
+		fields := make([][]byte, 0, 4+len(c.Msc.Signatures))
+		fields = append(fields, c.Msc.BatchId, c.Msc.SignerBitmap, uint64ToBytes(uint64(len(c.Msc.Signatures))))
+		fields = append(fields, c.Msc.Signatures...)
+		return lengthPrefixed(append(fields, c.Msc.AggregatedSignature)), nil
+	case *availabilitypb.Cert_Avid:
+		if c.Avid == nil {
+			return nil, fmt.Errorf("empty AVID certificate")
+		}
+		fields := make([][]byte, 0, 3+len(c.Avid.Signatures))
+		fields = append(fields, c.Avid.MerkleRoot, c.Avid.SignerBitmap, uint64ToBytes(uint64(len(c.Avid.Signatures))))
+		return lengthPrefixed(append(fields, c.Avid.Signatures...)), nil
+	default:
+		return nil, fmt.Errorf("unknown availability certificate type: %T", cert.GetType())
+	}
+}
+
+// CheckpointForHash returns the checkpointed state to be hashed, consisting of the application snapshot
+// and the requests delivered to the application, so that the checkpoint certificate covers both.
+// All variable-length fields are prefixed by their lengths (and lists by the numbers of their elements).
+func CheckpointForHash(appSnapshot []byte, clientProgress *isspb.ClientProgress) [][]byte {
+	fields := [][]byte{appSnapshot, uint64ToBytes(uint64(len(clientProgress.GetClients())))}
+	for _, client := range clientProgress.GetClients() {
+		fields = append(fields,
+			[]byte(client.ClientId),
+			uint64ToBytes(client.LowWm),
+			uint64ToBytes(uint64(len(client.Delivered))),
+		)
+		for _, reqNo := range client.Delivered {
+			fields = append(fields, uint64ToBytes(reqNo))
+		}
+	}
+	return lengthPrefixed(fields)
+}
+
+// lengthPrefixed returns the given fields, each preceded by its length.
+func lengthPrefixed(fields [][]byte) [][]byte {
+	data := make([][]byte, 0, 2*len(fields))
+	for _, field := range fields {
+		data = append(data, uint64ToBytes(uint64(len(field))), field)
+	}
+	return data
+}
+
+func uint64ToBytes(n uint64) []byte {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, n)
+	return buf
+}
+
 func CheckpointForSig(epoch t.EpochNr, seqNr t.SeqNr, snapshotHash []byte) [][]byte {
 	epochBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(epochBytes, uint64(epoch))
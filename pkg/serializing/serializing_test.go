@@ -0,0 +1,65 @@
+package serializing
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/avidpb"
+	"github.com/filecoin-project/mir/pkg/pb/availabilitypb/mscpb"
+)
+
+func TestCertForHashUnambiguous(t *testing.T) {
+	msc := func(cert *mscpb.Cert) *availabilitypb.Cert {
+		return &availabilitypb.Cert{Type: &availabilitypb.Cert_Msc{Msc: cert}}
+	}
+	avid := func(cert *avidpb.Cert) *availabilitypb.Cert {
+		return &availabilitypb.Cert{Type: &availabilitypb.Cert_Avid{Avid: cert}}
+	}
+
+	// Each pair of certificates differs, but the plain concatenation of their fields would be the same.
+	testCases := map[string][2]*availabilitypb.Cert{
+		"signature vs aggregated signature": {
+			msc(&mscpb.Cert{BatchId: []byte("batch"), SignerBitmap: []byte{7}, Signatures: [][]byte{[]byte("sig")}}),
+			msc(&mscpb.Cert{BatchId: []byte("batch"), SignerBitmap: []byte{7}, AggregatedSignature: []byte("sig")}),
+		},
+		"split signatures": {
+			msc(&mscpb.Cert{BatchId: []byte("batch"), Signatures: [][]byte{[]byte("ab"), []byte("c")}}),
+			msc(&mscpb.Cert{BatchId: []byte("batch"), Signatures: [][]byte{[]byte("a"), []byte("bc")}}),
+		},
+		"batch ID vs bitmap": {
+			msc(&mscpb.Cert{BatchId: []byte("batch"), SignerBitmap: []byte{7}}),
+			msc(&mscpb.Cert{BatchId: []byte("batch\x07")}),
+		},
+		"AVID split signatures": {
+			avid(&avidpb.Cert{MerkleRoot: []byte("root"), Signatures: [][]byte{[]byte("ab"), []byte("c")}}),
+			avid(&avidpb.Cert{MerkleRoot: []byte("root"), Signatures: [][]byte{[]byte("abc")}}),
+		},
+	}
+
+	for name, certs := range testCases {
+		certs := certs
+		t.Run(name, func(t *testing.T) {
+			data0, err := CertForHash(certs[0])
+			require.NoError(t, err)
+			data1, err := CertForHash(certs[1])
+			require.NoError(t, err)
+			assert.NotEqual(t, bytes.Join(data0, nil), bytes.Join(data1, nil))
+		})
+	}
+}
+
+func TestCertForHashMalformed(t *testing.T) {
+	for _, cert := range []*availabilitypb.Cert{
+		nil,
+		{},
+		{Type: &availabilitypb.Cert_Msc{}},
+		{Type: &availabilitypb.Cert_Avid{}},
+	} {
+		_, err := CertForHash(cert)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,36 @@
+package threshcrypto
+
+import t "github.com/filecoin-project/mir/pkg/types"
+
+// The ThreshCrypto interface represents an implementation of the threshold cryptographic primitives
+// inside the MirModule module of this package.
+// It is responsible for producing and verifying threshold signatures.
+// A threshold signature scheme distributes shares of a single (group) private key among the nodes.
+// Each node can produce a signature share using its key share,
+// and any threshold of valid signature shares of the same data can be combined into a full signature.
+// A full signature is verifiable against the group public key and its size does not depend on the number of nodes.
+// Both the key shares and the information about which node holds which key share
+// are internally stored by the implementation.
+type ThreshCrypto interface {
+
+	// SignShare signs the provided data and returns the resulting signature share.
+	// The data to be signed is the concatenation of all the passed byte slices.
+	// A signature share produced by SignShare is verifiable using VerifyShare.
+	// After combining a threshold of signature shares of the same data with Recover,
+	// the resulting full signature is verifiable with VerifyFull.
+	SignShare(data [][]byte) ([]byte, error)
+
+	// VerifyShare verifies a signature share produced by the node with ID nodeID over data.
+	// Returns nil on success (i.e., if the given signature share is valid) and a non-nil error otherwise.
+	VerifyShare(data [][]byte, sigShare []byte, nodeID t.NodeID) error
+
+	// VerifyFull verifies a full signature over data against the group public key.
+	// Returns nil on success (i.e., if the given signature is valid) and a non-nil error otherwise.
+	VerifyFull(data [][]byte, signature []byte) error
+
+	// Recover combines signature shares of data into a full signature.
+	// The signature shares must be valid (as checked by VerifyShare) and produced by distinct nodes.
+	// Returns the full signature on success and a non-nil error if not enough signature shares are given
+	// or if they cannot be combined.
+	Recover(data [][]byte, sigShares [][]byte) ([]byte, error)
+}
@@ -0,0 +1,216 @@
+package dsl
+
+import (
+	"github.com/filecoin-project/mir/pkg/dsl"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	tcpb "github.com/filecoin-project/mir/pkg/pb/threshcryptopb"
+	tcevents "github.com/filecoin-project/mir/pkg/threshcrypto/events"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Module-specific dsl functions for emitting events.
+
+// SignShare emits a request event to produce a signature share of the given data.
+// The response should be processed using UponSignShareResult with the same context type C.
+// C can be an arbitrary type and does not have to be serializable.
+func SignShare[C any](m dsl.Module, dest t.ModuleID, data [][]byte, context *C) {
+	contextID := m.DslHandle().StoreContext(context)
+
+	origin := &tcpb.SignShareOrigin{
+		Module: m.ModuleID().Pb(),
+		Type: &tcpb.SignShareOrigin_Dsl{
+			Dsl: dsl.Origin(contextID),
+		},
+	}
+
+	dsl.EmitEvent(m, tcevents.SignShare(dest, data, origin))
+}
+
+// SignShareResult is a response to a SignShare event.
+func SignShareResult(m dsl.Module, dest t.ModuleID, sigShare []byte, origin *tcpb.SignShareOrigin) {
+	dsl.EmitEvent(m, tcevents.SignShareResult(dest, sigShare, origin))
+}
+
+// VerifyShare emits a request event to verify a signature share of the given data produced by node nodeID.
+// The response should be processed using UponVerifyShareResult with the same context type C.
+// C can be an arbitrary type and does not have to be serializable.
+func VerifyShare[C any](m dsl.Module, dest t.ModuleID, data [][]byte, sigShare []byte, nodeID t.NodeID, context *C) {
+	contextID := m.DslHandle().StoreContext(context)
+
+	origin := &tcpb.VerifyShareOrigin{
+		Module: m.ModuleID().Pb(),
+		Type: &tcpb.VerifyShareOrigin_Dsl{
+			Dsl: dsl.Origin(contextID),
+		},
+	}
+
+	dsl.EmitEvent(m, tcevents.VerifyShare(dest, data, sigShare, nodeID, origin))
+}
+
+// VerifyShareResult is a response to a VerifyShare event.
+func VerifyShareResult(m dsl.Module, dest t.ModuleID, err error, origin *tcpb.VerifyShareOrigin) {
+	dsl.EmitEvent(m, tcevents.VerifyShareResult(dest, err, origin))
+}
+
+// VerifyFull emits a request event to verify a full signature of the given data.
+// The response should be processed using UponVerifyFullResult with the same context type C.
+// C can be an arbitrary type and does not have to be serializable.
+func VerifyFull[C any](m dsl.Module, dest t.ModuleID, data [][]byte, fullSig []byte, context *C) {
+	contextID := m.DslHandle().StoreContext(context)
+
+	origin := &tcpb.VerifyFullOrigin{
+		Module: m.ModuleID().Pb(),
+		Type: &tcpb.VerifyFullOrigin_Dsl{
+			Dsl: dsl.Origin(contextID),
+		},
+	}
+
+	dsl.EmitEvent(m, tcevents.VerifyFull(dest, data, fullSig, origin))
+}
+
+// VerifyFullResult is a response to a VerifyFull event.
+func VerifyFullResult(m dsl.Module, dest t.ModuleID, err error, origin *tcpb.VerifyFullOrigin) {
+	dsl.EmitEvent(m, tcevents.VerifyFullResult(dest, err, origin))
+}
+
+// Recover emits a request event to combine signature shares of the given data into a full signature.
+// The response should be processed using UponRecoverResult with the same context type C.
+// C can be an arbitrary type and does not have to be serializable.
+func Recover[C any](m dsl.Module, dest t.ModuleID, data [][]byte, sigShares [][]byte, context *C) {
+	contextID := m.DslHandle().StoreContext(context)
+
+	origin := &tcpb.RecoverOrigin{
+		Module: m.ModuleID().Pb(),
+		Type: &tcpb.RecoverOrigin_Dsl{
+			Dsl: dsl.Origin(contextID),
+		},
+	}
+
+	dsl.EmitEvent(m, tcevents.Recover(dest, data, sigShares, origin))
+}
+
+// RecoverResult is a response to a Recover event.
+func RecoverResult(m dsl.Module, dest t.ModuleID, fullSig []byte, err error, origin *tcpb.RecoverOrigin) {
+	dsl.EmitEvent(m, tcevents.RecoverResult(dest, fullSig, err, origin))
+}
+
+// Module-specific dsl functions for processing events.
+
+// UponEvent registers a handler for the given threshcrypto event type.
+func UponEvent[EvWrapper tcpb.Event_TypeWrapper[Ev], Ev any](m dsl.Module, handler func(ev *Ev) error) {
+	dsl.UponEvent[*eventpb.Event_Threshcrypto](m, func(ev *tcpb.Event) error {
+		evWrapper, ok := ev.Type.(EvWrapper)
+		if !ok {
+			return nil
+		}
+		return handler(evWrapper.Unwrap())
+	})
+}
+
+// UponSignShare registers a handler for the SignShare events.
+func UponSignShare(m dsl.Module, handler func(data [][]byte, origin *tcpb.SignShareOrigin) error) {
+	UponEvent[*tcpb.Event_SignShare](m, func(ev *tcpb.SignShare) error {
+		return handler(ev.Data, ev.Origin)
+	})
+}
+
+// UponSignShareResult invokes handler when the module receives a response to a request made by SignShare
+// with the same context type C.
+func UponSignShareResult[C any](m dsl.Module, handler func(sigShare []byte, context *C) error) {
+	UponEvent[*tcpb.Event_SignShareResult](m, func(ev *tcpb.SignShareResult) error {
+		originWrapper, ok := ev.Origin.Type.(*tcpb.SignShareOrigin_Dsl)
+		if !ok {
+			return nil
+		}
+
+		contextRaw := m.DslHandle().RecoverAndCleanupContext(dsl.ContextID(originWrapper.Dsl.ContextID))
+		context, ok := contextRaw.(*C)
+		if !ok {
+			return nil
+		}
+
+		return handler(ev.SignatureShare, context)
+	})
+}
+
+// UponVerifyShare registers a handler for the VerifyShare events.
+func UponVerifyShare(
+	m dsl.Module,
+	handler func(data [][]byte, sigShare []byte, nodeID t.NodeID, origin *tcpb.VerifyShareOrigin) error,
+) {
+	UponEvent[*tcpb.Event_VerifyShare](m, func(ev *tcpb.VerifyShare) error {
+		return handler(ev.Data, ev.SignatureShare, t.NodeID(ev.NodeId), ev.Origin)
+	})
+}
+
+// UponVerifyShareResult invokes handler when the module receives a response to a request made by VerifyShare
+// with the same context type C.
+func UponVerifyShareResult[C any](m dsl.Module, handler func(err error, context *C) error) {
+	UponEvent[*tcpb.Event_VerifyShareResult](m, func(ev *tcpb.VerifyShareResult) error {
+		originWrapper, ok := ev.Origin.Type.(*tcpb.VerifyShareOrigin_Dsl)
+		if !ok {
+			return nil
+		}
+
+		contextRaw := m.DslHandle().RecoverAndCleanupContext(dsl.ContextID(originWrapper.Dsl.ContextID))
+		context, ok := contextRaw.(*C)
+		if !ok {
+			return nil
+		}
+
+		return handler(t.ErrorFromPb(ev.Ok, ev.Error), context)
+	})
+}
+
+// UponVerifyFull registers a handler for the VerifyFull events.
+func UponVerifyFull(m dsl.Module, handler func(data [][]byte, fullSig []byte, origin *tcpb.VerifyFullOrigin) error) {
+	UponEvent[*tcpb.Event_VerifyFull](m, func(ev *tcpb.VerifyFull) error {
+		return handler(ev.Data, ev.FullSignature, ev.Origin)
+	})
+}
+
+// UponVerifyFullResult invokes handler when the module receives a response to a request made by VerifyFull
+// with the same context type C.
+func UponVerifyFullResult[C any](m dsl.Module, handler func(err error, context *C) error) {
+	UponEvent[*tcpb.Event_VerifyFullResult](m, func(ev *tcpb.VerifyFullResult) error {
+		originWrapper, ok := ev.Origin.Type.(*tcpb.VerifyFullOrigin_Dsl)
+		if !ok {
+			return nil
+		}
+
+		contextRaw := m.DslHandle().RecoverAndCleanupContext(dsl.ContextID(originWrapper.Dsl.ContextID))
+		context, ok := contextRaw.(*C)
+		if !ok {
+			return nil
+		}
+
+		return handler(t.ErrorFromPb(ev.Ok, ev.Error), context)
+	})
+}
+
+// UponRecover registers a handler for the Recover events.
+func UponRecover(m dsl.Module, handler func(data [][]byte, sigShares [][]byte, origin *tcpb.RecoverOrigin) error) {
+	UponEvent[*tcpb.Event_Recover](m, func(ev *tcpb.Recover) error {
+		return handler(ev.Data, ev.SignatureShares, ev.Origin)
+	})
+}
+
+// UponRecoverResult invokes handler when the module receives a response to a request made by Recover
+// with the same context type C.
+// If the recovery failed, err is not nil and fullSig is to be ignored.
+func UponRecoverResult[C any](m dsl.Module, handler func(fullSig []byte, err error, context *C) error) {
+	UponEvent[*tcpb.Event_RecoverResult](m, func(ev *tcpb.RecoverResult) error {
+		originWrapper, ok := ev.Origin.Type.(*tcpb.RecoverOrigin_Dsl)
+		if !ok {
+			return nil
+		}
+
+		contextRaw := m.DslHandle().RecoverAndCleanupContext(dsl.ContextID(originWrapper.Dsl.ContextID))
+		context, ok := contextRaw.(*C)
+		if !ok {
+			return nil
+		}
+
+		return handler(ev.FullSignature, t.ErrorFromPb(ev.Ok, ev.Error), context)
+	})
+}
@@ -0,0 +1,131 @@
+package events
+
+import (
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	tcpb "github.com/filecoin-project/mir/pkg/pb/threshcryptopb"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Event creates an eventpb.Event out of a threshcryptopb.Event.
+func Event(dest t.ModuleID, ev *tcpb.Event) *eventpb.Event {
+	return &eventpb.Event{
+		DestModule: dest.Pb(),
+		Type: &eventpb.Event_Threshcrypto{
+			Threshcrypto: ev,
+		},
+	}
+}
+
+// SignShare requests a signature share of the concatenation of data.
+func SignShare(dest t.ModuleID, data [][]byte, origin *tcpb.SignShareOrigin) *eventpb.Event {
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_SignShare{
+			SignShare: &tcpb.SignShare{
+				Data:   data,
+				Origin: origin,
+			},
+		},
+	})
+}
+
+// SignShareResult is a response to a SignShare event.
+func SignShareResult(dest t.ModuleID, sigShare []byte, origin *tcpb.SignShareOrigin) *eventpb.Event {
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_SignShareResult{
+			SignShareResult: &tcpb.SignShareResult{
+				SignatureShare: sigShare,
+				Origin:         origin,
+			},
+		},
+	})
+}
+
+// VerifyShare requests the verification of a signature share of the concatenation of data produced by nodeID.
+func VerifyShare(
+	dest t.ModuleID,
+	data [][]byte,
+	sigShare []byte,
+	nodeID t.NodeID,
+	origin *tcpb.VerifyShareOrigin,
+) *eventpb.Event {
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_VerifyShare{
+			VerifyShare: &tcpb.VerifyShare{
+				Data:           data,
+				SignatureShare: sigShare,
+				NodeId:         nodeID.Pb(),
+				Origin:         origin,
+			},
+		},
+	})
+}
+
+// VerifyShareResult is a response to a VerifyShare event.
+func VerifyShareResult(dest t.ModuleID, err error, origin *tcpb.VerifyShareOrigin) *eventpb.Event {
+	ok, errStr := t.ErrorPb(err)
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_VerifyShareResult{
+			VerifyShareResult: &tcpb.VerifyShareResult{
+				Ok:     ok,
+				Error:  errStr,
+				Origin: origin,
+			},
+		},
+	})
+}
+
+// VerifyFull requests the verification of a full signature of the concatenation of data.
+func VerifyFull(dest t.ModuleID, data [][]byte, fullSig []byte, origin *tcpb.VerifyFullOrigin) *eventpb.Event {
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_VerifyFull{
+			VerifyFull: &tcpb.VerifyFull{
+				Data:          data,
+				FullSignature: fullSig,
+				Origin:        origin,
+			},
+		},
+	})
+}
+
+// VerifyFullResult is a response to a VerifyFull event.
+func VerifyFullResult(dest t.ModuleID, err error, origin *tcpb.VerifyFullOrigin) *eventpb.Event {
+	ok, errStr := t.ErrorPb(err)
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_VerifyFullResult{
+			VerifyFullResult: &tcpb.VerifyFullResult{
+				Ok:     ok,
+				Error:  errStr,
+				Origin: origin,
+			},
+		},
+	})
+}
+
+// Recover requests combining signature shares of the concatenation of data into a full signature.
+func Recover(dest t.ModuleID, data [][]byte, sigShares [][]byte, origin *tcpb.RecoverOrigin) *eventpb.Event {
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_Recover{
+			Recover: &tcpb.Recover{
+				Data:            data,
+				SignatureShares: sigShares,
+				Origin:          origin,
+			},
+		},
+	})
+}
+
+// RecoverResult is a response to a Recover event.
+// If err is not nil, fullSig is ignored.
+func RecoverResult(dest t.ModuleID, fullSig []byte, err error, origin *tcpb.RecoverOrigin) *eventpb.Event {
+	ok, errStr := t.ErrorPb(err)
+	return Event(dest, &tcpb.Event{
+		Type: &tcpb.Event_RecoverResult{
+			RecoverResult: &tcpb.RecoverResult{
+				FullSignature: fullSig,
+				Ok:            ok,
+				Error:         errStr,
+				Origin:        origin,
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package threshcrypto
+
+import (
+	prand "math/rand"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Bit length of the modulus of the keys generated by NodePseudo.
+// It is too small to be secure, but makes the key generation reasonably fast.
+const pseudoModulusBits = 512
+
+// NodePseudo returns a ThreshCrypto module to be used by a Node, generating a new threshold key
+// in a pseudo-random manner.
+// The key is shared among all the given nodes, such that any threshold of them can produce a full signature.
+// It is generated deterministically, based on a given configuration and a random seed.
+// NodePseudo is not secure.
+// Intended for testing purposes and assuming a static membership known to all nodes,
+// NodePseudo can be invoked by each Node independently (specifying the same seed, e.g. crypto.DefaultPseudoSeed)
+// and generates the same key shares for the whole system at each node, obviating a distributed key generation.
+func NodePseudo(nodes []t.NodeID, threshold int, ownID t.NodeID, seed int64) (ThreshCrypto, error) {
+
+	// Create a new pseudorandom source from the given seed.
+	randomness := prand.New(prand.NewSource(seed)) //nolint:gosec
+
+	// Generate the key shares.
+	// All key shares except the own one will be discarded.
+	pubKey, privShares, err := GenerateThreshRSAKeys(len(nodes), threshold, pseudoModulusBits, randomness)
+	if err != nil {
+		return nil, err
+	}
+
+	// Look up the own key share and create a ThreshCrypto module instance that would sign with this key share.
+	for i, id := range nodes {
+		if id == ownID {
+			return NewThreshRSAImpl(pubKey, nodes, ownID, privShares[i])
+		}
+	}
+
+	// Own ID was not found in the given membership.
+	return NewThreshRSAImpl(pubKey, nodes, ownID, nil)
+}
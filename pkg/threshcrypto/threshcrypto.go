@@ -0,0 +1,85 @@
+// Package threshcrypto provides a module for threshold signatures, analogous to the MirModule of package crypto.
+// It supports threshold RSA signatures.
+package threshcrypto
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/modules"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	tcpb "github.com/filecoin-project/mir/pkg/pb/threshcryptopb"
+	tcevents "github.com/filecoin-project/mir/pkg/threshcrypto/events"
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+type MirModule struct {
+	threshCrypto ThreshCrypto
+}
+
+func New(threshCrypto ThreshCrypto) *MirModule {
+	return &MirModule{threshCrypto: threshCrypto}
+}
+
+func (c *MirModule) ApplyEvents(eventsIn *events.EventList) (*events.EventList, error) {
+	return modules.ApplyEventsConcurrently(eventsIn, c.ApplyEvent)
+}
+
+func (c *MirModule) ApplyEvent(event *eventpb.Event) (*events.EventList, error) {
+	switch e := event.Type.(type) {
+	case *eventpb.Event_Init:
+		// no actions on init
+		return events.EmptyList(), nil
+	case *eventpb.Event_Threshcrypto:
+		return c.applyTCEvent(e.Threshcrypto)
+	default:
+		// Complain about all other incoming event types.
+		return nil, fmt.Errorf("unexpected type of threshcrypto MirModule event: %T", event.Type)
+	}
+}
+
+func (c *MirModule) applyTCEvent(event *tcpb.Event) (*events.EventList, error) {
+	switch e := event.Type.(type) {
+	case *tcpb.Event_SignShare:
+		// Compute a signature share over the provided data and produce a SignShareResult event.
+
+		sigShare, err := c.threshCrypto.SignShare(e.SignShare.Data)
+		if err != nil {
+			return nil, err
+		}
+		return events.ListOf(
+			tcevents.SignShareResult(t.ModuleID(e.SignShare.Origin.Module), sigShare, e.SignShare.Origin),
+		), nil
+
+	case *tcpb.Event_VerifyShare:
+		// Verify a signature share and produce a VerifyShareResult event.
+
+		err := c.threshCrypto.VerifyShare(e.VerifyShare.Data, e.VerifyShare.SignatureShare, t.NodeID(e.VerifyShare.NodeId))
+		return events.ListOf(
+			tcevents.VerifyShareResult(t.ModuleID(e.VerifyShare.Origin.Module), err, e.VerifyShare.Origin),
+		), nil
+
+	case *tcpb.Event_VerifyFull:
+		// Verify a full signature and produce a VerifyFullResult event.
+
+		err := c.threshCrypto.VerifyFull(e.VerifyFull.Data, e.VerifyFull.FullSignature)
+		return events.ListOf(
+			tcevents.VerifyFullResult(t.ModuleID(e.VerifyFull.Origin.Module), err, e.VerifyFull.Origin),
+		), nil
+
+	case *tcpb.Event_Recover:
+		// Combine signature shares into a full signature and produce a RecoverResult event.
+
+		fullSig, err := c.threshCrypto.Recover(e.Recover.Data, e.Recover.SignatureShares)
+		return events.ListOf(
+			tcevents.RecoverResult(t.ModuleID(e.Recover.Origin.Module), fullSig, err, e.Recover.Origin),
+		), nil
+
+	default:
+		// Complain about all other incoming event types.
+		return nil, fmt.Errorf("unexpected type of threshcrypto event: %T", event.Type)
+	}
+}
+
+// The ImplementsModule method only serves the purpose of indicating that this is a Module and must not be called.
+func (c *MirModule) ImplementsModule() {}
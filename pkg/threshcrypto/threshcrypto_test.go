@@ -0,0 +1,80 @@
+package threshcrypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/events"
+	"github.com/filecoin-project/mir/pkg/pb/dslpb"
+	"github.com/filecoin-project/mir/pkg/pb/eventpb"
+	tcpb "github.com/filecoin-project/mir/pkg/pb/threshcryptopb"
+	tcevents "github.com/filecoin-project/mir/pkg/threshcrypto/events"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+// applyEvent applies a single event to the module and returns the single resulting threshcrypto event.
+func applyEvent(t *testing.T, module *MirModule, event *eventpb.Event) *tcpb.Event {
+	evsOut, err := module.ApplyEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, 1, evsOut.Len())
+
+	result := evsOut.Slice()[0]
+	assert.Equal(t, "origin", result.DestModule)
+	return result.Type.(*eventpb.Event_Threshcrypto).Threshcrypto
+}
+
+func TestMirModule(t *testing.T) {
+	nodes := []types.NodeID{"0", "1", "2", "3"}
+	data := [][]byte{[]byte("hello"), []byte("world")}
+
+	modules := make([]*MirModule, len(nodes))
+	for i, nodeID := range nodes {
+		impl, err := NodePseudo(nodes, 2, nodeID, crypto.DefaultPseudoSeed)
+		require.NoError(t, err)
+		modules[i] = New(impl)
+	}
+
+	evsOut, err := modules[0].ApplyEvent(events.Init("threshcrypto"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, evsOut.Len())
+
+	// Each SignShare event results in a signature share, returned to the origin.
+	signOrigin := &tcpb.SignShareOrigin{Module: "origin", Type: &tcpb.SignShareOrigin_Dsl{Dsl: &dslpb.Origin{}}}
+	shares := make([][]byte, len(nodes))
+	for i := range nodes {
+		result := applyEvent(t, modules[i], tcevents.SignShare("threshcrypto", data, signOrigin))
+		require.NotNil(t, result.GetSignShareResult())
+		assert.Equal(t, signOrigin, result.GetSignShareResult().Origin)
+		shares[i] = result.GetSignShareResult().SignatureShare
+	}
+
+	// A VerifyShare event reports whether the signature share is valid.
+	verifyShareOrigin := &tcpb.VerifyShareOrigin{Module: "origin", Type: &tcpb.VerifyShareOrigin_Dsl{Dsl: &dslpb.Origin{}}}
+	result := applyEvent(t, modules[1], tcevents.VerifyShare("threshcrypto", data, shares[0], "0", verifyShareOrigin))
+	assert.True(t, result.GetVerifyShareResult().Ok)
+	result = applyEvent(t, modules[1], tcevents.VerifyShare("threshcrypto", data, shares[0], "2", verifyShareOrigin))
+	assert.False(t, result.GetVerifyShareResult().Ok)
+	assert.NotEmpty(t, result.GetVerifyShareResult().Error)
+
+	// A Recover event combines the signature shares into a full signature, unless there are not enough of them.
+	recoverOrigin := &tcpb.RecoverOrigin{Module: "origin", Type: &tcpb.RecoverOrigin_Dsl{Dsl: &dslpb.Origin{}}}
+	result = applyEvent(t, modules[2], tcevents.Recover("threshcrypto", data, shares[:1], recoverOrigin))
+	assert.False(t, result.GetRecoverResult().Ok)
+	result = applyEvent(t, modules[2], tcevents.Recover("threshcrypto", data, shares[1:3], recoverOrigin))
+	require.True(t, result.GetRecoverResult().Ok)
+	fullSig := result.GetRecoverResult().FullSignature
+
+	// A VerifyFull event reports whether the full signature is valid for the data.
+	verifyFullOrigin := &tcpb.VerifyFullOrigin{Module: "origin", Type: &tcpb.VerifyFullOrigin_Dsl{Dsl: &dslpb.Origin{}}}
+	result = applyEvent(t, modules[3], tcevents.VerifyFull("threshcrypto", data, fullSig, verifyFullOrigin))
+	assert.True(t, result.GetVerifyFullResult().Ok)
+	result = applyEvent(t, modules[3], tcevents.VerifyFull("threshcrypto", data[:1], fullSig, verifyFullOrigin))
+	assert.False(t, result.GetVerifyFullResult().Ok)
+
+	// Other events are rejected.
+	_, err = modules[0].ApplyEvent(events.TimerDelay("threshcrypto", nil, 0))
+	assert.Error(t, err)
+}
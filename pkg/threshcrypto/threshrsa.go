@@ -0,0 +1,490 @@
+package threshcrypto
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+
+	t "github.com/filecoin-project/mir/pkg/types"
+)
+
+// Public exponent of the threshold RSA keys.
+// It must be a prime larger than the number of key shares.
+const threshRSAPublicExponent = 65537
+
+// Bit length of the challenge used in the proofs of correctness of signature shares.
+const threshRSAChallengeBits = 256
+
+// ThreshRSAPublicKey is the public part of a threshold RSA key, known to all nodes.
+// It consists of the RSA public key itself, which is used to verify full signatures,
+// and of the verification keys used to verify the signature shares.
+type ThreshRSAPublicKey struct {
+
+	// RSA modulus, the product of two safe primes.
+	N *big.Int
+
+	// RSA public exponent.
+	E *big.Int
+
+	// Generator of the group of squares modulo N.
+	V *big.Int
+
+	// Verification keys of the key shares, VerificationKeys[i] corresponding to the share with index i+1.
+	VerificationKeys []*big.Int
+
+	// Number of signature shares necessary to recover a full signature.
+	Threshold int
+}
+
+// ThreshRSAImpl is an implementation of ThreshCrypto using the practical threshold RSA signatures by Victor Shoup
+// (https://www.iacr.org/archive/eurocrypt2000/1807/18070209-new.pdf).
+// A full signature is a standard RSA signature of a full-domain hash of the data.
+// Each signature share comes with a non-interactive proof of its correctness,
+// and thus can be verified individually before being combined with the others.
+type ThreshRSAImpl struct {
+	pubKey *ThreshRSAPublicKey
+
+	// Index of this node's key share, starting from 1.
+	ownIndex int
+
+	// This node's key share, used to produce signature shares.
+	privShare *big.Int
+
+	// The key share index of each node, starting from 1.
+	indices map[t.NodeID]int
+
+	// The factorial of the number of key shares, used by the scheme to avoid computing inverses modulo the unknown
+	// order of the group of squares.
+	delta *big.Int
+}
+
+// NewThreshRSAImpl returns a new ThreshRSAImpl.
+// members lists the nodes holding key shares, the share with index i+1 belonging to members[i].
+// privShare is the key share of the node ownID.
+func NewThreshRSAImpl(
+	pubKey *ThreshRSAPublicKey,
+	members []t.NodeID,
+	ownID t.NodeID,
+	privShare *big.Int,
+) (*ThreshRSAImpl, error) {
+
+	if len(members) != len(pubKey.VerificationKeys) {
+		return nil, fmt.Errorf("number of members (%d) does not match number of verification keys (%d)",
+			len(members), len(pubKey.VerificationKeys))
+	}
+
+	indices := make(map[t.NodeID]int, len(members))
+	for i, nodeID := range members {
+		indices[nodeID] = i + 1
+	}
+
+	ownIndex, ok := indices[ownID]
+	if !ok {
+		return nil, fmt.Errorf("ownID (%v) not found among members", ownID)
+	}
+
+	return &ThreshRSAImpl{
+		pubKey:    pubKey,
+		ownIndex:  ownIndex,
+		privShare: privShare,
+		indices:   indices,
+		delta:     factorial(len(members)),
+	}, nil
+}
+
+// SignShare produces a signature share of the data, along with a proof of its correctness.
+func (c *ThreshRSAImpl) SignShare(data [][]byte) ([]byte, error) {
+	n := c.pubKey.N
+	x := c.hashToGroup(data)
+
+	// x_i = x^(2 * delta * s_i)
+	exp := new(big.Int).Mul(c.delta, c.privShare)
+	exp.Lsh(exp, 1)
+	share := new(big.Int).Exp(x, exp, n)
+
+	// Prove that log_v(v_i) = log_(x^(4 * delta))(x_i^2) in zero knowledge.
+	r, err := crand.Int(crand.Reader, new(big.Int).Lsh(big.NewInt(1), uint(n.BitLen()+2*threshRSAChallengeBits)))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate proof randomness: %w", err)
+	}
+	xTilde := c.xTilde(x)
+	challenge := c.challenge(
+		xTilde,
+		c.pubKey.VerificationKeys[c.ownIndex-1],
+		new(big.Int).Exp(share, big.NewInt(2), n),
+		new(big.Int).Exp(c.pubKey.V, r, n),
+		new(big.Int).Exp(xTilde, r, n),
+	)
+	z := new(big.Int).Mul(c.privShare, challenge)
+	z.Add(z, r)
+
+	return encodeShare(c.ownIndex, share, challenge, z), nil
+}
+
+// VerifyShare verifies that sigShare is a correct signature share of the data produced by node nodeID.
+func (c *ThreshRSAImpl) VerifyShare(data [][]byte, sigShare []byte, nodeID t.NodeID) error {
+	index, ok := c.indices[nodeID]
+	if !ok {
+		return fmt.Errorf("node %v holds no key share", nodeID)
+	}
+
+	shareIndex, share, challenge, z, err := decodeShare(sigShare)
+	if err != nil {
+		return err
+	}
+	if shareIndex != index {
+		return fmt.Errorf("signature share index (%d) does not match the key share index of node %v (%d)",
+			shareIndex, nodeID, index)
+	}
+
+	return c.verifyShare(c.hashToGroup(data), index, share, challenge, z)
+}
+
+// Recover combines the signature shares of the data into a full signature.
+// The signature shares are assumed to be correct and produced by distinct nodes.
+// Only the first Threshold shares are used, and any further shares are ignored.
+// Recover fails if less than Threshold shares are given or if they do not combine into a valid full signature.
+func (c *ThreshRSAImpl) Recover(data [][]byte, sigShares [][]byte) ([]byte, error) {
+	n := c.pubKey.N
+	x := c.hashToGroup(data)
+
+	if len(sigShares) < c.pubKey.Threshold {
+		return nil, fmt.Errorf("not enough signature shares: %d (need %d)", len(sigShares), c.pubKey.Threshold)
+	}
+
+	indices := make([]int, c.pubKey.Threshold)
+	shares := make([]*big.Int, c.pubKey.Threshold)
+	for i, sigShare := range sigShares[:c.pubKey.Threshold] {
+		var err error
+		if indices[i], shares[i], _, _, err = decodeShare(sigShare); err != nil {
+			return nil, err
+		}
+		if indices[i] < 1 || indices[i] > len(c.pubKey.VerificationKeys) {
+			return nil, fmt.Errorf("invalid signature share index: %d", indices[i])
+		}
+		for j := 0; j < i; j++ {
+			if indices[i] == indices[j] {
+				return nil, fmt.Errorf("duplicate signature share index: %d", indices[i])
+			}
+		}
+	}
+
+	// w = prod_j x_j^(2 * lambda_j), where lambda_j is the integral Lagrange coefficient of share j,
+	// satisfies w^e = x^(4 * delta^2).
+	w := big.NewInt(1)
+	for i, share := range shares {
+		lambda := c.lagrangeCoefficient(indices, i)
+		w.Mul(w, modExp(share, lambda.Lsh(lambda, 1), n))
+		w.Mod(w, n)
+	}
+
+	// As gcd(4 * delta^2, e) = 1, find a, b such that 4 * delta^2 * a + e * b = 1.
+	// Then y = w^a * x^b satisfies y^e = x.
+	ePrime := new(big.Int).Mul(c.delta, c.delta)
+	ePrime.Lsh(ePrime, 2)
+	a, b := new(big.Int), new(big.Int)
+	if gcd := new(big.Int).GCD(a, b, ePrime, c.pubKey.E); gcd.Cmp(big.NewInt(1)) != 0 {
+		return nil, fmt.Errorf("public exponent not coprime with 4 * delta^2")
+	}
+	y := modExp(w, a, n)
+	y.Mul(y, modExp(x, b, n))
+	y.Mod(y, n)
+
+	signature := y.FillBytes(make([]byte, (n.BitLen()+7)/8))
+	if err := c.VerifyFull(data, signature); err != nil {
+		return nil, fmt.Errorf("recovered signature invalid: %w", err)
+	}
+	return signature, nil
+}
+
+// VerifyFull verifies a full signature of the data against the public key.
+func (c *ThreshRSAImpl) VerifyFull(data [][]byte, signature []byte) error {
+	n := c.pubKey.N
+	y := new(big.Int).SetBytes(signature)
+	if y.Cmp(n) >= 0 {
+		return fmt.Errorf("signature out of range")
+	}
+	if new(big.Int).Exp(y, c.pubKey.E, n).Cmp(c.hashToGroup(data)) != 0 {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// verifyShare checks the proof of correctness of a signature share of x by the holder of the key share index.
+func (c *ThreshRSAImpl) verifyShare(x *big.Int, index int, share, challenge, z *big.Int) error {
+	n := c.pubKey.N
+	if share.Sign() <= 0 || share.Cmp(n) >= 0 {
+		return fmt.Errorf("signature share out of range")
+	}
+	if z.Sign() < 0 || z.BitLen() > n.BitLen()+2*threshRSAChallengeBits+1 {
+		return fmt.Errorf("proof response out of range")
+	}
+
+	vi := c.pubKey.VerificationKeys[index-1]
+	shareSquared := new(big.Int).Exp(share, big.NewInt(2), n)
+	xTilde := c.xTilde(x)
+	minusC := new(big.Int).Neg(challenge)
+
+	// v' = v^z * v_i^(-c), x' = x~^z * x_i^(-2c)
+	vPrime := new(big.Int).Exp(c.pubKey.V, z, n)
+	vPrime.Mul(vPrime, modExp(vi, minusC, n))
+	vPrime.Mod(vPrime, n)
+	xPrime := new(big.Int).Exp(xTilde, z, n)
+	xPrime.Mul(xPrime, modExp(shareSquared, minusC, n))
+	xPrime.Mod(xPrime, n)
+
+	if c.challenge(xTilde, vi, shareSquared, vPrime, xPrime).Cmp(challenge) != 0 {
+		return fmt.Errorf("invalid proof of signature share correctness")
+	}
+	return nil
+}
+
+// hashToGroup computes a full-domain hash of the concatenation of all byte slices in data,
+// i.e., a hash that is (almost) uniformly distributed modulo N.
+func (c *ThreshRSAImpl) hashToGroup(data [][]byte) *big.Int {
+	h := sha256.New()
+	for _, d := range data {
+		h.Write(d)
+	}
+	digest := h.Sum(nil)
+
+	// Expand the digest to 128 bits more than the length of N, to make the bias of the reduction negligible.
+	expanded := make([]byte, 0, (c.pubKey.N.BitLen()+128)/8+sha256.Size)
+	for counter := uint32(0); len(expanded) < (c.pubKey.N.BitLen()+128)/8; counter++ {
+		h.Reset()
+		_ = binary.Write(h, binary.BigEndian, counter)
+		h.Write(digest)
+		expanded = h.Sum(expanded)
+	}
+
+	return new(big.Int).Mod(new(big.Int).SetBytes(expanded), c.pubKey.N)
+}
+
+// xTilde returns x^(4 * delta), the base with respect to which the correctness of signature shares is proven.
+func (c *ThreshRSAImpl) xTilde(x *big.Int) *big.Int {
+	return new(big.Int).Exp(x, new(big.Int).Lsh(c.delta, 2), c.pubKey.N)
+}
+
+// challenge computes the challenge of a proof of correctness of a signature share
+// as a hash of the values involved in the proof.
+func (c *ThreshRSAImpl) challenge(xTilde, vi, shareSquared, vPrime, xPrime *big.Int) *big.Int {
+	h := sha256.New()
+	for _, v := range []*big.Int{c.pubKey.V, xTilde, vi, shareSquared, vPrime, xPrime} {
+		b := v.Bytes()
+		_ = binary.Write(h, binary.BigEndian, uint32(len(b)))
+		h.Write(b)
+	}
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
+
+// lagrangeCoefficient returns delta times the Lagrange coefficient for interpolating the value at 0
+// from the values at the given indices, corresponding to the index at position i.
+// The result is always an integer.
+func (c *ThreshRSAImpl) lagrangeCoefficient(indices []int, i int) *big.Int {
+	num := new(big.Int).Set(c.delta)
+	den := big.NewInt(1)
+	for j, index := range indices {
+		if j != i {
+			num.Mul(num, big.NewInt(int64(-index)))
+			den.Mul(den, big.NewInt(int64(indices[i]-index)))
+		}
+	}
+	return num.Quo(num, den)
+}
+
+// GenerateThreshRSAKeys generates a threshold RSA key with numShares key shares,
+// any threshold of which are sufficient to produce a signature.
+// The modulus has modulusBits bits and is the product of two safe primes.
+// The randomness parameter should be backed by a high-quality source of entropy such as crypto/rand.Reader.
+// Note that generating safe primes is slow for secure modulus sizes.
+// The returned private key shares are to be passed, along with the public key, to NewThreshRSAImpl,
+// the share privShares[i] having index i+1.
+func GenerateThreshRSAKeys(
+	numShares int,
+	threshold int,
+	modulusBits int,
+	randomness io.Reader,
+) (pubKey *ThreshRSAPublicKey, privShares []*big.Int, err error) {
+
+	if threshold < 1 || threshold > numShares {
+		return nil, nil, fmt.Errorf("invalid threshold %d for %d shares", threshold, numShares)
+	}
+	if numShares >= threshRSAPublicExponent {
+		return nil, nil, fmt.Errorf("too many shares: %d", numShares)
+	}
+
+	// Generate the modulus n = p * q, where p = 2p' + 1 and q = 2q' + 1 are safe primes.
+	p, pPrime, err := generateSafePrime(modulusBits/2, randomness)
+	if err != nil {
+		return nil, nil, err
+	}
+	var q, qPrime *big.Int
+	for q == nil || q.Cmp(p) == 0 {
+		if q, qPrime, err = generateSafePrime(modulusBits-modulusBits/2, randomness); err != nil {
+			return nil, nil, err
+		}
+	}
+	n := new(big.Int).Mul(p, q)
+	m := new(big.Int).Mul(pPrime, qPrime)
+
+	// Compute the private exponent d, which is the secret being shared.
+	e := big.NewInt(threshRSAPublicExponent)
+	d := new(big.Int).ModInverse(e, m)
+	if d == nil {
+		return nil, nil, fmt.Errorf("public exponent not invertible")
+	}
+
+	// Share d using a random polynomial of degree threshold-1 over Z_m.
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = d
+	for i := 1; i < threshold; i++ {
+		if coefficients[i], err = crand.Int(randomness, m); err != nil {
+			return nil, nil, err
+		}
+	}
+	privShares = make([]*big.Int, numShares)
+	for i := range privShares {
+		privShares[i] = evaluatePolynomial(coefficients, big.NewInt(int64(i+1)), m)
+	}
+
+	// Choose a random square v and compute the verification keys v^s_i.
+	var u *big.Int
+	for u == nil || new(big.Int).GCD(nil, nil, u, n).Cmp(big.NewInt(1)) != 0 {
+		if u, err = crand.Int(randomness, n); err != nil {
+			return nil, nil, err
+		}
+	}
+	v := new(big.Int).Exp(u, big.NewInt(2), n)
+	verificationKeys := make([]*big.Int, numShares)
+	for i, s := range privShares {
+		verificationKeys[i] = new(big.Int).Exp(v, s, n)
+	}
+
+	return &ThreshRSAPublicKey{
+		N:                n,
+		E:                e,
+		V:                v,
+		VerificationKeys: verificationKeys,
+		Threshold:        threshold,
+	}, privShares, nil
+}
+
+// generateSafePrime returns a random safe prime p = 2p' + 1 of the given bit length and the prime p'.
+func generateSafePrime(bits int, randomness io.Reader) (p, pPrime *big.Int, err error) {
+	if bits < 16 {
+		return nil, nil, fmt.Errorf("safe prime too small: %d bits", bits)
+	}
+
+	buf := make([]byte, (bits+6)/8)
+	pPrime = new(big.Int)
+	p = new(big.Int)
+	for {
+		if _, err = io.ReadFull(randomness, buf); err != nil {
+			return nil, nil, err
+		}
+
+		// Make p' an odd number of bits-1 bits with the top two bits set, so that p has exactly the given length.
+		pPrime.SetBytes(buf)
+		for i := bits - 1; i < len(buf)*8; i++ {
+			pPrime.SetBit(pPrime, i, 0)
+		}
+		pPrime.SetBit(pPrime, bits-2, 1)
+		pPrime.SetBit(pPrime, bits-3, 1)
+		pPrime.SetBit(pPrime, 0, 1)
+		p.Lsh(pPrime, 1).Add(p, big.NewInt(1))
+
+		if hasSmallFactor(pPrime) || hasSmallFactor(p) {
+			continue
+		}
+		if pPrime.ProbablyPrime(20) && p.ProbablyPrime(20) {
+			return p, pPrime, nil
+		}
+	}
+}
+
+// smallPrimesProduct is the product of all odd primes smaller than 1000.
+var smallPrimesProduct = func() *big.Int {
+	product := big.NewInt(1)
+	for i := int64(3); i < 1000; i += 2 {
+		if big.NewInt(i).ProbablyPrime(0) {
+			product.Mul(product, big.NewInt(i))
+		}
+	}
+	return product
+}()
+
+// hasSmallFactor returns true if the (large) number x is divisible by an odd prime smaller than 1000.
+func hasSmallFactor(x *big.Int) bool {
+	return new(big.Int).GCD(nil, nil, x, smallPrimesProduct).Cmp(big.NewInt(1)) != 0
+}
+
+// evaluatePolynomial evaluates the polynomial with the given coefficients (starting with the constant one) at x,
+// modulo m.
+func evaluatePolynomial(coefficients []*big.Int, x *big.Int, m *big.Int) *big.Int {
+	result := new(big.Int)
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result.Mul(result, x)
+		result.Add(result, coefficients[i])
+		result.Mod(result, m)
+	}
+	return result
+}
+
+// factorial returns n!.
+func factorial(n int) *big.Int {
+	return new(big.Int).MulRange(1, int64(n))
+}
+
+// modExp returns x^y mod m, where y may be negative, in which case x must be invertible modulo m.
+func modExp(x, y, m *big.Int) *big.Int {
+	if y.Sign() >= 0 {
+		return new(big.Int).Exp(x, y, m)
+	}
+	inverse := new(big.Int).ModInverse(x, m)
+	if inverse == nil {
+		// Only happens if x reveals a factor of the modulus.
+		return big.NewInt(0)
+	}
+	return inverse.Exp(inverse, new(big.Int).Neg(y), m)
+}
+
+// encodeShare serializes a signature share along with the index of the key share that produced it
+// and the proof of its correctness.
+func encodeShare(index int, share, challenge, z *big.Int) []byte {
+	buf := make([]byte, 4, 4+3*4+len(share.Bytes())+len(challenge.Bytes())+len(z.Bytes()))
+	binary.BigEndian.PutUint32(buf, uint32(index))
+	for _, v := range []*big.Int{share, challenge, z} {
+		b := v.Bytes()
+		buf = append(buf, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(buf[len(buf)-4:], uint32(len(b)))
+		buf = append(buf, b...)
+	}
+	return buf
+}
+
+// decodeShare deserializes a signature share serialized by encodeShare.
+func decodeShare(data []byte) (index int, share, challenge, z *big.Int, err error) {
+	if len(data) < 4 {
+		return 0, nil, nil, nil, fmt.Errorf("signature share too short")
+	}
+	index = int(binary.BigEndian.Uint32(data))
+	data = data[4:]
+
+	values := make([]*big.Int, 3)
+	for i := range values {
+		if len(data) < 4 || uint64(len(data)-4) < uint64(binary.BigEndian.Uint32(data)) {
+			return 0, nil, nil, nil, fmt.Errorf("malformed signature share")
+		}
+		length := binary.BigEndian.Uint32(data)
+		values[i] = new(big.Int).SetBytes(data[4 : 4+length])
+		data = data[4+length:]
+	}
+	if len(data) != 0 {
+		return 0, nil, nil, nil, fmt.Errorf("malformed signature share")
+	}
+
+	return index, values[0], values[1], values[2], nil
+}
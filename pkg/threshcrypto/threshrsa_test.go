@@ -0,0 +1,57 @@
+package threshcrypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/mir/pkg/crypto"
+	"github.com/filecoin-project/mir/pkg/types"
+)
+
+func TestThreshRSA(t *testing.T) {
+	nodes := []types.NodeID{"0", "1", "2", "3"}
+	data := [][]byte{[]byte("hello"), []byte("world")}
+
+	impls := make([]ThreshCrypto, len(nodes))
+	shares := make([][]byte, len(nodes))
+	for i, nodeID := range nodes {
+		var err error
+		impls[i], err = NodePseudo(nodes, 3, nodeID, crypto.DefaultPseudoSeed)
+		require.NoError(t, err)
+		shares[i], err = impls[i].SignShare(data)
+		require.NoError(t, err)
+	}
+
+	// A signature share is only accepted as coming from the node that produced it and only for the same data.
+	for i := range nodes {
+		assert.NoError(t, impls[(i+1)%len(nodes)].VerifyShare(data, shares[i], nodes[i]))
+		assert.Error(t, impls[i].VerifyShare(data, shares[i], nodes[(i+1)%len(nodes)]))
+		assert.Error(t, impls[i].VerifyShare([][]byte{[]byte("hello")}, shares[i], nodes[i]))
+	}
+
+	// A tampered signature share is rejected.
+	tampered := append([]byte{}, shares[0]...)
+	tampered[len(tampered)-1]++
+	assert.Error(t, impls[1].VerifyShare(data, tampered, nodes[0]))
+
+	// Any 3 signature shares recover the same full signature, which is only valid for the same data.
+	sig, err := impls[0].Recover(data, shares[:3])
+	require.NoError(t, err)
+	assert.NoError(t, impls[1].VerifyFull(data, sig))
+	assert.Error(t, impls[1].VerifyFull([][]byte{[]byte("hello")}, sig))
+
+	otherSig, err := impls[2].Recover(data, [][]byte{shares[3], shares[1], shares[2]})
+	require.NoError(t, err)
+	assert.Equal(t, sig, otherSig)
+
+	// 2 signature shares are not enough, and neither are 3 shares from only 2 nodes.
+	_, err = impls[0].Recover(data, shares[:2])
+	assert.Error(t, err)
+	_, err = impls[0].Recover(data, [][]byte{shares[0], shares[1], shares[0]})
+	assert.Error(t, err)
+
+	_, err = NodePseudo(nodes, 3, "4", crypto.DefaultPseudoSeed)
+	assert.Error(t, err)
+}
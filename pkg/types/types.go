@@ -60,8 +60,8 @@ func NodeIDSlicePb(nids []NodeID) []string {
 type TxID string
 
 // Pb converts a TxID to its underlying native type.
-func (id TxID) Pb() string {
-	return string(id)
+func (id TxID) Pb() []byte {
+	return []byte(id)
 }
 
 // Bytes serializes the object to a sequence of bytes.
@@ -71,7 +71,7 @@ func (id TxID) Bytes() []byte {
 
 // TxIDSlice converts a slice of TxIDs represented directly as their underlying native type
 // to a slice of abstractly typed transaction IDs.
-func TxIDSlice(ids []string) []TxID {
+func TxIDSlice(ids [][]byte) []TxID {
 	txIDs := make([]TxID, len(ids))
 	for i, nid := range ids {
 		txIDs[i] = TxID(nid)
@@ -81,8 +81,8 @@ func TxIDSlice(ids []string) []TxID {
 
 // TxIDSlicePb converts a slice of TxIDs to a slice of the native type underlying TxID.
 // This is required for serialization using Protocol Buffers.
-func TxIDSlicePb(ids []TxID) []string {
-	pbSlice := make([]string, len(ids))
+func TxIDSlicePb(ids []TxID) [][]byte {
+	pbSlice := make([][]byte, len(ids))
 	for i, nid := range ids {
 		pbSlice[i] = nid.Pb()
 	}
@@ -95,8 +95,8 @@ func TxIDSlicePb(ids []TxID) []string {
 type BatchID string
 
 // Pb converts a BatchID to its underlying native type.
-func (id BatchID) Pb() string {
-	return string(id)
+func (id BatchID) Pb() []byte {
+	return []byte(id)
 }
 
 // Bytes serializes the object to a sequence of bytes.
@@ -106,7 +106,7 @@ func (id BatchID) Bytes() []byte {
 
 // BatchIDSlice converts a slice of BatchIDs represented directly as their underlying native type
 // to a slice of abstractly typed batch IDs.
-func BatchIDSlice(ids []string) []BatchID {
+func BatchIDSlice(ids [][]byte) []BatchID {
 	batchIDs := make([]BatchID, len(ids))
 	for i, nid := range ids {
 		batchIDs[i] = BatchID(nid)
@@ -116,8 +116,8 @@ func BatchIDSlice(ids []string) []BatchID {
 
 // BatchIDSlicePb converts a slice of BatchIDs to a slice of the native type underlying BatchID.
 // This is required for serialization using Protocol Buffers.
-func BatchIDSlicePb(ids []BatchID) []string {
-	pbSlice := make([]string, len(ids))
+func BatchIDSlicePb(ids []BatchID) [][]byte {
+	pbSlice := make([][]byte, len(ids))
 	for i, nid := range ids {
 		pbSlice[i] = nid.Pb()
 	}
@@ -225,6 +225,16 @@ func (tri TimerRetIndex) Pb() uint64 {
 
 // ================================================================================
 
+// RetentionIndex represents the retention index used for garbage-collecting the availability layer and the mempool.
+type RetentionIndex uint64
+
+// Pb converts a RetentionIndex to its underlying native type.
+func (ri RetentionIndex) Pb() uint64 {
+	return uint64(ri)
+}
+
+// ================================================================================
+
 // SBInstanceNr identifies the instance of Sequenced Broadcast (SB) within an epoch.
 type SBInstanceNr uint64
 
@@ -233,6 +243,11 @@ func (i SBInstanceNr) Pb() uint64 {
 	return uint64(i)
 }
 
+// Bytes converts a SBInstanceNr to a slice of bytes (useful for serialization).
+func (i SBInstanceNr) Bytes() []byte {
+	return uint64ToBytes(uint64(i))
+}
+
 // ================================================================================
 
 // EpochNr represents the number of an epoch.
@@ -243,6 +258,11 @@ func (e EpochNr) Pb() uint64 {
 	return uint64(e)
 }
 
+// Bytes converts an EpochNr to a slice of bytes (useful for serialization).
+func (e EpochNr) Bytes() []byte {
+	return uint64ToBytes(uint64(e))
+}
+
 // ================================================================================
 
 // NumRequests represents the number of requests (e.g. pending in some buffer)
@@ -8,3 +8,10 @@ func Min[T constraints.Ordered](a, b T) T {
 	}
 	return b
 }
+
+func Max[T constraints.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -16,3 +16,23 @@ func Generate[T any](n int, f func(i int) T) []T {
 	}
 	return arr
 }
+
+// Contains returns true if value is an element of arr.
+func Contains[T comparable](arr []T, value T) bool {
+	for _, v := range arr {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Index returns the index of the first occurrence of value in arr, or -1 if value is not present in arr.
+func Index[T comparable](arr []T, value T) int {
+	for i, v := range arr {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
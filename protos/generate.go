@@ -16,12 +16,18 @@ package protos
 //go:generate protoc-events recordingpb/recordingpb.proto
 //go:generate protoc-events isspb/isspb.proto
 //go:generate protoc-events bcbpb/bcbpb.proto
+//go:generate protoc-events rbcpb/rbcpb.proto
+//go:generate protoc-events abbapb/abbapb.proto
+//go:generate protoc-events threshcryptopb/threshcryptopb.proto
 //go:generate protoc-events isspbftpb/isspbftpb.proto
 //go:generate protoc-events contextstorepb/contextstorepb.proto
 //go:generate protoc-events dslpb/dslpb.proto
 //go:generate protoc-events mempoolpb/mempoolpb.proto
+//go:generate protoc-events mempoolpb/simplemempoolpb/simplemempoolpb.proto
 //go:generate protoc-events availabilitypb/availabilitypb.proto
 //go:generate protoc-events availabilitypb/mscpb/mscpb.proto
+//go:generate protoc-events availabilitypb/avidpb/avidpb.proto
+//go:generate protoc-events commitlogpb/commitlogpb.proto
 
 //go:generate protoc --proto_path=. --go_out=:../pkg/ --go_opt=paths=source_relative simplewal/simplewal.proto
 //go:generate protoc --proto_path=. --go_out=:../samples/ --go_opt=paths=source_relative chat-demo/chatdemo.proto
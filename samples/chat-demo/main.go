@@ -172,6 +172,10 @@ func run() error {
 	// Create a Mir Node, attaching the ChatApp implementation and other modules.
 	// ================================================================================
 
+	// Create a notifier module that reports requests rejected by ISS back to the clients.
+	// The same notifier is passed to the request receiver (created below), over whose connections it reports.
+	rejectionNotifier := requestreceiver.NewRejectionNotifier(logger)
+
 	// Create a Mir Node, using a default configuration and passing the modules initialized just above.
 	modulesWithDefaults, err := iss.DefaultModules(map[t.ModuleID]modules.Module{
 		"net": transport,
@@ -185,6 +189,9 @@ func run() error {
 		// consisting of a single zero byte and treats those signatures as valid.
 		// TODO: Remove this line once a default crypto implementation is provided by Mir.
 		"crypto": mirCrypto.New(&mirCrypto.DummyCrypto{DummySig: []byte{0}}),
+
+		// Rejected requests are reported to the clients through the request receiver.
+		"clients": rejectionNotifier,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize Mir modules: %w", err)
@@ -210,7 +217,7 @@ func run() error {
 	// Create a request receiver and start receiving requests.
 	// Note that the RequestReceiver is _not_ part of the Node as its module.
 	// It is external to the Node and only submits requests it receives to the node.
-	reqReceiver := requestreceiver.NewRequestReceiver(node, "iss", logger)
+	reqReceiver := requestreceiver.NewRequestReceiver(node, "iss", nil, rejectionNotifier, logger)
 	if err := reqReceiver.Start(reqReceiverBasePort + ownID); err != nil {
 		return fmt.Errorf("could not start request receiver: %w", err)
 	}